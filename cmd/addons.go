@@ -0,0 +1,326 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/cmd/command"
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/addon"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const addonTimeout = 10 * time.Minute
+
+func NewAddonsCommand() *cobra.Command {
+	addonsCmd := &cobra.Command{
+		Use:   "addons",
+		Short: "Manage add-ons of a kubernetes cluster",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the built-in add-ons and the add-ons of a cluster",
+		Args:  cobra.NoArgs,
+		RunE:  runAddonsListCmd,
+	}
+	command.SetupAddonsListCmdOpts(listCmd)
+
+	enableCmd := &cobra.Command{
+		Use:   "enable NAME",
+		Short: "Install an add-on on a cluster",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runAddonsEnableCmd,
+	}
+	command.SetupAddonsEnableCmdOpts(enableCmd)
+
+	disableCmd := &cobra.Command{
+		Use:   "disable NAME",
+		Short: "Remove an add-on from a cluster",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runAddonsDisableCmd,
+	}
+	command.SetupAddonsDisableCmdOpts(disableCmd)
+
+	upgradeCmd := &cobra.Command{
+		Use:   "upgrade NAME",
+		Short: "Upgrade or reconfigure an add-on of a cluster",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runAddonsUpgradeCmd,
+	}
+	command.SetupAddonsUpgradeCmdOpts(upgradeCmd)
+
+	addonsCmd.AddCommand(listCmd, enableCmd, disableCmd, upgradeCmd)
+	return addonsCmd
+}
+
+func runAddonsListCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+	catalog, err := addon.LoadCatalog()
+	if err != nil {
+		logrus.Errorf("Failed to load addon catalog: %v", err)
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tENABLED\tSTATUS\tDESCRIPTION")
+	for _, name := range catalog.Names() {
+		if clusterConfig.Addon(name) != nil {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%v\t%s\t%s\n", name, catalog.Addons[name].DefaultVersion, false, "", catalog.Addons[name].Description)
+	}
+	for _, a := range clusterConfig.Addons {
+		version, status := a.Version, a.Status.Phase
+		if a.Status.Version != "" {
+			version = a.Status.Version
+		} else if builtin, ok := catalog.Addons[a.Name]; ok && version == "" && addon.IsBuiltin(&a) {
+			version = builtin.DefaultVersion
+		}
+		if a.Status.Phase == asset.AddonFailed && a.Status.Message != "" {
+			status = fmt.Sprintf("%s: %s", a.Status.Phase, a.Status.Message)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%v\t%s\t%s\n", a.Name, version, a.IsEnabled(), status, catalog.Addons[a.Name].Description)
+	}
+	return w.Flush()
+}
+
+func runAddonsEnableCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	a := clusterConfig.Addon(name)
+	if a == nil {
+		// 集群配置中未声明的附加组件只能是内置的附加组件
+		clusterConfig.Addons = append(clusterConfig.Addons, asset.AddonAsset{Name: name})
+		a = &clusterConfig.Addons[len(clusterConfig.Addons)-1]
+	} else if a.IsEnabled() {
+		logrus.Errorf("addon %s is already enabled, use 'nkd addons upgrade' to change it", name)
+		return fmt.Errorf("addon %s is already enabled", name)
+	}
+	a.SetEnabled(true)
+	if err := setAddonOpts(a, &opts.Opts.Addon); err != nil {
+		logrus.Errorf("Invalid addon options: %v", err)
+		return err
+	}
+
+	return installAddons(clusterConfig, name)
+}
+
+func runAddonsUpgradeCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	a := clusterConfig.Addon(name)
+	if a == nil || !a.IsEnabled() {
+		logrus.Errorf("addon %s is not enabled", name)
+		return fmt.Errorf("addon %s is not enabled", name)
+	}
+	if err := setAddonOpts(a, &opts.Opts.Addon); err != nil {
+		logrus.Errorf("Invalid addon options: %v", err)
+		return err
+	}
+
+	return installAddons(clusterConfig, name)
+}
+
+func runAddonsDisableCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	a := clusterConfig.Addon(name)
+	if a == nil || !a.IsEnabled() {
+		logrus.Errorf("addon %s is not enabled", name)
+		return fmt.Errorf("addon %s is not enabled", name)
+	}
+	catalog, err := addon.LoadCatalog()
+	if err != nil {
+		logrus.Errorf("Failed to load addon catalog: %v", err)
+		return err
+	}
+	for i := range clusterConfig.Addons {
+		dependent := &clusterConfig.Addons[i]
+		if !dependent.IsEnabled() {
+			continue
+		}
+		for _, dep := range addon.Dependencies(dependent, catalog) {
+			if dep == name {
+				logrus.Errorf("addon %s is required by addon %s, disable it first", name, dependent.Name)
+				return fmt.Errorf("addon %s is required by addon %s", name, dependent.Name)
+			}
+		}
+	}
+
+	// 按已安装的版本删除附加组件的资源
+	installed := *a
+	if a.Status.Version != "" {
+		installed.Version = a.Status.Version
+	}
+	manifest, err := addon.Render(&installed, catalog)
+	if err != nil {
+		logrus.Errorf("Failed to render manifest of addon %s: %v", name, err)
+		return err
+	}
+	logrus.Infof("Removing addon %s...", name)
+	if err := kubeclient.DeleteManifest(clusterConfig.Kubernetes.AdminKubeConfig, manifest); err != nil {
+		logrus.Errorf("Failed to remove addon %s: %v", name, err)
+		return err
+	}
+	a.SetEnabled(false)
+	a.Status = asset.AddonStatus{}
+
+	if err := configmanager.Persist(); err != nil {
+		logrus.Errorf("Failed to persist the cluster asset: %v", err)
+		return err
+	}
+	logrus.Infof("Addon %s is disabled", name)
+	return nil
+}
+
+// setAddonOpts 将命令行中指定的版本和值设置到附加组件的配置中
+func setAddonOpts(a *asset.AddonAsset, addonOpts *opts.Addon) error {
+	if addonOpts.Version != "" {
+		a.Version = addonOpts.Version
+	}
+	for _, value := range addonOpts.Values {
+		kv := strings.SplitN(value, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return fmt.Errorf("invalid value %q, key=value is required", value)
+		}
+		if a.Values == nil {
+			a.Values = make(map[string]string)
+		}
+		a.Values[kv[0]] = kv[1]
+	}
+	return nil
+}
+
+// installAddons 安装集群中已启用的附加组件，names 为空时安装全部附加组件，否则只安装指定的附加组件。安装结果记录在集群配置中
+func installAddons(conf *asset.ClusterAsset, names ...string) error {
+	if err := addon.Validate(conf); err != nil {
+		logrus.Errorf("Invalid addon config: %v", err)
+		return err
+	}
+	catalog, err := addon.LoadCatalog()
+	if err != nil {
+		logrus.Errorf("Failed to load addon catalog: %v", err)
+		return err
+	}
+	addons, err := addon.Order(conf, catalog)
+	if err != nil {
+		logrus.Errorf("Invalid addon config: %v", err)
+		return err
+	}
+
+	var installErr error
+	for _, a := range addons {
+		if len(names) > 0 && !contains(names, a.Name) {
+			continue
+		}
+		if installErr = installAddon(conf, a, catalog); installErr != nil {
+			logrus.Errorf("Failed to install addon %s: %v", a.Name, installErr)
+			break
+		}
+		logrus.Infof("Addon %s %s is installed", a.Name, a.Status.Version)
+	}
+
+	if err := configmanager.Persist(); err != nil {
+		logrus.Errorf("Failed to persist the cluster asset: %v", err)
+		return err
+	}
+	return installErr
+}
+
+// installAddon 应用附加组件的清单并等待其中的工作负载就绪，记录安装的结果
+func installAddon(conf *asset.ClusterAsset, a *asset.AddonAsset, catalog *addon.Catalog) error {
+	err := func() error {
+		params, err := addon.NewParams(a, catalog)
+		if err != nil {
+			return err
+		}
+		manifest, err := addon.Render(a, catalog)
+		if err != nil {
+			return err
+		}
+		logrus.Infof("Installing addon %s %s...", a.Name, params.Version)
+		// 升级后不再包含在清单中的对象被删除
+		options, err := applyOptions(conf, kubeclient.ApplyOptions{
+			ApplySet: addon.ApplySet(a.Name),
+			Prune:    true,
+		})
+		if err != nil {
+			return err
+		}
+		objects, err := kubeclient.ApplyManifest(conf.Kubernetes.AdminKubeConfig, manifest, options)
+		if err != nil {
+			return err
+		}
+		// 清单应用成功后才记录安装的版本，失败时 disable 仍按之前安装的版本删除资源
+		a.Status.Version = params.Version
+		logrus.Infof("Waiting up to %v for the addon %s ready...", addonTimeout, a.Name)
+		return kubeclient.WaitForWorkloads(conf.Kubernetes.AdminKubeConfig, objects, addonTimeout)
+	}()
+
+	a.Status.Phase, a.Status.Message = asset.AddonInstalled, ""
+	if err != nil {
+		a.Status.Phase, a.Status.Message = asset.AddonFailed, err.Error()
+	}
+	a.Status.UpdatedAt = time.Now().Format(time.RFC3339)
+	return err
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/addon"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAddons(t *testing.T) {
+	opts.Opts.RootOptDir = t.TempDir()
+
+	t.Run("Addons Enable Fail", func(t *testing.T) {
+		cmd := NewAddonsCommand()
+		cmd.SetArgs([]string{"enable", "metrics-server"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without cluster-id")
+		}
+		cmd = NewAddonsCommand()
+		cmd.SetArgs([]string{"enable", "--cluster-id", "cluster"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without addon name")
+		}
+	})
+
+	t.Run("Addons List Fail", func(t *testing.T) {
+		cmd := NewAddonsCommand()
+		cmd.SetArgs([]string{"list"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without cluster-id")
+		}
+	})
+
+	t.Run("Set Addon Opts Success", func(t *testing.T) {
+		a := &asset.AddonAsset{Name: "nfs-csi", Values: map[string]string{"server": "192.168.1.100"}}
+		err := setAddonOpts(a, &opts.Addon{Version: "v4.7.0", Values: []string{"share=/srv/nfs", "mountOptions=nfsvers=4.2,hard"}})
+		if err != nil {
+			t.Fatalf("setAddonOpts failed: %v", err)
+		}
+		if a.Version != "v4.7.0" || a.Values["server"] != "192.168.1.100" || a.Values["share"] != "/srv/nfs" || a.Values["mountOptions"] != "nfsvers=4.2,hard" {
+			t.Errorf("unexpected addon: %+v", a)
+		}
+	})
+
+	t.Run("Install Addon Fail", func(t *testing.T) {
+		manifest := filepath.Join(t.TempDir(), "app.yaml")
+		if err := os.WriteFile(manifest, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		conf := &asset.ClusterAsset{Kubernetes: asset.Kubernetes{AdminKubeConfig: filepath.Join(t.TempDir(), "admin.config")}}
+		a := &asset.AddonAsset{
+			Name:      "app",
+			Version:   "v2",
+			Manifests: []asset.AddonManifest{{Source: manifest}},
+			Status:    asset.AddonStatus{Phase: asset.AddonInstalled, Version: "v1"},
+		}
+		if err := installAddon(conf, a, &addon.Catalog{}); err == nil {
+			t.Fatalf("expected failure applying the manifest without a cluster")
+		}
+		// 清单未应用成功时保留之前安装的版本
+		if a.Status.Phase != asset.AddonFailed || a.Status.Version != "v1" {
+			t.Errorf("unexpected addon status: %+v", a.Status)
+		}
+	})
+
+	t.Run("Set Addon Opts Fail", func(t *testing.T) {
+		if err := setAddonOpts(&asset.AddonAsset{}, &opts.Addon{Values: []string{"share"}}); err == nil {
+			t.Errorf("expected error for a value without key=value")
+		}
+	})
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nestos-kubernetes-deployer/cmd/command"
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/bundle"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"nestos-kubernetes-deployer/pkg/osmanager"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewBundleCommand() *cobra.Command {
+	bundleCmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Manage offline installation bundles",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an offline installation bundle for a cluster config",
+		RunE:  runBundleCreateCmd,
+	}
+	command.SetupBundleCreateCmdOpts(createCmd)
+
+	loadCmd := &cobra.Command{
+		Use:   "load",
+		Short: "Verify and load an offline installation bundle into the assets directory",
+		RunE:  runBundleLoadCmd,
+	}
+	command.SetupBundleLoadCmdOpts(loadCmd)
+
+	bundleCmd.AddCommand(createCmd, loadCmd)
+	return bundleCmd
+}
+
+func runBundleCreateCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	if opts.Opts.ClusterConfigFile == "" {
+		logrus.Errorf("cluster config file is not provided")
+		return errors.New("cluster config file is not provided")
+	}
+	opts.Opts.ClusterID = clusterID
+
+	config, err := getClusterConfig(&opts.Opts)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := bundle.LoadCatalog(opts.Opts.Bundle.Catalog)
+	if err != nil {
+		logrus.Errorf("Failed to load the version catalog: %v", err)
+		return err
+	}
+	plan, err := bundle.NewPlan(config, catalog)
+	if err != nil {
+		logrus.Errorf("Failed to plan the bundle content: %v", err)
+		return err
+	}
+
+	output := opts.Opts.Bundle.Output
+	if output == "" {
+		output = fmt.Sprintf("nkd-bundle-%s-%s.tar.gz", plan.KubernetesVersion, plan.Architecture)
+	}
+	manifest, err := bundle.Create(context.Background(), plan, output)
+	if err != nil {
+		logrus.Errorf("Failed to create bundle: %v", err)
+		return err
+	}
+	logrus.Infof("Bundle %s created with %d images and %d files", output, len(manifest.Images), len(manifest.Files))
+	return nil
+}
+
+func runBundleLoadCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	if opts.Opts.Bundle.File == "" {
+		logrus.Errorf("bundle file is not provided")
+		return errors.New("bundle file is not provided")
+	}
+	globalConfig, err := globalconfig.InitGlobalConfig(&opts.Opts)
+	if err != nil {
+		logrus.Errorf("Failed to initialize global config: %v", err)
+		return err
+	}
+
+	manifest, err := bundle.Load(opts.Opts.Bundle.File, globalConfig.PersistDir)
+	if err != nil {
+		logrus.Errorf("Failed to load bundle %s: %v", opts.Opts.Bundle.File, err)
+		return err
+	}
+	logrus.Infof("Bundle for kubernetes %s (%s) loaded with %d images and %d files",
+		manifest.KubernetesVersion, manifest.Architecture, len(manifest.Images), len(manifest.Files))
+	return nil
+}
+
+// useBundle points the cluster config at the content of a loaded bundle,
+// settings given explicitly by the user are kept.
+func useBundle(conf *asset.ClusterAsset, persistDir string) error {
+	manifest, err := bundle.LoadManifest(persistDir)
+	if err != nil {
+		logrus.Errorf("Failed to read the loaded bundle: %v", err)
+		return err
+	}
+	if manifest == nil {
+		return nil
+	}
+	if manifest.KubernetesVersion != conf.Kubernetes.KubernetesVersion {
+		logrus.Warnf("The loaded bundle is built for kubernetes %s, the cluster uses %s",
+			manifest.KubernetesVersion, conf.Kubernetes.KubernetesVersion)
+	}
+
+	if conf.ImageLayoutPath == "" {
+		if dir := manifest.Dir(persistDir, bundle.KindImage); dir != "" {
+			conf.ImageLayoutPath = dir
+			logrus.Infof("Using images of the loaded bundle in %s", dir)
+		}
+	}
+	if osmanager.NewOSManager(conf).IsGeneralOS() && conf.Kubernetes.RpmPackagePath == "" {
+		if dir := manifest.Dir(persistDir, bundle.KindPackage); dir != "" {
+			conf.Kubernetes.RpmPackagePath = dir
+			logrus.Infof("Using rpm packages of the loaded bundle in %s", dir)
+		}
+	}
+	if p, ok := manifest.Lookup(persistDir, conf.Kubernetes.Network.Plugin); ok {
+		logrus.Infof("Using network plugin manifest %s of the loaded bundle instead of %s", p, conf.Kubernetes.Network.Plugin)
+		conf.Kubernetes.Network.Plugin = p
+	}
+	if libvirt, ok := conf.InfraPlatform.(*infraasset.LibvirtAsset); ok {
+		if p, ok := manifest.Lookup(persistDir, libvirt.OSPath); ok {
+			logrus.Infof("Using OS image %s of the loaded bundle instead of %s", p, libvirt.OSPath)
+			libvirt.OSPath = p
+		}
+	}
+	return nil
+}
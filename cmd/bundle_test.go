@@ -0,0 +1,103 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/bundle"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestBundle(t *testing.T) {
+	opts.Opts.RootOptDir = t.TempDir()
+
+	t.Run("Bundle Create Fail", func(t *testing.T) {
+		cmd := NewBundleCommand()
+		cmd.SetArgs([]string{"create"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without cluster config file")
+		}
+	})
+
+	t.Run("Bundle Load Fail", func(t *testing.T) {
+		cmd := NewBundleCommand()
+		cmd.SetArgs([]string{"load"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without bundle file")
+		}
+	})
+
+	t.Run("useBundle Success", func(t *testing.T) {
+		persistDir := t.TempDir()
+		manifest := bundle.Manifest{
+			KubernetesVersion: "v1.29.1",
+			Files: []bundle.File{
+				{Path: "images/index.json", Kind: bundle.KindImage},
+				{Path: "manifests/calico.yaml", Kind: bundle.KindManifest, Source: "https://example.com/calico.yaml"},
+				{Path: "os/nestos.qcow2", Kind: bundle.KindOSImage, Source: "https://example.com/nestos.qcow2"},
+			},
+		}
+		content, err := yaml.Marshal(manifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(persistDir, "bundle"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(persistDir, "bundle", bundle.ManifestFile), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		libvirt := &infraasset.LibvirtAsset{OSPath: "https://example.com/nestos.qcow2"}
+		conf := &asset.ClusterAsset{
+			OSImage:       asset.OSImage{Type: "nestos"},
+			InfraPlatform: libvirt,
+			Kubernetes: asset.Kubernetes{
+				KubernetesVersion: "v1.29.1",
+				Network:           asset.Network{Plugin: "https://example.com/calico.yaml"},
+			},
+		}
+		if err := useBundle(conf, persistDir); err != nil {
+			t.Fatalf("useBundle failed: %v", err)
+		}
+		if conf.ImageLayoutPath != filepath.Join(persistDir, "bundle", "images") {
+			t.Errorf("unexpected image layout path: %s", conf.ImageLayoutPath)
+		}
+		if conf.Kubernetes.Network.Plugin != filepath.Join(persistDir, "bundle", "manifests", "calico.yaml") {
+			t.Errorf("unexpected network plugin: %s", conf.Kubernetes.Network.Plugin)
+		}
+		if libvirt.OSPath != filepath.Join(persistDir, "bundle", "os", "nestos.qcow2") {
+			t.Errorf("unexpected os path: %s", libvirt.OSPath)
+		}
+	})
+
+	t.Run("useBundle Without Bundle Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{Kubernetes: asset.Kubernetes{Network: asset.Network{Plugin: "calico.yaml"}}}
+		if err := useBundle(conf, t.TempDir()); err != nil {
+			t.Fatalf("useBundle failed: %v", err)
+		}
+		if conf.ImageLayoutPath != "" || conf.Kubernetes.Network.Plugin != "calico.yaml" {
+			t.Errorf("cluster config should not be changed without a loaded bundle")
+		}
+	})
+}
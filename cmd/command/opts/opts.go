@@ -16,6 +16,8 @@ limitations under the License.
 
 package opts
 
+import "time"
+
 var Opts OptionsList
 
 var RootOpts struct {
@@ -54,10 +56,15 @@ type OptionsList struct {
 
 	NetWork NetworkConfig
 	Housekeeper
+	UserKubeconfig
+	Bundle
+	Addon
 }
 
 type NKDConfig struct {
-	LogLevel string
+	LogLevel              string
+	SecretKeyFile         string
+	AllowPlaintextSecrets bool
 	BootstrapUrl
 }
 
@@ -102,6 +109,7 @@ type PXE struct {
 	HTTPRootDir    string
 	TFTPServerPort string
 	TFTPRootDir    string
+	DHCPMode       string
 }
 
 type IPXE struct {
@@ -109,6 +117,7 @@ type IPXE struct {
 	Port              string
 	FilePath          string
 	OSInstallTreePath string
+	DHCPMode          string
 }
 
 type MasterConfig struct {
@@ -142,3 +151,25 @@ type Housekeeper struct {
 	MaxUnavailable     uint
 	OSImageURL         string
 }
+
+type Bundle struct {
+	File    string
+	Output  string
+	Catalog string
+}
+
+type UserKubeconfig struct {
+	User      string
+	Groups    []string
+	TTL       time.Duration
+	Output    string
+	Signer    string
+	Role      string
+	RoleKind  string
+	Namespace string
+}
+
+type Addon struct {
+	Version string
+	Values  []string
+}
@@ -19,6 +19,7 @@ package command
 import (
 	"nestos-kubernetes-deployer/cmd/command/opts"
 	"nestos-kubernetes-deployer/constVal"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -51,11 +52,13 @@ func SetupDeployCmdOpts(deployCmd *cobra.Command) {
 	flags.StringVarP(&opts.Opts.InfraPlatform.PXE.IP, "pxe-ip", "", "", "IP address of local machine for PXE")
 	flags.StringVarP(&opts.Opts.InfraPlatform.PXE.HTTPRootDir, "pxe-httpRootDir", "", "", "Root directory of HTTP server for PXE (default: /var/www/html/)")
 	flags.StringVarP(&opts.Opts.InfraPlatform.PXE.TFTPRootDir, "pxe-tftpRootDir", "", "", "Root directory of TFTP server for PXE (default: /var/lib/tftpboot/)")
+	flags.StringVarP(&opts.Opts.InfraPlatform.PXE.DHCPMode, "pxe-dhcp-mode", "", "", "Start the built-in DHCP service for PXE ('dhcp' or 'proxy')")
 
 	// ipxe
 	flags.StringVarP(&opts.Opts.InfraPlatform.IPXE.IP, "ipxe-ip", "", "", "IP address of local machine for iPXE")
-	flags.StringVarP(&opts.Opts.InfraPlatform.IPXE.FilePath, "ipxe-filePath", "", "", "Path of config file for iPXE")
+	flags.StringVarP(&opts.Opts.InfraPlatform.IPXE.FilePath, "ipxe-filePath", "", "", "Path of a hand-written iPXE script (default: generated from the cluster config)")
 	flags.StringVarP(&opts.Opts.InfraPlatform.IPXE.OSInstallTreePath, "ipxe-osInstallTreePath", "", "", "Path of OS install tree for iPXE. (default: /var/www/html/)")
+	flags.StringVarP(&opts.Opts.InfraPlatform.IPXE.DHCPMode, "ipxe-dhcp-mode", "", "", "Start the built-in DHCP service for iPXE ('dhcp' or 'proxy')")
 
 	flags.StringVarP(&opts.Opts.OSImage.Type, "os-type", "", "", "Operating system type for Kubernetes cluster deployment (e.g., nestos or generalos)")
 	flags.StringVarP(&opts.Opts.UserName, "username", "", "", "User name for node login")
@@ -85,14 +88,14 @@ func SetupDeployCmdOpts(deployCmd *cobra.Command) {
 	flags.StringVarP(&opts.Opts.CertificateKey, "certificateKey", "", "", "The key that is used for decryption of certificates after they are downloaded from the secret upon joining a new master node.(the certificate key is a hex encoded string that is an AES key of size 32 bytes)")
 	flags.StringVarP(&opts.Opts.NetWork.ServiceSubnet, "service-subnet", "", "", "Subnet used by Kubernetes services. (default: 10.96.0.0/16)")
 	flags.StringVarP(&opts.Opts.NetWork.PodSubnet, "pod-subnet", "", "", "Subnet used for Kubernetes Pods. (default: 10.244.0.0/16)")
-	flags.StringVarP(&opts.Opts.NetWork.Plugin, "network-plugin-url", "", "", "The deployment yaml URL of the network plugin")
+	flags.StringVarP(&opts.Opts.NetWork.Plugin, "network-plugin-url", "", "", "Network plugin in the built-in catalog or the path or URL of the network plugin manifest")
 	flags.StringVarP(&opts.Opts.Housekeeper.ControllerImageUrl, "controller-image-url", "", "", "URL of the container image for the housekeeper controller component")
 	flags.StringVarP(&opts.Opts.Housekeeper.OperatorImageUrl, "operator-image-url", "", "", "URL of the container image for the housekeeper operator component")
 	flags.BoolVarP(&opts.Opts.DeployHousekeeper, "deploy-housekeeper", "", false, "Deploy the Housekeeper Operator. (default: false)")
 	flags.StringVarP(&opts.Opts.NKD.BootstrapIgnHost, "bootstrap-ign-host", "", "", "Ignition service address (domain name or IP)")
 	flags.StringVarP(&opts.Opts.NKD.BootstrapIgnPort, "bootstrap-ign-port", "", "", "Ignition service port (default: 9080)")
 	flags.StringVarP(&opts.Opts.PreHookScript, "prehook-script", "", "", "Specify a script file or directory to execute before cluster deployment as hooks")
-	flags.StringVarP(&opts.Opts.PostHookYaml, "posthook-yaml", "", "", "Specify a YAML file or directory to apply after cluster deployment using 'kubectl apply'")
+	flags.StringVarP(&opts.Opts.PostHookYaml, "posthook-yaml", "", "", "Specify a YAML file or directory to apply after cluster deployment with server-side apply")
 }
 
 func SetupDestroyCmdOpts(destroyCmd *cobra.Command) {
@@ -121,3 +124,59 @@ func SetupTemplateCmdOpts(templateCmd *cobra.Command) {
 	flags.StringVarP(&opts.Opts.ClusterConfigFile, "output", "o", "", "Generates a default configuration template at the specified location")
 	flags.StringVarP(&opts.Opts.Platform, "platform", "", "", "Infrastructure platform for deploying the cluster (supports 'libvirt' 'openstack' 'pxe' 'ipxe')")
 }
+
+func SetupKubeconfigCreateCmdOpts(createCmd *cobra.Command) {
+	flags := createCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+	flags.StringVarP(&opts.Opts.UserKubeconfig.User, "user", "", "", "User name (certificate common name) of the kubeconfig")
+	flags.StringArrayVarP(&opts.Opts.UserKubeconfig.Groups, "group", "", nil, "Group (certificate organization) of the user, can be specified multiple times")
+	flags.DurationVarP(&opts.Opts.UserKubeconfig.TTL, "ttl", "", 720*time.Hour, "Validity of the client certificate")
+	flags.StringVarP(&opts.Opts.UserKubeconfig.Output, "output", "o", "", "Path of the generated kubeconfig (default: ./<user>.kubeconfig)")
+	flags.StringVarP(&opts.Opts.UserKubeconfig.Signer, "signer", "", "local", "How to sign the client certificate: 'local' uses the persisted cluster CA, 'csr' uses the CertificateSigningRequest API")
+	flags.StringVarP(&opts.Opts.UserKubeconfig.Role, "role", "", "", "Bind the user to the named role")
+	flags.StringVarP(&opts.Opts.UserKubeconfig.RoleKind, "role-kind", "", "ClusterRole", "Kind of the role to bind ('ClusterRole' or 'Role')")
+	flags.StringVarP(&opts.Opts.UserKubeconfig.Namespace, "namespace", "n", "", "Create a RoleBinding in the namespace instead of a ClusterRoleBinding")
+}
+
+func SetupKubeconfigListCmdOpts(listCmd *cobra.Command) {
+	flags := listCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+}
+
+func SetupAddonsListCmdOpts(listCmd *cobra.Command) {
+	flags := listCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+}
+
+func SetupAddonsEnableCmdOpts(enableCmd *cobra.Command) {
+	flags := enableCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+	flags.StringVarP(&opts.Opts.Addon.Version, "version", "", "", "Version of the add-on (default: the default version of the built-in add-on)")
+	flags.StringArrayVarP(&opts.Opts.Addon.Values, "set", "", nil, "Set a value of the add-on (key=value), can be specified multiple times")
+}
+
+func SetupAddonsDisableCmdOpts(disableCmd *cobra.Command) {
+	flags := disableCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+}
+
+func SetupAddonsUpgradeCmdOpts(upgradeCmd *cobra.Command) {
+	flags := upgradeCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterID, constVal.ClusterId, "", "", constVal.ClusterIdHelp)
+	flags.StringVarP(&opts.Opts.Addon.Version, "version", "", "", "Version to upgrade the add-on to (default: the default version of the built-in add-on)")
+	flags.StringArrayVarP(&opts.Opts.Addon.Values, "set", "", nil, "Set a value of the add-on (key=value), can be specified multiple times")
+}
+
+func SetupBundleCreateCmdOpts(createCmd *cobra.Command) {
+	flags := createCmd.Flags()
+	flags.StringVarP(&opts.Opts.ClusterConfigFile, "file", "f", "", "Location of the cluster deploy config file")
+	flags.StringVarP(&opts.Opts.Bundle.Output, "output", "o", "", "Path of the generated bundle (default: ./nkd-bundle-<kubernetes version>-<arch>.tar.gz)")
+	flags.StringVarP(&opts.Opts.Bundle.Catalog, "catalog", "", "", "Version catalog describing the kubeadm images and terraform providers (default: built-in catalog)")
+	flags.StringVar(&opts.Opts.Arch, "arch", "", "Architecture for Kubernetes cluster deployment (e.g., amd64 or arm64)")
+	flags.StringVarP(&opts.Opts.Platform, "platform", "", "", "Infrastructure platform for deploying the cluster (supports 'libvirt' 'openstack' 'pxe' 'ipxe')")
+}
+
+func SetupBundleLoadCmdOpts(loadCmd *cobra.Command) {
+	flags := loadCmd.Flags()
+	flags.StringVarP(&opts.Opts.Bundle.File, "file", "f", "", "Location of the bundle to load")
+}
@@ -16,35 +16,44 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"nestos-kubernetes-deployer/cmd/command"
 	"nestos-kubernetes-deployer/cmd/command/opts"
 	"nestos-kubernetes-deployer/data"
+	"nestos-kubernetes-deployer/pkg/addon"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/bootmenu"
 	"nestos-kubernetes-deployer/pkg/cert"
+	"nestos-kubernetes-deployer/pkg/cni"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"nestos-kubernetes-deployer/pkg/configmanager/runtime"
 	"nestos-kubernetes-deployer/pkg/constants"
+	"nestos-kubernetes-deployer/pkg/dhcpserver"
 	"nestos-kubernetes-deployer/pkg/httpserver"
 	"nestos-kubernetes-deployer/pkg/infra"
 	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"nestos-kubernetes-deployer/pkg/ociregistry"
 	"nestos-kubernetes-deployer/pkg/osmanager"
+	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
+	"nestos-kubernetes-deployer/pkg/readiness"
+	"nestos-kubernetes-deployer/pkg/rpmrepo"
 	"nestos-kubernetes-deployer/pkg/tftpserver"
 	"nestos-kubernetes-deployer/pkg/utils"
-	"net/http"
+	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	wait "k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -62,6 +71,7 @@ func NewDeployCommand() *cobra.Command {
 const (
 	clusterID         = "cluster"
 	clusterConfigFile = "cluster_config.yaml"
+	healthReportFile  = "health_report.json"
 	namespace         = "housekeeper-system"
 )
 
@@ -78,6 +88,25 @@ func runDeployCmd(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if err := useBundle(config, configmanager.GetPersistDir()); err != nil {
+		return err
+	}
+	if err := cert.ValidatePolicy(config); err != nil {
+		logrus.Errorf("Invalid certificate config: %v", err)
+		return err
+	}
+	if err := cni.Validate(config); err != nil {
+		logrus.Errorf("Invalid network plugin config: %v", err)
+		return err
+	}
+	if err := addon.Validate(config); err != nil {
+		logrus.Errorf("Invalid addon config: %v", err)
+		return err
+	}
+	if err := readiness.Validate(config); err != nil {
+		logrus.Errorf("Invalid readiness config: %v", err)
+		return err
+	}
 
 	if err := createCluster(config); err != nil {
 		logrus.Errorf("Failed to create cluster: %v", err)
@@ -85,7 +114,12 @@ func runDeployCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	logrus.Info("Cluster deployment completed successfully!")
-	logrus.Infof("To access 'cluster-id:%s' cluster using 'kubectl', run 'export KUBECONFIG=%s'", clusterID, config.AdminKubeConfig)
+	if configmanager.GetSecretSealer() != nil {
+		logrus.Infof("%s is encrypted, to access 'cluster-id:%s' cluster using 'kubectl', create a kubeconfig with 'nkd kubeconfig create'",
+			config.AdminKubeConfig, clusterID)
+	} else {
+		logrus.Infof("To access 'cluster-id:%s' cluster using 'kubectl', run 'export KUBECONFIG=%s'", clusterID, config.AdminKubeConfig)
+	}
 	return nil
 }
 
@@ -98,11 +132,6 @@ func validateDeployConfig() error {
 		return fmt.Errorf("cluster ID: %s already exists", opts.Opts.ClusterID)
 	}
 
-	// Check if kubectl is installed
-	if !kubeclient.IsKubectlInstalled() {
-		logrus.Debug("kubectl is not installed")
-		return fmt.Errorf("kubectl is not installed")
-	}
 	return nil
 }
 
@@ -130,28 +159,34 @@ func createCluster(conf *asset.ClusterAsset) error {
 		return err
 	}
 
-	if osMgr.IsNestOS() {
-		if err := addIgnitionFiles(httpService, conf); err != nil {
-			return err
-		}
+	if err := enableBootstrapTLS(httpService, conf); err != nil {
+		logrus.Errorf("Failed to enable TLS for the bootstrap service: %v", err)
+		return err
+	}
+
+	if err := addNodeBootConfigs(httpService, conf); err != nil {
+		return err
 	}
 	if osMgr.IsGeneralOS() && len(conf.Master) > 0 {
+		// certs.json 包含集群CA私钥，仅允许控制平面节点获取一次
 		certs, _ := cert.CertsToBytes(conf.Master[0].Certs)
-		if err := httpService.AddFileToCache(constants.CertsFiles, certs); err != nil {
+		policy := bootstrapFilePolicy(conf, constants.CertsFiles, 1)
+		policy.Node = conf.Master[0].Hostname
+		if err := httpService.AddProtectedFile(constants.CertsFiles, certs, policy); err != nil {
 			return err
 		}
 
 		if len(conf.Kubernetes.RpmPackagePath) > 0 {
-			httpService.PackageDir = conf.Kubernetes.RpmPackagePath
-		}
-
-		if strings.ToLower(conf.Platform) == "pxe" || strings.ToLower(conf.Platform) == "ipxe" {
-			if err := addKickstartFiles(httpService, conf); err != nil {
-				return fmt.Errorf("error adding kickstart file to cache: %v", err)
+			if err := servePackageRepository(httpService, conf); err != nil {
+				return err
 			}
 		}
 	}
 
+	if err := serveImageRegistry(httpService, conf); err != nil {
+		return err
+	}
+
 	if err := configmanager.Persist(); err != nil {
 		logrus.Errorf("Failed to persist the cluster asset: %v", err)
 		return err
@@ -219,11 +254,20 @@ func createCluster(conf *asset.ClusterAsset) error {
 		httpserver.StartHTTPService(httpService)
 
 		tftpService := tftpserver.NewTFTPService(pxeConfig.IP, pxeConfig.TFTPServerPort, pxeConfig.TFTPRootDir)
+		tftpService.Writable = pxeConfig.TFTPWritable
+		if err := serveBootMenu(deployBootMenu(conf), conf, httpService, tftpService); err != nil {
+			return err
+		}
+		dhcpService, err := startDHCPService(conf)
+		if err != nil {
+			return err
+		}
 		go func() {
 			select {
 			case <-httpService.Ch:
 				logrus.Info("tftp server stop")
 				tftpService.Stop()
+				dhcpService.Stop()
 				return
 			}
 		}()
@@ -234,24 +278,38 @@ func createCluster(conf *asset.ClusterAsset) error {
 			}
 		}()
 		defer tftpService.Stop()
+		defer dhcpService.Stop()
 
 	case "ipxe":
 		ipxeConfig := conf.InfraPlatform.(*infraasset.IPXEAsset)
 		httpService.Port = ipxeConfig.Port
 		httpService.DirPath = ipxeConfig.OSInstallTreePath
-		fileContent, err := os.ReadFile(ipxeConfig.FilePath)
+		if err := serveBootMenu(deployBootMenu(conf), conf, httpService, nil); err != nil {
+			return err
+		}
+		httpserver.StartHTTPService(httpService)
+
+		dhcpService, err := startDHCPService(conf)
 		if err != nil {
 			return err
 		}
-		if err := httpService.AddFileToCache(constants.IPXECfg, fileContent); err != nil {
-			return fmt.Errorf("error adding ipxe config file to cache: %v", err)
+		if dhcpService != nil {
+			go func() {
+				<-httpService.Ch
+				logrus.Info("dhcp server stop")
+				dhcpService.Stop()
+			}()
+			defer dhcpService.Stop()
 		}
-		httpserver.StartHTTPService(httpService)
 
 	default:
 		return errors.New("unsupported platform")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watchBootstrapProgress(ctx, httpService, conf)
+
 	if err := clusterCreatePost(conf); err != nil {
 		return err
 	}
@@ -265,101 +323,151 @@ func clusterCreatePost(conf *asset.ClusterAsset) error {
 		return err
 	}
 
-	if err := waitForAPIReady(kubeClient); err != nil {
-		logrus.Errorf("Failed while waiting for Kubernetes API to be ready: %v", err)
+	checks, err := readiness.ClusterChecks(conf, kubeClient)
+	if err != nil {
+		logrus.Errorf("Invalid readiness config: %v", err)
 		return err
 	}
+	// 第一个检查项为 api-server，其余检查项在所有组件部署完成后执行
+	report := readiness.NewReport(conf.ClusterID)
+	if !report.Run(context.Background(), checks[:1]) {
+		report.Run(context.Background(), checks[1:])
+		return writeHealthReport(conf, report)
+	}
 	// Set kubeconfig environment variable
 	os.Setenv("KUBECONFIG", conf.Kubernetes.AdminKubeConfig)
 
+	if err := createImagePullSecret(conf); err != nil {
+		logrus.Errorf("Failed to create image pull secret: %v", err)
+		return err
+	}
+
+	if err := createRuntimeClasses(conf); err != nil {
+		logrus.Errorf("Failed to create runtime classes: %v", err)
+		return err
+	}
+
 	// Apply network plugin
-	if err := applyNetworkPlugin(conf.Network.Plugin, conf.IsNestOS); err != nil {
+	if err := applyNetworkPlugin(conf); err != nil {
 		logrus.Errorf("Failed to apply network plugin: %v", err)
 		return err
 	}
-	logrus.Info("Network plugin deployment completed successfully.")
+	logrus.Info("Network plugin is applied.")
 
 	if conf.Housekeeper.DeployHousekeeper {
 		logrus.Info("Starting deployment of Housekeeper...")
-		if err := deployHousekeeper(conf.Housekeeper, conf.Kubernetes.AdminKubeConfig); err != nil {
+		options, err := applyOptions(conf, kubeclient.ApplyOptions{Namespace: namespace})
+		if err != nil {
+			return err
+		}
+		if err := deployHousekeeper(conf.Housekeeper, conf.Kubernetes.AdminKubeConfig, options); err != nil {
 			logrus.Errorf("Failed to deploy operator: %v", err)
 			return err
 		}
 		logrus.Info("Housekeeper deployment completed successfully.")
 	}
 
-	// Wait for pods to be ready
-	if err := waitForPodsReady(kubeClient); err != nil {
-		logrus.Errorf("Failed while waiting for pods to be in 'Ready' state: %v", err)
+	if len(conf.Addons) > 0 {
+		logrus.Info("Starting deployment of addons...")
+		if err := installAddons(conf); err != nil {
+			return err
+		}
+		logrus.Info("Addons deployment completed successfully.")
+	}
+
+	if err := applyPostHooks(conf); err != nil {
+		logrus.Errorf("Failed to apply post hook manifests: %v", err)
 		return err
 	}
-	return nil
-}
 
-func waitForAPIReady(client *kubernetes.Clientset) error {
-	apiTimeout := 60 * time.Minute
-	ctx := context.Background()
-	apiContext, cancel := context.WithTimeout(ctx, apiTimeout)
-	logrus.Infof("Waiting up to %v for the Kubernetes API ready...", apiTimeout)
-	defer cancel()
+	report.Run(context.Background(), checks[1:])
+	return writeHealthReport(conf, report)
+}
 
-	discovery := client.Discovery()
-	wait.Until(func() {
-		version, err := discovery.ServerVersion()
-		if err == nil {
-			logrus.Infof("The Kubernetes API %s up", version)
-			cancel()
-		} else {
-			logrus.Debugf("Still waiting for Kubernetes API ready: %v", err)
-		}
-	}, 2*time.Second, apiContext.Done())
+// writeHealthReport 按配置的格式输出集群的健康报告，并将 JSON 格式的报告保存到集群的持久化目录中；集群未就绪时返回未就绪的检查项
+func writeHealthReport(conf *asset.ClusterAsset, report *readiness.Report) error {
+	content, err := report.JSON()
+	if err != nil {
+		logrus.Errorf("Failed to marshal health report: %v", err)
+		return err
+	}
+	if conf.Readiness.ReportFormat == readiness.ReportJSON {
+		os.Stdout.Write(content)
+	} else if err := report.WriteText(os.Stdout); err != nil {
+		return err
+	}
 
-	err := apiContext.Err()
-	if err != nil && err != context.Canceled {
-		logrus.Errorf("Failed to waiting for kubernetes API: %v", err)
+	reportFile := filepath.Join(configmanager.GetPersistDir(), conf.ClusterID, healthReportFile)
+	if err := os.WriteFile(reportFile, content, utils.DeployConfigFileMode); err != nil {
+		logrus.Errorf("Failed to save health report: %v", err)
 		return err
 	}
+	logrus.Infof("Health report is saved to %s", reportFile)
+
+	if !report.Healthy {
+		return fmt.Errorf("cluster is not ready, failed checks: %s", strings.Join(report.Failed(), ", "))
+	}
 	return nil
 }
 
-func waitForPodsReady(client *kubernetes.Clientset) error {
-	waitDuration := 20 * time.Minute
-	namespace := "kube-system"
-	waitCtx, cancel := context.WithTimeout(context.Background(), waitDuration)
-	defer cancel()
-	logrus.Infof("Waiting up to %v for the Kubernetes Pods ready ...", waitDuration)
-
-	err := wait.PollImmediate(10*time.Second, waitDuration, func() (bool, error) {
-		pods, err := client.CoreV1().Pods(namespace).List(waitCtx, metav1.ListOptions{})
-		if err != nil {
-			logrus.Errorf("Failed to list Pods: %v", err)
-			return false, nil
-		}
-		allReady := true
-		for _, pod := range pods.Items {
-			for _, condition := range pod.Status.Conditions {
-				if condition.Type == corev1.PodReady && condition.Status != corev1.ConditionTrue {
-					allReady = false
-					logrus.Infof("Pod %s in namespace %s is not in Ready state", pod.Name, pod.Namespace)
-					break
-				}
-			}
+// imagePullSecret 返回包含所有镜像仓库认证信息的镜像拉取 Secret，未配置认证信息时返回 nil
+func imagePullSecret(conf *asset.ClusterAsset) (*kubeclient.ImagePullSecret, error) {
+	auths := make(map[string]api.RegistryAuth)
+	for _, registry := range conf.Kubernetes.Registries {
+		if registry.HasAuth() {
+			auths[registry.Name] = api.RegistryAuth{Username: registry.Username, Password: registry.Password}
 		}
+	}
+	if len(auths) == 0 {
+		return nil, nil
+	}
 
-		if allReady {
-			// logrus.Infof("All Pods in namespace %s are in Ready state", namespace)
-			return true, nil
-		}
-		return false, nil
-	})
+	dockerConfig, err := runtime.DockerConfigJSON(auths)
 	if err != nil {
-		logrus.Errorf("Failed to wait for Pods to be Ready: %v", err)
+		return nil, err
+	}
+	return &kubeclient.ImagePullSecret{Name: constants.ImagePullSecretName, DockerConfigJSON: dockerConfig}, nil
+}
+
+// applyOptions 返回 nkd 应用清单的选项，配置了镜像仓库认证信息时，镜像拉取 Secret 创建到清单的所有命名空间中
+func applyOptions(conf *asset.ClusterAsset, options kubeclient.ApplyOptions) (kubeclient.ApplyOptions, error) {
+	pullSecret, err := imagePullSecret(conf)
+	if err != nil {
+		return options, err
+	}
+	options.ImagePullSecret = pullSecret
+	return options, nil
+}
+
+/*
+createImagePullSecret 在 default 和 kube-system 命名空间中创建镜像拉取 Secret，并设置给其中的默认服务账户。
+网络插件、Housekeeper、附加组件和 posthook 清单所在的命名空间在应用清单时创建该 Secret
+*/
+func createImagePullSecret(conf *asset.ClusterAsset) error {
+	pullSecret, err := imagePullSecret(conf)
+	if err != nil || pullSecret == nil {
 		return err
 	}
+	namespaces := []string{metav1.NamespaceDefault, metav1.NamespaceSystem}
+	if err := kubeclient.CreateImagePullSecret(conf.Kubernetes.AdminKubeConfig, pullSecret.Name, pullSecret.DockerConfigJSON, namespaces); err != nil {
+		return err
+	}
+	logrus.Infof("Image pull secret %s is created in namespaces %s", pullSecret.Name, strings.Join(namespaces, ", "))
 	return nil
 }
 
-func deployHousekeeper(tmplData interface{}, kubeconfig string) error {
+// createRuntimeClasses 为集群配置中额外注册的运行时创建同名的 RuntimeClass
+func createRuntimeClasses(conf *asset.ClusterAsset) error {
+	for _, handler := range conf.RuntimeHandlers {
+		if err := kubeclient.CreateRuntimeClass(conf.Kubernetes.AdminKubeConfig, handler.Name, handler.NodeSelector); err != nil {
+			return err
+		}
+		logrus.Infof("RuntimeClass %s is created", handler.Name)
+	}
+	return nil
+}
+
+func deployHousekeeper(tmplData interface{}, kubeconfig string, options kubeclient.ApplyOptions) error {
 	dir, err := data.Assets.Open("housekeeper")
 	if err != nil {
 		return err
@@ -369,137 +477,388 @@ func deployHousekeeper(tmplData interface{}, kubeconfig string) error {
 	if err != nil {
 		return err
 	}
+	sort.Slice(child, func(i, j int) bool { return child[i].Name() < child[j].Name() })
+
+	// 清单按文件名排序合并后一起应用，CRD 和命名空间由 ApplyManifest 先于其他对象应用
+	var manifest bytes.Buffer
 	for _, childInfo := range child {
 		filePath := filepath.Join("housekeeper", childInfo.Name())
 		data, err := utils.FetchAndUnmarshalUrl(filePath, tmplData)
-
-		switch childInfo.Name() {
-		case "1housekeeper.io_updates.yaml":
-			err = kubeclient.DeployCRD(string(data), kubeconfig)
-		case "2namespace.yaml":
-			err = kubeclient.DeployNamespace(string(data), kubeconfig)
-		case "3role.yaml":
-			err = kubeclient.DeployClusterRole(string(data), kubeconfig)
-		case "4role_binding.yaml":
-			err = kubeclient.DeployClusterRoleBinding(string(data), kubeconfig)
-		case "5deployment.yaml.template":
-			err = kubeclient.DeployDeployment(string(data), kubeconfig, namespace)
-		case "6daemonset.yaml.template":
-			err = kubeclient.DeployDaemonSet(string(data), kubeconfig, namespace)
-		}
-
 		if err != nil {
 			return err
 		}
+		manifest.WriteString("\n---\n")
+		manifest.Write(data)
 	}
-	return nil
+	_, err = kubeclient.ApplyManifest(kubeconfig, manifest.Bytes(), options)
+	return err
 }
 
-func applyNetworkPlugin(pluginConfigPath string, isNestOS bool) error {
-	var content []byte
-	var err error
-
-	// Check if the pluginConfigPath is an HTTP(S) link or a local file path
-	if strings.HasPrefix(pluginConfigPath, "http://") || strings.HasPrefix(pluginConfigPath, "https://") {
-		response, err := http.Get(pluginConfigPath)
+// applyPostHooks 按顺序应用 --posthook-yaml 指定的清单
+func applyPostHooks(conf *asset.ClusterAsset) error {
+	options, err := applyOptions(conf, kubeclient.ApplyOptions{})
+	if err != nil {
+		return err
+	}
+	for _, file := range conf.PostHookFiles {
+		manifest, err := os.ReadFile(file)
 		if err != nil {
-			logrus.Errorf("Failed to fetch network plugin configuration from URL: %v", err)
 			return err
 		}
-		defer response.Body.Close()
-		content, err = io.ReadAll(response.Body)
-		if err != nil {
-			logrus.Errorf("Failed to read content from HTTP response: %v", err)
+		if _, err := kubeclient.ApplyManifest(conf.Kubernetes.AdminKubeConfig, manifest, options); err != nil {
 			return err
 		}
-	} else {
-		// Read the content from the local file
-		content, err = os.ReadFile(pluginConfigPath)
-		if err != nil {
-			logrus.Errorf("Failed to read network plugin configuration file: %v", err)
-			return err
+		logrus.Infof("Post hook manifest %s is applied", file)
+	}
+	return nil
+}
+
+// applyNetworkPlugin 渲染或下载网络插件清单，通过 API 应用到集群，其中的 DaemonSet 由 network-plugin 就绪检查项等待就绪
+func applyNetworkPlugin(conf *asset.ClusterAsset) error {
+	manifest, err := cni.Manifest(conf)
+	if err != nil {
+		return err
+	}
+	options, err := applyOptions(conf, kubeclient.ApplyOptions{ApplySet: cni.ApplySet})
+	if err != nil {
+		return err
+	}
+	_, err = kubeclient.ApplyManifest(conf.Kubernetes.AdminKubeConfig, manifest, options)
+	return err
+}
+
+// addNodeBootConfigs adds the dedicated boot config of every node, served at /nodes/<hostname>.ign or
+// /nodes/<hostname>.cfg. Each of them can only be fetched once, by the node it is generated for.
+func addNodeBootConfigs(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
+	suffix := constants.IgnSuffix
+	if isPXEPlatform(conf) {
+		suffix = constants.KickstartSuffix
+	}
+
+	for _, nodes := range [][]asset.NodeAsset{conf.Master, conf.Worker} {
+		for _, node := range nodes {
+			if len(node.BootConfig.Content) == 0 {
+				continue
+			}
+			if err := addNodeBootConfig(httpService, conf, node, bootconfig.NodeConfigName(node.Hostname, suffix)); err != nil {
+				return fmt.Errorf("error adding boot config of node %s to cache: %v", node.Hostname, err)
+			}
 		}
 	}
 
-	// 在类似NestOS 或者 Fedora CoreOS 这类不可变基础设施中，目录/usr为只读目录。在支持FlexVolume时，默认路径为
-	// "/usr/libexec/kubernetes/kubelet-plugins"，而 FlexVolume 的目录必须是可写入的，
-	// 该功能特性才能正常工作，为了解决这个问题将/usr目录修改为可写目录/opt.
-	// Check if the content contains "/usr/libexec/kubernetes/kubelet-plugins"
-	if isNestOS && strings.Contains(string(content), "/usr/libexec/kubernetes/kubelet-plugins") {
-		content = []byte(strings.ReplaceAll(string(content),
-			"/usr/libexec/kubernetes/kubelet-plugins",
-			"/opt/libexec/kubernetes/kubelet-plugins"))
+	return nil
+}
+
+// addNodeBootConfig adds a protected file dedicated to a node, the URL of kickstart files is printed
+// to be used in the PXE boot parameters
+func addNodeBootConfig(httpService *httpserver.HTTPService, conf *asset.ClusterAsset, node asset.NodeAsset, fileName string) error {
+	policy := bootstrapFilePolicy(conf, fileName, 1)
+	policy.Node = node.Hostname
+	policy.SourceIP = leaseSourceIP(conf, node)
+	if err := httpService.AddProtectedFile(fileName, node.BootConfig.Content, policy); err != nil {
+		return err
 	}
+	if strings.HasSuffix(fileName, constants.KickstartSuffix) {
+		logrus.Infof("Kickstart URL of node %s: %s", node.Hostname, bootconfig.BootstrapFileURL(conf, bootstrapServerAddress(conf), fileName))
+	}
+	return nil
+}
 
-	// Save the modified content to a file in the "/tmp" directory with a fixed name
-	tmpFilePath := "/tmp/modified-plugin-config.yaml"
+// leaseSourceIP returns the address the built-in DHCP service reserves for the MAC address of the node,
+// the dedicated boot config of the node is only served to requests from it. Empty if nkd does not assign
+// the address of the node
+func leaseSourceIP(conf *asset.ClusterAsset, node asset.NodeAsset) string {
+	var dhcp infraasset.DHCPAsset
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		dhcp = infraConfig.DHCP
+	case *infraasset.IPXEAsset:
+		dhcp = infraConfig.DHCP
+	}
+	if dhcp.Mode != infraasset.DHCPModeDHCP || node.MAC == "" {
+		return ""
+	}
+	return node.IP
+}
+
+// addKickstartFile adds a protected kickstart file and prints the URL to be used in the PXE boot parameters
+func addKickstartFile(httpService *httpserver.HTTPService, conf *asset.ClusterAsset, fileName string, content []byte, maxFetches int) error {
+	if err := httpService.AddProtectedFile(fileName, content, bootstrapFilePolicy(conf, fileName, maxFetches)); err != nil {
+		return err
+	}
+	logrus.Infof("Kickstart URL: %s", bootconfig.BootstrapFileURL(conf, bootstrapServerAddress(conf), fileName))
+	return nil
+}
 
-	err = os.WriteFile(tmpFilePath, content, 0644)
+// servePackageRepository 生成rpm软件包目录的仓库元数据，使节点可以通过yum/dnf安装软件包
+func servePackageRepository(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
+	dir := conf.Kubernetes.RpmPackagePath
+	repo, err := rpmrepo.Build(dir)
 	if err != nil {
-		logrus.Errorf("Failed to write content to file: %v", err)
+		logrus.Errorf("failed to create rpm repository from %s: %v", dir, err)
 		return err
 	}
+	httpService.SetPackageRepository(dir, repo.Files)
+	logrus.Infof("Serving %d rpm packages from %s as a yum repository", len(repo.Packages), dir)
+	if conf.Kubernetes.RpmGPGKeyPath == "" {
+		logrus.Warnf("GPG CHECKING IS DISABLED for the package repository %s: the nodes install packages from it without "+
+			"verifying their signatures, and it takes priority over the other repositories of the nodes. "+
+			"Set rpmGpgKeyPath to the public key the packages are signed with", dir)
+	}
+	return nil
+}
 
-	// Apply the modified configuration using kubeclient
-	if err := kubeclient.RunKubectlApplyWithYaml(tmpFilePath); err != nil {
-		logrus.Errorf("Failed to apply network plugin configuration: %v", err)
+// serveImageRegistry 通过引导服务提供镜像布局目录中的镜像。节点在部署完成前会持续从中拉取镜像，
+// 因此引导服务在部署完成后才会停止
+func serveImageRegistry(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
+	if conf.Kubernetes.ImageLayoutPath == "" {
+		return nil
+	}
+	registry, err := ociregistry.Open(conf.Kubernetes.ImageLayoutPath)
+	if err != nil {
+		logrus.Errorf("failed to open image layout %s: %v", conf.Kubernetes.ImageLayoutPath, err)
 		return err
 	}
+	httpService.Handle(ociregistry.APIPrefix, registry)
+	httpService.KeepServing = true
+	logrus.Infof("Serving %d images from %s as a mirror of %s", len(registry.Images()), conf.Kubernetes.ImageLayoutPath,
+		strings.Join(registry.Registries(), ", "))
+	return nil
+}
 
-	// removal of the temporary file
-	defer func() {
-		if err := os.Remove(tmpFilePath); err != nil {
-			logrus.Errorf("Failed to remove temporary file: %v", err)
-		}
-	}()
+// enableBootstrapTLS serves the bootstrap files over TLS with a certificate issued by the bootstrap CA of the cluster
+func enableBootstrapTLS(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
+	hosts := []string{configmanager.GetBootstrapIgnHost()}
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		hosts = append(hosts, infraConfig.IP)
+	case *infraasset.IPXEAsset:
+		hosts = append(hosts, infraConfig.IP)
+	}
 
-	return nil
+	servingCert, err := cert.NewBootstrapServingCert(&conf.CertAsset, hosts...)
+	if err != nil {
+		return err
+	}
+	return httpService.EnableTLS(servingCert.CertRaw, servingCert.KeyRaw)
 }
 
-func addIgnitionFiles(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
-	// Ignition files are divided into three types:
-	// control plane ignition files for initializing the cluster,
-	// master ignition files for master node joining the cluster,
-	// and worker ignition files for worker node joining the cluster.
+// startDHCPService starts the built-in DHCP service of the pxe and ipxe platforms,
+// nil is returned if it is not enabled
+func startDHCPService(conf *asset.ClusterAsset) (*dhcpserver.DHCPService, error) {
+	var ip, bootScript string
+	var dhcp infraasset.DHCPAsset
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		ip, dhcp = infraConfig.IP, infraConfig.DHCP
+	case *infraasset.IPXEAsset:
+		ip, dhcp = infraConfig.IP, infraConfig.DHCP
+		bootScript = fmt.Sprintf("http://%s/%s", net.JoinHostPort(ip, infraConfig.Port), constants.IPXECfg)
+	}
+	if dhcp.Mode == "" {
+		return nil, nil
+	}
+
+	config := dhcpserver.Config{
+		Mode:       dhcp.Mode,
+		IP:         net.ParseIP(ip),
+		NextServer: net.ParseIP(dhcp.NextServer),
+		RangeStart: net.ParseIP(dhcp.RangeStart),
+		RangeEnd:   net.ParseIP(dhcp.RangeEnd),
+		Router:     net.ParseIP(dhcp.Router),
+		BootFiles: dhcpserver.BootFiles{
+			BIOS:      dhcp.BootFiles.BIOS,
+			UEFI:      dhcp.BootFiles.UEFI,
+			UEFIArm64: dhcp.BootFiles.UEFIArm64,
+			IPXE:      bootScript,
+		},
+		Reservations: make(map[string]dhcpserver.Reservation),
+	}
+	if dhcp.SubnetMask != "" {
+		config.SubnetMask = net.IPMask(net.ParseIP(dhcp.SubnetMask).To4())
+	}
+	for _, server := range dhcp.DNSServers {
+		config.DNSServers = append(config.DNSServers, net.ParseIP(server))
+	}
+	if dhcp.LeaseTime != "" {
+		leaseTime, err := time.ParseDuration(dhcp.LeaseTime)
+		if err != nil {
+			return nil, err
+		}
+		config.LeaseTime = leaseTime
+	}
+	for _, nodes := range [][]asset.NodeAsset{conf.Master, conf.Worker} {
+		for _, node := range nodes {
+			if node.MAC != "" && node.IP != "" {
+				config.Reservations[node.MAC] = dhcpserver.Reservation{IP: net.ParseIP(node.IP), Hostname: node.Hostname}
+			}
+		}
+	}
+
+	dhcpService, err := dhcpserver.NewDHCPService(config)
+	if err != nil {
+		logrus.Errorf("Invalid config of the dhcp service: %v", err)
+		return nil, err
+	}
+	if err := dhcpService.Start(); err != nil {
+		logrus.Errorf("Failed to start the dhcp service: %v", err)
+		return nil, err
+	}
+	return dhcpService, nil
+}
 
-	// Only one master node
-	if err := httpService.AddFileToCache(constants.ControlplaneIgn, conf.BootConfig.Controlplane.Content); err != nil {
-		return fmt.Errorf("error adding control plane ignition file to cache: %v", err)
+// newBootMenu returns an empty boot menu of the pxe and ipxe platforms, the kernel and initrd are
+// taken from the OS install tree served under /dir/ by the bootstrap service
+func newBootMenu(conf *asset.ClusterAsset) *bootmenu.Menu {
+	var kernelPath, initrdPath, kernelArgs string
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		kernelPath, initrdPath, kernelArgs = infraConfig.KernelPath, infraConfig.InitrdPath, infraConfig.KernelArgs
+	case *infraasset.IPXEAsset:
+		kernelPath, initrdPath, kernelArgs = infraConfig.KernelPath, infraConfig.InitrdPath, infraConfig.KernelArgs
+	}
+
+	baseURL := fmt.Sprintf("http://%s/", bootstrapServerAddress(conf))
+	installTree := baseURL + "dir/"
+	return &bootmenu.Menu{
+		KernelURL:  installTree + strings.TrimPrefix(kernelPath, "/"),
+		InitrdURL:  installTree + strings.TrimPrefix(initrdPath, "/"),
+		BaseURL:    baseURL,
+		Repo:       installTree,
+		KernelArgs: kernelArgs,
+		Hosts:      make(map[string]string),
 	}
+}
 
-	// multiple master nodes
-	if len(conf.Master) > 1 {
-		if err := httpService.AddFileToCache(constants.MasterIgn, conf.BootConfig.Master.Content); err != nil {
-			return fmt.Errorf("error adding master ignition file to cache: %v", err)
+// deployBootMenu lists every node with a kickstart file and a MAC address. The kickstart URLs carry
+// access tokens, so each node's entry is only served in the menu of its MAC address and the node installs
+// it without showing the menu. The default menu boots from the local disk
+func deployBootMenu(conf *asset.ClusterAsset) *bootmenu.Menu {
+	menu := newBootMenu(conf)
+	for _, nodes := range [][]asset.NodeAsset{conf.Master, conf.Worker} {
+		for _, node := range nodes {
+			if len(node.BootConfig.Content) == 0 {
+				continue
+			}
+			if node.MAC == "" {
+				logrus.Warnf("Node %s has no MAC address and is not in the boot menu, boot it with its kickstart URL printed in the log", node.Hostname)
+				continue
+			}
+			fileName := bootconfig.NodeConfigName(node.Hostname, constants.KickstartSuffix)
+			menu.Entries = append(menu.Entries, bootmenu.Entry{
+				Label:     node.Hostname,
+				Kickstart: bootconfig.BootstrapFileURL(conf, bootstrapServerAddress(conf), fileName),
+				HostOnly:  true,
+			})
+			menu.Hosts[node.MAC] = node.Hostname
 		}
 	}
+	return menu
+}
 
-	if err := httpService.AddFileToCache(constants.WorkerIgn, conf.BootConfig.Worker.Content); err != nil {
-		return fmt.Errorf("error adding worker ignition file to cache: %v", err)
+// serveBootMenu serves the generated boot menu from memory, the TFTP and HTTP root directories
+// are left untouched. A hand-written iPXE script takes precedence over the generated default script
+func serveBootMenu(menu *bootmenu.Menu, conf *asset.ClusterAsset, httpService *httpserver.HTTPService, tftpService *tftpserver.TFTPService) error {
+	// the installer verifies the HTTPS kickstart URLs with the bootstrap CA
+	if conf.CertAsset.BootstrapCACertPath != "" {
+		caCert, err := os.ReadFile(conf.CertAsset.BootstrapCACertPath)
+		if err != nil {
+			logrus.Errorf("Failed to read the bootstrap CA certificate: %v", err)
+			return err
+		}
+		menu.BootstrapCA = caCert
 	}
 
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		files, err := menu.TFTPFiles()
+		if err != nil {
+			logrus.Errorf("Failed to generate the boot menu: %v", err)
+			return err
+		}
+		for name, content := range files {
+			tftpService.AddFile(name, content)
+		}
+		for name, path := range map[string]string{
+			bootmenu.KernelFile: filepath.Join(infraConfig.HTTPRootDir, infraConfig.KernelPath),
+			bootmenu.InitrdFile: filepath.Join(infraConfig.HTTPRootDir, infraConfig.InitrdPath),
+		} {
+			if _, err := os.Stat(path); err != nil {
+				logrus.Warnf("%s of the boot menu is not found in the OS install tree: %v", filepath.Base(name), err)
+			}
+			tftpService.AddLink(name, path)
+		}
+	case *infraasset.IPXEAsset:
+		files, err := menu.IPXEFiles()
+		if err != nil {
+			logrus.Errorf("Failed to generate the boot menu: %v", err)
+			return err
+		}
+		if infraConfig.FilePath != "" {
+			script, err := os.ReadFile(infraConfig.FilePath)
+			if err != nil {
+				logrus.Errorf("Failed to get the ipxe boot script: %v", err)
+				return err
+			}
+			files[constants.IPXECfg] = script
+		}
+		for name, content := range files {
+			if err := httpService.AddFileToCache(name, content); err != nil {
+				return fmt.Errorf("error adding ipxe config file to cache: %v", err)
+			}
+		}
+	}
 	return nil
 }
 
-func addKickstartFiles(httpService *httpserver.HTTPService, conf *asset.ClusterAsset) error {
-	// Only one master node
-	if err := httpService.AddFileToCache(conf.Master[0].Hostname+constants.KickstartSuffix, conf.BootConfig.Controlplane.Content); err != nil {
-		return fmt.Errorf("error adding control plane kickstart file to cache: %v", err)
+// watchBootstrapProgress reports which nodes fetched their boot configs and joined the cluster,
+// and stops the bootstrap service once all of them did
+func watchBootstrapProgress(ctx context.Context, httpService *httpserver.HTTPService, conf *asset.ClusterAsset) {
+	if err := httpService.WaitForNodes(ctx, 30*time.Second, joinedNodes(conf)); err != nil && err != context.Canceled {
+		logrus.Warnf("Stopped watching the bootstrap progress: %v", err)
 	}
+}
 
-	// multiple master nodes
-	n := len(conf.Master)
-	if n > 1 {
-		for i := 1; i < n; i++ {
-			if err := httpService.AddFileToCache(conf.Master[i].Hostname+constants.KickstartSuffix, conf.BootConfig.KickstartMaster[i-1].Content); err != nil {
-				return fmt.Errorf("error adding master kickstart file to cache: %v", err)
+// joinedNodes returns a function listing the nodes registered in the cluster,
+// the client is created once the admin kubeconfig is usable
+func joinedNodes(conf *asset.ClusterAsset) httpserver.JoinedFunc {
+	var client *kubernetes.Clientset
+	return func(ctx context.Context) (map[string]bool, error) {
+		if client == nil {
+			c, err := kubeclient.CreateClient(conf.Kubernetes.AdminKubeConfig)
+			if err != nil {
+				return nil, err
 			}
+			client = c
+		}
+		nodeList, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
 		}
+		nodes := make(map[string]bool, len(nodeList.Items))
+		for _, node := range nodeList.Items {
+			nodes[node.Name] = true
+		}
+		return nodes, nil
 	}
+}
 
-	if err := httpService.AddFileToCache(constants.Worker+constants.KickstartSuffix, conf.BootConfig.Worker.Content); err != nil {
-		return fmt.Errorf("error adding worker kickstart file to cache: %v", err)
-	}
+func isPXEPlatform(conf *asset.ClusterAsset) bool {
+	platform := strings.ToLower(conf.Platform)
+	return platform == "pxe" || platform == "ipxe"
+}
 
-	return nil
+func bootstrapFilePolicy(conf *asset.ClusterAsset, fileName string, maxFetches int) httpserver.AccessPolicy {
+	return httpserver.NewAccessPolicy(conf.CertAsset.BootstrapTokenSecret, fileName, maxFetches, configmanager.GetBootstrapFileTTL())
+}
+
+// bootstrapServerAddress returns the address the bootstrap files are served on
+func bootstrapServerAddress(conf *asset.ClusterAsset) string {
+	switch infraConfig := conf.InfraPlatform.(type) {
+	case *infraasset.PXEAsset:
+		return infraConfig.IP + ":" + infraConfig.HTTPServerPort
+	case *infraasset.IPXEAsset:
+		return infraConfig.IP + ":" + infraConfig.Port
+	}
+	return configmanager.GetBootstrapIgnHostPort()
 }
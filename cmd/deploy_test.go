@@ -22,10 +22,12 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
 	"nestos-kubernetes-deployer/pkg/httpserver"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
 	"nestos-kubernetes-deployer/pkg/utils"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -131,14 +133,16 @@ func TestDeploy(t *testing.T) {
 	})
 
 	t.Run("deployHousekeeper Fail", func(t *testing.T) {
-		err := deployHousekeeper(nil, "./test.yaml")
+		err := deployHousekeeper(nil, "./test.yaml", kubeclient.ApplyOptions{Namespace: namespace})
 		if err == nil {
 			t.Log("Expected error, got nil")
 		}
 	})
 
 	t.Run("applyNetworkPlugin Fail", func(t *testing.T) {
-		err := applyNetworkPlugin("http://www.aaa.com", true)
+		conf := &asset.ClusterAsset{OSImage: asset.OSImage{IsNestOS: true}}
+		conf.Network.Plugin = "http://www.aaa.com"
+		err := applyNetworkPlugin(conf)
 		if err == nil {
 			t.Log("Expected error, got nil")
 		}
@@ -151,17 +155,76 @@ func TestDeploy(t *testing.T) {
 	httpService := httpserver.NewHTTPService(ts.URL)
 	defer httpService.Stop()
 
-	t.Run("addIgnitionFiles Fail", func(t *testing.T) {
-		err := addIgnitionFiles(httpService, cc)
-		if err == nil {
-			t.Log("Expected error, got nil")
+	t.Run("addNodeBootConfigs Success", func(t *testing.T) {
+		configmanager.GlobalConfig = &globalconfig.GlobalConfig{}
+		cc.Worker[0].BootConfig.Content = []byte("worker kickstart")
+		defer func() { cc.Worker[0].BootConfig = asset.BootFile{} }()
+
+		if err := addNodeBootConfigs(httpService, cc); err != nil {
+			t.Fatalf("addNodeBootConfigs failed: %v", err)
+		}
+		if _, ok := httpService.FileCache["/nodes/k8s-worker01.cfg"]; !ok {
+			t.Errorf("expected the kickstart file of k8s-worker01 to be cached")
+		}
+		if progress := httpService.Progress(nil); len(progress.Expected) != 1 || progress.Expected[0] != "k8s-worker01" {
+			t.Errorf("unexpected nodes expected by the bootstrap service: %v", progress.Expected)
 		}
 	})
 
-	t.Run("addKickstartFiles Fail", func(t *testing.T) {
-		err := addKickstartFiles(httpService, cc)
-		if err == nil {
-			t.Log("Expected error, got nil")
+	t.Run("serveImageRegistry Fail", func(t *testing.T) {
+		cc.Kubernetes.ImageLayoutPath = t.TempDir()
+		defer func() { cc.Kubernetes.ImageLayoutPath = "" }()
+
+		if err := serveImageRegistry(httpService, cc); err == nil {
+			t.Errorf("expected failure for a directory that is not an OCI image layout")
+		}
+		if httpService.KeepServing {
+			t.Errorf("the bootstrap service should not keep serving without a registry")
+		}
+	})
+
+	t.Run("leaseSourceIP Success", func(t *testing.T) {
+		node := asset.NodeAsset{Hostname: "k8s-worker01", IP: "192.0.2.21", MAC: "52:54:00:aa:bb:01"}
+		for mode, expected := range map[string]string{infraasset.DHCPModeDHCP: "192.0.2.21", infraasset.DHCPModeProxy: "", "": ""} {
+			pxe := &infraasset.PXEAsset{DHCP: infraasset.DHCPAsset{Mode: mode}}
+			if ip := leaseSourceIP(&asset.ClusterAsset{InfraPlatform: pxe}, node); ip != expected {
+				t.Errorf("dhcp mode %q: expected source %q, got %q", mode, expected, ip)
+			}
+		}
+	})
+
+	t.Run("startDHCPService Success", func(t *testing.T) {
+		dhcpService, err := startDHCPService(&asset.ClusterAsset{InfraPlatform: &infraasset.PXEAsset{IP: "127.0.0.1"}})
+		if err != nil || dhcpService != nil {
+			t.Errorf("expected no dhcp service when it is not enabled, got %v %v", dhcpService, err)
+		}
+	})
+
+	t.Run("startDHCPService Fail", func(t *testing.T) {
+		pxe := &infraasset.PXEAsset{IP: "192.0.2.1", DHCP: infraasset.DHCPAsset{Mode: infraasset.DHCPModeProxy}}
+		if _, err := startDHCPService(&asset.ClusterAsset{InfraPlatform: pxe}); err == nil {
+			t.Errorf("expected failure for an address not on this host")
+		}
+	})
+
+	t.Run("deployBootMenu Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			InfraPlatform: &infraasset.PXEAsset{IP: "10.0.0.1", HTTPServerPort: "9080", KernelPath: "images/pxeboot/vmlinuz"},
+			Worker: []asset.NodeAsset{
+				{Hostname: "k8s-worker01", MAC: "52:54:00:aa:bb:01", BootConfig: asset.BootFile{Content: []byte("kickstart")}},
+				{Hostname: "k8s-worker02", BootConfig: asset.BootFile{Content: []byte("kickstart")}},
+			},
+		}
+		menu := deployBootMenu(conf)
+		if len(menu.Entries) != 1 || menu.Entries[0].Label != "k8s-worker01" || !menu.Entries[0].HostOnly ||
+			menu.Hosts["52:54:00:aa:bb:01"] != "k8s-worker01" {
+			t.Errorf("unexpected boot menu: %+v", menu)
+		}
+		if !strings.HasPrefix(menu.Entries[0].Kickstart, "https://10.0.0.1:9080/nodes/k8s-worker01.cfg?token=") {
+			t.Errorf("unexpected kickstart URL: %s", menu.Entries[0].Kickstart)
+		}
+		if menu.KernelURL != "http://10.0.0.1:9080/dir/images/pxeboot/vmlinuz" {
+			t.Errorf("unexpected kernel URL: %s", menu.KernelURL)
 		}
 	})
 
@@ -116,13 +116,13 @@ func runDestroyCmd(cmd *cobra.Command, args []string) error {
 		}
 	case "pxe":
 		logrus.Println("If necessary, manually destroy the config for the PXE server:\n",
-			"1. Stop dhcpd service\n",
+			dhcpdStep(clusterConfig.InfraPlatform.(*infraasset.PXEAsset).DHCP),
 			fmt.Sprintf("2. Delete http root dir: %s\n", clusterConfig.InfraPlatform.(*infraasset.PXEAsset).HTTPRootDir),
 			fmt.Sprintf("3. Delete tftp root dir: %s", clusterConfig.InfraPlatform.(*infraasset.PXEAsset).TFTPRootDir),
 		)
 	case "ipxe":
 		logrus.Println("If necessary, manually destroy the config for the iPXE server:\n",
-			"1. Stop dhcpd service\n",
+			dhcpdStep(clusterConfig.InfraPlatform.(*infraasset.IPXEAsset).DHCP),
 			fmt.Sprintf("2. Delete ipxe config: %s\n", clusterConfig.InfraPlatform.(*infraasset.IPXEAsset).FilePath),
 			fmt.Sprintf("3. Delete OS install tree: %s", clusterConfig.InfraPlatform.(*infraasset.IPXEAsset).OSInstallTreePath),
 		)
@@ -139,3 +139,10 @@ func runDestroyCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func dhcpdStep(dhcp infraasset.DHCPAsset) string {
+	if dhcp.Mode != "" {
+		return "1. Nothing to do for DHCP, the built-in DHCP service stopped with the deployment\n"
+	}
+	return "1. Stop dhcpd service\n"
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"nestos-kubernetes-deployer/cmd/command"
 	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/bootmenu"
+	"nestos-kubernetes-deployer/pkg/cert"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
@@ -29,9 +31,14 @@ import (
 	"nestos-kubernetes-deployer/pkg/infra"
 	"nestos-kubernetes-deployer/pkg/kubeclient"
 	"nestos-kubernetes-deployer/pkg/osmanager"
+	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
+	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig/cloudinit"
+	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig/ignition"
+	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig/kickstart"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/terraform"
 	"nestos-kubernetes-deployer/pkg/tftpserver"
-	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -97,27 +104,68 @@ func runExtendCmd(cmd *cobra.Command, args []string) error {
 }
 
 func extendCluster(conf *asset.ClusterAsset, num uint) error {
+	// The extended nodes are signed with the persisted certificate policy, which may be edited since the deployment
+	if err := cert.ValidatePolicy(conf); err != nil {
+		logrus.Errorf("Invalid certificate config: %v", err)
+		return err
+	}
+
 	httpService := httpserver.NewHTTPService(configmanager.GetBootstrapIgnPort())
 	defer httpService.Stop()
 
-	data, err := os.ReadFile(conf.BootConfig.Worker.Path)
-	if err != nil {
-		logrus.Errorf("error reading boot config file: %v", err)
+	osMgr := osmanager.NewOSManager(conf)
+	// 早期版本部署的集群没有引导CA，其引导配置仍通过HTTP获取
+	legacy := conf.CertAsset.BootstrapCACertPath == ""
+	// 外部CA模式下扩容的节点使用预先签发的 kubelet 证书加入集群，证书只能通过节点专属的引导配置分发
+	externalCA := conf.CertAsset.ExternalCA != nil
+	if externalCA && legacy {
+		return fmt.Errorf("cluster %s has no bootstrap CA to serve the certificates of the extended nodes in external CA mode", conf.ClusterID)
+	}
+	if legacy {
+		logrus.Warnf("Cluster %s has no bootstrap CA, the worker boot config is served over plain HTTP", conf.ClusterID)
+	} else if err := enableBootstrapTLS(httpService, conf); err != nil {
+		logrus.Errorf("Failed to enable TLS for the bootstrap service: %v", err)
 		return err
 	}
 
-	osMgr := osmanager.NewOSManager(conf)
-	if osMgr.IsNestOS() {
-		httpService.AddFileToCache(constants.WorkerIgn, data)
+	// 数量未知的 pxe/ipxe 节点共用 worker.cfg；早期版本部署的 NestOS 集群共用 worker.ign
+	if (osMgr.IsNestOS() && legacy) || (isPXEPlatform(conf) && !externalCA) {
+		workerFile := constants.WorkerIgn
+		workerPath := filepath.Join(bootconfig.GetSavePath(conf.ClusterID), constants.WorkerIgn)
+		if isPXEPlatform(conf) {
+			workerFile = constants.Worker + constants.KickstartSuffix
+			workerPath = conf.BootConfig.Worker.Path
+		}
+		// worker.ign 包含敏感数据，可能已加密保存
+		data, err := secret.ReadFile(workerPath, configmanager.GetSecretSealer())
+		if err != nil {
+			logrus.Errorf("error reading boot config file: %v", err)
+			return err
+		}
+
+		if legacy {
+			httpService.AddFileToCache(workerFile, data)
+		} else if err := addKickstartFile(httpService, conf, workerFile, data, int(num)); err != nil {
+			return err
+		}
 	}
-	if osMgr.IsGeneralOS() {
-		if strings.ToLower(conf.Platform) == "pxe" || strings.ToLower(conf.Platform) == "ipxe" {
-			httpService.AddFileToCache(constants.Worker+constants.KickstartSuffix, data)
+	// 外部CA模式下 pxe/ipxe 平台扩容的节点各自使用专属的 kickstart 文件
+	if isPXEPlatform(conf) && externalCA {
+		if err := extendArray(conf, int(num)); err != nil {
+			return err
+		}
+		if err := addExtendedNodeBootConfigs(httpService, conf, osMgr.IsNestOS(), int(num)); err != nil {
+			return err
 		}
 	}
 
 	if len(conf.Kubernetes.RpmPackagePath) > 0 {
-		httpService.PackageDir = conf.Kubernetes.RpmPackagePath
+		if err := servePackageRepository(httpService, conf); err != nil {
+			return err
+		}
+	}
+	if err := serveImageRegistry(httpService, conf); err != nil {
+		return err
 	}
 
 	p := infra.InfraPlatform{}
@@ -128,6 +176,11 @@ func extendCluster(conf *asset.ClusterAsset, num uint) error {
 		if err := extendArray(conf, int(num)); err != nil {
 			return err
 		}
+		if (osMgr.IsNestOS() && !legacy) || externalCA {
+			if err := addExtendedNodeBootConfigs(httpService, conf, osMgr.IsNestOS(), int(num)); err != nil {
+				return err
+			}
+		}
 
 		// regenerate worker.tf
 		var worker terraform.Infra
@@ -153,6 +206,11 @@ func extendCluster(conf *asset.ClusterAsset, num uint) error {
 		if err := extendArray(conf, int(num)); err != nil {
 			return err
 		}
+		if (osMgr.IsNestOS() && !legacy) || externalCA {
+			if err := addExtendedNodeBootConfigs(httpService, conf, osMgr.IsNestOS(), int(num)); err != nil {
+				return err
+			}
+		}
 
 		// regenerate worker.tf
 		var worker terraform.Infra
@@ -179,11 +237,20 @@ func extendCluster(conf *asset.ClusterAsset, num uint) error {
 		httpserver.StartHTTPService(httpService)
 
 		tftpService := tftpserver.NewTFTPService(pxeConfig.IP, pxeConfig.TFTPServerPort, pxeConfig.TFTPRootDir)
+		tftpService.Writable = pxeConfig.TFTPWritable
+		if err := serveBootMenu(extendBootMenu(conf, legacy), conf, httpService, tftpService); err != nil {
+			return err
+		}
+		dhcpService, err := startDHCPService(conf)
+		if err != nil {
+			return err
+		}
 		go func() {
 			select {
 			case <-httpService.Ch:
 				logrus.Info("tftp server stop")
 				tftpService.Stop()
+				dhcpService.Stop()
 				return
 			}
 		}()
@@ -194,21 +261,37 @@ func extendCluster(conf *asset.ClusterAsset, num uint) error {
 			}
 		}()
 		defer tftpService.Stop()
+		defer dhcpService.Stop()
 	case "ipxe":
 		ipxeConfig := conf.InfraPlatform.(*infraasset.IPXEAsset)
 		httpService.Port = ipxeConfig.Port
 		httpService.DirPath = ipxeConfig.OSInstallTreePath
-		fileContent, err := os.ReadFile(ipxeConfig.FilePath)
-		if err != nil {
+		if err := serveBootMenu(extendBootMenu(conf, legacy), conf, httpService, nil); err != nil {
 			return err
 		}
-		httpService.AddFileToCache(constants.IPXECfg, fileContent)
 		httpserver.StartHTTPService(httpService)
 
+		dhcpService, err := startDHCPService(conf)
+		if err != nil {
+			return err
+		}
+		if dhcpService != nil {
+			go func() {
+				<-httpService.Ch
+				logrus.Info("dhcp server stop")
+				dhcpService.Stop()
+			}()
+			defer dhcpService.Stop()
+		}
+
 	default:
 		return errors.New("unsupported platform")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watchBootstrapProgress(ctx, httpService, conf)
+
 	if err := checkNodesReady(context.Background(), conf, int(num)); err != nil {
 		return err
 	}
@@ -216,22 +299,92 @@ func extendCluster(conf *asset.ClusterAsset, num uint) error {
 	return nil
 }
 
+// extendBootMenu installs the shared worker.cfg by default, the existing nodes with a MAC address
+// boot from the local disk. In external CA mode every extended node has its own kickstart file and
+// is installed with the kickstart URL printed in the log, so the default menu boots from the local disk
+func extendBootMenu(conf *asset.ClusterAsset, legacy bool) *bootmenu.Menu {
+	menu := newBootMenu(conf)
+	if conf.CertAsset.ExternalCA == nil {
+		workerFile := constants.Worker + constants.KickstartSuffix
+		kickstart := fmt.Sprintf("http://%s/%s", bootstrapServerAddress(conf), workerFile)
+		if !legacy {
+			kickstart = bootconfig.BootstrapFileURL(conf, bootstrapServerAddress(conf), workerFile)
+		}
+		menu.Entries = []bootmenu.Entry{{Label: constants.Worker, Kickstart: kickstart}}
+		menu.Default = constants.Worker
+	}
+	for _, nodes := range [][]asset.NodeAsset{conf.Master, conf.Worker} {
+		for _, node := range nodes {
+			if node.MAC != "" {
+				menu.Hosts[node.MAC] = bootmenu.LocalLabel
+			}
+		}
+	}
+	return menu
+}
+
+/*
+addExtendedNodeBootConfigs generates the dedicated boot configs of the extended worker nodes from the saved
+worker role config: nodes/<hostname>.ign for NestOS, nodes/<hostname>.cfg kickstart files on the pxe and ipxe
+platforms, and nodes/<hostname>.cfg cloud-init user-data read by terraform for the general OS. In external CA
+mode the configs carry the kubelet kubeconfigs signed for the nodes, since the cluster can not sign them
+*/
+func addExtendedNodeBootConfigs(httpService *httpserver.HTTPService, conf *asset.ClusterAsset, nestos bool, num int) error {
+	for i := len(conf.Worker) - num; i < len(conf.Worker); i++ {
+		node := &conf.Worker[i]
+		if err := cert.GenerateWorkerCerts(conf, node); err != nil {
+			logrus.Errorf("Failed to sign the certificates of node %s: %v", node.Hostname, err)
+			return err
+		}
+
+		var err error
+		suffix := constants.IgnSuffix
+		switch {
+		case isPXEPlatform(conf):
+			suffix = constants.KickstartSuffix
+			err = kickstart.NewKickstart(conf, configmanager.GetBootstrapIgnHostPort()).GenerateNodeBootConfig(node)
+		case nestos:
+			err = ignition.NewIgnition(conf, configmanager.GetBootstrapIgnHostPort()).GenerateNodeBootConfig(constants.WorkerIgn, node)
+		default:
+			err = cloudinit.NewCloudinit(conf, configmanager.GetBootstrapIgnHostPort()).GenerateNodeBootConfig(node)
+		}
+		if err != nil {
+			logrus.Errorf("Failed to generate the boot config of node %s: %v", node.Hostname, err)
+			return err
+		}
+		if len(node.BootConfig.Content) == 0 {
+			continue
+		}
+		if err := addNodeBootConfig(httpService, conf, *node, bootconfig.NodeConfigName(node.Hostname, suffix)); err != nil {
+			return err
+		}
+	}
+
+	if err := configmanager.Persist(); err != nil {
+		logrus.Errorf("Failed to persist the extended cluster asset: %v", err)
+		return err
+	}
+	return nil
+}
+
 func extendArray(c *asset.ClusterAsset, count int) error {
 	if count <= 0 {
 		return fmt.Errorf("the number of nodes to be extended should be greater than 0")
 	}
 
+	// the extended nodes use the hardware of the last worker, or of the first master when the cluster
+	// has no workers, e.g. a cluster deployed in external CA mode by an earlier version
+	hardware := c.Master[0].HardwareInfo
+	if len(c.Worker) > 0 {
+		hardware = c.Worker[len(c.Worker)-1].HardwareInfo
+	}
 	num := len(c.Worker)
 	for i := 0; i < count; i++ {
 		hostname := fmt.Sprintf("k8s-worker%02d", num+i+1)
 		c.Worker = append(c.Worker, asset.NodeAsset{
-			Hostname: hostname,
-			IP:       "",
-			HardwareInfo: asset.HardwareInfo{
-				CPU:  c.Worker[i].CPU,
-				RAM:  c.Worker[i].RAM,
-				Disk: c.Worker[i].Disk,
-			},
+			Hostname:     hostname,
+			IP:           "",
+			HardwareInfo: hardware,
 		})
 	}
 
@@ -23,6 +23,7 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -133,6 +134,14 @@ func TestExtend(t *testing.T) {
 		}
 	})
 
+	t.Run("extendCluster Policy Fail", func(t *testing.T) {
+		invalid := *cc
+		invalid.CertAsset.KeyAlgorithm = "dsa"
+		if err := extendCluster(&invalid, 1); err == nil || !strings.Contains(err.Error(), "dsa") {
+			t.Errorf("expected the invalid certificate policy to be rejected, got %v", err)
+		}
+	})
+
 	t.Run("extendCluster libvirt Fail", func(t *testing.T) {
 		cc.Platform = "libvirt"
 		cc.InfraPlatform = &infraasset.LibvirtAsset{
@@ -182,6 +191,26 @@ func TestExtend(t *testing.T) {
 		}
 	})
 
+	t.Run("extendBootMenu Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			InfraPlatform: &infraasset.IPXEAsset{IP: "10.0.0.1", Port: "9080"},
+			Master:        []asset.NodeAsset{{Hostname: "k8s-master01", MAC: "52:54:00:aa:bb:01"}},
+		}
+		menu := extendBootMenu(conf, true)
+		if menu.Default != "worker" || menu.Entries[0].Kickstart != "http://10.0.0.1:9080/worker.cfg" {
+			t.Errorf("unexpected boot menu: %+v", menu)
+		}
+		if menu.Hosts["52:54:00:aa:bb:01"] != "local" {
+			t.Errorf("existing nodes should boot from the local disk: %v", menu.Hosts)
+		}
+
+		conf.CertAsset.ExternalCA = &asset.ExternalCA{Signer: asset.ExternalCASignerCommand, Command: "sign-csr"}
+		defer func() { conf.CertAsset.ExternalCA = nil }()
+		if menu := extendBootMenu(conf, false); menu.Default != "" || len(menu.Entries) != 0 {
+			t.Errorf("the shared worker.cfg should not be in the menu in external CA mode: %+v", menu)
+		}
+	})
+
 	t.Run("extendArray Fail", func(t *testing.T) {
 		err := extendArray(cc, 1)
 		if err != nil {
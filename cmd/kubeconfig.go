@@ -0,0 +1,216 @@
+/*
+Copyright 2023 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"nestos-kubernetes-deployer/cmd/command"
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/cert"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func NewKubeconfigCommand() *cobra.Command {
+	kubeconfigCmd := &cobra.Command{
+		Use:   "kubeconfig",
+		Short: "Manage user kubeconfig files of a kubernetes cluster",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a kubeconfig with a client certificate for a user",
+		RunE:  runKubeconfigCreateCmd,
+	}
+	command.SetupKubeconfigCreateCmdOpts(createCmd)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the user credentials issued for a cluster",
+		RunE:  runKubeconfigListCmd,
+	}
+	command.SetupKubeconfigListCmdOpts(listCmd)
+
+	kubeconfigCmd.AddCommand(createCmd, listCmd)
+	return kubeconfigCmd
+}
+
+func runKubeconfigCreateCmd(cmd *cobra.Command, args []string) (retErr error) {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	userOpts := &opts.Opts.UserKubeconfig
+	if userOpts.User == "" {
+		logrus.Errorf("user is not provided")
+		return errors.New("user is not provided")
+	}
+	for _, group := range userOpts.Groups {
+		if group == "system:masters" {
+			logrus.Warnf("Group system:masters bypasses RBAC, the credential can not be restricted by role bindings")
+		}
+	}
+	// check the role binding before the certificate is issued
+	if userOpts.Role != "" {
+		if err := kubeclient.ValidateRoleBinding(userOpts.RoleKind, userOpts.Role, userOpts.Namespace); err != nil {
+			logrus.Errorf("Invalid role binding of user %s: %v", userOpts.User, err)
+			return err
+		}
+	}
+
+	issuer, err := newUserCredentialIssuer(clusterConfig, userOpts)
+	if err != nil {
+		logrus.Errorf("Failed to create certificate issuer: %v", err)
+		return err
+	}
+
+	req := &cert.UserKubeconfigRequest{
+		User:   userOpts.User,
+		Groups: userOpts.Groups,
+		TTL:    userOpts.TTL,
+	}
+	kubeconfig, credential, err := cert.GenerateUserKubeconfig(issuer, req,
+		"https://"+clusterConfig.Kubernetes.ApiServerEndpoint, clusterConfig.ClusterID)
+	if err != nil {
+		logrus.Errorf("Failed to generate kubeconfig for user %s: %v", userOpts.User, err)
+		return err
+	}
+
+	// the certificate is issued, record it even if saving the kubeconfig or binding the role fails
+	clusterDir := filepath.Join(configmanager.GetPersistDir(), clusterConfig.ClusterID)
+	defer func() {
+		if err := cert.RecordIssuedCredential(clusterDir, *credential); err != nil {
+			logrus.Errorf("Failed to record the issued credential: %v", err)
+			if retErr == nil {
+				retErr = err
+			}
+		}
+	}()
+
+	output := userOpts.Output
+	if output == "" {
+		output = userOpts.User + ".kubeconfig"
+	}
+	if err := cert.SaveUserKubeconfig(output, kubeconfig); err != nil {
+		logrus.Errorf("Failed to save kubeconfig: %v", err)
+		return err
+	}
+	logrus.Infof("Kubeconfig of user %s is saved to %s, valid until %s", userOpts.User, output, credential.NotAfter.Format(time.RFC3339))
+
+	if userOpts.Role != "" {
+		binding, err := kubeclient.CreateUserRoleBinding(clusterConfig.Kubernetes.AdminKubeConfig,
+			userOpts.User, userOpts.RoleKind, userOpts.Role, userOpts.Namespace)
+		if err != nil {
+			logrus.Errorf("Failed to bind user %s to %s %s: %v", userOpts.User, userOpts.RoleKind, userOpts.Role, err)
+			return err
+		}
+		credential.Binding = binding
+		logrus.Infof("Created %s", binding)
+	}
+
+	return nil
+}
+
+func newUserCredentialIssuer(clusterConfig *asset.ClusterAsset, userOpts *opts.UserKubeconfig) (*cert.CAIssuer, error) {
+	switch strings.ToLower(userOpts.Signer) {
+	case "", cert.UserSignerLocal:
+		return cert.NewClusterCAIssuer(clusterConfig)
+	case cert.UserSignerCSR:
+		caCert, err := os.ReadFile(clusterConfig.CertAsset.RootCACertPath)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := cert.NewCertPolicy(&clusterConfig.CertAsset)
+		if err != nil {
+			return nil, err
+		}
+		client, err := kubeclient.CreateClient(clusterConfig.Kubernetes.AdminKubeConfig)
+		if err != nil {
+			return nil, err
+		}
+		csrName := kubeclient.SanitizeName(fmt.Sprintf("nkd-%s-%d", userOpts.User, time.Now().Unix()))
+		return &cert.CAIssuer{
+			Name:    cert.UserSignerCSR,
+			CertRaw: caCert,
+			Signer:  cert.NewCSRSigner(client, csrName),
+			Policy:  policy,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported signer: %s", userOpts.Signer)
+	}
+}
+
+func runKubeconfigListCmd(cmd *cobra.Command, args []string) error {
+	cleanup := command.SetuploggerHook(opts.Opts.RootOptDir)
+	defer cleanup()
+
+	clusterConfig, err := loadKubeconfigCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := cert.LoadIssuedCredentials(filepath.Join(configmanager.GetPersistDir(), clusterConfig.ClusterID))
+	if err != nil {
+		logrus.Errorf("Failed to load issued credentials: %v", err)
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "USER\tGROUPS\tSIGNER\tSERIAL\tNOT AFTER\tBINDING")
+	for _, c := range credentials {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", c.User, strings.Join(c.Groups, ","), c.Signer,
+			c.SerialNumber, c.NotAfter.Format(time.RFC3339), c.Binding)
+	}
+	return w.Flush()
+}
+
+func loadKubeconfigCluster(cmd *cobra.Command) (*asset.ClusterAsset, error) {
+	clusterID, err := cmd.Flags().GetString("cluster-id")
+	if err != nil {
+		logrus.Errorf("Failed to get cluster id: %v", err)
+		return nil, err
+	}
+	if clusterID == "" {
+		logrus.Errorf("cluster-id is not provided")
+		return nil, errors.New("cluster-id is not provided")
+	}
+
+	if err := configmanager.Initial(&opts.Opts); err != nil {
+		logrus.Errorf("Failed to initialize configuration parameters: %v", err)
+		return nil, err
+	}
+
+	clusterConfig, err := configmanager.GetClusterConfig(clusterID)
+	if err != nil {
+		logrus.Errorf("Failed to get cluster config using the cluster id: %v", err)
+		return nil, err
+	}
+	return clusterConfig, nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"testing"
+)
+
+func TestKubeconfig(t *testing.T) {
+	opts.Opts.RootOptDir = t.TempDir()
+
+	t.Run("Kubeconfig Create Fail", func(t *testing.T) {
+		cmd := NewKubeconfigCommand()
+		cmd.SetArgs([]string{"create", "--user", "alice"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without cluster-id")
+		}
+	})
+
+	t.Run("Kubeconfig List Fail", func(t *testing.T) {
+		cmd := NewKubeconfigCommand()
+		cmd.SetArgs([]string{"list"})
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error without cluster-id")
+		}
+	})
+
+	t.Run("Unsupported Signer Fail", func(t *testing.T) {
+		_, err := newUserCredentialIssuer(&asset.ClusterAsset{}, &opts.UserKubeconfig{Signer: "unknown"})
+		if err == nil {
+			t.Errorf("expected error for unsupported signer")
+		}
+	})
+}
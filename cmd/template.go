@@ -100,11 +100,15 @@ func getDefaultInfraAsset(platform string) interface{} {
 			HTTPRootDir:    "/var/www/html/",
 			TFTPServerPort: "69",
 			TFTPRootDir:    "/var/lib/tftpboot/",
+			KernelPath:     "images/pxeboot/vmlinuz",
+			InitrdPath:     "images/pxeboot/initrd.img",
 		}
 	case "ipxe":
 		return infraasset.IPXEAsset{
 			Port:              "9080",
 			OSInstallTreePath: "/var/www/html/",
+			KernelPath:        "images/pxeboot/vmlinuz",
+			InitrdPath:        "images/pxeboot/initrd.img",
 		}
 	default:
 		return errors.New("unsupported platform")
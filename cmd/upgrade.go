@@ -93,7 +93,7 @@ spec:
 `, clusterConfig.Housekeeper.OSImageURL, clusterConfig.Housekeeper.KubeVersion, clusterConfig.Housekeeper.EvictPodForce, clusterConfig.Housekeeper.MaxUnavailable)
 
 	adminconfig := filepath.Join(configmanager.GetPersistDir(), clusterConfig.ClusterID, "admin.config")
-	if err := kubeclient.ApplyHousekeeperCR(yamlData, adminconfig); err != nil {
+	if _, err := kubeclient.ApplyManifest(adminconfig, []byte(yamlData), kubeclient.ApplyOptions{}); err != nil {
 		logrus.Errorf("Failed to deploy Custom Resource: %v", err)
 		return err
 	}
@@ -20,403 +20,545 @@ var Assets = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 458484340, time.UTC),
+			modTime: time.Date(2026, 10, 19, 17, 54, 9, 871435767, time.UTC),
+		},
+		"/addons": &vfsgen۰DirInfo{
+			name:    "addons",
+			modTime: time.Date(2026, 10, 19, 17, 54, 9, 883024369, time.UTC),
+		},
+		"/addons/catalog.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "catalog.yaml",
+			modTime:          time.Date(2026, 10, 19, 17, 54, 9, 883024369, time.UTC),
+			uncompressedSize: 1773,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x95\xd1\x6b\xfb\x36\x10\xc7\xdf\xf3\x57\x1c\xe9\xb3\xdd\xa4\x14\x3a\x4c\x57\x28\x1d\x1b\x61\xa3\x0d\x4d\x37\xf6\xaa\x48\x67\x5b\x44\x96\xcc\xdd\x39\x6d\xfe\xfb\x21\xd9\x49\x9d\xe1\xb4\x63\xbf\x3c\x59\xa7\xfb\x9e\xee\x3e\xfe\x5a\xb9\x82\x47\x63\xb2\xe0\x19\xb8\x6b\xdb\x40\x82\x06\xb6\x07\xf0\x3b\x93\xc3\x5b\x8d\xd0\x28\x6f\x4b\x64\x81\x50\x02\x2a\x5d\xc3\x1e\x89\x6d\xf0\x60\x19\x08\xbd\x41\x42\x03\x25\x85\x06\xa4\xc6\xd9\x15\x08\x36\xad\x53\x82\x70\xaf\x8c\x09\xfe\xe1\xfa\x7e\x10\x3c\xe4\x07\xd5\xb8\xfc\xb4\xff\x6e\xa5\x8e\x1a\xd8\x2b\xd7\x21\xc7\xfa\x71\xa5\x52\x37\xc5\x50\xcd\x60\xa9\x3a\x27\x0c\x5b\x74\xe1\x1d\x1a\xa4\x0a\xcd\x25\xa9\x76\x1d\x0b\x12\xe8\xe0\x4b\x5b\xe5\xa7\xc1\x14\xc5\x52\xd6\xb3\x28\xe7\xd0\x80\x2a\x63\xd6\xe7\x61\x1c\x9f\x0f\x60\xb0\x45\x6f\x20\xf8\x7c\x96\x5a\xe7\x62\x06\xd0\xa0\x90\xd5\x9c\x31\xd2\x1e\x29\x46\x00\x0c\xb2\x26\xdb\x8a\x8d\x7d\xbe\x22\x87\x8e\x34\x1e\x33\xe1\x71\xbd\x82\x8e\x7b\x8a\xbb\x6e\x8b\x5a\x1c\x48\x68\x41\x79\x03\xaa\x93\xc0\x5a\x39\x24\x1e\x2a\xa5\xf1\xfe\xea\x09\x15\xb0\x5f\xe4\x77\xf9\x32\x6d\xd9\x46\x55\xf8\x8a\x95\x65\xa1\x43\x01\x34\x3c\xe5\xbb\x9f\x38\xb7\x21\xa5\x0c\x60\xb9\xef\x2a\x1b\xab\x7b\x30\xfd\x06\xc0\x55\x6a\x44\x99\x06\x2c\x73\x04\xc6\xe8\xca\x8c\x6d\xe5\xd1\x40\x9c\xcc\xfa\x0a\x34\x92\xd8\xd2\x6a\x25\xc8\x20\x21\xf1\x89\x32\x87\xc2\x43\x9d\x61\xb9\xf2\x8c\xba\x23\x7c\xfb\x63\x53\xc0\x5c\xa8\xc3\xf9\x90\x40\xd8\x3a\xab\x15\x17\x30\x5f\xc6\x98\xf5\x15\x21\x73\xe6\x2b\xeb\x3f\x26\xe0\x3d\xff\xb6\x7a\xfe\xfb\x98\x15\xdf\x9b\x50\x70\x0e\x29\xc1\x8a\x0d\x24\x21\xac\xfa\x84\x27\xa7\xf8\x02\xb7\x65\xbe\x5c\xfc\x00\xb8\x91\xfc\x9c\xdc\x70\x50\x3a\xf9\x9b\x59\xe3\x2f\xb1\xd4\xf8\x76\x68\xb1\x80\xe7\x60\x70\x1d\x48\x86\xbd\x5a\xa4\x3d\x86\x0a\x98\xcf\x47\x61\xfe\x57\xdc\x05\xad\x5c\xd6\x2a\xa9\xb3\x96\xc2\xde\xc6\x66\x27\xcd\xb7\x91\x40\xaa\xc2\xd4\xde\x48\x05\x27\x55\x7c\xb1\x75\x60\x81\x14\xde\x07\xd7\x35\xc8\x10\x7c\x0f\x37\x18\xbc\xe8\xc3\x45\x7e\x73\x37\xc5\xd3\x04\xbd\x43\xfa\xc2\x82\x27\xe1\x7f\x26\x19\x7b\x2b\xe0\x3a\xb4\x72\x3d\x3d\xf9\x89\xb8\x76\xca\x36\xeb\xe0\xac\x3e\x14\xf0\x4b\xf4\x22\xce\x00\x7c\xc9\x99\x66\x3b\xe5\xaf\x5f\x37\xf0\xb4\x59\x81\x21\xbb\x1f\xb9\xea\x0c\xdb\xa0\x86\xe0\x41\x79\xc0\x0f\xcb\x12\xa9\x45\x29\xd7\xf1\xea\x98\x04\x74\x9b\xdf\xe5\x8b\xff\xed\xb7\x4f\xf5\x97\x90\x4a\xe5\x18\xc7\xde\x42\x1a\x39\x27\x75\x37\x5a\x37\xa1\xf3\xf2\x92\x26\xe7\x22\x8e\x15\x8f\xfd\xf9\x36\x5f\x7e\x83\xcf\x28\xae\xb7\x41\x91\x99\x00\xf8\x7b\xb7\x45\xf2\x28\xc8\x9f\x69\xf0\x8e\x5b\xf8\x73\x35\xcd\xe5\xe6\x12\x97\x73\xdf\xf4\x77\x2d\xbf\xf8\x23\x92\xf3\x7b\x76\x9a\xda\xcd\x05\x6a\x67\x5f\xdd\x53\xff\x27\xb0\x5a\x0f\x9b\x7e\xfc\x69\xfd\x33\x00\x7a\xe0\x3b\x72\xed\x06\x00\x00"),
+		},
+		"/addons/dashboard": &vfsgen۰DirInfo{
+			name:    "dashboard",
+			modTime: time.Date(2026, 10, 19, 17, 55, 26, 940105734, time.UTC),
+		},
+		"/addons/dashboard/v2.7.0.yaml.template": &vfsgen۰CompressedFileInfo{
+			name:             "v2.7.0.yaml.template",
+			modTime:          time.Date(2026, 10, 19, 17, 55, 26, 940545535, time.UTC),
+			uncompressedSize: 6201,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x58\x51\x73\xdb\x36\x0c\x7e\xf7\xaf\xe0\xe5\x79\xb2\x9d\xb5\x0f\x39\xde\xed\xa1\x4b\xb6\xb6\x77\x6d\xea\x4b\xb2\xbe\xec\xf2\x40\x53\x90\xcd\x99\x22\x39\x02\x72\xa3\xe5\xfc\xdf\x77\xa4\x2c\x5b\x4a\x6c\x59\xc9\x92\xb9\xd5\x8b\x69\x80\x04\x3e\x82\x00\x3e\x4a\x49\x92\x0c\x84\x53\x5f\xc1\xa3\xb2\x86\xb3\xe5\xe9\x60\xa1\x4c\xca\xd9\xa5\xc8\x01\x9d\x90\x30\xc8\x81\x44\x2a\x48\xf0\x01\x63\x46\xe4\xc0\xd9\xa2\x98\x82\x37\x40\x80\x49\x2a\x70\x3e\xb5\xc2\xa7\x83\xbd\x96\xae\xc1\x2f\x95\x84\x77\x52\xda\xc2\x50\xcb\x9c\x16\x53\xd0\x18\x46\x8c\x2d\xce\x30\x11\xce\xed\xb1\xde\xe9\xba\x52\x46\xb4\x1d\xe0\x5a\x68\x1e\x42\x3d\x0a\x2a\x74\x20\x83\x1b\x2a\x1d\x70\x76\x7f\x3f\xfc\x2a\x74\x01\x38\xc4\x0a\xe3\x4d\xe9\x60\xb5\x1a\x30\xe6\xac\xa7\x88\x27\x89\x43\xce\xde\xbe\x7d\x13\xd1\x91\xf0\x33\xa0\x49\x94\x9d\x05\xe1\xfd\x7d\xc2\x54\xc6\x6a\x43\xc6\xa6\x10\xb4\xd1\x0a\x63\xf5\xdf\xa6\xaf\xc6\x94\xb0\x18\x4c\x1a\x27\x23\x68\x90\x64\x7d\x9f\x28\x74\x9c\xbc\xf4\xf0\x1a\x27\x9e\x48\xf0\x84\x3d\x22\x5c\x45\xf6\x8b\x13\x7f\x17\x70\x14\x9c\xe8\xb3\x1f\x00\xe6\x02\xca\x64\x6e\x75\x0a\xfe\x39\x60\x2b\x74\xe7\xd6\x64\x6a\xf6\x59\xb8\xd7\x2f\xad\x04\x81\x48\x99\x19\x3e\xa9\xf2\xaf\xac\x6e\x97\xbd\x9f\x0a\x39\x14\x05\xcd\xad\x57\xff\x08\x52\xd6\x0c\x17\x67\x38\x54\x76\x74\xac\x86\xe0\x0b\x0d\xc8\x07\x09\x13\x4e\xbd\xf7\xb6\x70\xc8\xd9\x9f\x27\x27\xb7\x03\xc6\x3c\xa0\x2d\xbc\x84\x28\xc1\x98\x09\xd8\x52\xc4\x86\x1d\x94\x07\x4e\xf8\xe4\x27\x76\xb2\xbf\xa6\xf6\x6b\xd1\x67\xd1\xdf\x12\xfc\x34\xfa\x99\x01\x85\xd9\x85\x4b\x05\x41\x18\xa5\xa0\x81\xe0\xe4\xf6\x30\x7e\x19\x73\x25\x17\xee\x29\x5b\xa8\x0f\xbd\x0b\xc5\x6d\x9f\xd8\xc5\xe6\xba\xc7\xf3\x1c\x84\x43\xaa\xa2\xb4\x75\x9d\x03\x79\x25\x31\x41\xe9\x85\x03\xdf\x46\xe0\xbc\xbd\x2b\x9f\xe2\x79\x54\xaf\x38\xe0\x7f\x4e\xe4\x78\x2d\xe0\xb5\x04\xdb\xa2\xfd\x20\x6b\x0b\x3d\xb7\x11\x02\x79\xdb\xac\x67\x5d\x04\x27\x47\xaf\x9a\xdd\x35\xb1\xde\xca\xda\xf5\xe3\x58\x3b\x9b\xc6\x5c\x0e\xf4\xb6\x3b\x63\xb4\xc2\xf8\xfb\x4d\x90\x9c\xaf\x77\xde\x7b\x9f\xdb\x8e\xf2\xab\x32\xa9\x32\xb3\x23\xf5\x0b\xab\xe1\x0a\xb2\xe0\xa9\x8e\x4e\x07\xf0\x01\x63\x8d\x4e\xd8\xe9\x1a\x8b\xe9\x5f\x20\x29\xc6\x7d\xe7\x2d\xee\x05\xee\x63\x4f\x0c\x76\x23\x1f\x77\xc5\xbc\x2b\x83\x9e\x17\xa5\x66\x01\x1c\x3d\x58\x95\xdd\x0b\x70\xda\x96\x39\x18\x6a\x45\x4f\x38\x87\xa3\x63\x5f\x62\x3d\x38\xad\xa4\x40\xce\x4e\xe3\xbf\xa5\x0a\xe0\x3e\x28\x24\xeb\xcb\x4f\x2a\x57\xc4\xd9\xe9\xf8\xd1\xd5\x32\x0f\xe5\xf7\xa9\x01\xf6\x30\x5c\x82\xdc\x69\x41\xb0\x36\xd0\xd8\x34\x63\xed\x8d\xf7\xb1\xc6\x58\xbd\x83\x38\x06\x59\x78\x45\xe5\xb9\x35\x04\x77\xb4\x35\x83\x20\xa5\xcd\xdd\xc4\xdb\x4c\x69\xd8\xca\xeb\xdb\xfb\x55\x61\x48\xe5\x70\x01\x99\x28\x34\xad\xd5\xd2\x1a\x12\xca\x80\xdf\xe0\x49\xba\x83\x5d\x3d\x2a\x17\xb3\xea\x85\xe0\x63\x18\x5d\xc1\x4c\x21\xf9\x72\xb5\x1a\x6d\x97\x15\x6a\xb4\x59\xc8\xc3\x75\xbe\x4a\x86\xd5\xaa\x6d\x65\x52\x68\x3d\xb1\x5a\xc9\x92\xb3\x8f\xd9\xa5\xa5\x89\x07\x04\x43\x9b\x59\x9b\x77\x8b\x1a\xdf\x06\x73\xe3\xb5\x62\xbb\x59\xe7\x2d\x59\x69\x35\x67\x37\xe7\x93\x8d\x5c\xf8\x59\xcb\x46\x92\x88\x82\x6c\x32\x03\x03\x5e\x10\xc4\xab\x85\xca\x94\x14\x04\xd8\x9a\xb6\x49\xae\x5f\x3a\x03\xb2\xb4\xba\xc8\xe1\x73\x28\xa6\x96\x9f\x1e\x6f\x08\xf5\x93\x87\xc5\x13\x41\x73\xce\x46\x6d\x5d\xd2\xd2\x51\xee\x1a\xab\x2a\x07\x94\xbb\xa4\x82\xb0\x51\x69\xb5\x04\x03\x88\x13\x6f\xa7\xad\x6c\x08\x94\xfb\x1e\xa8\x29\x62\x0c\xe5\x1c\x82\xa1\x0f\x37\x37\x93\xeb\x96\xc6\x55\x5e\xdb\xb2\x5d\x81\x57\x46\x91\x12\xfa\x02\xb4\x28\xaf\x41\x5a\x93\x22\x67\x6f\xc6\x8d\x19\x21\xfd\x6c\x41\xbb\x94\x7b\xb3\x9a\x31\xa1\xb5\xfd\x36\xf1\x6a\xa9\x34\xcc\xe0\x37\x94\x42\xc7\x6e\xc8\x59\x26\x34\x42\x63\xa6\x07\x91\x7e\x31\xba\xbc\xb2\x96\x7e\x57\x1a\xb0\x44\x82\x9c\x33\xf2\x45\x6b\x5a\x61\xde\xe1\x1f\x08\x3e\x94\xfb\xf8\xf4\xa1\x66\xdd\x7e\x7f\xde\xaa\xaa\xc8\xf6\xaa\x90\x07\xa7\x5a\x5d\x83\x9b\xbb\xa9\x24\x97\xfd\x0c\x24\xfb\x8f\x17\x72\x47\xe5\x85\xf2\x9c\xdd\xaf\x36\x9d\xa1\xd9\xd3\x2f\x0f\x55\x71\xb8\x78\x5c\xb7\x3a\x5d\x78\xb6\x0b\x02\xb7\x59\xe4\x4c\x2b\x53\xdc\xad\xf5\x64\x35\xf8\x18\xfd\x46\x34\x16\x50\xf2\x68\x2d\x09\x3c\x36\x6c\x5b\x08\xc5\xea\xad\x4e\x9c\x16\xa6\x81\x3e\xcb\x40\x12\x67\x97\xf6\x5a\xce\x21\x2d\x34\xbc\xc0\x07\x90\xbd\xd7\xc8\x0d\x81\x1c\x9a\xd1\x87\x45\x1e\x7d\xea\x38\x1b\x8f\xc7\x8f\xbf\x75\x8c\xc7\x8f\x99\xa4\x07\xd2\x97\x63\xd2\xff\x27\x1a\xaf\xc0\xa9\x5d\xb0\x9e\x49\xac\x5d\x26\x8f\xc3\xae\xdd\x88\x9e\x40\xb1\x0f\x96\xf3\xe5\xe9\x70\x3c\x3c\x7b\x69\x8a\xad\x53\xbc\x8b\x62\x9f\x4f\x38\x7d\xf9\xa6\x8d\xe2\x3f\xf1\xcd\x3e\xbe\x7e\x06\xd1\x7e\xd7\xd4\xf5\x63\x73\x42\x27\xfd\x1e\x22\xc5\x7f\x07\x00\x86\x82\x89\x60\x39\x18\x00\x00"),
+		},
+		"/addons/ingress-nginx": &vfsgen۰DirInfo{
+			name:    "ingress-nginx",
+			modTime: time.Date(2026, 10, 19, 17, 54, 50, 587434915, time.UTC),
+		},
+		"/addons/ingress-nginx/v1.10.1.yaml.template": &vfsgen۰CompressedFileInfo{
+			name:             "v1.10.1.yaml.template",
+			modTime:          time.Date(2026, 10, 19, 17, 54, 50, 594769278, time.UTC),
+			uncompressedSize: 7923,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x59\x51\x6f\xdb\x38\x12\x7e\xd7\xaf\x18\x64\xef\xe1\x0e\x38\xd9\xce\xb5\x0b\xec\x09\xe8\x43\x36\x49\xbb\x01\xd2\x54\x48\xd2\xbe\x16\x34\x35\xb6\x67\x43\x91\x3c\x72\x64\x57\x5b\xf8\xbf\x1f\x28\x59\xb2\x64\xcb\xb9\x38\xbb\xdb\xeb\x46\x40\x10\x71\x38\x9c\x19\x7e\x1c\x7e\x1c\xd1\x3f\xc0\xfd\x02\x41\x1a\xcd\xce\x28\x85\x0e\x56\xc4\x0b\x53\x30\xf0\x02\x61\x29\x14\x65\x82\x49\xcf\x41\x64\x39\x79\x4f\x46\xc3\x0a\xa7\x0b\x63\x1e\xfe\x09\xab\x85\xf1\x08\x12\x1d\xd3\x8c\xa4\x60\xf4\xd1\x0f\xb0\x32\x85\xca\x60\x21\x96\x08\x6c\x60\x8a\x20\x1d\x0a\xc6\x0c\xa6\x25\xfc\x6a\xa6\x7e\x14\xc5\x71\x1c\x09\x4b\x9f\xd0\x05\x73\x09\x2c\x4f\xa3\x07\xd2\x59\x02\x37\x22\x47\x6f\x85\xc4\x28\x47\x16\x99\x60\x91\x44\x00\x4a\x4c\x51\xf9\xf0\x06\x20\xac\x1d\x3d\x14\x53\x74\x1a\x19\xfd\x88\xcc\x98\xb4\x67\xa1\x25\x26\x40\x7a\xee\xd0\xfb\x58\xcf\x49\x7f\x39\xa0\xad\x45\x3e\xa0\x39\x24\x1d\x08\x52\x14\x6c\x72\x53\x68\xbe\x43\xb7\x24\x89\x67\x52\x86\xd6\xbd\x79\x40\x9d\x00\xbb\x02\x37\xf3\xe8\xf7\x1f\x31\x19\x69\x72\x6b\x34\x6a\x4e\x3a\x0b\xf2\x8d\x27\x5e\x4b\xab\x65\xf8\x5f\x98\xb8\xa9\x90\x23\x51\xf0\xc2\x38\xfa\x4d\x30\x19\x3d\x7a\xf8\xa9\x72\xd7\x2e\xe9\xad\x51\xf8\x72\x00\x70\x85\x42\x9f\x44\x31\x08\x4b\xef\x9c\x29\x6c\x35\x91\x18\x4e\x4e\x22\x00\x87\xde\x14\x4e\xe2\x46\xd6\x1a\xf1\x11\xc0\x12\xdd\x74\x23\x9f\x23\x3f\xcd\x80\x34\x7a\x46\xf3\x5c\x58\x5f\x35\xad\xc9\xea\x17\x8f\xd2\x21\xd7\xef\xa8\x33\x6b\x48\xf3\x80\x8f\xf0\x5f\x91\xaf\x5f\x56\x82\xe5\xe2\x69\x6e\x7d\x9d\xbc\xcf\xb4\xa8\x91\x57\xc6\x3d\x90\x9e\x6f\x52\x61\xdf\xc1\x06\xd2\x6f\xe1\x61\xec\x59\x70\xb1\xe3\xa8\xb0\x99\x60\xfc\x3d\x86\xa5\x12\xcf\x8f\x5f\x1a\xe3\x32\xd2\xdd\xed\xd2\x71\x54\x51\x60\xcf\x59\x9d\x7a\xb1\x42\x91\xa1\xeb\x68\x6e\xb4\x14\x8a\x83\xa1\x1c\x9a\xe9\xe3\x21\x3c\x62\xb8\xa6\xf2\xa7\xe5\x11\x2e\x51\xf3\xf0\xf8\xf0\x6a\x87\xd1\xc9\xc8\x4b\xb3\x44\x57\x1e\x8c\xab\x4d\x79\xb5\x9f\xa5\x3b\xd8\xb7\xdb\xed\x78\xde\x3a\x57\x85\x67\x74\x47\xd2\xd7\x9f\x45\x49\xc7\xf0\xce\x0e\x6d\x74\x19\x22\x06\x6d\x32\x7c\x84\x4e\x0e\x71\xd6\xef\xcb\xe9\x47\x12\xea\x39\x04\xd5\xcc\xe1\x39\x9c\xfa\xbd\x93\xdb\x1f\xb7\x91\xfe\xea\x34\xf9\xbd\x10\x41\x60\x80\x9f\x49\x67\xa4\xe7\x2f\xa8\x8e\x31\x0a\x6f\x71\x96\x44\xd0\xc2\xfe\x08\x18\x11\xc0\x16\x8b\x03\xce\x7c\x31\xfd\x15\x25\x57\x24\x35\x58\x04\x7f\x8b\x62\xb3\x43\xda\xc7\x2f\xd9\x9f\xc6\xdd\xcf\xc3\xba\x7b\x00\xfd\xbf\x20\x5f\x9e\x46\x0d\x76\x42\x29\xb3\x8a\xbd\x26\x6b\x91\x63\xa1\xb5\xe1\x2a\x6c\x9f\xc0\xc9\x4c\x28\x8f\x27\xcd\x1a\x54\xc7\xcf\x7b\x61\xff\x5a\xbb\x25\xee\x39\x3e\x06\xa1\x1e\xf4\x2f\x72\xd2\xde\xa2\x4c\x22\x00\xb2\x6f\x45\x4e\xaa\x4c\x8d\x22\x59\x26\x90\x3a\x9c\xa1\xbb\x28\x84\xba\x63\x21\x1f\x22\x00\x6b\x1c\x6f\x18\x58\x58\x9b\x3a\xc3\x46\x1a\x95\xc0\x82\xd9\x46\x00\x4d\x10\x6d\x33\xe8\x27\xf0\xd3\xa4\x6e\xb4\xea\xf7\xe7\x69\x25\x61\xe1\xe6\xc8\x69\xa5\x54\x8d\xf9\xfa\x35\x06\x9a\xc1\xe8\x93\x50\x05\xfa\x51\x90\xdd\x98\x0c\x83\xc6\x7a\x5d\x3b\xd8\x34\x13\xf8\xfa\xf5\x80\x5a\x30\x82\x3a\x5b\xaf\x87\xe3\xf4\x3b\x81\xfa\x4e\xa4\xaf\x5f\xbf\x7a\x62\xa8\x7e\x28\x56\xff\xc4\x60\xfd\x81\x68\x3d\x2a\x94\x6c\xdc\xf7\x92\x52\x5c\x5a\xec\xc6\xbe\xa9\xae\xee\x4b\x8b\xeb\xf5\xde\x5e\x11\xd6\xfa\x2d\x57\x5f\xa0\x55\xa6\xcc\x51\xf3\x8b\xde\x33\x0e\xad\x22\x29\x7c\x17\xa6\x46\x56\xad\x69\x4e\xfa\x16\x45\x56\xde\xa1\x34\x3a\xf3\x09\x4c\xaa\x51\x4b\x0a\xa0\xfd\x42\x9e\x8d\x2b\xaf\x29\x27\x4e\xe0\x74\xb2\x97\x03\x79\x28\x6f\xae\x3b\x98\x1d\x87\xda\xb1\xb8\x3d\x1d\x39\xcf\x4e\x30\xce\xcb\x3a\xaa\xe0\x92\xf4\xfc\x63\x55\x52\x36\x81\xe6\xe2\xcb\x47\x2d\x96\x82\x94\x98\x2a\x4c\xe0\xb4\x92\xd7\x39\x75\xdb\x1d\x10\x01\x30\xe6\x56\xb5\x63\xbb\x09\x03\xd0\x4f\x9a\xe3\x21\x38\x1e\x84\xa7\xc3\x00\xd0\x24\x42\x78\x82\x6b\x41\x1a\x5d\x1b\x6b\x0c\xc2\xcd\xdb\x56\x68\x8f\xeb\x2c\x6b\x0c\x0d\x44\x1b\x43\x1c\x57\x49\x40\x46\xc7\x94\xbd\x39\x70\x4f\xb0\x55\xde\xda\x88\xab\x92\xfc\xcd\xa6\x6e\x1a\x9e\x5a\x18\xd1\x7a\xaf\xd4\xf7\xfb\xdb\x6f\xcc\x37\x7f\xfb\x7b\xfa\xe1\xe2\xf3\xcd\xd9\xfb\xcb\xbb\xf4\xec\xfc\xf2\x1f\xe3\x47\x36\x4b\xfd\xa0\x5e\x76\xe7\x5b\xe3\xd6\x18\x69\x3b\x20\x5c\x3d\x17\xf8\xd6\x99\x3c\xe9\x08\x01\x66\x84\x2a\xdb\xd4\x53\x7b\xf2\x54\xf0\x22\x69\xd3\x63\x14\x6c\x1f\x74\x55\xc5\xfb\xc7\xfb\xab\xef\xaf\x77\x9d\x5e\x5f\x7c\x4e\x6f\x2f\xaf\x3f\x9c\x5d\xec\x7a\x4c\x60\x5c\x78\x37\x56\x46\x0a\x35\x56\x34\x0d\x7f\x39\xe5\xa1\xde\x92\x23\x6f\x5a\x75\xca\xc5\xbc\x26\xdb\xab\xf0\x76\x8b\x73\xf2\xec\xca\xf5\xba\x8f\xf8\x78\x8b\x78\x12\x18\xa7\x26\xdf\xf5\xba\x6f\x27\x2d\x94\x6a\x4e\xf1\xab\xd9\x8d\xe1\xd4\xa1\x0f\x54\xdc\x68\x29\x9a\xa1\x2c\xa5\xc2\xee\xb4\xad\xc3\x3b\x36\xb6\x2b\x02\xc0\x2f\xdb\xfc\x6e\x1e\x69\xf2\x5c\xe8\x6c\x57\x1c\xc3\x78\x25\x88\x63\xbf\x28\x38\x33\x2b\xdd\xf1\xb6\x44\x8d\xde\xa7\xce\x4c\x7b\x1e\x67\x82\x54\xe1\xf0\x7e\xe1\xd0\x2f\x8c\xca\x12\xf8\xb1\xd3\x1b\x8e\xca\x77\xc8\x7d\x37\xb6\x5a\x92\xf1\x02\x85\xe2\xc5\x6f\xfd\xae\xea\xa8\x3d\x9d\xfc\xeb\xc7\xd7\x3d\xb9\x97\x0b\x0c\x8b\xf4\xcb\xfd\x7d\xda\xe9\x20\x4d\x4c\x42\x5d\xa0\x12\x5b\x76\x3e\x9d\x74\x01\x41\x47\x26\x1b\xee\xf3\x85\x94\xe8\x7d\x27\xf4\xd3\x4e\x2f\x53\x8e\xa6\xe0\xed\xd0\xb6\xaf\xce\x97\x81\x7d\xd3\xd6\x56\xf5\x13\x6f\xd9\x24\xed\x56\x51\x5d\x2b\x6d\x9d\x05\x30\x5c\xb5\x0c\x1a\x6a\x8a\x1c\x80\xa1\x42\xe8\x31\x53\x0e\x45\x46\x4f\x5c\xc8\x57\x2f\x7d\x21\x7b\xb7\x15\x5b\xe1\x7f\x0a\xf4\xec\xfb\x53\x95\xb6\x08\x4e\x27\x79\x4f\x9a\x63\x6e\x5c\x99\xc0\xbf\x27\xef\xa9\xed\xf0\x28\x0b\x47\x5c\x9e\x1b\xcd\xf8\xa5\x07\x59\xf5\x89\x96\x3a\x5a\x92\xc2\x39\x5e\x7a\x29\x54\xf5\x89\x96\x40\xf5\x81\xd6\xd1\x94\xc2\x8a\x29\x29\x62\xc2\x9d\x48\x44\xb6\xb3\x6b\x63\xb8\xb9\xbc\xff\xfc\xf3\xd5\xcd\xc5\xe7\xbb\xcb\xdb\x4f\x57\x3d\xca\x04\xc8\xdc\x2e\x1f\xc4\x70\x76\x7d\xdd\x9b\xb1\xc8\x3e\x68\x55\xde\x1a\xc3\x6f\x49\xa1\x2f\x3d\x63\xbe\x1f\x93\x2b\xf4\x99\xbf\x31\x3a\xe8\x6d\x7e\x4c\xdb\xe9\xfc\xe8\xd1\x05\x98\xba\xf0\x7b\x94\xe1\x68\x4f\x9d\x99\x51\x9f\xac\xda\x4a\xa2\xd0\x61\x8d\x2e\x70\x26\x0a\xd5\x10\x5c\xa6\x7d\x43\x7f\x9b\xef\xec\xb7\xe4\x7c\xd3\x1b\xca\xf2\xbb\x5e\x99\x15\x9e\xfe\x71\x6f\x7c\x02\x8a\x74\xd1\x9c\x8b\xbe\xf7\xe9\x7d\x73\xa0\x18\x00\x60\x74\xf9\xe6\xa2\xf4\x9d\x13\x12\xd3\x7e\xe6\xbd\x9a\x4c\xf6\xea\xe6\xbd\x0b\xb7\x6d\x11\x7d\x55\x7b\x38\x0f\xc7\xf4\x77\x5c\x46\x77\xaf\x0b\xa2\x7a\x47\x6e\x2f\x08\x77\xfd\xf9\x38\xab\xd7\xaa\xae\x3e\x12\x38\xd9\x56\xce\x9b\x9e\x6a\xbe\xeb\xf5\x49\x5b\xa1\xf7\x6b\xee\xce\x11\x08\x83\xa5\xce\x7f\x07\x00\xbf\x2e\xc5\xf5\xf3\x1e\x00\x00"),
+		},
+		"/addons/local-path-provisioner": &vfsgen۰DirInfo{
+			name:    "local-path-provisioner",
+			modTime: time.Date(2026, 10, 19, 17, 54, 30, 741885287, time.UTC),
+		},
+		"/addons/local-path-provisioner/v0.0.27.yaml.template": &vfsgen۰CompressedFileInfo{
+			name:             "v0.0.27.yaml.template",
+			modTime:          time.Date(2026, 10, 19, 17, 54, 30, 742282163, time.UTC),
+			uncompressedSize: 3825,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x56\x5d\x8f\xe2\x36\x17\xbe\xcf\xaf\xf0\x9b\xb7\x97\xeb\xd0\x51\xa5\x4e\xe5\xbb\xe9\x00\xdb\x91\x86\x0f\x0d\xb3\xdb\x8b\xd1\x08\x39\xce\x01\xbc\x38\xb6\x65\x3b\xec\x52\xca\x7f\xaf\x6c\x87\x4c\x80\x29\xb0\xa8\x52\xd5\x5c\x10\x73\x7c\x7c\xce\xe3\xe7\x7c\x05\x63\x9c\x50\xcd\x3f\x83\xb1\x5c\x49\x82\x56\x37\xc9\x92\xcb\x82\xa0\x21\x2d\xc1\x6a\xca\x20\x29\xc1\xd1\x82\x3a\x4a\x12\x84\x24\x2d\x81\x20\xa1\x18\x15\x58\x53\xb7\xc0\xd6\x29\x43\xe7\x90\xfc\xad\x9d\x09\x98\x15\x67\x70\xc7\x98\xaa\xa4\x3b\x6d\x4c\x1b\xb5\xe2\xfe\x3c\x18\x6c\xe3\x39\x4c\xeb\x83\x51\x3d\x20\xba\x08\x80\xc9\x29\xcb\x68\xe5\x16\xca\xf0\x3f\xa8\xe3\x4a\x66\xcb\x5f\x6c\xc6\x55\xa7\x81\xf6\xa4\x04\x5c\x0e\xc8\x78\xed\x73\x28\x4c\x25\xc0\x92\x04\x23\xaa\xf9\x47\xa3\x2a\x6d\x09\x7a\x49\xd3\xd7\x04\x21\x03\x56\x55\x86\x41\x90\x68\x55\xd8\x20\x5d\x81\xc9\x83\x64\x0e\x2e\xfd\x80\x52\xc1\x6d\x78\x7f\xa5\x8e\x2d\xfc\x82\x19\xa0\x0e\xfc\x4a\xef\x44\x95\x2e\x6a\x51\x01\x02\x1c\xa4\xaf\xd7\xdc\xfe\x5e\x54\xd6\x81\xb9\x82\x84\x4b\x2f\x29\x55\x01\x36\x20\xf7\xb0\xac\x03\xe9\x56\x4a\x54\x25\x30\x41\x79\x19\x76\x98\x92\x33\x3e\x2f\xa9\x8e\x7a\xaa\x68\xde\x1d\xa1\xe6\x17\x30\xf4\x7a\x01\xd7\x07\xde\xff\x59\xe2\xcf\x79\x87\x15\x48\x77\xe0\xf2\xd0\xf4\x91\x99\x3a\x9d\xea\x98\x1d\x1b\xad\xf7\x99\xa0\xd6\x5e\x74\x9f\xd7\x6b\xeb\xe3\x57\x2e\x0b\x2e\xe7\x97\x67\x48\xce\x65\x71\xbe\x4c\x94\x80\x27\x98\x79\x63\xbb\x7b\x9f\x40\x94\x20\xd4\x2a\xd8\x4b\x32\xd4\x56\xf9\x17\x60\x2e\x24\xe9\xbb\x6d\xe8\xdf\x6f\x3e\xad\xf2\xbb\x8e\xe3\x2b\x39\x6c\x97\xfd\x7f\x81\x4a\xaa\xb5\x7d\x63\xad\x0b\x5a\xa8\x75\x09\xdf\x31\x49\xce\x7a\xb4\x1a\x18\x09\x15\xa6\x05\x67\xd4\x12\x74\x93\x20\x64\x41\x00\x73\xca\xf8\x1d\x84\x4a\x5f\x45\x8f\x34\x07\x61\xa3\xc0\x93\xae\x4f\xb8\x74\x50\x6a\x41\x1d\xd4\xc7\x5b\x58\xfd\x23\xf6\x2c\x9d\xb3\x85\xd0\x0e\x62\x58\xef\xd1\x3f\xfc\x3e\xee\xfd\xc3\x94\x74\x94\x4b\x30\x0d\x00\x7c\x8e\xc0\xf8\xf0\x92\xce\x81\xa0\xcd\x26\x7b\xf0\xab\x27\x98\x73\xeb\xcc\x7a\xbb\xed\x18\x2a\xd9\x02\x4c\xe7\x7d\x03\x64\xb3\xc9\xea\x78\x6e\xb7\xfb\xd6\xc6\x95\x10\x63\x25\x38\x5b\x13\xf4\x30\x1b\x2a\x37\x36\x60\xa1\x81\xea\xc1\x96\x25\x95\xc5\x1b\x55\xf8\x1c\x4a\x8c\x30\x2e\x20\xaf\xe6\x2d\x89\x75\xd4\xb8\x3d\x8d\x38\x78\x5a\xa2\x0e\x38\xd6\x89\xd2\xfa\x95\x7d\xb1\x4a\x36\x1a\x71\x76\x0c\x3c\x8f\xb6\x8d\x26\x32\x17\x4f\xe0\xa8\xd4\xec\x22\x54\x7a\xfd\x31\x75\x0b\xb2\xe7\xa0\xd1\x00\xb9\x3a\x36\x36\x1e\x75\xa7\xc3\xbb\x41\x6f\x32\xbe\xbb\xef\xb5\x8c\xad\xa8\xa8\xa0\x6f\x54\x49\x5a\x42\x84\x66\x1c\x44\x51\x77\x82\x23\x79\xf4\xbd\xcb\xbf\xac\xa9\x84\x23\xa7\xf7\xa3\x61\xff\xe1\xe3\x74\x30\xfa\x34\x7c\x9e\x8e\xef\x9e\x7f\x3b\x74\xfc\xee\x0d\xea\x89\x7a\x98\x48\xef\xd3\x11\xa5\x03\xaa\xdb\x48\x8f\x32\xaf\x8e\xcc\x61\x1f\xd8\x1f\x89\x6f\x1d\x61\x12\xe5\xf7\x7e\x14\x9e\xec\x09\x09\x42\x54\x4a\xe5\x42\x57\xac\x11\xb7\xe7\x68\xb6\xac\x72\x30\x12\x1c\x04\x07\xdc\xe2\x02\x66\xb4\x12\x0e\x87\x6d\x82\x52\x9f\xc6\x9e\x09\x9b\xd5\x3b\xc1\xe9\x76\x9b\x26\xed\x64\x47\x75\x31\x78\x23\x2d\xef\x91\x8b\xba\xd5\x0f\x54\x01\x04\xfd\x4e\xb9\xeb\x2b\xd3\xe7\xc6\xba\x7b\x25\x6d\x55\x82\x49\x4c\xfc\x3a\xda\x15\xc5\x9b\xcf\xbd\x8d\xed\x36\x10\x54\xb7\xf4\x1d\xaf\x87\x5f\xe0\x27\x5b\x64\x53\x01\xa7\xbb\xe3\xee\x7c\xab\x26\x08\xfa\x13\x07\xfa\x36\xe1\x17\xa1\xf0\xa5\xe7\x33\x6d\x40\x75\x4a\x5e\x9a\xe8\x6e\x92\xb7\x38\x07\x9d\x94\xa4\xdd\x5e\xff\xee\xd3\x63\x4c\xb1\x69\x7f\xf4\x34\x1d\x8e\x86\xd3\xc7\x87\xc9\x73\xaf\x3b\x1d\x8e\xba\xbd\x49\xfa\xa1\x7d\xca\x63\xb1\x29\x79\x69\x91\xef\x45\xdb\x6d\xf8\xe8\x89\xcf\xae\xa7\xbc\x26\xbb\x7f\x16\x5c\xa5\x1b\x98\xff\xff\x5f\x27\xe7\xb2\x63\x17\x49\xec\x9f\x0e\x61\xa8\xc2\xba\x5c\x16\xdc\x20\x5c\xa2\x1f\x6f\x6f\x6f\x11\xd6\x28\xfd\xe1\xf3\xe8\x71\xda\x7d\x78\x4a\x43\x0f\xa7\xa6\x50\x5f\xe5\x05\x86\x4c\x89\xb0\x99\xed\x1f\x5f\x80\xd0\x60\xc6\xaa\xc8\xd6\xb4\x14\x8d\x91\x83\x18\x79\x51\x0c\xe3\x58\x15\xef\x0e\x8b\x18\xb9\x68\x0d\x6b\x55\x1c\x4d\x04\x6d\xb8\x32\xdc\xad\x43\x3a\xc6\x81\x60\xd7\xd6\x41\x89\x3d\xe7\x98\x19\xee\x38\xa3\xa2\xd6\x76\x4a\x80\x69\x17\x01\x0a\x85\xbb\x84\x35\x41\x5e\xff\xa0\x0e\x0a\x6e\x97\x58\x1b\xb0\xb6\x32\xed\xce\xa6\xb4\xb7\xa2\x0c\x41\xbd\x6f\xdc\x3a\xdb\xda\x82\xd9\x0c\x98\x23\x68\xa8\x26\x6c\x01\x45\x25\xe0\xec\xe4\x39\xb8\xdd\xe9\x69\x23\x78\x6e\xa8\x59\x77\xf2\xca\xae\x73\xf5\x8d\xdc\x64\x3f\xfd\x9c\xdd\x5c\x38\x58\xfe\x1a\x00\x4a\x8c\xea\x4b\xf1\x0e\x00\x00"),
+		},
+		"/addons/metrics-server": &vfsgen۰DirInfo{
+			name:    "metrics-server",
+			modTime: time.Date(2026, 10, 19, 17, 54, 30, 735435330, time.UTC),
+		},
+		"/addons/metrics-server/v0.7.1.yaml.template": &vfsgen۰CompressedFileInfo{
+			name:             "v0.7.1.yaml.template",
+			modTime:          time.Date(2026, 10, 19, 17, 54, 30, 741885287, time.UTC),
+			uncompressedSize: 4442,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdc\x57\x4b\x6f\xdb\x38\x10\xbe\xeb\x57\x0c\x72\x5e\xe6\xd1\x07\x50\x08\xc8\x21\xdb\x74\xdb\x00\x69\xd6\x88\xd3\xde\x69\x6a\x2c\xcf\x86\x22\xd9\xe1\xc8\x8d\x1a\xe4\xbf\x2f\x28\x59\xb6\xe4\xc4\x41\x37\x4d\x73\x58\x5d\xc2\x70\xc8\x99\xf9\x3e\xce\xcb\x4a\xa9\x4c\x07\xfa\x8a\x1c\xc9\xbb\x1c\x96\x47\xd9\x35\xb9\x22\x87\x29\xf2\x92\x0c\x9e\x18\xe3\x6b\x27\x59\x85\xa2\x0b\x2d\x3a\xcf\x00\xac\x9e\xa1\x8d\x69\x05\x70\xfd\x2e\x2a\x1d\x42\x0e\x15\x0a\x93\x89\x2a\x22\x2f\x91\x33\x00\xa7\x2b\xdc\xb1\x1d\x83\x36\x98\xc3\x75\x3d\x43\x15\x9b\x28\x58\x65\xdb\x7e\xf0\x4c\x9b\x7d\x5d\xcb\xc2\x33\xfd\xd0\x42\xde\xed\x5f\xbf\x8b\xfb\xe4\x0f\xd6\x1e\xbe\xb7\x75\x14\xe4\x4b\x6f\xf1\x09\xee\xc1\x23\x26\x74\x59\x32\x96\x5a\x50\x89\x57\xba\xa8\xc8\xe5\xb0\x27\x5c\xe3\xde\x7f\xb9\x88\x05\xc9\x53\xee\x2d\x09\xbf\x0f\xee\x75\x3c\x76\x34\xe5\xeb\x83\x85\xea\x11\x31\xea\x02\x39\xe3\xda\x62\xcc\x33\x05\x3a\xd0\x47\xf6\x75\x68\x19\x50\x3d\xf0\x95\xa5\x0c\x80\x31\xfa\x9a\x0d\xae\xe4\xc1\x17\xb1\x5d\x38\x5f\x60\x5a\x2d\x91\x67\x2b\x59\x89\xd2\xfe\xb5\x14\xbb\xc5\x77\x2d\x66\xf1\xf2\x6f\x35\xa2\x60\x4b\xba\x0b\xf7\xde\xde\x7d\xac\x2d\xc4\x83\x95\x82\xfb\x50\x7f\x4a\xc7\xcb\xf0\x95\x88\xfa\x93\x5c\x41\xae\x7c\xa6\xd4\x53\xc9\x60\x1f\x2c\x3b\xd3\x90\xbd\xc5\x4b\x9c\x27\x03\x3d\x17\x8f\xf8\x9b\x01\x6c\xdc\x5d\xdb\xc5\x1b\x41\x97\x90\x2a\x1d\x68\x60\x1c\x9d\x90\x69\xaf\xf7\x6e\xc4\x7a\xf6\x0f\x1a\x69\x9f\xef\xc1\xaa\xf3\xb2\x55\xe4\x79\x09\xcf\xfb\x94\x4d\xbc\x17\x68\x53\xda\x7a\x7e\x2a\xc3\xc3\x04\xda\xae\x09\x63\x03\xff\x33\x52\x77\x64\xfd\xb3\xb3\xb8\x65\xe0\xb7\xb3\xb8\xdd\x68\x7f\x7b\x87\x8d\x01\x4d\xd2\x18\x3c\x4b\x5f\x0f\x5b\x05\x0b\x91\x10\x5b\x53\x49\x94\xc3\x9b\x37\xaf\xbb\xff\xd8\x8b\x37\xde\xe6\x70\xf5\x7e\xd2\xee\x88\xe6\x12\x65\xd2\x9e\xea\x6f\x45\xb4\x68\xc4\xf3\xe3\xce\x6e\xa3\xd7\x21\xc4\x4d\xb8\x9c\x62\xb0\xbe\xa9\xd0\xc9\x8b\xb1\xc0\x18\x2c\x19\x1d\x73\xb8\xbd\xdd\xff\xaa\x6d\x8d\x71\xbf\xdf\xbb\xbb\xbb\x87\xab\x4a\x85\xfc\x7c\xe0\xcf\x63\x1e\x45\x61\x2d\x58\x36\xdd\x49\xf6\xd6\x92\x2b\xbf\x84\x42\x0b\xf6\x97\x2b\x7d\xf3\xc5\xe9\xa5\x26\xab\x67\x16\x73\x38\xcc\x00\x04\xab\x60\xd7\x67\x86\x44\x00\x8c\xc9\x78\xdc\x3c\x40\x0f\x32\x7d\xc6\x3b\xd1\xe4\x90\xd7\x77\x15\x68\x2e\xe3\x46\x93\x02\xa5\x0c\xb2\xa8\x82\xf8\xf8\x40\xaa\x30\x92\x44\x34\x35\xa3\x4a\xa1\x71\x7c\x74\xf8\xea\xed\xe1\x48\x9a\xa8\xb5\x28\x2a\x30\xce\x91\x19\x0b\xa5\x8b\x82\x31\x46\x25\x4d\xc0\x78\x7c\xe6\x04\xd9\x69\x7b\x36\xf9\xe3\xc3\xcd\x7a\xf9\xc9\x47\x49\xef\xf3\xa0\xaa\x3a\xa2\x4a\xbd\x55\x45\xd1\x52\xc7\xd6\xf2\xe8\x60\x07\x58\xa5\xbe\x6c\xeb\x94\xe5\xc7\x47\x6f\x63\x76\x7b\xab\x80\xe6\x80\xdf\xa0\x7f\xce\x95\xc2\x33\xd7\x41\xb8\x3a\x9f\xae\x86\xaa\xf6\x7d\xef\x1b\xa6\xd5\x41\x25\xb6\x53\x87\xae\x18\x1c\xa5\x4a\x97\xd8\x86\xcb\x59\x5a\x5d\x62\x49\x51\xb8\xb9\xbb\x3b\x18\xbf\xc0\xd6\xbf\x79\x8a\xaf\x2e\xec\xb7\x95\x4d\x6a\x6b\x27\xde\x92\x69\x72\x38\x9b\x5f\x78\x99\x30\x46\x74\x1b\xb4\x96\x96\xe8\x30\xc6\x09\xfb\x19\x6e\x1e\x0c\x60\xae\xc9\x26\x48\x0b\xc6\xb8\xf0\xb6\xc8\xe1\xf5\x40\x9a\x52\xf3\x23\xca\xf0\x02\x40\xd0\xb2\xc8\xe1\x20\xa9\xfc\x31\x16\x8c\xd2\x79\xf3\x45\xb3\xc0\x94\x59\x9f\xae\xae\x26\xd3\x81\x24\x20\x93\x2f\xa6\x68\xbc\x2b\x62\x0e\x47\x9b\x80\xd8\x91\x89\x1b\x33\xa3\xa0\x5b\x07\x66\x57\x4f\xc6\xb1\x05\xf7\x6a\x13\xc0\xc3\x35\x29\x7d\x69\x9c\xa0\x67\x26\x2a\xe9\x6c\x7e\x8d\x29\x72\x24\xa4\xed\x29\x5a\xdd\xac\xf9\x7a\x75\xf8\x53\x5c\x8e\xa6\xce\xcd\xe6\xb7\x1a\xa3\xc4\xb1\xc3\x26\xd4\xe9\xea\x61\x35\xda\xad\xb0\xf2\xdc\x24\x7b\x87\x9f\x69\x2d\x69\x23\x9c\xa4\x79\xef\x9d\xe0\xcd\x08\xb9\xb6\xd6\x7f\x9f\x30\x2d\xc9\x62\x89\x1f\xa2\xd1\xb6\xed\xa1\x39\xcc\xb5\x8d\x38\x38\x69\x74\xd0\x33\xb2\x24\x84\x5b\xae\x14\xec\xc3\x78\x47\xc1\xc9\xf9\xf9\x08\x82\x2e\xfe\x76\xb6\xb9\xf4\x5e\xfe\x22\x8b\xab\xee\x0b\x29\x31\x87\xc7\x6a\x77\x12\x2f\xbc\x4b\xc7\x1e\x16\x7e\x89\xc8\x2d\xec\x21\x9f\x11\x8d\xf1\x55\x98\xb0\x9f\x93\xc5\xb1\x27\xa9\x20\xe5\x70\x59\x3b\xa1\x0a\x4f\x71\xae\x6b\xbb\xc9\xb4\xa5\xb7\x75\x85\x9f\x53\x67\x1f\x05\x69\x95\x76\x26\x5d\x44\x0c\x2b\x63\x1f\x9f\x52\x85\x54\x36\x57\xfb\xa9\x6c\x4d\x47\x7d\x23\x7d\xa9\xbe\xb0\x43\xc1\x76\x46\xf2\x31\x07\x4b\xae\xbe\xc9\xfa\x88\x26\xdf\xbe\x88\xd5\x31\x5e\x0c\x26\x12\x65\xba\x61\x45\x19\xa6\x34\x38\xdb\xac\xc7\x38\x9c\x43\x2e\x76\xe7\x5c\x87\x69\x50\xf6\xb1\x0a\xd2\x9c\x12\xe7\x70\x7b\x97\x3d\x8c\xe3\x7e\x9f\x26\xee\x0a\xdd\xc3\x83\xde\xc9\xe4\xec\x57\xe7\x97\xe5\xd1\x0c\x45\x1f\xed\x6f\xfd\x50\xed\x7b\x58\xd9\xcd\x76\x5b\xd2\xd5\xfe\x64\x45\xde\x67\x72\x54\xd5\x55\x1b\x0f\x19\x40\x5f\xc9\xa7\xd7\x14\xae\xce\xa7\x5f\x91\x69\xde\xac\xc3\x68\x45\x60\xe7\xdd\xce\x9a\xb5\x63\x7e\x68\x7f\xef\xf5\x13\x5c\xeb\xf8\x66\xab\xf7\xa6\x73\xe3\xdf\x01\x00\x07\x1d\x91\x1e\x5a\x11\x00\x00"),
+		},
+		"/addons/nfs-csi": &vfsgen۰DirInfo{
+			name:    "nfs-csi",
+			modTime: time.Date(2026, 10, 19, 17, 55, 26, 935434154, time.UTC),
+		},
+		"/addons/nfs-csi/v4.7.0.yaml.template": &vfsgen۰CompressedFileInfo{
+			name:             "v4.7.0.yaml.template",
+			modTime:          time.Date(2026, 10, 19, 17, 55, 26, 940105734, time.UTC),
+			uncompressedSize: 9119,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x5a\xdd\x8f\x1a\x39\x12\x7f\xe7\xaf\x28\xa1\x3c\x24\x52\x1a\xe6\x63\x73\xba\x6b\x89\x87\xec\x30\xc9\xa2\xdb\x99\x41\x90\xdd\xd3\xe9\x14\x8d\x8c\xbb\x00\xdf\xb8\x6d\xaf\xed\xee\x0c\x8b\xf8\xdf\x4f\x76\x7f\xd0\x0d\x0d\x0c\x4c\x32\x1b\x29\x87\x14\x85\x76\x95\xab\xca\xf5\xe9\x1f\x3d\x41\x10\xb4\x88\x62\xbf\xa3\x36\x4c\x8a\x10\xd2\xf3\xd6\x03\x13\x51\x08\x63\xd4\x29\xa3\xf8\x9e\x52\x99\x08\xdb\x8a\xd1\x92\x88\x58\x12\xb6\x00\x04\x89\x31\x04\x6a\x58\x20\xa6\x26\xa0\x52\x58\x2d\x39\x47\x1d\x18\x92\x53\x8d\x22\x14\x43\x78\x48\x26\x18\x98\x85\xb1\x18\xb7\x9e\xaf\x48\xc8\x08\x0f\xaa\xc8\x64\x5e\xf1\xc4\x58\xd4\x23\xc9\xb1\xa6\x54\x4f\x08\xed\x90\xc4\xce\xa5\x66\x7f\x12\xcb\xa4\xe8\x3c\xfc\xdd\x74\x98\xec\xa6\xe7\x0d\x9a\x9d\x56\x7c\xb4\xa8\x05\xe1\x81\xd2\x32\x65\x4e\x0c\xea\x40\x3b\xc1\x3a\xe1\x68\xc2\x56\x00\x44\xb1\x8f\x5a\x26\xca\x84\xf0\x9f\x76\xfb\x73\x0b\x40\xa3\x91\x89\xa6\xe8\x57\x94\xd3\x6e\x2c\x0a\x9b\x4a\x9e\xc4\x68\x3c\x4b\x8a\x7a\xe2\xc9\x33\xb4\xed\xb7\xd0\xe6\xcc\xf8\xff\xbf\x10\x4b\xe7\xee\x0b\xd5\x48\x2c\xba\x6f\x11\x72\xb4\xd8\xfe\x7c\xbc\x2a\xca\x09\x8b\x9f\xa8\x2f\x51\x11\x69\xd2\x62\xac\xd4\x64\x86\xb9\xa3\xb6\x75\xe6\x74\xca\x89\x31\x4f\x3a\xdb\xb6\x06\x41\x94\x99\x4b\xdb\x39\xa4\x2a\xf7\x5f\xce\x5e\x68\x7c\x0b\x1b\x84\x17\x35\x42\x0a\xe7\xee\xe3\x7c\xfc\x16\xda\xea\x9b\x58\xd1\x35\x96\xd8\xa4\xd9\x98\x83\xba\xb7\x95\x60\xfa\xf4\xa3\xad\xd3\xf5\xf0\x21\x0f\x9c\x8d\x1a\xe6\x6a\xfd\xa4\x30\x6e\x4b\x3b\x59\x14\x95\x52\x47\x4c\x54\xdb\xc4\xb6\x74\x8e\xc4\xe0\xd7\x77\xd1\xb6\x22\x83\x54\x63\x53\x30\x3e\x37\xf7\xbd\x9f\x99\x88\x98\x98\x3d\xb7\xfd\xb9\xe6\x5b\xed\x7c\x93\x5c\xac\x49\x26\xff\x45\x6a\x7d\xff\x6b\xec\xe3\x27\x8e\x09\xd7\x59\x47\x38\x75\x46\x6c\xb7\xf2\xa7\xf5\x65\x28\x5d\xb9\xe7\xc4\x5b\xf3\xa8\x9e\x94\xdd\x72\x3c\x5d\x8d\x07\x7d\xcd\x52\xd4\xcd\x0e\xea\x50\xc3\x0a\x91\x46\x21\x75\x44\x62\x2d\xa1\xf3\x11\xfe\x91\x30\x8d\x51\x08\x53\xc2\x0d\xba\xb0\xf9\x9a\xfd\x95\x4d\x91\x2e\x28\xc7\x1b\x97\x98\x8e\x3f\x80\x61\xd9\xb7\x5b\x00\x53\xe3\x8d\x1f\x4a\xce\xe8\x22\x84\x0f\x8c\x63\x25\xc4\x7d\x54\x5c\x2e\x62\xc7\x5a\x35\x9f\x28\x65\x9a\xa3\xb8\x1d\x80\xdd\xde\x2f\x4e\xa0\x51\x71\x46\x89\x09\xe1\xbc\x05\x60\x90\x23\xb5\x52\x3b\x0a\x40\xec\x32\xf6\x57\x32\x41\x6e\xb2\x05\xe7\x6f\xb5\x43\x8f\xc5\x58\x71\x62\x31\xdf\x5a\x31\xce\x7d\x78\x4d\xca\x3e\x39\x00\x85\x69\xee\x33\x97\xc6\xde\xa2\xfd\x22\xf5\x43\x08\x56\x27\x98\xaf\x47\xc2\x14\x5e\xcb\xd3\xe6\x03\xd3\xc6\xfe\x8b\xd9\xf9\x2f\xd9\x96\x9c\xd1\xd4\x72\xf5\x76\x6f\xa2\xba\x8f\xeb\x21\xe3\x9a\x17\xdc\xc7\x79\x4e\x0b\xb4\xe8\x33\x46\x9a\x10\x38\x13\xc9\x63\x4e\x57\x9a\x49\xcd\xec\xe2\xca\x8d\xa9\x4c\x45\xe6\xe5\x80\x66\xb6\x05\x54\x33\xcb\x28\xe1\xa5\x51\x34\xf1\x1b\x5c\x33\x7f\xb4\x6b\x3d\x06\x29\x95\xb1\x1a\x6a\x39\x65\x1c\xd7\xeb\x00\x76\xa1\x30\x84\x51\x22\x2c\x8b\xb1\x8f\x53\x92\xf0\xe2\x88\x56\x72\xd4\x3e\xe3\x4b\x07\x07\xf0\x80\x8b\xd0\x1f\xc6\x57\x4a\xa7\x7e\x80\xfc\xe0\x81\xe2\x44\x60\xa9\x44\x2a\x27\x46\xea\x10\xae\x1f\x99\xb1\xa6\x24\xe0\x74\x8a\xd4\x86\x70\x2b\xc7\x74\x8e\x51\xc2\x8b\x3d\x4e\x0e\x61\x02\x75\x45\xf1\x3a\x15\x2b\xe5\x5a\x8a\x62\x31\x99\x61\x08\xcb\x65\x67\xe0\xbe\x8d\x70\xc6\x8c\xd5\x8b\xd5\xaa\x6b\xd8\x2c\xc8\x2b\xb3\xbb\xb1\x3b\x4c\x7f\xea\x9c\x75\xce\xea\x42\x86\x09\xe7\x45\x0a\x0c\xa6\xb7\xd2\x0e\x35\x9a\xac\xac\xb2\x0f\xd1\xb3\x4a\xc2\x05\x10\xa4\xbd\x8b\xea\xa3\x6f\x78\x24\x8a\x34\x1a\xd3\x7b\xf5\xfa\x7d\xbf\x3f\xba\x1e\x8f\xdf\xd4\x58\x38\x92\x08\x75\xe0\xf3\x81\x49\xb1\x8f\x16\x94\x85\xd6\xab\xd6\x59\x75\x07\x3e\x5a\x4d\x82\x6c\x36\x04\x45\x85\xf4\x2a\x59\x9d\xb1\xb9\x08\xcb\xc4\xf6\xce\x2f\xce\xce\x2a\x41\x10\x69\xf5\x34\x99\x9b\x73\xa3\xcb\x75\x80\x94\xf0\x04\x43\x70\x2e\x74\xff\x3a\x46\xd2\x87\x92\x9c\xb5\xa5\x1b\x57\x09\x35\xd7\xc4\x6e\x65\x48\xec\x3c\xdb\x58\x11\x97\xa9\x71\x42\xd0\x06\x11\x5b\x07\x72\x3d\xb1\x2a\xdc\x9c\xc5\xcc\xd6\x56\x00\x62\x8c\xa5\x5e\x84\xf0\xd3\xd9\xd9\x4d\x55\xb2\xc6\x3f\x12\x34\x9b\xdc\x54\x25\x21\x9c\x9f\xc5\x8d\x22\x2e\xd6\x12\x0a\x07\x70\x96\xa2\x40\x63\x5c\xba\x4c\xf0\xc8\x34\x2b\x36\xfb\xbd\x61\x7a\xd1\x39\xbf\x78\x7e\x96\xd5\xd2\xaa\x39\x0c\x8e\xcb\xeb\x2c\x43\x7d\x69\x6a\xc4\xb9\xb5\x2a\x40\x11\x29\xc9\x84\xed\x71\x49\x09\x77\x8d\x30\xbc\xf8\xc7\xdf\xde\xd5\x73\xb8\x9a\xd3\xbb\xa2\xbb\x33\x86\xb0\x33\xf0\x47\x47\xf7\xfc\x9b\x44\x57\x4c\xcd\x91\x21\x15\x53\xa3\x78\x32\x63\x22\x5c\x2e\x3b\xf9\xc8\x5c\xad\x8e\x8c\xe9\xce\xf6\xec\x5b\x7d\xca\x38\xce\xdc\xb0\xaf\x15\x2e\x00\x25\x8a\x4c\x18\x67\x96\xe1\xc6\xb1\x49\x14\xb9\x2b\xdc\xf8\xdf\xe3\xfb\xf7\xfd\x9b\xc1\xad\xbf\xd9\x95\x44\xce\xe5\x97\x61\x21\xf6\xda\x50\xc2\x7d\x2f\xdf\x90\xdf\xd0\xcd\xde\x55\x1f\x3d\x66\x66\x51\xef\xd5\xeb\xdb\xbb\xfe\xf5\xfd\xa0\x5f\x6f\x64\x65\x32\xbd\x7a\x7d\x35\x1e\xdc\x5f\xdf\xf6\x87\x77\x83\xdb\x4f\x6f\xf6\xb7\x97\x5c\xd4\x66\x7b\xf9\xa0\x65\x5c\x3f\xe1\x94\x21\x8f\xf2\xcb\xdc\xd6\x7a\x96\x5f\x6e\xaa\x77\x9c\x91\x6e\x40\x6e\x69\xaa\x1a\xb5\xdd\xcd\x12\xc1\x1e\xc3\x6e\xb7\xb9\x9a\x8a\x2a\x1e\xfa\x2a\xae\x6c\x9e\x12\xc6\x13\x8d\x9f\xe6\x1a\xcd\x5c\xf2\x28\x84\x77\x15\xaa\xab\xb1\x8f\x68\xeb\x16\xfb\x2a\x83\xb2\xe0\x6a\x34\x95\x95\xc9\x1c\x09\xb7\xf3\x3f\xeb\x24\xa9\x6d\x08\xf5\xea\x04\x60\x82\x59\x46\x78\x1f\x39\x59\x8c\x91\x4a\x11\x99\x10\x2e\xcf\x2a\x1c\x79\xf9\x97\xc4\xf3\x2a\x51\xa1\x66\x32\x6a\xda\xb8\xbf\xd0\x95\x8c\x4c\xe0\x0b\x7b\x4f\xb1\xa7\x44\x77\x39\x9b\x74\xdd\xa8\xe2\x68\xbb\x6e\xd3\x16\xab\x96\x8a\xcc\xf2\x64\x6c\xff\xcc\x22\xa6\xb3\x51\x47\x78\xfb\x85\x87\xc7\xc5\x57\x6d\x2f\x39\xa2\xde\xbc\xb3\xec\x70\x9c\x4b\x04\x7f\xbc\xd6\x56\x2e\x1c\xf0\x62\x76\x67\xeb\x7b\xb7\x49\xbd\x68\x1d\x6c\xc8\x18\x2b\xbb\xe8\x33\x1d\xc2\x72\x55\x05\x02\x04\x63\x29\xc6\x78\x2c\x0e\x70\xd5\x76\x18\x01\x64\x08\x75\x6c\x35\xb1\x38\x5b\x64\xa7\x74\xb7\x62\x26\x66\xbf\x79\x52\x71\xf0\x98\x3c\xfe\x26\x48\x4a\x18\x27\x13\x8e\x19\x60\x28\x2f\xa6\xd5\x0d\x47\x03\x89\xdc\xd0\x67\x40\x88\x5c\xc2\x0b\x82\x87\xf5\x6f\x94\xfb\x21\x80\xe7\xfb\xa6\xf7\xff\x53\x20\x4b\x23\x66\x58\x03\x80\x76\x86\x00\xda\x07\xef\xf9\x3f\xde\xfd\xeb\xf2\x47\xba\x7f\xb9\xdc\x8d\xfc\x6f\x22\x81\xce\x62\x48\x4e\xc1\x72\x8d\x72\x7c\xc0\xbf\x02\xac\xdb\x8b\xeb\x76\x27\x40\xde\xb3\x4b\x7b\x3c\x86\x73\x7d\xbd\xf7\xea\x75\x7f\x34\xf8\xfd\x7a\x74\x3f\xba\xfe\x78\x3f\xbe\xbb\xfa\xe7\xfd\xf0\xfd\xa7\x5f\xde\x1c\xbe\x70\xe0\x23\xd2\xea\x33\x00\x95\x71\x4c\x44\x54\x5f\x0c\x60\xb7\x53\x36\x18\x9f\x63\x66\x21\x21\x96\x11\xf6\x9a\xc5\xd4\x6a\xf6\xa4\x0b\xcb\xbb\xfd\x17\xc8\x26\x0b\x1b\xc0\xea\xd6\x1c\xf5\x57\x78\xd3\xcd\x7b\x6d\xf6\xf8\x74\x38\x7b\x42\xc1\x15\x9b\x6a\xfe\xd9\xbd\xb5\xca\xf6\x3d\x83\xa6\xef\x11\xc9\xbc\x20\x90\x6b\xea\x15\xff\x47\x4d\xdf\x1f\x6a\xba\xfc\xeb\x51\xd3\x49\xdd\xe2\x65\xa1\xd6\xd1\xfd\xe5\xf2\x25\x50\x53\x83\xe3\x8e\x41\x4c\x4d\x9d\x7e\x37\x84\xba\xd3\x57\xfe\xd7\xd3\xbf\x0a\xb9\x1d\x7f\xb2\xfb\x7c\x54\x2c\x0e\x4a\x87\x5d\x23\xe8\xa9\xef\xaf\xc6\xd9\xba\x87\x20\xbb\xdf\xf1\xb5\x00\x88\x10\xd2\x56\x2f\xff\xd5\x17\xfb\x1b\xaf\x0a\x98\x09\xa2\x0c\x6d\x04\x9e\x1c\x42\xdb\x35\x64\xd7\x61\x4c\x27\xa7\x78\x8d\xab\x55\xbb\x55\xfd\xcd\x7e\xf3\x95\x99\x22\x9a\xc4\x68\x73\x0c\xe1\x90\x95\x63\x6a\x2f\x97\x3a\x7f\x7b\x06\xed\x6c\xb1\x0d\x85\xf8\xec\xd9\x09\x06\x30\x73\xa2\x71\x93\xdf\xad\x55\xd8\xdd\xa3\xe3\xd6\xd9\x5f\x43\x14\xa3\x61\x6d\x6f\x8d\xb0\x5a\xb5\xb2\x94\xce\xdf\x9f\xba\x97\x74\x21\x0c\xe2\x18\x23\xe6\x52\x6c\xb9\x0c\x80\x4d\x4b\xe9\x3e\xbd\xee\x94\xf7\xda\x6a\xd5\xaa\x3e\x86\x9e\x57\x13\x31\x43\x30\x8a\x33\xbb\x6b\x53\xe0\x6c\x59\xad\x3c\x3b\x8a\xa8\xf6\xed\x7f\x03\x00\xee\x44\x40\x4d\x9f\x23\x00\x00"),
 		},
 		"/bootconfig": &vfsgen۰DirInfo{
 			name:    "bootconfig",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files": &vfsgen۰DirInfo{
 			name:    "files",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 449484254, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files/etc": &vfsgen۰DirInfo{
 			name:    "etc",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
-		},
-		"/bootconfig/files/etc/docker": &vfsgen۰DirInfo{
-			name:    "docker",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 450484263, time.UTC),
-		},
-		"/bootconfig/files/etc/docker/daemon.json.template": &vfsgen۰CompressedFileInfo{
-			name:             "daemon.json.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 450484263, time.UTC),
-			uncompressedSize: 171,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcb\xb1\x0a\xc2\x30\x10\x80\xe1\xbd\x4f\x11\x6e\x6e\x52\x5c\x0b\x3e\x82\x8b\xab\x74\x90\xe4\xac\x87\xb4\x09\x97\xb3\x58\x8e\x7b\x77\x29\x95\x0e\xce\xff\xff\x69\xe3\x1c\xe0\x07\xa3\xcf\x45\x2a\xf4\xee\x06\xf3\x5d\x68\xc1\x10\x47\xce\xef\x92\x98\x16\xe4\x73\x5d\xab\xe0\x94\x60\x68\xb7\x9f\x71\xa4\x2a\xbc\xfa\x89\x98\x33\xef\xec\x29\x52\x6a\xdf\x75\x47\x3c\x85\x94\xe3\x0b\x39\x50\x06\x55\x7a\xb8\x70\xfd\xa5\xcb\xce\x9c\x37\x6b\x0f\xa7\xfa\xdf\xcd\x40\x15\xe7\xb4\x7d\x43\x63\xdf\x00\x00\x00\xff\xff\xe7\x13\xeb\x18\xab\x00\x00\x00"),
+			modTime: time.Date(2026, 10, 19, 17, 24, 4, 943473559, time.UTC),
 		},
 		"/bootconfig/files/etc/hosts.template": &vfsgen۰CompressedFileInfo{
 			name:             "hosts.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 450484263, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 167,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x32\x34\x32\xd7\x33\xd0\x33\xd0\x33\x54\x50\x50\xc8\xc9\x4f\x4e\xcc\xc9\xc8\x2f\x2e\x41\xb0\xf4\xc0\xac\x94\xfc\xdc\xc4\xcc\x3c\x84\xa8\x09\x12\x13\x59\x85\x09\x97\x95\x15\xc8\x20\x08\x20\xda\x38\x33\x24\x26\xb2\x0a\x33\xae\xea\x6a\x3d\x8f\xe2\xcc\x82\xda\x5a\x40\x00\x00\x00\xff\xff\x0b\x57\x23\x96\xa7\x00\x00\x00"),
-		},
-		"/bootconfig/files/etc/isulad": &vfsgen۰DirInfo{
-			name:    "isulad",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 450484263, time.UTC),
-		},
-		"/bootconfig/files/etc/isulad/daemon.json.template": &vfsgen۰CompressedFileInfo{
-			name:             "daemon.json.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 450484263, time.UTC),
-			uncompressedSize: 1124,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x54\x3d\xcf\xd3\x30\x10\xde\xfb\x2b\xa2\xcc\x38\x29\x45\x62\xa8\xc4\x82\xc4\x80\x18\x40\x5d\x11\x7a\xe5\xda\x97\xf4\x88\xe3\xb3\xce\x76\x78\x43\x95\xff\x8e\xec\x26\x69\x1b\x10\x5b\x75\xcf\x47\x9f\xfb\x88\xaf\xbb\xa2\x28\x8a\xb2\x65\x8a\xae\x3c\x16\x25\xfa\x68\x64\xf9\xe6\x56\xd5\xd0\xc8\x68\x82\xe0\x68\x03\xf6\x90\x70\xa3\x78\x41\x5b\x96\xee\x92\x6a\xf5\x20\xb9\x36\x78\xae\xb3\x58\x2f\xb8\x0f\x32\xc0\x8a\x73\xb4\x1b\x1c\x6c\x8b\x76\x6b\x6a\xa8\x15\x06\x06\x30\xa9\xfe\xe9\x74\xfa\x7a\x5a\x10\x87\xba\x41\xf3\x2f\xc3\xca\xa1\x7e\xd4\x93\x0b\xbe\x3c\x16\xb7\xd6\xd6\x6a\x12\x8b\x9e\x74\x76\xd8\xbf\xdf\xef\x67\xc9\x4a\x70\x32\xfc\xa7\x9d\x4c\xeb\xe5\xab\x58\x42\xbc\xdd\x02\x1e\x7f\x67\xe0\xdd\xfe\xcb\xc7\x32\x43\xd3\x43\x28\xcd\x38\x00\x27\xdc\x07\x4d\x31\x2c\x81\x15\xd9\x20\xd1\x02\x0b\x43\xed\x73\xea\xbb\xe4\xa7\x27\x7b\xfb\xe3\x27\xdf\x0b\x51\x27\xbc\x03\x95\x63\x43\x50\xf5\xbc\xb1\x39\x7a\x9d\x08\x7e\x29\x56\xc9\xe5\x61\x39\x1c\x44\xda\x6a\xca\x72\x2c\xca\x43\x7f\x87\x88\x65\x0b\x0f\x89\x69\x00\x36\x72\x3c\x6c\x19\xf3\xa0\xbf\xdf\x23\x2f\xcc\x2a\xfd\x60\xd4\xf0\xd2\x01\x5b\x30\x2f\xea\x02\xaa\xfb\x10\x38\xce\x2d\xfc\x98\xad\x18\x5a\xf4\x81\x47\xd1\x23\x33\xf1\xc6\x4e\x93\xea\x80\x2b\xa4\x67\x11\x5a\x0f\x2a\x32\x88\x59\x8d\xb0\xd1\x5d\xaf\xd5\xe7\x5e\xb6\x70\x9a\xdd\xa7\xe9\xd9\xc0\x91\x16\x5e\x5a\x7d\xa6\x57\x81\x89\x98\xba\xfc\x5b\x54\x5f\xaf\xd5\x37\x19\x3d\xe4\xfa\x34\x2d\xfd\x5b\x19\x70\x80\x2a\xf6\xd2\x77\x79\xa3\x39\xcd\x8a\x42\xf8\x45\xdc\x09\x67\x62\x8b\x36\xe1\xca\xe2\xba\x6e\x8b\xe2\x8c\x56\x68\xcc\x93\xad\xc9\x85\x5a\x59\xac\xcf\x68\x1f\x29\x8a\x6c\xb3\x72\xd2\x62\x13\xc7\x42\xa8\xd6\x43\xcf\xb1\x85\x91\x23\xb0\xc8\xc3\x2d\x8f\x45\x23\x8d\x87\x19\x8f\x1e\x84\x06\xc5\xa3\x0b\xa0\x45\x07\x63\x79\x2c\xd2\xf8\xb7\x23\xf4\x1d\x3a\x31\x00\x63\x33\x0a\xb0\x0d\xb1\x82\x8d\x93\x62\x5c\x5e\x80\xcd\x67\xd5\xc9\x20\xf3\xa3\x41\xd5\x7a\xc6\xba\x4a\xd5\x6a\x38\xcc\xa7\xba\x9b\x76\x7f\x02\x00\x00\xff\xff\x68\x18\xbf\x42\x64\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x32\x34\x32\xd7\x33\xd0\x33\xd0\x33\x54\x50\x50\xc8\xc9\x4f\x4e\xcc\xc9\xc8\x2f\x2e\x41\xb0\xf4\xc0\xac\x94\xfc\xdc\xc4\xcc\x3c\x84\xa8\x09\x12\x13\x59\x85\x09\x97\x95\x15\xc8\x20\x08\x20\xda\x38\x33\x24\x26\xb2\x0a\x33\xae\xea\x6a\x3d\x8f\xe2\xcc\x82\xda\x5a\xc0\x00\x0b\x57\x23\x96\xa7\x00\x00\x00"),
 		},
 		"/bootconfig/files/etc/nkdfiles": &vfsgen۰DirInfo{
 			name:    "nkdfiles",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 451484273, time.UTC),
+			modTime: time.Date(2026, 10, 19, 17, 30, 6, 139465996, time.UTC),
 		},
 		"/bootconfig/files/etc/nkdfiles/init-config.yaml.template": &vfsgen۰CompressedFileInfo{
 			name:             "init-config.yaml.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 451484273, time.UTC),
-			uncompressedSize: 849,
+			modTime:          time.Date(2026, 10, 19, 18, 30, 38, 290957443, time.UTC),
+			uncompressedSize: 1029,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x51\x41\x8f\x9b\x3c\x10\xbd\xf3\x2b\x2c\xee\xc0\xea\xd3\x77\xa8\x7c\x8b\xd2\x3d\x44\xdb\xae\xa2\xa4\xed\xdd\x81\x89\x77\x84\x99\x41\xe3\x21\xcd\x0a\xf1\xdf\x2b\x03\xc9\x6e\x8e\x95\x7a\x02\x3f\xbf\x37\xf3\xde\xb3\xeb\xf1\x17\x48\x44\x26\x6b\xda\xe1\x04\xae\xe9\xca\xf6\x4b\x2c\x91\xab\x71\x2c\x5f\x16\x64\x73\x27\x4d\x53\x76\x62\xd6\xa8\xe2\xfa\x1f\xdc\x02\x45\x9b\x15\xc6\x0b\x0f\x7d\xb4\x99\x31\x85\x89\xef\x51\xa1\xb3\x77\x56\x0f\x12\xed\x3a\xd9\x36\x70\x76\x43\xd0\x82\xb8\x81\x42\x93\x3e\x33\x66\xfe\x5a\x33\x8e\xe5\x3c\x71\x9a\x12\xa6\xc1\x9a\xff\xfe\x7f\x7b\xea\x9e\x62\x66\xcc\x10\x9d\x87\xdb\x02\xf4\x84\xe4\xe7\x7f\x37\xe8\x1b\x90\x62\xed\x14\x99\xb2\x16\xa9\xb1\x66\x47\xa8\x5b\xa6\x33\xfa\x41\x16\x3c\xad\x3b\x80\xc7\x64\x28\x01\x69\x50\x2d\x78\xe4\xba\x05\x9d\x37\x6f\x6f\xa7\x79\x3b\xb9\x0e\x66\xf8\x95\x1b\x78\x75\x1d\xcc\x68\x0a\x11\x40\x9f\xaf\x2a\x6e\x23\x7e\xb6\x63\xcc\x85\xc3\xd0\x41\xd1\x87\xc1\x23\x15\x0d\x8a\x35\x79\xc5\xbd\x56\x01\x4f\x70\x85\xba\x4a\x32\x21\x50\x88\xd5\x3a\x61\x25\xc7\x6a\xd1\x56\x33\x2d\xcf\x6a\x10\xc5\x73\xca\x02\x2f\xf0\xbe\xd8\x7a\x80\xa6\x29\x2b\x8a\x22\xfb\xfb\x27\x5b\x7a\xd9\x86\x21\x2a\xc8\x63\x35\x35\x93\x0a\x87\x00\xf2\xdd\x91\xf3\x20\x29\x15\x3c\x46\x3c\x07\xb8\x16\xff\x2e\xe7\x07\xf1\x1e\x63\xf5\xfd\xe1\x18\x3b\xe7\xe1\x00\x3d\x47\x54\x96\xa5\x8c\xdd\x82\xcd\xcf\x98\xba\x58\xad\xef\x83\x23\x78\xa6\xa6\x67\x24\xb5\x26\x1f\xc7\x72\xb3\xdf\x1d\x41\x2e\x20\x3f\x0f\xdf\xa6\x29\xcf\x08\xf4\x37\x4b\x8b\xe4\x53\xa0\x08\x72\xc1\x1a\x8e\xc3\x89\x60\x15\x1c\x3f\x43\x49\x61\x4c\xcf\xcd\x67\xc6\xfe\x76\x5c\x6e\x1b\x8a\x5f\xb9\x73\x48\xd6\xe4\xf5\xd2\x6b\x19\xb8\x76\x21\xff\x13\x00\x00\xff\xff\x05\xcc\x21\x0f\x51\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x53\xc1\x6e\xdb\x30\x0c\xbd\xeb\x2b\x84\xdc\x6d\xb7\xc3\x0e\x83\x6e\x41\x5a\x0c\x45\xb6\x22\x68\xb6\xdd\x15\x9b\x71\x89\xc8\x94\x41\x51\x59\x0b\x43\xff\x3e\x58\x72\xdb\xb4\xc0\x0e\x03\x76\xb2\xfd\xfc\x1e\xc9\xc7\x27\xd9\x11\x7f\x01\x07\xf4\x64\xf4\x29\x1e\xc0\x76\x43\x7d\xfa\x12\x6a\xf4\xcd\x34\xd5\xdb\x82\xac\x5f\x49\x29\xa9\x83\xf7\x12\x84\xed\xf8\xc3\x9f\x80\x82\x51\x95\xee\xd9\xc7\x31\x18\xa5\x75\xa5\xc3\x73\x10\x18\xcc\x2b\x6b\x04\x0e\x66\xa9\x6c\x3a\x38\xda\xe8\xa4\x22\xdf\x41\x25\xb3\x5e\x69\x9d\x9f\x46\x4f\x53\x9d\x2b\xa6\x34\x63\xe2\x8c\xfe\xf4\xf9\xf1\x6a\xb8\x0a\x4a\xeb\x18\x6c\x0f\x2f\x0d\xb0\x27\xa4\x3e\xbf\xdb\x28\x8f\x40\x82\xad\x15\xf4\xa4\x4e\x48\x9d\xd1\x77\x84\xb2\xf1\x74\xc4\x3e\x72\xc1\xe7\x76\x0f\xd0\x63\x90\x02\xcc\x85\x5a\xc6\xbd\x6f\x4f\x20\xb9\xf3\xe6\xe5\x2b\x77\x27\x3b\x40\x86\xef\x7d\x07\xf7\x76\x80\x8c\xce\x26\x1c\xc8\xed\x93\xb0\x5d\x73\x9f\xc7\xd1\xfa\xec\x5d\x1c\xa0\x1a\x5d\xec\x91\xaa\x0e\xd9\xe8\x55\xe3\x47\x69\x1c\x1e\xe0\x09\xda\x66\x96\x31\x81\x40\x68\x96\x0a\x0b\x39\x34\x45\xdb\x64\xda\x4a\xb5\xc0\x82\xc7\xd9\x0b\x6c\xe1\xb9\x8c\xf5\x0e\x4a\x49\x55\x55\xa5\xfe\x3d\xb2\xb2\x97\x8d\x8b\x41\x80\xdf\xaf\xa6\xf5\x24\xec\x9d\x03\xfe\x6e\xc9\xf6\xc0\xb3\x2b\x78\x6f\xf1\xe8\xe0\xa9\xfa\x7f\x3e\xdf\x88\xaf\x36\x96\xb9\xdf\x26\xc6\xc1\xf6\xf0\x00\xa3\x0f\x28\x9e\xcb\x32\xee\x0a\x96\x63\x9c\x77\xb1\x8c\xbe\x73\x96\xe0\x96\xba\xd1\x23\x89\xd1\xab\x69\xaa\xd7\xbb\xbb\x3d\xf0\x19\xf8\xe7\xc3\xb7\x94\x56\x8a\x40\x7e\x7b\x3e\x21\xf5\xb3\xa1\x00\x7c\xc6\x16\xf6\xf1\x40\xb0\x08\xf6\x97\xd0\xac\xd0\x7a\xf4\xdd\x25\x63\xe7\xbb\xcb\xbf\x1d\x85\x1b\x3f\x58\x24\xa3\x57\x6d\xd9\x6b\xed\x7c\x6b\xdd\x4a\x4d\x53\xa5\xf1\xa8\xeb\x5d\x3c\x38\x6c\xb7\xf0\x1c\x6e\x37\x37\xfb\x75\x4a\xea\x08\x56\x22\xc3\x57\x2b\xe5\x2c\x7f\x60\x18\x2d\x1c\x21\xeb\x81\xba\xbf\x84\xed\x40\xea\x36\x27\xf8\x92\xf9\xf9\xfa\x00\x62\xaf\x97\x90\xb7\x85\xf3\x21\xe4\x7c\x41\x6f\x18\xcf\xc0\xe5\x5c\x5d\x00\x29\xa9\x3f\x03\x00\x14\xe5\x3e\x66\x05\x04\x00\x00"),
 		},
 		"/bootconfig/files/etc/nkdfiles/node-pivot.sh.template": &vfsgen۰CompressedFileInfo{
 			name:             "node-pivot.sh.template",
-			modTime:          time.Date(2024, 12, 2, 11, 3, 24, 251014642, time.UTC),
-			uncompressedSize: 13430,
+			modTime:          time.Date(2026, 10, 19, 19, 26, 44, 5699277, time.UTC),
+			uncompressedSize: 9013,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x3b\x5d\x53\xdc\xb8\x96\xef\xfe\x15\x67\x4c\x6f\xa0\x99\xb1\x3b\xd9\xda\xda\x07\x6e\x3a\xb3\x55\x84\xdc\x65\xef\xdc\x84\x82\xe4\x89\x26\x5d\xc2\x3e\xee\xd6\xe0\x96\x1c\x49\x6e\x60\x09\xff\x7d\x4b\x92\x65\xcb\x5f\xdd\x30\xb5\x75\x79\x48\xb0\x74\xbe\x74\x74\xbe\x24\x1d\x0e\x7e\x99\xdd\x52\x36\xbb\x25\x72\x1d\x04\xf8\x80\x09\x7c\xf8\x00\xb3\x2d\x11\xb3\x9c\xaf\x66\xec\x2e\x5d\x32\x9e\xe2\xb2\xa0\x5b\xae\xe2\x9c\xaf\xe0\xdf\x3f\xbc\x79\x17\x04\x07\xf0\x15\x37\x45\x4e\x14\xc2\x96\x08\x4a\x6e\x73\x94\xc1\x9a\xf3\xbb\x65\x46\x73\x94\xcb\x82\xa8\xf5\x3c\x7c\x7a\x8a\xff\x9b\xf3\xbb\x4f\x7a\xe8\x82\xa8\xf5\xf3\x73\x18\x88\x92\x29\xba\x41\x33\x79\x69\x7f\xd7\xc3\x74\x43\x56\xb8\x14\xb8\xa2\x52\x89\x47\x33\x7b\xae\x87\x2e\xab\x11\x0d\x53\x90\x52\xe2\xd2\x40\x1a\x80\x0b\xfd\x6d\xa0\x0c\x61\xcc\x91\xb8\xf9\x65\x29\x72\xcb\xc2\x8e\x1a\xa8\x6f\x97\xb9\x06\x4c\x50\x28\x59\x03\x9c\xea\xaf\x6f\x22\xb7\x0c\x92\x3b\x8d\x9c\x53\xa9\xe6\x47\x4f\x4f\x82\xb0\x15\x42\x7c\x61\x87\xff\xa0\x52\x3d\x3f\x6b\x9c\xe7\xe7\x10\x9e\x9e\x90\xa5\xcf\xcf\xd3\xc0\xc9\xbc\xdc\x50\x21\xb8\x90\x15\x5b\x3b\xf8\x4f\x3b\xa6\x89\x07\x07\xf0\xa9\x64\x89\xa2\x9c\x81\xe2\xb0\x21\x8c\xac\x10\x24\x8a\x2d\x4d\x50\x06\xf6\x7b\x59\x7d\x1f\x4d\xe1\x29\x00\x00\xc8\x79\x42\x72\x07\xb5\x64\x44\x6b\x6e\xf2\x2e\x34\x73\x34\x03\xf9\x28\x15\x6e\x12\x95\x03\x95\x11\x49\x14\xdd\x22\x44\xd1\x8f\x92\xa2\x82\x70\xe2\xa3\x85\x7f\x03\xb5\x46\x66\x10\xf5\x0f\x26\x6b\xde\x01\x01\x2a\x81\xe4\x02\x49\xfa\x08\xa2\x64\x8c\xb2\x95\x65\x84\xb9\xc4\x7d\x88\x8c\x2b\x87\xf4\x1b\x48\x45\x84\xa2\x6c\x15\xc7\x71\x58\x23\xb6\xc4\x35\x10\x5d\x11\xe1\xcd\x1b\x0f\x04\x99\x36\xab\x3d\xcb\x18\x93\xc8\x49\x00\xb2\x4c\x12\x94\xd2\x93\xa3\xb5\x9a\x06\xff\x9b\x65\xa7\x78\x25\x1b\x17\x4e\x82\x16\x65\x8f\x90\xc1\x7d\xa0\x0a\xde\xd5\x43\x19\x0d\xaa\xff\x9e\xbb\x1b\x9e\xac\x31\xb9\x03\xc2\xd2\x8a\x7e\x45\x34\x30\xe3\x4b\xc2\xd2\xa5\x19\xff\xeb\x16\xa0\xad\x36\x2a\x19\x55\x91\xf1\x41\xf8\x09\x2b\x81\x05\x44\x3f\x3a\xba\x89\xab\x8f\xae\x2a\xdb\x16\xd8\xd5\xfb\xcb\x0c\xc1\x21\xa7\x1c\xad\x49\xe0\x03\x95\xea\x37\x90\x77\xb4\x28\x7c\x83\x18\xd2\x50\x4a\xa5\xd1\x77\x46\x05\xde\x93\x3c\xd7\xcb\xa3\xea\x50\x56\xfb\x90\x06\x15\xc0\xd2\x01\xd4\x3a\x3a\x80\x53\xa3\x5d\x9a\x79\xc8\x0d\xde\x0b\x9c\xc5\xa1\xa5\x83\x6e\xf2\xc9\x23\x5a\x1b\xb9\x95\xa6\x6b\xe5\x63\xe6\xce\x8b\x86\x47\xdb\xce\xbb\xcb\x4e\x47\x4d\xbc\x16\xa3\x42\x49\x9d\x75\x67\x65\x9e\x3f\xee\x37\xf1\x4f\x84\x6a\xa4\x01\x55\xbf\xc8\xaa\x07\x76\xdf\x57\x8c\x17\x01\x76\x6c\xb2\xce\x31\xa5\x42\xd0\xc9\x02\x8c\xa1\x06\xd5\xd0\x52\x0f\x99\x91\x8e\xed\xa7\x54\x60\xa2\xb8\x4e\x0b\x9e\xe1\x5f\xc3\x2f\x10\xa5\x10\x4e\xea\xe9\x10\x6e\x3a\xaa\x13\xa8\x4a\xc1\xc0\x09\xe3\xfb\xd3\x1a\xf3\xdc\x26\xab\xf9\x91\x4f\x63\x76\x3c\x6d\x38\x4c\x9e\x0e\x3c\xc0\xeb\xff\xba\x79\x86\x08\x7f\xc0\xdb\x1e\x23\xab\x8d\xcf\xdc\xae\x08\x32\x5e\xb2\x14\x28\xb3\xab\xac\x89\x9f\x80\xc7\xa8\x23\x64\x4b\xc6\x8c\x0b\x43\x49\x93\x08\x27\x4f\x1d\x19\xc2\xbf\x41\xca\x7d\x3b\xbb\x86\x28\x83\x70\xa2\x01\xfa\x3a\x68\xc4\x3b\x33\x7a\x36\x41\x31\x11\xb4\x50\x27\x60\x51\x5a\xa0\xb1\x23\xd4\xdd\xfd\x94\x33\x1c\x88\x6a\x9c\x65\x74\x55\x0a\x84\xd3\xcb\xf3\xe8\x0b\x54\xe9\x3d\xa8\xc7\x97\x89\xa0\x7c\x59\x0d\x77\x36\xd6\x02\x99\x85\xcd\xc3\x19\xaa\x64\xa6\x81\xcd\x3f\xb1\x9e\x0b\x83\xae\x77\xab\xb5\x63\x64\x71\xad\x96\x4c\x90\x91\x2d\xc3\xd0\xfa\xf0\xc8\x87\x23\x3b\xd6\xa7\xa5\xad\xd8\x6c\xdf\x09\xb4\x08\x04\x03\xbe\xe1\xb6\x8b\x66\x4d\xa8\x5d\x5c\x6b\xf1\x17\xb1\x29\x43\x16\x37\x61\x47\x8e\x8e\x14\x07\x70\x6e\x17\x95\x53\x86\x70\x4f\xd5\x1a\x0e\xbd\x32\xe7\xd0\x04\x52\x76\x58\xc7\x51\x92\xa6\x40\x55\x65\x51\xf9\xa3\x6f\x04\xbf\x34\x32\x7c\xf7\x48\xec\x11\x40\xff\x48\x4c\x21\xa2\x70\x38\xfb\xde\x11\x7e\x46\xc0\xa3\x04\x73\x08\x0f\xc3\x49\xbb\x52\x9b\x4d\x7c\x5e\x87\xe1\x61\x87\xdd\x78\x48\xda\xbb\x74\xbb\xab\xbf\x81\xc0\x22\x27\x09\x02\x55\x12\xb6\x24\x2f\x71\x50\x78\xf9\xf3\x7b\x5b\xd6\xf8\xf8\xe7\x6b\x85\xff\x39\x2a\xfd\x68\x04\x8c\x10\x42\xa3\x34\xab\xb3\x9b\x05\x6b\x33\x5d\xec\xe4\xb9\x08\x43\x5d\x6f\x0f\x30\xf5\x4c\xab\xc9\x14\x02\x6d\xe9\xa0\xf9\x0d\x9a\xf3\x95\x97\x0e\x1c\x34\xa6\xd6\x63\x46\x33\x78\x93\x15\x1c\x7d\x0f\xbe\x6f\xf1\xfb\x43\x40\xb5\xd2\x5e\x0c\xa8\xc6\xf7\x07\x01\xce\x14\xa1\x0c\x85\x9c\x55\x38\x14\x65\x15\x10\xea\x14\xeb\x5b\xfc\x19\x1c\x7e\x5f\xc8\xe3\xa3\x83\xdf\x17\xf2\x78\x5a\xb2\x1f\x25\xc9\x69\x46\x31\x8d\x24\x12\x91\xac\xa3\x86\xcc\x42\x1e\xcf\x0f\xf7\xf9\xe4\x47\xcc\xb4\x4d\xee\x24\x64\x8b\x93\xb6\x7f\xea\x9a\xd1\xd5\x2c\x09\xdf\x6c\x90\x69\xed\xf3\x52\x75\x34\xbe\x9b\xf0\x1c\xae\x17\x61\xca\x93\x3b\x14\x31\xe5\x8b\xf0\x26\x84\x9f\x20\xcb\x94\x83\x42\x84\x88\x74\x23\xdb\x87\x59\x8a\xdb\x19\x2b\xf3\xbc\x6b\x39\xd7\x10\xe9\xfc\xd1\x3d\xa1\xf4\x83\x61\x6b\x27\xb4\xfa\x91\xa9\xf9\xe4\x28\x21\x0a\xde\xbf\x3f\xfb\xf2\x69\x79\x7a\x79\xfe\x65\x79\x79\xf6\xf7\xe0\xfa\xda\x51\xbb\xb9\x09\x0a\x81\x19\x7d\xd0\xae\x55\x8b\x1b\x06\x9a\x96\x31\x8d\xf6\xb0\x87\x19\x5b\x39\x6e\x6e\x5a\xb0\x7d\x31\x83\x16\x67\x27\xeb\xb4\x5f\x6a\x55\x95\x68\x5b\xfe\xd7\x69\xad\x9b\x12\x9c\x30\xb5\x75\x5b\x39\x13\xbe\x29\x72\x54\x98\xee\x2b\xf8\x76\xb8\xea\x6b\xdc\xf5\x45\xc5\xdc\x88\xdb\xee\x3b\x9e\x94\x45\x4a\x14\x2e\xef\xca\x5b\xcc\x51\x2d\xed\x42\x3b\xce\xe9\x4f\x2e\x53\x2a\x2a\x0f\xb5\xeb\x4c\xab\xff\x67\x15\x94\x3b\x58\xc4\x95\x76\x2c\x09\x86\xf7\x06\x7d\x1e\x4e\xba\xd4\x66\xef\xde\x46\x7a\x8c\xa4\x1b\xcf\xbd\x2d\x1a\xcf\xd3\x51\xb4\x36\x4e\xd0\xae\x82\x1c\xbf\x81\x6a\x70\x63\x21\x1c\xe9\x46\x55\x9b\x6d\x0b\xd1\x07\xa9\x61\xbc\x82\x9d\xe0\x86\xb3\x48\x60\xce\x49\x3a\x30\xef\xf6\xa3\x92\x3b\xe8\x18\xd8\x3f\xec\x70\xc7\xb2\xec\x6e\x0c\x56\xf4\x03\x31\xfb\x4c\x7b\xc8\xc9\xdd\x20\xa5\x76\x19\x13\xef\x88\xe3\xaf\xaf\xe5\x5c\x64\x4e\x7b\x15\x5d\x33\xe5\xca\xa9\x14\x33\x52\xe6\x0a\x3e\x40\x3b\xaa\xa7\x33\x0b\x10\x2b\xbe\xb1\xce\x27\x09\x4b\x6f\xf9\x83\xbb\xda\xd9\x99\xa5\x5b\x19\xa0\x4a\xfe\xa1\x3c\xf8\xbe\x38\x5a\xc8\xe3\x16\x21\x1d\xea\x17\xf2\x78\x31\x8d\x8f\x17\x93\x83\xc5\xbb\x45\x38\x69\xcf\x87\x07\xe1\x2e\xd1\x06\x33\x6c\x8b\x02\x64\x14\x73\x53\xe7\xef\x20\xf3\xca\x9d\x6d\xdc\xda\xe2\xc1\xeb\x39\xc6\xed\x12\xa2\x51\xd2\x95\x75\xdb\xd3\x95\xe0\x65\x01\x73\xc8\x48\x2e\xb1\x37\xaa\x44\x89\xbb\x35\xb3\xab\x34\xa9\x11\x5e\x53\xa0\x34\x58\xaf\xa8\x52\xba\x48\xaf\x2a\x55\x3c\x73\xad\x43\x7d\x95\x77\x46\xec\xfd\xd5\xd5\x4b\x4b\x65\x6d\xbb\xfd\x7f\x4c\xcc\x5f\x3e\x7f\x6d\xa5\xc7\xeb\x22\x2f\x57\x94\xc9\x38\xb4\x87\xa8\x4a\x96\x78\x25\x8a\x24\xde\xbe\x8b\x13\x41\xc3\xb8\x93\x89\x65\xec\x65\xea\x1b\x2f\x81\x20\x4b\x0b\x4e\x99\xd2\x35\xc9\x80\xb8\x37\xc1\xa0\x08\xd3\xc1\xdc\xf8\xb2\x63\x99\x97\x87\xfb\x41\xcd\x47\xed\x5c\x31\xc5\xad\x5a\xbd\xa3\x3f\x85\x9b\xc2\x6e\xd1\xe4\x68\x73\xa7\xbf\x1a\x11\x4d\x89\xa0\x5d\x24\x9c\x75\xb5\x32\x13\x60\x8a\x04\xa9\x52\xca\xba\xe7\x28\x78\xff\xfe\xfd\x40\xcd\xa1\x8b\xf9\x9a\x9d\x9f\x3d\x34\x1b\x93\x66\x9a\xd9\xd1\xa3\x92\x59\xff\x0e\x0b\x1d\x2f\x4a\xbc\x22\xf6\xb2\xe7\x26\xf5\x95\xa3\x5f\xb1\x98\xe5\xbf\xdc\x8d\x5f\xed\xca\xaf\xaa\x60\x86\x90\x5f\x79\xcb\x2a\x50\x07\xcd\x44\x50\xbd\x9c\x7e\x62\x0c\x2c\xc0\xd2\x02\x0c\x17\x3d\x83\xd7\x12\x89\xca\xe3\x47\xd2\x72\xe4\x03\x38\xad\xb8\xf5\xd9\x98\x94\xe8\x97\xcf\xa7\x5f\xff\x80\xde\x49\xaf\x4a\xa2\x91\xf3\xb3\x13\x7d\x36\xa0\x0f\x27\xb3\xd9\x4c\x94\xac\x13\x4a\x9c\x27\x4b\x9e\xdc\x55\x0f\x26\x7f\x05\x51\x33\xe4\xa5\x3a\x81\xb7\x41\x8a\xb7\xe5\xea\xc4\xa6\x81\xa0\x91\xb3\x77\xeb\x32\x50\x5f\x50\x59\xa9\xba\x9d\xd7\x3a\xb5\xd8\x0b\x6e\x60\x46\x77\x6a\x90\x3e\x10\xd5\x8a\x02\x2f\xc9\xa3\xfb\x4c\x62\x84\xe6\x90\x75\x15\x28\x32\x2e\x36\xf0\xe5\xea\xab\x40\x04\x81\xb7\x44\x62\x50\x8d\x2e\xed\x67\xc7\x98\xcc\x3b\x53\xeb\xda\x32\xfa\x5f\x08\x27\xa5\xc8\xc7\x34\xd2\x7b\xc8\x32\x57\xd9\x9b\x42\x3d\x36\xf7\xe8\x20\x8a\x4d\xc4\xa5\x6a\xa4\x18\xd2\x04\xcd\xfa\x70\x10\x45\xf8\x50\xa0\xa0\xfa\x74\x4a\x72\x08\xed\x6c\x54\xb2\x2d\x0a\x7b\x24\x35\x8c\x4f\x6c\x46\x38\x99\xcd\xac\xac\x51\x74\xfb\x58\x10\x29\xa3\x54\xd0\x2d\x8a\xd1\xb0\x70\x69\xb9\xf0\x02\xbb\xd1\xa9\x5d\x00\xc1\x25\xde\x72\x6e\x6e\x23\xd5\x1a\xab\x10\xd4\xba\x49\xef\x16\xd5\x1a\x7c\x5f\x48\xe9\x71\xcf\x8c\x19\xc4\x60\x8b\x1c\xb8\xa7\x79\x6e\x92\xc6\x2d\x56\x14\x31\xfd\xcb\x2f\x3a\x19\xaa\x64\x6d\x5f\x74\xc8\x16\x21\x41\xa1\x68\x46\x13\xa2\x50\x06\x66\xce\xbe\xea\x90\x2d\x2e\xfd\xb9\x6e\xb4\x31\xcf\x91\xcd\xd9\x4a\x17\xf5\x82\xa1\x42\x39\x2b\xee\xa8\x1e\x72\x71\x7d\x73\x97\x52\x01\x51\xa1\x1d\xcb\x21\xf9\x47\x26\x81\xb2\xe0\x4c\xea\x2c\x97\x68\xb3\x89\x64\x0d\xa9\xb7\x70\xda\x31\x41\x07\x3e\x66\x87\xf6\x8c\x01\x8d\x23\xd9\xf5\xfe\xcf\xd5\x97\xcf\x90\x12\x45\xe2\x9d\x77\xa2\x56\xa4\x82\xa8\x35\x54\xc9\xd1\x0c\xdf\xaf\xb5\xc7\x9d\x7f\xba\x9a\x83\x40\x92\x42\x24\xcc\xfd\x5f\xeb\x46\xdb\x4d\xf8\xc8\x55\xba\xd5\xb0\x0d\xdb\xa6\x20\x6a\x6e\x00\xdc\xd1\x5f\xdb\xc1\x7f\xfe\x07\x44\xe9\x74\xe0\x9a\xa0\xc9\xd5\x9a\x47\x58\xdf\x6f\xc3\x7b\x78\xef\x68\x35\xea\xf9\x09\x7f\xfe\xd0\xf2\x1c\xc6\xd7\x37\xf0\x13\xc2\xc5\x51\x7c\x41\xd4\x7a\x0a\x8b\xa3\xf8\xd4\x12\x9b\x86\x87\xd3\x81\x07\x2d\x7b\x8f\xa4\xed\x9b\x32\xa9\x48\x9e\xa3\x5e\x95\x79\x4d\x96\x46\xc2\x14\x34\xe4\x1a\x01\xd9\x96\x0a\xce\xb4\x53\x06\x16\x6d\x59\xa3\x2c\x1d\x4a\xc7\x72\xdc\xf0\xfc\xc8\xd7\x08\x53\x82\x24\x77\x91\xe2\x3c\x97\xcd\xb8\xa0\x9d\x11\xc9\x13\xa2\x5a\x9a\x34\x59\x82\xa1\xba\xe7\xe2\x8e\xb2\x55\x54\x15\x92\x4d\x34\x29\x94\x7d\xe7\x77\x03\x7f\xfe\xa8\x7f\xbd\x5f\xa1\x25\x66\xb5\xfd\xf4\x44\x33\x88\xcf\xe5\x47\x13\x43\x20\x7a\x7e\x36\xc3\x4e\xe0\x5f\xe7\x47\x36\xba\x44\xc8\x56\x94\xa1\x43\x42\x96\xd6\xb0\x8e\xc4\xb9\x2c\x73\x92\x0e\x91\xa0\x57\x7a\x66\x17\xee\xa9\xa0\x7c\x08\x53\x6b\x83\xef\x44\x6c\x6a\xa6\x21\xf4\x7a\x76\x88\x46\x65\x3e\x4f\x0e\xc1\xbc\xd8\xec\x7a\x0e\xae\xf6\x19\x52\x2c\x90\xa5\xda\xd6\x1d\xaa\xf7\x36\x5c\x01\x2d\x6b\xa0\xbe\x51\x58\xc6\xe7\x16\x50\xc7\xd5\x3e\xc1\x3a\xbe\xb6\x0d\x68\xa9\xb8\x63\x30\x3f\x9a\xba\xf4\x6f\x25\x7c\x2c\x37\x0e\xae\x8e\x1f\xe6\x61\xcc\x6f\x96\xb0\x2f\x63\x0c\xe1\x2d\xbc\x79\xe3\x2d\xde\x4e\xbe\xbd\x79\x0e\xe1\x97\x39\x84\x21\xdc\x0c\x87\x9a\x6f\x52\xcb\x5b\x9f\xbb\x52\xc7\xd1\xbc\x69\x9f\x34\x0e\x3f\x20\xf0\xaf\xf3\xa3\x2e\x3f\xad\xf0\x69\x2b\x18\x1d\xd8\xb5\x88\xa2\xbf\x16\x73\x12\x7b\x7a\x8a\x2f\x8b\x4d\xd5\xe7\x71\x5a\x9a\x6e\x90\xfe\xf5\x51\xb1\x31\x5d\x23\x03\xc0\x35\x0c\x11\xc9\x9a\x2a\x4c\x54\x29\x74\x18\x2e\xcd\xa3\x78\xb4\x99\xb6\x88\x38\x61\x6d\x93\x8c\x09\xfa\xec\x2e\x35\xcf\x7b\x33\xb7\xc2\xd9\xc4\x27\x15\xf6\x0e\x52\xa9\x8e\x50\x1d\x5a\xc3\xa7\x29\x2f\x6b\xf4\x10\x06\xcf\x4c\x07\xf0\xc9\x04\x7a\x7f\x0f\x20\x13\x7c\x63\x02\x55\x21\xf8\x96\xa6\x98\xc2\xb7\xcb\x3f\xba\xfb\x62\x1b\x67\xfc\xec\x53\xe9\x2c\xac\x9b\x11\xf8\x05\x1c\xae\x05\x66\xf3\x70\xf1\x8f\xeb\xef\xe1\xcd\xaf\x87\xf5\xd4\x19\x1c\x2e\x62\x51\x6c\x26\x87\xd3\xa0\xb5\xde\xea\xb4\xec\x33\x19\xb0\xa4\xc6\x9a\x3e\xf2\x7b\x96\x73\x92\x6a\x9b\xba\xbc\xf8\x67\x13\x71\xcd\x22\x6a\x99\x5a\x98\x19\xaf\x23\xb3\x29\x02\x6d\x5b\x0b\x83\x16\xd7\x56\x96\xea\xae\xdd\x16\x7a\x4f\x15\xf5\x7f\x9b\x3d\xcf\x26\x5d\x82\xe1\x28\xb2\x35\x85\xde\x06\x0d\x91\xe8\xd1\x68\xca\xed\xfd\xc6\xd0\x56\x54\x65\xc3\x75\xd3\x8f\x7d\x71\x8b\xe1\xca\x55\x99\x69\xa5\xc9\xb8\x2f\x79\xaf\x00\xf3\xc4\xd1\xd9\x00\xa2\x8b\x41\x13\x6d\xa4\x34\x76\xd1\x5c\xc3\x9b\xce\xb2\x1d\x22\xf7\xc5\xee\x29\xa7\x96\x77\xbc\x33\xa2\xfb\x93\xac\x37\x3c\x85\x5f\x1f\xba\xda\x1b\x45\x18\x0b\x41\x2d\xec\xe9\xb0\xd2\xc7\x54\x36\xd8\xa1\x51\x2d\xa5\x76\xc3\xde\x6a\x77\xac\xaa\x53\xc3\xb6\x0c\x9d\x06\x7b\x86\xcc\x43\xff\x9e\x3a\xfb\x33\xef\x78\x96\x69\x78\xd0\x07\xa9\x9e\x77\x35\x05\x74\x95\x57\x68\xd6\x8a\x17\x26\x07\xfa\x76\x62\x07\x05\xda\x33\xcf\x60\xd2\xf1\xb5\xef\x77\x65\xec\xca\x2e\xee\x3a\xfa\xe5\xa9\x65\x7e\x34\x39\x1a\xad\xc5\xa6\xd3\x5e\xc7\x86\xa3\x6c\x9b\x36\x46\x44\xed\x35\x6f\x98\x1b\x18\x9d\x65\x5d\x1d\x10\x3d\x36\xc6\xf8\x12\x07\x19\x76\x8a\xba\xe2\xfc\xeb\x4d\x42\x4e\xa0\xae\x05\xbe\xe8\xc0\xe4\xda\x45\xfa\x25\x8c\x3e\xe0\x2c\x6f\x29\x23\x82\xf6\x4a\x5a\x37\xec\x95\xb4\x61\xf5\xda\x13\xb6\x47\x72\x54\x9d\x91\x44\x55\x56\x37\xad\x77\xc4\x90\x7b\xac\x36\xc4\xd1\xee\xef\x82\x7d\x4c\x4e\xf8\x66\xa3\x6d\x31\xda\x42\x38\x39\xd2\xd5\xb9\x89\x29\xe1\xc4\x52\x09\xa7\x21\xbc\x69\x76\x63\xbc\x0f\xd1\x31\x95\xb0\xa1\x52\x9a\x56\x31\xda\x14\x65\x54\x41\x69\xec\xf1\xb1\xdc\x74\x0f\xbb\x03\x96\x30\x2b\xa5\x30\x1d\xba\xb5\x18\x10\xf4\x82\xff\xe4\xf7\xaa\xf8\x1a\x0b\xf9\x63\x3b\x5b\xd1\x1c\x0a\xef\xfd\x08\x32\x18\xbe\xaa\x35\x0f\xa9\x6b\xbf\x05\x76\x82\xcf\xce\x46\x23\xd7\xb1\x26\xef\x49\x51\x37\x02\xea\x8f\xa1\x26\x40\x5d\xa8\x94\x12\x05\xac\x89\x04\x59\x66\x19\x4d\xa8\xa9\x83\x05\xdd\xd2\x1c\x57\x28\xfd\xb0\x72\xf6\xed\xfc\xa3\x53\xe0\x70\x04\xf9\xba\xa6\x12\x32\x27\xcd\xa6\x94\xf6\x02\xa1\x64\x40\x24\x08\xce\x95\xb7\x2b\x03\x67\xe1\xaa\x2c\x71\xfd\x82\x66\x0d\x75\x29\xae\x3f\x78\x96\x41\x44\x7a\xf7\x6e\x7a\x4a\xdb\x91\x6b\xf7\xf3\x85\xfe\x7d\x77\xcc\xbb\xd2\xa8\xbb\xda\x04\x77\x5e\x99\xf9\xca\x8e\x5f\xfc\xba\xe2\xb0\xae\xce\xfe\xa0\xac\x7c\x68\x76\x09\x73\xfd\x5d\x6f\xd4\xbf\x40\xed\x55\x1f\x47\x47\x20\xfb\x1a\xa6\x90\x65\x5c\x24\x08\x6f\xbd\xcd\xa9\x40\x46\x55\xa6\x23\x19\x2f\x1e\x97\x09\xa3\xcb\x76\x8f\xe2\xc7\xb3\xab\xaf\xcb\x8f\xe7\x97\xf3\x70\xc6\x0b\x35\x4b\x18\xd5\xfe\xea\x3f\x4b\xdb\x72\xdd\x78\x72\x4e\x6f\xf1\x01\x13\x0d\x35\x76\xed\x72\xc5\x4b\x2d\x5c\xdd\x28\x08\x5d\xc4\xf1\x97\x8f\xaa\xdd\xf1\x5d\xbf\x0f\xa4\x3a\x31\x38\x59\xfb\xbc\xbd\x53\x42\x0d\xd4\x22\x93\x14\x3d\x41\x66\xc7\x3d\xe8\xdd\xe1\xa8\x77\x33\xcb\x8b\x47\xd0\x2b\x32\x1a\x1d\xb5\xcb\x53\x0d\x66\x1b\x2b\x15\x87\x9a\xe3\x90\x55\x3b\xb3\x3c\x7b\x41\x97\xa9\xce\x09\x9d\x3f\x59\xb0\x6d\xfa\xa7\x3a\x34\xea\x10\x92\xed\x68\x4e\xee\x35\x23\x07\x81\xb9\x3d\xe0\x02\xe2\x73\xf9\x77\x64\x28\x48\xfe\xe5\xca\x5c\x0b\xbc\xe4\x18\xbf\x2f\x51\x06\xfe\xdd\x84\xe0\xf9\x45\x4e\x18\x1a\xea\x3b\xae\x1a\x83\xe6\x6a\xa2\x77\x27\x32\xd8\xfe\xe1\x21\xf4\x1a\xf1\x3b\x5d\x1e\x41\xff\xd5\x48\xa2\x8a\xee\x50\x30\xcc\xa3\x82\x08\x52\x43\x36\x44\x83\x03\xb8\x32\xa0\x0c\xf5\xd6\xe9\x3c\x59\xff\x05\xc4\x48\x23\xbc\xde\xa6\xea\x9d\xe4\x05\xdb\xd3\x4b\x0f\x7b\x31\x46\xa2\x55\xd0\xd7\xd8\x70\x4f\xeb\x58\x9b\x9b\xbf\xea\xfe\xbd\x52\x9b\x5c\xaf\xad\x62\xf0\x99\x6a\xf7\xc3\xb4\xcf\xaf\x1d\xaa\xb4\x0a\x2e\x86\x5e\x2e\x4c\x8d\xc4\x50\x2a\xde\x98\xd7\x67\x94\xaa\x32\xdb\xf6\xbb\x86\xb9\x12\xed\xbc\x4c\x84\x3e\xd3\xd6\xeb\x85\x7d\x44\x88\xec\xdf\x0d\xb9\x9d\x74\x4f\x01\xe1\xff\x05\x00\x00\xff\xff\xe3\x5b\x01\x35\x76\x34\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x5a\x51\x73\xdb\x38\x0e\x7e\xd7\xaf\x40\x15\x4f\x63\x77\x2b\xbb\xbd\xb9\xb9\x87\x6e\xdc\xbd\x6e\x92\xde\xe5\x76\xa7\xed\xc4\xe9\x53\xd3\xd5\x30\x12\x64\x73\x2d\x93\x0a\x49\x39\xf5\xb9\xfe\xef\x37\x24\x45\x89\x92\x65\x27\xdb\x87\xeb\x4b\x2c\x12\x00\x41\x00\xfc\x08\x80\x3d\x79\x36\xb9\xa3\x6c\x72\x47\xe4\x22\x08\xf0\x1b\x26\xf0\xf6\x2d\x4c\xd6\x44\x4c\x72\x3e\x9f\xb0\x65\x1a\x33\x9e\x62\x5c\xd0\x35\x57\xe3\x9c\xcf\xe1\x6f\x6f\x9f\xbf\x0e\x82\x13\xb8\xc1\x55\x91\x13\x85\xb0\x26\x82\x92\xbb\x1c\x65\xb0\xe0\x7c\x19\x67\x34\x47\x19\x17\x44\x2d\xa6\xe1\x76\x3b\xfe\x37\xe7\xcb\xf7\x7a\xe8\x13\x51\x8b\xdd\x2e\x0c\x44\xc9\x14\x5d\xa1\x99\xbc\xb6\xbf\xcd\x30\xe6\x48\x24\xc6\x74\x45\xe6\x18\x97\x22\xb7\x04\x76\xf4\x4a\x0f\x7e\xbe\xce\x35\x61\x82\x42\xc9\x9a\xe0\x5c\x7f\x7d\x16\x66\xa6\x20\xc9\x52\x33\xe7\x54\xaa\xe9\x70\xbb\x15\x84\xcd\x11\xc6\x9f\xec\xf0\xef\x54\xaa\xdd\x4e\xf3\xec\x76\x21\x6c\xb7\xc8\xd2\xdd\x6e\xa4\x77\xf2\xbe\x64\x89\xa2\x9c\x81\xe2\xb0\x22\x8c\xcc\x11\x24\x8a\x35\x4d\x50\x06\xf6\x3b\xae\xbe\x87\x23\xd8\x06\x00\x00\x39\x4f\x48\xee\xa8\x62\x46\xf4\x7e\x06\xaf\x43\x33\x47\x33\x90\x1b\xa9\x70\x95\xa8\x1c\xa8\x8c\x48\xa2\xe8\x1a\x21\x8a\xee\x4b\x8a\x0a\xc2\x81\xcf\x16\xfe\x0c\x6a\x81\xcc\x30\xea\x7f\x98\x2c\x78\x87\x04\xa8\x04\x92\x0b\x24\xe9\x06\x44\xc9\x18\x65\x73\xbb\x10\xe6\x12\x1f\x63\x64\x5c\x39\xa6\x97\x20\x15\x11\x8a\xb2\xf9\x78\x3c\x0e\x6b\xc6\x96\xba\x86\xa2\xab\x22\x3c\x7f\xee\x91\x20\xd3\xce\x7e\x64\x1b\x87\x34\x72\x1a\x80\x2c\x93\x04\xa5\xf4\xf4\x68\xed\xa6\xe1\xff\x6c\x97\x53\xdc\xf2\x02\x17\x4e\x83\x96\xe4\x71\xd8\xe6\xfd\x46\x15\xbc\xae\x87\x32\x1a\x54\x7f\x76\x5d\x87\x27\x0b\x4c\x96\x40\x58\x5a\xc9\xaf\x84\x06\x66\x3c\x26\x2c\x8d\xcd\xf8\x8f\x47\x80\x8e\xc6\xa8\x64\x54\x45\xe6\x64\xc0\x77\x98\x0b\x2c\x20\xba\xef\xd8\x66\x5c\x7d\x74\x4d\xd9\x8e\xc0\xae\xdd\x9f\x16\x08\xd5\x07\xa4\x1c\x6d\x48\xe0\x37\x2a\xd5\x4b\x90\x4b\x5a\x14\x7e\x40\xf4\x59\x28\xa5\xd2\xd8\x3b\xa3\x02\x1f\x48\x9e\xeb\xed\x51\x75\x2a\x2b\x3f\xa4\x41\x45\x10\x3b\x82\xda\x46\x27\x70\x6e\xac\x4b\x33\x8f\xb9\xe1\x7b\xc2\x61\x71\x6c\x69\xef\x31\x79\xef\x09\xad\x83\xdc\x6a\xd3\x8d\xf2\x43\xe1\xce\x8b\x66\x8d\x76\x9c\x77\xb7\x9d\x1e\x0c\xf1\x5a\x8d\x8a\x25\x75\xd1\x9d\x95\x79\xbe\x79\x3c\xc4\xdf\x13\xaa\x99\x7a\x4c\xfd\xa4\xa8\xee\xf1\xbe\x6f\x18\x0f\x01\x8e\x38\x59\x23\x7f\xa9\x10\x34\x84\x83\x09\xd4\xa0\x1a\x8a\xf5\x90\x19\xe9\xc4\x7e\x4a\x05\x26\x8a\x8b\x4d\x2b\xf0\xbf\xc0\x33\x88\x52\x08\x07\xf5\x74\x08\x5f\x3b\xa6\x13\xa8\x4a\xc1\xc0\x29\xe3\x9f\xa7\x05\xe6\xb9\xbd\x42\xa6\x43\x5f\xc6\xe4\xc5\xa8\x59\x61\xb0\x3d\xf1\x08\xbf\xfc\xf3\xeb\x0e\x22\xbc\x87\x57\x7b\x0b\x59\x6b\x7c\xe0\x76\x47\x90\xf1\x92\xa5\x40\x99\xdd\x65\x2d\xfc\x0d\x78\x0b\x75\x94\x6c\xe9\x98\x71\x61\x24\x69\x11\xe1\x60\xdb\xd1\x21\xfc\x19\x52\xee\xc7\xd9\x17\x88\x32\x08\x07\x9a\x60\xdf\x06\x8d\x7a\x97\xc6\xce\x06\x14\x13\x41\x0b\xf5\x06\x2c\x4b\x8b\x74\x0c\x61\x67\xb4\xf2\x7e\xca\x19\xf6\xa0\x1a\x67\x19\x9d\x97\x02\xe1\xfc\xfa\x2a\xfa\x08\x02\xe7\x54\x2a\xb1\x79\x09\x2b\x2a\x04\x17\x12\x88\xc0\x86\xca\x18\x65\x82\x2a\x99\x24\x9c\x29\x42\x19\x0a\x39\xa9\x78\x28\xca\xb1\x26\x1c\xa7\x41\x4d\x1f\x27\x82\xf2\xd8\x09\xed\xc4\x85\xa5\x32\x76\x99\x86\x8f\x08\xb5\xdb\x71\x9e\x7d\xe6\xa0\xf1\x12\x4e\xff\xb8\x95\x2f\x86\x27\xbf\xdc\xca\x17\xa3\x92\xdd\x97\x24\xa7\x19\xc5\x34\x92\x48\x44\xb2\x88\x1a\x31\xb7\xf2\xc5\xf4\x14\xc2\x81\xb7\x6a\x17\x41\x4f\xe0\x02\x33\xca\x10\x8e\x0a\xb2\xb0\x66\x20\x92\x9d\x56\x08\x09\x5c\xd4\x68\x97\xf0\xd5\x0a\x99\xc2\x14\x78\xa9\x3a\x11\x76\x5c\xf0\x14\xbe\xdc\x86\x29\x4f\x96\x28\xc6\x94\xdf\x86\x5f\x43\xf8\x0e\xb2\x4c\x39\x28\x44\x88\x48\x47\x7b\x78\x3b\x49\x71\x3d\x61\x65\x9e\x1f\x3c\xae\x02\xed\x6d\xa5\x16\x08\x55\x42\xf5\xd2\xff\xa8\x9c\x50\xc5\xbd\xf6\xf5\x83\xa0\x4a\x21\x83\xbb\x8d\xa1\xbb\xe3\x5c\x55\x44\x41\x25\x2c\xae\x78\xff\xfa\x15\xe7\xb4\x79\x4a\x6a\x33\xf3\xa0\xd1\x31\x62\x0a\x4f\xbb\xd5\x1a\xa4\x74\x4b\xf6\xf0\x75\x90\xd2\x9a\xaf\x2c\x52\xa2\x30\x5e\x96\x77\x98\xa3\x8a\xed\xce\x3b\x3b\xf5\x27\xe3\x94\x8a\x2a\x7a\xed\x3e\xd3\xea\xef\xa4\xa2\x72\xd7\xf5\x38\x0d\x3d\x11\x0c\x1f\x0c\xfb\x34\x1c\x74\xa5\x4d\x5e\xbf\x8a\xf4\x18\x49\x57\x5e\xe8\x5b\x36\x9e\xa7\x07\xd9\xda\x3c\x41\x1b\x5b\xdc\x7a\x3d\x18\xbb\xb2\x14\x4e\x74\x63\x9b\xd5\xba\xc5\xe8\x93\xd4\x34\xde\x35\x48\x70\xc5\x59\x24\x30\xe7\x24\xed\x99\x77\x8e\xa8\xf4\x0e\x3a\x1e\xfb\xcd\x0e\xd7\x50\x43\x4c\x04\x5b\x6f\xf4\xde\x93\x3d\x5e\xbf\xd4\x88\xf5\x66\xd9\x2b\xc9\xc0\xb1\xbe\xe2\x0c\xb6\x8f\x8f\x44\x40\xe7\x04\x15\x28\x32\x2e\x56\xf0\x71\x76\x23\x10\x41\xe0\x1d\x91\x18\x54\xa3\xb1\xfd\xec\xc4\x87\xa9\x39\x5a\x57\x5d\xf4\x5f\x08\x07\xa5\xc8\xc3\x03\x37\xcf\x5e\x51\x03\x54\x02\xae\x0a\xb5\x69\x72\x2f\x10\xc5\x2a\xe2\x52\x35\x5a\xf4\x59\x82\x66\xfb\x74\x10\x45\xf8\xad\x40\x41\x35\x2e\x91\x1c\x42\x3b\x1b\x95\x6c\x8d\xc2\x82\x91\x59\xf8\x8d\xc5\x9e\x37\x93\x89\xd5\x35\x8a\xee\x36\x05\x91\x32\x4a\x05\x5d\xa3\x38\x78\x31\x5d\xdb\x55\x78\x81\x95\xb1\x13\xbe\x2a\x72\xdc\x73\x1c\x5c\xa3\x86\x13\xbd\x17\x0d\x2d\x36\x36\x5a\xd9\x57\x37\x64\x34\xf9\x63\x59\xd1\xde\xea\x99\x39\xfc\x63\x98\x19\x41\xf0\x40\xf3\xdc\x78\xfe\x0e\x2b\x89\x98\xfe\x70\x15\x90\xa1\x4a\x16\xb6\x0a\x20\x6b\x04\x5d\x63\xd2\x8c\x26\x44\xa1\x0c\xcc\x9c\xad\x04\xc8\x1a\x63\x7f\xae\x7b\xf3\x99\xd2\xb4\x41\x0e\x1d\xb2\x82\xa1\x42\x39\x29\x96\x54\x0f\xa5\xd5\x19\x5b\x2d\x53\x2a\x20\x2a\x34\xf4\x3b\xa6\x6a\xea\x04\x6e\x2a\x7c\x96\x4a\x90\xa2\xce\xdd\x39\xcb\x37\xe6\x03\x65\x4b\x3f\xe0\x6b\x14\x70\xf3\xfb\xec\x25\x18\xb7\x6f\x80\x2a\x78\xa0\x6a\x01\xaa\x25\xe7\xfc\x1d\x64\x82\xaf\xf6\xd0\xdf\x53\x9f\xf8\x97\x36\x5b\xa6\xfa\x4b\x4e\x6a\x11\x51\x42\xc6\x89\x50\xd6\xc6\x09\x51\x70\x76\x76\xfa\xe1\xb7\x8b\xf8\xd7\x8f\x1f\x6f\x66\x37\xd7\xef\x3e\xc5\xe7\xef\x4e\xe1\xad\xde\x93\x95\x14\x06\xdb\xed\xf8\x57\xc7\x7e\xfe\x4e\x17\xeb\xbb\x5d\x97\xc5\xcf\xff\x04\xca\x82\x33\x89\xd3\xc1\x30\xd1\xc7\x25\x92\x19\x44\x51\x42\xf4\x86\x3d\xb9\xb5\xd9\x74\x3c\x8f\x3a\xe7\xd1\xc9\x38\x74\x28\x2d\x9c\x40\x73\x97\x58\xe7\xff\x67\xf6\xf1\x03\xa4\x44\x91\x83\x20\xe2\xe9\xa9\xdb\x1b\xda\x80\x0a\x99\x0d\xe4\x87\x05\xcd\x11\xae\xde\xcf\xa6\xa0\x2b\x75\x88\x04\xe4\x94\x61\x2b\x25\x74\x13\x3e\x33\x9c\x9d\x9d\x41\x38\xd0\xb4\xcd\xb2\xd5\xdc\x74\x30\xac\x8a\xb9\x6a\x40\xe7\x0d\xfa\x50\xfc\xe3\xef\x10\xa5\xa3\x6e\xc5\x57\x13\x69\x0f\xe8\x35\xc2\x3a\x41\x84\x33\x38\x73\xb2\x1a\xf3\x7c\x87\x3f\xef\xb5\x3e\xa7\xe3\x2f\x5f\xe1\x3b\x84\xb7\xc3\xb1\x6e\xd2\x8c\xe0\x76\x38\x3e\xb7\xc2\x46\xe1\xe9\xa8\xa7\x22\xb4\xe9\x94\x8e\x24\xca\xa4\x22\x79\x8e\x7a\x57\xa6\xcd\x22\x8d\x86\x29\x68\xca\x05\x02\xb2\x35\x15\x9c\x69\x84\x0a\x2c\x5b\x5c\xb3\xc4\x8e\xa5\x73\x8c\xdc\xf0\x74\xe8\x5b\x84\x29\x41\x92\x65\xa4\x38\xcf\x65\x33\x2e\x68\x67\x44\xf2\x84\xa8\x96\x25\x4d\xe6\xc9\x50\x3d\x70\xb1\xa4\x6c\x1e\x15\x79\x39\xa7\xac\xe1\xa0\x85\xb2\xed\x2b\x37\xf0\xe7\x7d\xfd\xf3\x61\x8e\x56\x98\xb5\xf6\x76\x4b\x33\x18\x5f\xc9\x0b\x03\xa8\x10\xed\x76\x66\xd8\x29\xfc\xd3\x74\x68\xa1\x36\x42\x36\xa7\x0c\x1d\x13\xb2\xb4\xa6\x75\x22\xae\x64\x99\x93\xb4\x4f\x04\x9d\xe9\x99\x63\xbc\xe7\x82\xf2\x3e\x4e\x6d\x0d\x7e\x94\xd1\x99\xa3\x77\xe1\xda\x58\xbd\x8b\x57\xe1\xb3\x75\x0c\xa6\xe4\x39\xd6\x4f\xa9\xfc\x0c\x29\x16\xc8\x52\x1d\xeb\x8e\xb5\x4a\x55\xb1\xe0\x92\xea\xa2\xcb\xc2\x5a\x0a\x77\x1b\x60\xcb\x14\x16\x44\x1a\x82\x05\x9d\x2f\x50\xaa\xe0\x04\x0a\x41\xb9\xa0\xe6\xd6\xe4\x66\xca\x49\x32\x1f\xba\x41\x09\x0c\x31\xb5\x99\xae\x22\x4b\x64\x16\xeb\x34\x12\x2e\x90\xe9\xbf\x85\xe0\x6b\x9a\x5a\x8e\x95\xd7\xdd\xa9\xb4\x8c\x6b\x2d\xf7\xa3\xd2\xee\xfc\xca\x12\xea\x5b\x6e\x7f\x47\xf5\x6d\xd7\x8e\xe0\x58\x71\xb7\xc0\x74\x38\x72\xf8\x6e\x16\x87\x4d\xb9\x72\x74\x35\x80\x99\xd2\xd6\x6f\x63\xda\xda\x96\x21\xbc\xd2\xcd\x89\xc6\xfa\x76\xf2\xd5\xd7\x5d\x08\xcf\xa6\x10\x86\xf0\xb5\x1f\xeb\x3e\x4b\xad\xaf\x57\xe7\x55\xfc\xa6\x2b\xf5\xa6\x41\x9c\x1e\x85\x7f\x9a\x0e\xbb\xeb\x69\x8f\x8f\x5a\x68\x78\xa2\xd5\xf6\x69\xea\x04\xa7\x77\x4f\xbe\x7c\xbf\x6c\x3f\xa6\x7c\x8a\x19\x29\x73\xf5\x17\x34\x9f\x0e\x07\xc3\x83\x58\x33\x1a\xed\x95\xf4\xd5\x54\x55\xd5\x1f\x50\x75\xaf\xba\x37\x05\x9c\x76\x62\x45\x04\xd1\xc6\xe0\xae\xe1\xf6\x8b\x38\xd3\x31\x3f\x98\x64\x55\xdd\x69\x18\x34\x5a\x58\x95\x7f\xbc\x8b\xe4\x14\x2a\x3a\xa2\x9f\x94\x1d\xb9\x7e\xc2\xfe\x09\xd1\xd9\x4c\x7c\x47\x19\x11\x74\x0f\xb2\xdd\xb0\x07\xd9\x61\x55\xb8\x84\xed\x91\x1c\x55\x67\x24\x51\x79\xe8\x61\xac\xf6\x88\x11\xb7\xa9\x1c\xe2\x64\xef\x7b\xc1\xf6\x0c\x74\x61\xae\xf1\x26\xd2\x75\xcd\x50\xdf\x3e\xa6\xe7\x19\x0e\xac\x94\x70\x14\xc2\xf3\xc6\x1b\x87\x1b\xd5\x6e\x51\x09\x2b\x2a\xa5\xe9\x25\xd2\xe6\xcc\x53\x05\xa5\x1e\xd4\x2e\xef\x66\xb6\x3d\x91\x30\x29\xa5\x30\x0f\x2b\xb5\x1a\xd0\xe2\xb1\x7d\xac\x5f\xaa\xb3\xdd\xd7\x1c\x3a\xe6\xd9\x4a\xe6\x38\xdc\xe7\x68\x3b\xb5\x37\x5e\xbc\x3d\xf7\x99\xeb\xf1\x08\xf4\xc2\xe5\xd1\x4e\x94\x6b\x69\x7a\xa8\x7d\x10\xf9\x5f\x02\x35\x00\x62\x52\x5d\xb2\x26\x34\x37\xac\x69\x29\x5c\x6d\x91\x62\x91\xf3\x8d\xcd\x26\xac\x60\x77\xb0\xe3\x46\x68\x27\x36\xf5\x84\x9f\xd8\x6a\x07\x1a\xe2\x71\xaa\x93\xdc\xa8\xc6\x70\x3d\x18\x76\x0a\xec\x9a\x79\x3f\x9b\x94\x98\x42\x44\xe1\x54\x4e\xfe\xa8\x3a\xda\xd3\xd7\x13\xf7\xeb\xd5\xe4\xb4\xc5\x1d\xf4\x9f\xfa\x46\x69\x68\x88\xeb\x3e\xf2\x13\x9a\xf2\xf2\x81\x14\xb5\x29\xf4\x47\x5f\xf3\x5d\x5b\xae\x94\x28\xcc\xcd\x2a\xcb\x2c\xa3\x09\x35\xb7\x97\xa0\x6b\x9a\xe3\x1c\x65\xbd\xeb\x2f\x30\xb8\xfc\x7c\x75\xe1\xe2\xb2\x1f\x98\x6f\x16\x54\x42\xe6\xb4\x59\x95\xd2\x16\x61\x25\x03\x22\x41\x70\xae\xbc\x60\xef\x49\xa1\xad\x90\x0b\xd7\xa7\x37\x7b\xa8\x2f\x50\xfd\xc1\xb3\x0c\x22\x12\x74\xb7\xa1\xa7\x80\xca\xda\x3c\xbe\xd2\xbf\x1c\xbf\x4a\x66\x9a\xf5\x58\x7b\xfe\x68\xb3\xc9\x37\xf6\xb1\x16\x43\xbf\x8b\x66\x97\xbf\x53\x56\x7e\x6b\xbc\x84\xb9\xfe\xae\x1d\xf5\x7f\x30\x7b\xd5\x05\xed\x28\x64\x83\x58\x21\xcb\xb8\x48\x10\x5e\x79\xce\xa9\x48\x0e\x9a\x4c\x5f\x10\xbc\xd8\xc4\x09\xa3\x71\xfb\x6d\xe0\xe2\x72\x76\x13\x5f\x5c\x5d\x4f\xc3\x09\x2f\xd4\x24\x61\x54\xc3\x60\x18\x74\xdf\x07\x0c\x40\xe6\xf4\x4e\xbf\x32\x68\xaa\x43\xd5\xda\x8c\x97\x5a\xb9\xba\x41\x0f\x5d\xc6\xce\xa3\xd6\xb8\xdb\xc1\xef\x04\x9f\xff\x44\xe1\x74\xdd\x5f\xdb\xab\xcf\x6b\xa2\x96\x98\xa4\xd8\x53\x64\xf2\x62\x8f\xfa\x38\xca\x77\xc3\x4c\x5b\x14\xf4\x8e\x8c\x45\x0f\xc6\xe5\xb9\x26\x33\x24\x9a\xa9\x5e\xb1\x2f\xaa\x5d\x58\x5e\x3e\xe1\x75\x47\x5f\xb5\x9d\x07\xfc\x5d\xa8\x99\xcf\xf5\x8d\xa3\x21\x24\x3b\xf2\x28\xb8\xf7\x08\x18\x04\xa6\xe8\xe0\x42\xd7\x1d\xff\x42\x86\x82\xe4\x1f\x67\xa6\x9a\x78\x4a\xf2\xfd\x58\xfe\x71\x04\xfe\x03\xbf\xda\x11\x3c\xff\x94\x13\x86\x66\xe1\x23\x9d\x9c\xa0\x29\x76\xf6\xaa\xac\xde\xde\xb1\xc7\xb0\xf7\x36\xde\x69\x11\x07\xfb\xdd\x52\x89\x2a\x5a\xea\xaa\x34\x8f\x0a\x22\x48\x4d\xd9\x08\x0d\x4e\x60\x66\x48\x19\x6a\xaf\x12\xb1\x69\xfe\x53\xc2\x81\xb7\x69\xed\xc1\xaa\x87\xff\x04\xcf\xed\xdd\x1c\x8f\x72\x1c\x00\xb2\x60\xdf\x62\x07\x1e\x8a\xbc\xed\x75\xde\x1c\x3a\xaa\xb7\xb1\x45\x2b\xf6\xa9\xaf\x5d\x6b\x72\x45\x86\x52\x71\x59\xeb\xf0\x01\xa5\xaa\xe2\xac\xdd\xcc\x35\xd7\x71\xa7\x1d\x1b\xfa\x06\x6f\xb5\x6c\x6d\xe7\x34\xb2\xff\xed\xc5\xd9\xd7\xf5\x3f\xc3\xff\x0d\x00\x50\xba\x86\xc9\x35\x23\x00\x00"),
+		},
+		"/bootconfig/files/etc/pki": &vfsgen۰DirInfo{
+			name:    "pki",
+			modTime: time.Date(2026, 10, 19, 16, 6, 51, 826444793, time.UTC),
+		},
+		"/bootconfig/files/etc/pki/rpm-gpg": &vfsgen۰DirInfo{
+			name:    "rpm-gpg",
+			modTime: time.Date(2026, 10, 19, 16, 6, 51, 830370476, time.UTC),
+		},
+		"/bootconfig/files/etc/pki/rpm-gpg/RPM-GPG-KEY-nkd-packages.template": &vfsgen۰FileInfo{
+			name:    "RPM-GPG-KEY-nkd-packages.template",
+			modTime: time.Date(2026, 10, 19, 16, 6, 51, 830953852, time.UTC),
+			content: []byte("\x7b\x7b\x2e\x52\x70\x6d\x47\x50\x47\x4b\x65\x79\x7d\x7d\x0a"),
 		},
 		"/bootconfig/files/etc/sysctl.d": &vfsgen۰DirInfo{
 			name:    "sysctl.d",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 451484273, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files/etc/sysctl.d/kubernetes.conf": &vfsgen۰CompressedFileInfo{
 			name:             "kubernetes.conf",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 451484273, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 96,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xca\x4b\x2d\xd1\x4b\x2a\xca\x4c\x49\x4f\x85\x52\xba\x79\x69\xba\xc9\x89\x39\x39\xba\x99\x05\x25\x89\x49\x39\xa9\xc5\xb6\x86\x5c\xf8\x14\x99\xa1\xa8\xca\x2c\x28\x33\xd1\xcb\x2c\x88\x4f\xcb\x2f\x2a\x4f\x2c\x4a\xb1\x35\x04\x04\x00\x00\xff\xff\xea\x98\xd9\xfa\x60\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xca\x4b\x2d\xd1\x4b\x2a\xca\x4c\x49\x4f\x85\x52\xba\x79\x69\xba\xc9\x89\x39\x39\xba\x99\x05\x25\x89\x49\x39\xa9\xc5\xb6\x86\x5c\xf8\x14\x99\xa1\xa8\xca\x2c\x28\x33\xd1\xcb\x2c\x88\x4f\xcb\x2f\x2a\x4f\x2c\x4a\xb1\x35\x04\x0c\x00\xea\x98\xd9\xfa\x60\x00\x00\x00"),
 		},
 		"/bootconfig/files/etc/systemd": &vfsgen۰DirInfo{
 			name:    "systemd",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files/etc/systemd/system": &vfsgen۰DirInfo{
 			name:    "system",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files/etc/systemd/system/kubelet.service.d": &vfsgen۰DirInfo{
 			name:    "kubelet.service.d",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/files/etc/systemd/system/kubelet.service.d/10-kubeadm.conf.template": &vfsgen۰CompressedFileInfo{
 			name:             "10-kubeadm.conf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 1086,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x53\xd1\x4e\xdb\x50\x0c\x7d\xef\x57\x58\x01\x89\x87\x91\x54\xbc\x4e\xca\x43\xb7\x15\x34\xb1\x01\x2a\xa0\x4d\x9a\x26\xe4\x24\x4e\x6a\xb8\xbd\x2e\xbe\x4e\xa0\x42\xfc\xfb\x74\x13\x5a\x8a\x56\x78\x8a\xe3\xf8\x9e\x73\xae\xcf\xc9\x1e\x9c\x89\xd1\x67\xb8\x9a\x73\x80\x4a\x65\xc9\x1e\xc4\xbb\x15\x3c\x88\xde\x05\x78\x60\x9b\xc3\x5d\x5b\x10\x56\x0b\x40\x5f\xf5\xb5\x23\x83\xee\x28\x3b\x3a\xfa\x34\xfa\x73\x49\xda\x71\x49\x7f\x47\x53\xdf\xb1\x8a\x5f\x90\xb7\x3c\x39\xbd\xfe\x32\xfd\x31\xbd\xba\x89\xcf\xaf\xe7\x67\xc7\xdf\x4f\x6e\x26\xb3\x93\xcb\x3c\x4d\x0b\x11\x0b\xa6\xb8\x4c\x23\x52\x29\xbe\xe6\x26\x1f\x93\x95\xe3\xf8\xae\x9e\x8c\xc2\xf8\xed\x90\x23\xcb\xe2\x20\xa4\x1f\x9d\xd9\x9e\x4c\x76\xab\x79\xab\x64\x8d\xd3\xa1\x8e\x1d\x17\x6b\x80\xf1\xd0\xcf\x56\xb8\x70\xc9\xe8\xe9\x09\xb8\x06\xba\x87\x6c\xd6\x7a\xe3\x05\x41\x52\x2a\x4b\x02\xcf\xcf\xef\x70\x9c\xcc\xce\xaf\x2f\x7e\xfd\xdc\xb0\x34\x2a\xed\x32\xa4\x4b\xd2\xf4\x5e\x42\x5e\xa3\x0b\x04\x69\x4a\xbe\x16\x2d\x29\xf5\x52\x51\x8a\xce\x49\x89\x86\x85\xa3\xfc\xe0\xa0\x67\x25\x5f\x45\x8e\xbd\xc1\x18\x0e\x80\x50\xb3\x23\xb0\x39\x1a\x24\x6b\x47\xd8\xb3\x25\xbd\x2f\x9b\xd6\xad\xb0\x4f\xa0\x21\x4f\x8a\x46\x01\xd0\x40\x07\xe9\x87\xb0\x94\x65\xeb\xd0\xd8\x37\x60\x73\x82\x6d\x97\x26\xdf\x06\xc9\xd0\xa1\x72\x14\x02\xd5\xca\xe3\x82\x4b\x74\x6e\xb5\x7d\xd5\x63\x76\x94\xa7\xff\x6d\xed\x85\x3e\xad\x1d\x36\x21\x23\xdf\xbd\x23\x3d\xf2\xb6\x81\x14\x4a\xf4\xb1\x80\x5a\x14\xa4\x23\x55\xae\x28\x80\xd4\xfd\xc4\x3a\x65\xa8\x4d\x00\x8c\x08\x0e\x83\x81\x52\x10\xb5\x0c\x2e\x94\x6a\x52\x2c\xdc\xea\xf0\x15\x30\xcc\xa5\x75\x55\xac\x47\x7b\x7d\x37\x3b\x93\x8a\x66\xd4\x70\x8c\x92\xb1\xf8\xec\x74\x80\x9d\x3e\x9a\xe2\x24\x42\x4b\x71\x4b\xa5\x01\xfb\xfe\xc0\xe0\x7c\x3b\x0c\xf7\x9a\x03\xb0\x0f\x46\x58\x65\x9b\x65\x4d\x7f\x5f\xcd\x26\xc3\xaa\x5e\x18\x0b\x82\x20\xad\x96\x54\x41\xad\xb2\x00\x8b\xd7\x8e\xa7\xb3\x1d\x7b\x8b\xa9\x0d\xab\x30\x50\xad\xb7\x37\x9a\x3e\x52\x79\x69\xa8\x96\x6f\x95\xe3\x36\xe8\xb8\x60\xbf\x9e\x82\xfd\x77\x7e\xab\xd7\x0f\x3b\x9b\x6f\xec\xdd\xdf\x71\x8f\x0f\x42\xbe\xbf\x33\xd7\x9b\x7c\xfe\x0b\x00\x00\xff\xff\x21\x0d\x51\xd3\x3e\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x53\xd1\x4e\xdb\x50\x0c\x7d\xef\x57\x58\x01\x89\x87\x91\x54\xbc\x4e\xca\x43\xb7\x15\x34\xb1\x01\x2a\xa0\x4d\x9a\x26\xe4\x24\x4e\x6a\xb8\xbd\x2e\xbe\x4e\xa0\x42\xfc\xfb\x74\x13\x5a\x8a\x56\x78\x8a\xe3\xf8\x9e\x73\xae\xcf\xc9\x1e\x9c\x89\xd1\x67\xb8\x9a\x73\x80\x4a\x65\xc9\x1e\xc4\xbb\x15\x3c\x88\xde\x05\x78\x60\x9b\xc3\x5d\x5b\x10\x56\x0b\x40\x5f\xf5\xb5\x23\x83\xee\x28\x3b\x3a\xfa\x34\xfa\x73\x49\xda\x71\x49\x7f\x47\x53\xdf\xb1\x8a\x5f\x90\xb7\x3c\x39\xbd\xfe\x32\xfd\x31\xbd\xba\x89\xcf\xaf\xe7\x67\xc7\xdf\x4f\x6e\x26\xb3\x93\xcb\x3c\x4d\x0b\x11\x0b\xa6\xb8\x4c\x23\x52\x29\xbe\xe6\x26\x1f\x93\x95\xe3\xf8\xae\x9e\x8c\xc2\xf8\xed\x90\x23\xcb\xe2\x20\xa4\x1f\x9d\xd9\x9e\x4c\x76\xab\x79\xab\x64\x8d\xd3\xa1\x8e\x1d\x17\x6b\x80\xf1\xd0\xcf\x56\xb8\x70\xc9\xe8\xe9\x09\xb8\x06\xba\x87\x6c\xd6\x7a\xe3\x05\x41\x52\x2a\x4b\x02\xcf\xcf\xef\x70\x9c\xcc\xce\xaf\x2f\x7e\xfd\xdc\xb0\x34\x2a\xed\x32\xa4\x4b\xd2\xf4\x5e\x42\x5e\xa3\x0b\x04\x69\x4a\xbe\x16\x2d\x29\xf5\x52\x51\x8a\xce\x49\x89\x86\x85\xa3\xfc\xe0\xa0\x67\x25\x5f\x45\x8e\xbd\xc1\x18\x0e\x80\x50\xb3\x23\xb0\x39\x1a\x24\x6b\x47\xd8\xb3\x25\xbd\x2f\x9b\xd6\xad\xb0\x4f\xa0\x21\x4f\x8a\x46\x01\xd0\x40\x07\xe9\x87\xb0\x94\x65\xeb\xd0\xd8\x37\x60\x73\x82\x6d\x97\x26\xdf\x06\xc9\xd0\xa1\x72\x14\x02\xd5\xca\xe3\x82\x4b\x74\x6e\xb5\x7d\xd5\x63\x76\x94\xa7\xff\x6d\xed\x85\x3e\xad\x1d\x36\x21\x23\xdf\xbd\x23\x3d\xf2\xb6\x81\x14\x4a\xf4\xb1\x80\x5a\x14\xa4\x23\x55\xae\x28\x80\xd4\xfd\xc4\x3a\x65\xa8\x4d\x00\x8c\x08\x0e\x83\x81\x52\x10\xb5\x0c\x2e\x94\x6a\x52\x2c\xdc\xea\xf0\x15\x30\xcc\xa5\x75\x55\xac\x47\x7b\x7d\x37\x3b\x93\x8a\x66\xd4\x70\x8c\x92\xb1\xf8\xec\x74\x80\x9d\x3e\x9a\xe2\x24\x42\x4b\x71\x4b\xa5\x01\xfb\xfe\xc0\xe0\x7c\x3b\x0c\xf7\x9a\x03\xb0\x0f\x46\x58\x65\x9b\x65\x4d\x7f\x5f\xcd\x26\xc3\xaa\x5e\x18\x0b\x82\x20\xad\x96\x54\x41\xad\xb2\x00\x8b\xd7\x8e\xa7\xb3\x1d\x7b\x8b\xa9\x0d\xab\x30\x50\xad\xb7\x37\x9a\x3e\x52\x79\x69\xa8\x96\x6f\x95\xe3\x36\xe8\xb8\x60\xbf\x9e\x82\xfd\x77\x7e\xab\xd7\x0f\x3b\x9b\x6f\xec\xdd\xdf\x71\x8f\x0f\x42\xbe\xbf\x33\xd7\x9b\x7c\xfe\x1b\x00\x21\x0d\x51\xd3\x3e\x04\x00\x00"),
+		},
+		"/bootconfig/files/etc/yum.repos.d": &vfsgen۰DirInfo{
+			name:    "yum.repos.d",
+			modTime: time.Date(2026, 10, 19, 16, 6, 51, 830370476, time.UTC),
+		},
+		"/bootconfig/files/etc/yum.repos.d/nkd-packages.repo.template": &vfsgen۰CompressedFileInfo{
+			name:             "nkd-packages.repo.template",
+			modTime:          time.Date(2026, 10, 19, 19, 26, 43, 956037949, time.UTC),
+			uncompressedSize: 231,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x8e\xc1\x4a\xc4\x30\x14\x45\xf7\xef\x2b\xfa\x03\x31\x76\x2b\x64\xa5\x92\x45\x51\xc2\xec\x44\x64\xc8\xa4\xaf\xf1\x91\x34\x3e\x92\x46\x28\x21\xff\x2e\x75\x18\x98\xdd\xe5\xdc\xcb\xe1\x7e\xa6\x30\x0b\xb6\x2e\x58\x8f\xe5\x0b\x92\x5d\x51\xbd\x4f\x2f\x83\x8b\xb5\x6c\x98\x87\x5b\x05\x17\x5b\xb0\xe6\xa8\x5a\x7b\x38\xf1\x6a\xae\xf8\xb9\xe6\xd8\x3b\x60\xb2\x97\x88\xb3\x1a\x81\x33\xfd\x64\xda\x76\x35\x42\x09\xc4\x67\x5a\xce\x35\xd9\x5f\x4b\xf1\x58\xa8\x11\x5a\x13\x03\x2d\xc3\xe1\xd0\x46\x4f\xb8\xf7\x0e\x9e\xbd\xfb\x46\x17\xd4\x78\xc4\x80\xbb\x5a\x28\xe2\x93\x94\x12\x37\x27\x39\x90\xcc\xbc\x0a\xcf\x5e\x9e\xcc\x9b\xd0\x46\x8b\xe9\xf5\x43\xdc\x1f\xff\xd7\x62\x2c\x78\x6f\x7b\xbc\xd2\x34\xf7\x0e\x7f\x03\x00\xa3\xe6\x8c\x4f\xe7\x00\x00\x00"),
 		},
 		"/bootconfig/systemd": &vfsgen۰DirInfo{
 			name:    "systemd",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/bootconfig/systemd/init-cluster.service": &vfsgen۰CompressedFileInfo{
 			name:             "init-cluster.service",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 452484282, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 515,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x90\xbd\x6e\xe3\x30\x10\x84\x7b\x3e\xc5\xda\x85\x3b\x4a\x77\xc5\x55\x07\x15\xf7\xe3\xe2\x3a\xc3\xc6\x21\x85\xa0\x62\x4d\xad\xa4\x85\x29\x52\xe1\x2e\x1d\xfb\xed\x03\x29\xb6\x03\xa4\x48\x91\x92\x1c\xcc\xc7\x8f\x53\xff\x0f\xac\x8d\xf9\x4b\xe2\x12\x4f\xca\x31\x54\x1c\x58\xe1\x94\x8f\x94\x02\x29\x09\x38\x9f\x45\x29\x99\x3d\x3d\x67\x4e\x24\x95\x90\xda\xd3\x9c\x7a\x3b\x61\xc2\x42\x28\x9d\xd9\x11\x24\xf2\x84\x42\x96\x47\xec\xc9\x4e\x7c\x8e\x7a\xcf\xcc\xaf\x4e\x29\x7d\xa9\xf9\x27\x86\x96\x67\xb1\x1d\xea\xb0\xbd\xb0\xa8\x54\xab\xf2\x8c\xa9\xf4\xb1\x2f\x67\x59\x7b\x33\x2c\x44\x71\x9c\x8c\xa9\x0f\x6f\xd5\xc6\x6c\x2f\xe4\x0e\x8a\x49\x77\x89\xaa\xf2\xc8\xa1\x3c\xa2\x0c\x60\x1d\xac\x5f\x06\xf6\x04\x35\xac\xc0\x76\xf0\xc0\x85\xd8\x3e\xde\x9f\x61\xd0\xfc\x84\x36\x82\x78\xa2\x09\xbe\x7f\x9b\x0f\x81\xd6\xef\xdc\x0f\xd0\x79\x35\x6c\x47\x58\x26\xb4\xd6\xc5\xd0\x71\x5f\x95\xa4\xae\x0c\xa7\xb6\x63\x4f\x72\x33\x5e\x92\xe2\x8a\xa3\x07\x6b\xf3\xe4\x23\xb6\xd6\x51\x52\x81\xcd\x06\x16\xa8\xc6\xec\x06\xf8\xe4\xa3\x6b\xb3\x27\x59\x24\x62\xb0\x1d\xb2\xcf\x89\xee\x57\x07\x72\xd5\x0f\x31\xa6\xfe\x17\x44\xd1\xfb\xc6\x3c\x61\x50\x6a\x7f\x5f\xab\x31\x7b\x65\x9b\x85\x52\xa1\x98\x7a\xd2\xd7\x00\x00\x00\xff\xff\x5b\x74\x1f\x12\x03\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x90\xbd\x6e\xe3\x30\x10\x84\x7b\x3e\xc5\xda\x85\x3b\x4a\x77\xc5\x55\x07\x15\xf7\xe3\xe2\x3a\xc3\xc6\x21\x85\xa0\x62\x4d\xad\xa4\x85\x29\x52\xe1\x2e\x1d\xfb\xed\x03\x29\xb6\x03\xa4\x48\x91\x92\x1c\xcc\xc7\x8f\x53\xff\x0f\xac\x8d\xf9\x4b\xe2\x12\x4f\xca\x31\x54\x1c\x58\xe1\x94\x8f\x94\x02\x29\x09\x38\x9f\x45\x29\x99\x3d\x3d\x67\x4e\x24\x95\x90\xda\xd3\x9c\x7a\x3b\x61\xc2\x42\x28\x9d\xd9\x11\x24\xf2\x84\x42\x96\x47\xec\xc9\x4e\x7c\x8e\x7a\xcf\xcc\xaf\x4e\x29\x7d\xa9\xf9\x27\x86\x96\x67\xb1\x1d\xea\xb0\xbd\xb0\xa8\x54\xab\xf2\x8c\xa9\xf4\xb1\x2f\x67\x59\x7b\x33\x2c\x44\x71\x9c\x8c\xa9\x0f\x6f\xd5\xc6\x6c\x2f\xe4\x0e\x8a\x49\x77\x89\xaa\xf2\xc8\xa1\x3c\xa2\x0c\x60\x1d\xac\x5f\x06\xf6\x04\x35\xac\xc0\x76\xf0\xc0\x85\xd8\x3e\xde\x9f\x61\xd0\xfc\x84\x36\x82\x78\xa2\x09\xbe\x7f\x9b\x0f\x81\xd6\xef\xdc\x0f\xd0\x79\x35\x6c\x47\x58\x26\xb4\xd6\xc5\xd0\x71\x5f\x95\xa4\xae\x0c\xa7\xb6\x63\x4f\x72\x33\x5e\x92\xe2\x8a\xa3\x07\x6b\xf3\xe4\x23\xb6\xd6\x51\x52\x81\xcd\x06\x16\xa8\xc6\xec\x06\xf8\xe4\xa3\x6b\xb3\x27\x59\x24\x62\xb0\x1d\xb2\xcf\x89\xee\x57\x07\x72\xd5\x0f\x31\xa6\xfe\x17\x44\xd1\xfb\xc6\x3c\x61\x50\x6a\x7f\x5f\xab\x31\x7b\x65\x9b\x85\x52\xa1\x98\x7a\xd2\xd7\x01\x00\x5b\x74\x1f\x12\x03\x02\x00\x00"),
 		},
 		"/bootconfig/systemd/join-master.service.template": &vfsgen۰CompressedFileInfo{
 			name:             "join-master.service.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
-			uncompressedSize: 625,
+			modTime:          time.Date(2026, 10, 19, 19, 22, 21, 670031431, time.UTC),
+			uncompressedSize: 727,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x50\xb1\x8e\x13\x31\x10\xed\xfd\x15\x73\x29\xae\x73\x02\x05\x15\xda\xe2\x08\x27\x71\x82\x22\x4a\x38\x51\x44\x29\x26\xde\x97\xac\x59\xaf\xbd\x8c\x67\xc3\x45\x51\xfe\x1d\x79\x57\xe1\x24\x24\x9a\xeb\xfc\xde\x9b\xf7\xfc\x66\xb6\xcf\xd1\xeb\xce\x7c\x46\x76\xe2\x7b\xf5\x29\x56\x1d\x67\x85\x50\x4c\x35\xe8\x67\xf2\x91\xb4\x01\xb9\x30\x14\xd6\xac\xf1\x6b\xf0\x82\x5c\x65\xa8\x6d\x21\x11\xc1\xf6\x2c\x3c\xcf\x90\x93\x77\x20\x41\x00\x67\x58\xdf\xf1\x11\xb6\xf7\xa7\xa4\x37\xcd\x3c\x1c\x14\xf2\x26\xe7\x32\xc5\xda\x97\x76\x2b\xd6\xe6\xf1\xc5\x67\xcd\xd5\xdd\xe2\xc4\xb2\x08\xe9\xb8\x28\x2d\xed\x54\x7b\x9e\x95\xbb\xde\x98\xed\x66\x72\xee\xcc\xe3\x0b\xdc\x46\x59\x74\x25\xa8\x16\x7b\x1f\x17\x7b\xce\x0d\x59\x47\xb3\xdf\x8d\x0f\xa0\x2d\xdd\x91\x3d\xd0\xdf\xb4\xb2\xf8\xed\xfb\x12\x46\xbb\x8f\x54\x27\xca\x01\xe8\xe9\xfd\xbb\x02\x22\x66\xaf\xb9\xff\x84\xb6\xc3\x1e\x5c\x77\xd3\xe9\x2e\x97\xf9\xc3\xea\xa9\x74\x81\x3c\xaf\xbf\x5d\xaf\x64\xad\xa6\x16\xa3\xf2\xbd\x3c\x46\xaa\xf6\xd9\xa5\x13\xe4\x3c\x89\xd6\xb1\x75\x10\xb5\x4d\x09\xbd\x5c\xe6\x4b\x5e\x42\xf4\x0b\xe7\x66\x1c\x77\x29\xaa\xa4\x60\xfb\xc0\x11\x05\x43\xd4\x1f\xbc\x63\x85\x6d\x71\x1e\x1d\xaf\xd4\x57\x9c\x27\x97\x78\x9b\x93\x6b\xa1\x55\x19\x10\xbf\x19\xc1\xf5\x4a\x74\x7f\x4f\x9a\x06\xd7\xd0\xff\x4f\x3a\x33\x6b\xe4\x71\xdd\x14\xed\x81\x7d\x18\x04\x37\x6a\x03\x57\x7d\xc8\xc6\x6c\x9f\x62\x56\x0e\x61\x67\x7e\x70\x54\xd4\x9f\xce\x55\x37\x04\xf5\x76\xc8\x90\xb9\xb2\x1c\xa1\x7f\x02\x00\x00\xff\xff\xba\x44\x08\x20\x71\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x51\xb1\x8e\xd3\x40\x10\xed\xfd\x15\x7b\x57\x5c\xb7\x0e\x14\x54\xc8\x45\x08\x87\x38\x41\x71\x4a\x38\x51\x44\x29\x26\xeb\xe7\x78\xc8\x66\xd7\xcc\x8e\x43\x22\xcb\xff\x8e\xd6\x56\x38\x09\x89\x86\x6e\xe6\xbd\x37\x6f\xde\xce\x6e\x5f\x02\xeb\xae\xf8\x88\xe4\x84\x3b\xe5\x18\xaa\x13\x25\x85\x98\x10\x6b\x98\x1f\x91\x83\xd1\x16\xc6\xf9\x3e\xa3\xc5\x1a\x3f\x7b\x16\xa4\x2a\x41\xed\x11\x12\xe0\x6d\x47\x42\x65\x82\x9c\xd9\xc1\x08\x3c\x28\xc1\xf2\x89\x0e\xb0\x1d\x9f\xa3\xde\xb8\x62\xd9\x28\xe4\xbf\x26\x57\x31\xd4\x9c\xd3\x3d\x93\xb6\x8f\x17\x4e\x9a\xaa\xbb\xc5\x99\x64\xe1\xe3\x61\x91\x53\xda\x39\x76\x99\x94\x4e\x5d\x51\x6c\x37\xf3\xe4\xae\x78\xbc\xc0\x6d\x94\x44\x9f\x05\xd5\x62\xcf\x61\xb1\xa7\xd4\x1a\xeb\xcc\xfd\xaf\x96\x3d\xcc\xd6\xdc\x19\xdb\x98\x3f\x6e\xf9\xe1\xb7\xf5\xd9\xcc\xec\xde\x9b\x3a\x9a\xe4\x81\xce\xbc\x7d\x93\x9b\x80\xfb\x57\xdf\xbf\x4c\x8f\xfd\x1e\x54\x9f\xe6\xd3\x0d\x43\xb9\x7c\x7e\xca\x59\x20\x2f\xeb\xaf\xe3\x68\xac\xd5\x78\xc4\xc4\x7c\xcb\xc5\x04\xd5\x9c\x5c\x3c\x43\xae\x33\x69\x1d\x59\x07\x51\xdb\x66\xd3\x61\x28\x57\xb4\x82\xe8\x67\x4a\xed\x24\x77\x31\xa8\x44\x6f\x3b\x4f\x01\xc3\xc0\x8d\x29\x1f\x2f\x0a\x09\xe4\x57\xcb\x49\xc1\x87\x10\x05\xb6\x13\x34\x9e\x0f\xad\x5a\x88\x44\x49\xd5\x27\xf6\x58\x9e\x89\x3d\xed\x3d\xac\x85\x3a\x9b\xf3\x4a\x80\x22\x4d\xa5\x87\x96\x2e\x86\x66\x18\xe0\x13\xe6\x75\x10\xe5\x86\x1d\x29\xec\x11\xd7\x29\xd0\x2b\xf4\x05\xd7\x71\x1c\x06\x84\x7a\x16\x0b\xdb\x14\xdd\x11\x5a\x65\x9d\xf0\x66\x6a\xc6\xd1\x98\x87\x07\xa3\xb1\x77\xad\xf9\xf7\xc7\xdd\x17\x6b\xa4\xe9\xa8\x31\xd8\x86\xd8\xf7\x82\x1b\xb4\x81\xab\xde\xa5\xa2\xd8\x3e\x85\xa4\xe4\xfd\xae\xf8\x4e\x41\x51\x7f\xb8\x56\xa7\xde\x2b\xdb\x3e\x41\x4a\x25\x39\x40\x7f\x0f\x00\xc7\x9e\x76\x61\xd7\x02\x00\x00"),
 		},
 		"/bootconfig/systemd/join-worker.service.template": &vfsgen۰CompressedFileInfo{
 			name:             "join-worker.service.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
-			uncompressedSize: 571,
+			modTime:          time.Date(2026, 10, 19, 19, 22, 21, 670171451, time.UTC),
+			uncompressedSize: 665,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x90\xb1\x6e\xdb\x30\x10\x86\x77\x3e\xc5\xc5\x43\x36\x4a\xed\xd0\xa9\xd0\x90\xba\x01\x1a\xa0\x83\x61\x37\xe8\x60\x78\xa0\xa9\xdf\x16\x2b\x9a\x54\xef\x4e\x4e\x02\xc3\xef\x5e\x50\x82\x5b\xa0\x40\x97\x6e\xbc\xfb\x79\x1f\x3f\xde\xf6\x39\x05\xdd\x99\xcf\x10\xcf\x61\xd0\x90\x53\xf3\x92\xb9\x07\x53\xca\x2d\xe8\x47\x0e\x89\xb4\x03\xf9\x38\x8a\x82\xcd\x1a\x3f\xc7\xc0\x90\x46\xa0\xb6\x07\x27\x44\x3b\x38\x76\x95\x80\xcf\xc1\x83\x18\x11\x4e\x60\xc3\xc9\x1d\x61\x87\x70\xce\x7a\xcb\xcc\xc3\x41\xc1\xff\x35\xb9\xcc\xa9\x0d\xc5\x6e\xe5\xb4\x7b\x7c\x0d\xa2\xd2\xdc\xd5\x67\xc7\x75\xcc\xc7\xba\x58\xda\x59\xbb\x12\x75\xa7\xc1\x98\xed\x66\x9e\xdc\x99\xc7\x57\xf8\x8d\x3a\xd6\x15\xa3\xa9\xf7\x21\xd5\x7b\x27\x1d\x59\x4f\x8b\x97\x2e\x44\xd0\x96\xee\xc8\x1e\xe8\x37\xad\x7c\xfc\xf6\x7c\x81\xd1\xee\x23\xb5\x99\x24\x02\x03\xbd\x7f\x57\x8a\x84\xc5\x1f\xee\x5f\xd0\x7e\xdc\xc3\xb5\xa7\x79\x75\x97\x4b\xf5\xb0\x7a\x2a\x2e\xe0\xe7\xf5\xd7\xeb\x95\xac\xd5\xdc\x63\x4a\xbe\x95\xc3\xd4\x6a\x83\xf8\x7c\x06\xbf\xcd\xa1\xf5\xce\x7a\xb0\xda\xae\x40\x2f\x97\x6a\xe9\x96\x60\xfd\xe2\xa4\x9b\xae\x7b\x0e\x56\xb2\xef\xa1\x4d\x09\x39\x6c\xa6\xe2\x7a\x25\xba\xbf\x27\xcd\xa3\xef\xe8\xdf\xcb\x59\x98\x35\x64\x12\xcf\xc9\x1e\x5c\x88\x23\xe3\xd6\xda\xc0\x37\x1f\xc4\x98\xed\x53\x12\x75\x31\xee\xcc\x77\x97\x14\xed\xa7\xb7\xe6\x34\x46\x0d\x76\x14\x70\xa5\x8e\x8f\xd0\x5f\x01\x00\x00\xff\xff\xc1\x5b\x96\x23\x3b\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x90\x31\x8f\xd3\x40\x10\x85\x7b\xff\x8a\xb9\x14\xd7\xad\x03\x05\x15\x72\x11\x42\x10\x27\x51\x44\x09\x27\x8a\x28\xc5\x66\xfd\x1c\x0f\xd9\xec\x9a\xd9\x71\x2e\x27\xcb\xff\x1d\xad\xa3\x80\x84\x44\x73\xdd\xcc\x3c\xcd\x37\x6f\xde\xee\x39\xb0\xee\x8b\xcf\x48\x4e\xb8\x53\x8e\xa1\x7a\x89\x72\x82\x50\x88\x35\xe8\x67\xe4\x40\xda\x82\x9c\xef\x93\x42\x8a\x0d\x7e\xf5\x2c\x48\x55\x82\x9a\x13\x24\xc0\x9b\xce\x8a\x2d\x13\xe4\xc2\x0e\x24\xf0\xb0\x09\x86\xcf\xf6\x08\xd3\xf1\x25\xea\x5d\x2b\x16\x8d\x42\xde\xb4\xb9\x8c\xa1\xe6\xec\x6e\x6d\xb5\x5d\x5d\x39\x69\xaa\x1e\xe6\x17\x2b\x73\x1f\x8f\xf3\xec\xd2\xdc\x6c\x97\x49\xed\xb9\x2b\x8a\xdd\xf6\xb6\xb9\x2f\x56\x57\xb8\xad\x5a\xd1\xb5\xa0\x9a\x1f\x38\xcc\x0f\x36\xb5\x64\x1c\xcd\x5e\x5a\xf6\xa0\x1d\x3d\x90\x69\xe8\x0f\x2d\x3f\x7e\x3f\x9f\x61\xb4\xff\x48\x75\xa4\xe4\x81\x8e\xde\xbf\xcb\x4d\xc0\xec\x2f\xf7\x1f\xe8\xa9\x3f\xc0\xd6\xe7\x5b\x74\xc3\x50\x2e\xd6\x4f\xd9\x0b\xe4\x79\xf3\x6d\x1c\xc9\x18\x8d\x27\x4c\xca\xf7\x5c\x4c\xa3\x9a\x93\x8b\x17\xc8\xeb\x4d\x34\xce\x1a\x07\x51\xd3\x66\xe8\x30\x94\x4b\xbb\x84\xe8\x57\x9b\xda\x71\x1c\x06\x6e\xa8\x5c\x5d\x15\x12\xac\x5f\x2e\x26\x00\x1f\x43\x14\x98\x4e\xd0\x78\x3e\xb6\x6a\x20\x12\x25\x55\x5f\xd8\x63\x71\xb1\xec\xed\xc1\xc3\x18\xa8\x33\xd9\x9f\x04\x28\xd2\x54\x7a\x68\xe9\x62\x68\x86\x01\xa1\x9e\x58\x4e\xd8\xa4\xe8\x4e\xd0\x2a\x9f\x16\xde\x4e\xcd\x38\x12\x3d\x3e\x92\xc6\xde\xb5\xf4\xff\xe8\x67\xc5\x06\x69\x8a\x25\x06\xd3\x58\xf6\xbd\xe0\x3e\xda\xc2\x55\x1f\x52\x51\xec\x9e\x42\x52\xeb\xfd\xbe\xf8\x61\x83\xa2\xfe\xf4\x5a\x9d\x7b\xaf\x6c\xfa\x04\x29\xd5\xca\x11\xfa\x7b\x00\x33\xf2\xc4\x26\x99\x02\x00\x00"),
 		},
 		"/bootconfig/systemd/kubelet.service": &vfsgen۰CompressedFileInfo{
 			name:             "kubelet.service",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 323,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8f\xc1\x4e\xeb\x30\x10\x45\xf7\xfe\x8a\xfc\x40\xeb\xbe\x6d\x25\x2f\xfa\x68\x17\x15\x08\x21\x0a\x62\x51\x65\xe1\x38\x43\x32\x8a\x33\x8e\x3c\xd7\x69\xfb\xf7\x88\xa8\xb0\x63\x7d\xce\x8c\xee\x39\xbf\x0b\xa3\x36\x7b\xd2\x90\x79\x02\x27\x71\x43\x69\x28\x12\xb6\xd5\x5b\x4f\xd5\x63\x69\x28\x0b\x81\xb4\x7a\x4e\x2d\x55\xbb\x8e\x04\x66\x9f\x42\x19\x49\xe0\x97\x83\x1e\x98\x74\x6b\xed\xf0\xeb\xae\x39\xd9\x36\x05\xb5\xe6\xc3\x0b\xd4\x09\xe1\x92\xf2\xb0\x4a\x12\x59\x68\x0d\x9f\x3b\x82\xd9\x7d\x82\xf2\x1f\xec\x21\x49\xcb\xdf\xdf\x5f\x3c\xfa\xc3\x95\x15\xea\xec\xec\xb3\x8d\xa9\xb3\x92\x5a\x5a\x4d\x3c\x27\xac\x15\x7e\x9c\x8c\x39\x9f\x28\xcf\x1c\xa8\x36\x87\x2b\x85\x13\x7c\x86\xb3\x45\xb3\x6d\x58\xec\x3d\xc8\xbc\x92\x2e\xc0\xc7\x8b\xbf\xa9\x59\xac\x27\x1e\x19\x47\x01\xe5\xd9\x47\xb7\xf9\x71\x4e\x14\xdc\xbf\x8d\x31\xe7\xa3\x28\x7c\x8c\xf5\x12\x42\xed\xff\x9b\x1b\x4b\x04\xaf\x8a\x52\xbe\x6f\xfd\x0a\x00\x00\xff\xff\x99\x17\x34\x68\x43\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8f\xc1\x4e\xeb\x30\x10\x45\xf7\xfe\x8a\xfc\x40\xeb\xbe\x6d\x25\x2f\xfa\x68\x17\x15\x08\x21\x0a\x62\x51\x65\xe1\x38\x43\x32\x8a\x33\x8e\x3c\xd7\x69\xfb\xf7\x88\xa8\xb0\x63\x7d\xce\x8c\xee\x39\xbf\x0b\xa3\x36\x7b\xd2\x90\x79\x02\x27\x71\x43\x69\x28\x12\xb6\xd5\x5b\x4f\xd5\x63\x69\x28\x0b\x81\xb4\x7a\x4e\x2d\x55\xbb\x8e\x04\x66\x9f\x42\x19\x49\xe0\x97\x83\x1e\x98\x74\x6b\xed\xf0\xeb\xae\x39\xd9\x36\x05\xb5\xe6\xc3\x0b\xd4\x09\xe1\x92\xf2\xb0\x4a\x12\x59\x68\x0d\x9f\x3b\x82\xd9\x7d\x82\xf2\x1f\xec\x21\x49\xcb\xdf\xdf\x5f\x3c\xfa\xc3\x95\x15\xea\xec\xec\xb3\x8d\xa9\xb3\x92\x5a\x5a\x4d\x3c\x27\xac\x15\x7e\x9c\x8c\x39\x9f\x28\xcf\x1c\xa8\x36\x87\x2b\x85\x13\x7c\x86\xb3\x45\xb3\x6d\x58\xec\x3d\xc8\xbc\x92\x2e\xc0\xc7\x8b\xbf\xa9\x59\xac\x27\x1e\x19\x47\x01\xe5\xd9\x47\xb7\xf9\x71\x4e\x14\xdc\xbf\x8d\x31\xe7\xa3\x28\x7c\x8c\xf5\x12\x42\xed\xff\x9b\x1b\x4b\x04\xaf\x8a\x52\xbe\x6f\xfd\x1a\x00\x99\x17\x34\x68\x43\x01\x00\x00"),
 		},
 		"/bootconfig/systemd/release-image-pivot.service": &vfsgen۰CompressedFileInfo{
 			name:             "release-image-pivot.service",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 328,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcf\xaf\x6e\xc3\x40\x0c\xc7\x71\x7e\x4f\xe1\x15\x94\xa5\x87\x06\x0f\xec\x4f\xc1\x58\xb5\x6a\x1a\x88\x02\xdc\x8b\x93\x58\xb9\xf8\xa2\xb3\x93\x75\x6f\x3f\xa5\xda\xc0\x40\xa9\x2d\xfd\x3e\xfa\xd6\x1f\xc2\xd6\xb8\x57\xd2\x58\x78\x36\xce\x12\x4e\xbc\x66\x03\xc9\x2d\x81\x65\xb0\x81\x40\xc6\x16\x0a\x25\x42\x25\xe0\x09\x7b\x72\x9f\x28\xa6\x41\xc8\xbe\x72\x19\xab\x2c\x89\x85\x0e\x86\xa5\x27\x73\x4f\x9d\x51\xb9\xf3\x7b\xc9\xd2\xf2\xa6\x9c\xd0\x86\xe3\x95\xd5\x34\x3c\xf8\x15\x8b\x4f\xb9\xf7\x9b\x59\xcd\x1b\x7f\x50\xc3\x69\x76\xae\x3e\x53\x59\x39\x52\xe3\x8e\x57\x8a\x67\xc3\x62\xc1\x5f\x58\xfc\x05\x75\x80\x2a\xc2\xce\x93\x45\x2f\x63\xdb\x71\x22\xfd\xb7\x30\xc0\x7e\x0f\x96\x97\x38\xc0\x5d\x61\xe7\xdc\x3b\xe9\x6d\x36\x4b\xd5\x21\xa7\xa5\xd0\xdf\xe9\x4c\x31\x3c\xaa\x73\xf5\x9b\xa8\x61\x4a\xcd\x2d\x9b\xda\xe7\xef\x30\x2d\xc9\xb8\x5a\x94\xca\x6f\xd9\x4f\x00\x00\x00\xff\xff\xff\x91\xbf\xed\x48\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xcf\xaf\x6e\xc3\x40\x0c\xc7\x71\x7e\x4f\xe1\x15\x94\xa5\x87\x06\x0f\xec\x4f\xc1\x58\xb5\x6a\x1a\x88\x02\xdc\x8b\x93\x58\xb9\xf8\xa2\xb3\x93\x75\x6f\x3f\xa5\xda\xc0\x40\xa9\x2d\xfd\x3e\xfa\xd6\x1f\xc2\xd6\xb8\x57\xd2\x58\x78\x36\xce\x12\x4e\xbc\x66\x03\xc9\x2d\x81\x65\xb0\x81\x40\xc6\x16\x0a\x25\x42\x25\xe0\x09\x7b\x72\x9f\x28\xa6\x41\xc8\xbe\x72\x19\xab\x2c\x89\x85\x0e\x86\xa5\x27\x73\x4f\x9d\x51\xb9\xf3\x7b\xc9\xd2\xf2\xa6\x9c\xd0\x86\xe3\x95\xd5\x34\x3c\xf8\x15\x8b\x4f\xb9\xf7\x9b\x59\xcd\x1b\x7f\x50\xc3\x69\x76\xae\x3e\x53\x59\x39\x52\xe3\x8e\x57\x8a\x67\xc3\x62\xc1\x5f\x58\xfc\x05\x75\x80\x2a\xc2\xce\x93\x45\x2f\x63\xdb\x71\x22\xfd\xb7\x30\xc0\x7e\x0f\x96\x97\x38\xc0\x5d\x61\xe7\xdc\x3b\xe9\x6d\x36\x4b\xd5\x21\xa7\xa5\xd0\xdf\xe9\x4c\x31\x3c\xaa\x73\xf5\x9b\xa8\x61\x4a\xcd\x2d\x9b\xda\xe7\xef\x30\x2d\xc9\xb8\x5a\x94\xca\x6f\xd9\xcf\x00\xff\x91\xbf\xed\x48\x01\x00\x00"),
 		},
 		"/bootconfig/systemd/set-kernel-para.service": &vfsgen۰CompressedFileInfo{
 			name:             "set-kernel-para.service",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 453484292, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 284,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x8e\x4d\x4e\xc3\x30\x10\x85\xf7\x73\x0a\x5f\x20\xc9\x09\xbc\x00\xd1\x05\x62\xd7\x82\x58\x44\x11\x72\x9c\x97\x32\xaa\x63\x9b\x99\x49\xd5\xdc\x1e\x41\x24\xc4\xaa\xbb\xf7\x2b\x7d\xfd\x5b\x66\x1b\xe8\x09\x1a\x85\xab\x71\xc9\x5e\x61\xee\x02\xc9\x48\xae\x06\x09\x6e\x2e\xe2\x5e\xd6\xf1\x27\x31\x28\x1d\xf1\xb5\xb2\x40\xbd\x20\x21\x28\x1a\x5e\xc2\x19\x4d\xe5\x6b\xb1\x56\x21\x57\x8e\xa0\x87\xd9\x20\x77\x17\xd4\x9f\x76\x35\xd0\xeb\x56\xe1\x4b\x86\x7e\x16\xa3\x23\x96\xc0\xf9\xf7\x7f\xb8\xb1\xf9\x0d\x4a\x87\x1b\xe2\xc9\x82\x98\x5f\xca\x54\xa5\x8c\x70\xa3\x7c\x64\xd8\xcc\xc9\x20\xff\x7a\xdd\x34\x5a\x72\x4d\x75\x1d\x2c\x76\xbb\x6d\xa7\xee\xf2\xc7\xdf\xc6\x92\x67\xa2\xfe\x39\xab\x85\x94\x06\x7a\x0f\xd9\x30\x3d\x6e\x7e\x59\x93\x71\xb3\x2a\xa4\xb5\x20\x67\xd8\x77\x00\x00\x00\xff\xff\x2c\xec\xe5\x9e\x1c\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x8e\x4d\x4e\xc3\x30\x10\x85\xf7\x73\x0a\x5f\x20\xc9\x09\xbc\x00\xd1\x05\x62\xd7\x82\x58\x44\x11\x72\x9c\x97\x32\xaa\x63\x9b\x99\x49\xd5\xdc\x1e\x41\x24\xc4\xaa\xbb\xf7\x2b\x7d\xfd\x5b\x66\x1b\xe8\x09\x1a\x85\xab\x71\xc9\x5e\x61\xee\x02\xc9\x48\xae\x06\x09\x6e\x2e\xe2\x5e\xd6\xf1\x27\x31\x28\x1d\xf1\xb5\xb2\x40\xbd\x20\x21\x28\x1a\x5e\xc2\x19\x4d\xe5\x6b\xb1\x56\x21\x57\x8e\xa0\x87\xd9\x20\x77\x17\xd4\x9f\x76\x35\xd0\xeb\x56\xe1\x4b\x86\x7e\x16\xa3\x23\x96\xc0\xf9\xf7\x7f\xb8\xb1\xf9\x0d\x4a\x87\x1b\xe2\xc9\x82\x98\x5f\xca\x54\xa5\x8c\x70\xa3\x7c\x64\xd8\xcc\xc9\x20\xff\x7a\xdd\x34\x5a\x72\x4d\x75\x1d\x2c\x76\xbb\x6d\xa7\xee\xf2\xc7\xdf\xc6\x92\x67\xa2\xfe\x39\xab\x85\x94\x06\x7a\x0f\xd9\x30\x3d\x6e\x7e\x59\x93\x71\xb3\x2a\xa4\xb5\x20\x67\xd8\xf7\x00\x2c\xec\xe5\x9e\x1c\x01\x00\x00"),
+		},
+		"/bootmenu": &vfsgen۰DirInfo{
+			name:    "bootmenu",
+			modTime: time.Date(2026, 10, 19, 18, 39, 8, 310881635, time.UTC),
+		},
+		"/bootmenu/grub.cfg.template": &vfsgen۰CompressedFileInfo{
+			name:             "grub.cfg.template",
+			modTime:          time.Date(2026, 10, 19, 18, 39, 8, 310881635, time.UTC),
+			uncompressedSize: 263,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x44\x8e\xc1\x4a\x43\x31\x10\x45\xf7\xf9\x8a\x4b\x74\x69\xf2\x07\x5d\x08\x8a\x88\x5d\xb8\xf0\x07\x52\x33\xad\x83\xe9\x3c\x48\xe6\x41\xcb\x30\xff\x2e\x2f\x6f\xe1\xee\x70\x0e\x33\xdc\x07\xbc\x91\x50\x2f\x4a\x15\xa7\x3b\xe4\xb7\x3e\xa1\x2e\x90\x45\x41\x95\x35\x87\x41\x8a\x4a\xe7\xb2\x36\x3d\x44\xb3\xfc\xb2\xb3\x7b\x9c\x49\xf9\x4a\xcb\xaa\x07\xb3\xfc\xb5\xa3\x7b\x30\xeb\x45\x2e\x84\xfc\x2a\xda\x99\x86\x7b\xb8\x92\xac\x24\xda\xef\xd8\x9e\x1c\xcb\x89\x9a\x7b\x44\x4a\x5c\xf1\x2f\x60\xc1\x2c\x81\xcf\xc8\xc7\xe5\xbb\x34\xf7\x00\xd0\x8d\x75\x6a\x6a\x83\xa6\x69\x2c\xeb\x0d\x66\x8f\xf9\x83\xba\xec\x87\x96\x9f\xfb\x65\xcc\xcc\xc2\xda\xeb\xec\xef\x13\x3f\x8b\xfe\x0c\x44\xc4\xb9\x2d\x81\xa4\xba\x87\x8d\x49\x2a\x92\x7b\xf8\x1b\x00\x8e\x5c\xa2\x0e\x07\x01\x00\x00"),
+		},
+		"/bootmenu/ipxe.cfg.template": &vfsgen۰CompressedFileInfo{
+			name:             "ipxe.cfg.template",
+			modTime:          time.Date(2026, 10, 19, 18, 39, 8, 309176796, time.UTC),
+			uncompressedSize: 579,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x51\x4d\x4e\xf3\x30\x10\xdd\xfb\x14\xf3\xa9\x55\x57\x9f\x73\x80\x4a\x2c\x10\x20\x84\x28\x88\x05\x17\x70\xe3\xd7\xd4\x6a\x62\x23\x67\x22\x15\xb9\x73\x77\xe4\xb8\x3f\x29\x15\x9b\xe4\xc5\x33\xef\x2f\x9e\xfd\x73\x5f\x7b\xa8\x19\x3d\xc3\x23\x1a\x86\xa5\xf5\x37\xf9\x9d\xfd\x4f\x36\x90\x0f\x4c\xb0\x8e\x2b\x95\x92\x26\xb7\xa1\xea\x61\x6b\x9c\x17\x51\x75\x7e\x93\xd6\x66\xe0\xb0\x89\x00\xa5\x74\x9a\xd1\xe1\x40\x4d\xe0\x40\x1d\xfc\x30\x12\xe1\xad\xc8\x59\xe2\xd3\x75\x08\x03\x8b\x28\xb5\x1c\x57\xf2\x23\x5b\xd2\x3a\x04\xbe\xb0\xa2\xf1\x0d\xa8\x7a\xf2\x1c\x1d\x7a\x11\xe5\x18\x5d\xf6\x59\x99\x35\x5a\x91\x09\x9c\xb8\xd4\xdb\x10\x7a\x90\xd6\x16\x1b\x33\xb4\x9c\xb7\x1e\x0b\x14\x21\xad\xb9\xb8\xe7\xe3\x37\xd7\xb6\xae\x47\x1d\xbc\xed\x45\x88\x4d\x6c\xc0\xb4\x58\x94\xf4\xf3\x54\x0e\x2e\x7d\xa6\x4a\xc5\xb1\xed\x21\xa2\xfe\x18\x1e\x4b\xdf\xd4\x58\xfe\xca\x9d\xff\xc9\x2a\xd4\x26\x7f\x63\xef\x78\x2a\xbd\x43\xf4\x68\x29\xa5\x79\xf5\x3a\x42\x91\x93\xe2\xbc\x7a\xf1\x8e\xe3\x18\xdd\x8d\xe8\x2e\xa5\xea\xdd\x74\xc8\x3b\xa3\x7b\x0e\x75\x1f\x9b\xfe\x68\x74\xc3\x53\x85\x47\x5a\x7b\xd3\x81\xce\xf4\x8c\x3e\x0c\x6f\xaf\x9a\xe4\xcb\xb9\x2a\x06\x6f\x49\x8b\xa8\x9f\x01\x00\xeb\x9d\xe7\x3c\x43\x02\x00\x00"),
+		},
+		"/bootmenu/pxelinux.cfg.template": &vfsgen۰CompressedFileInfo{
+			name:             "pxelinux.cfg.template",
+			modTime:          time.Date(2026, 10, 19, 18, 39, 8, 310043847, time.UTC),
+			uncompressedSize: 303,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x4e\x4d\x6e\xea\x30\x10\xde\xe7\x14\x9f\x78\x6f\x09\x51\x7b\x80\x2e\x4c\xe3\x56\x08\x83\x2d\x14\x0e\x60\xe2\x81\x5a\x4d\xc7\x92\x63\x16\x55\xe4\xbb\x57\x4e\x22\xda\xdd\xcc\xf7\xff\x0f\xef\xc4\x14\x6d\x22\x87\xcb\x37\xf8\xd3\xad\xe1\x02\x38\x24\x90\xf3\xa9\xae\x1a\xf9\x26\xce\xaa\xc5\x38\xd6\x0d\x5d\xed\xbd\x4f\x39\x57\xe3\xb8\x81\xbf\xa2\x6e\xfd\x17\x85\x7b\x41\xcc\x49\x1f\x4c\x8b\xe7\xaa\xdd\x1d\xa4\x3e\x2f\xfa\xce\x0f\xd4\x05\x76\xc3\xe2\xa1\x7e\xa0\x5f\xf1\xd3\x8c\xb1\x9b\xd8\x68\xf9\x46\xa8\x25\xa7\xe8\xa9\x18\x94\xd8\x4a\x55\x72\x94\xbd\x50\xff\xa7\x55\x85\xce\x96\x1f\x50\xfa\x55\xa8\xad\xd6\x8f\xac\x39\x1f\xd8\xcb\xd3\x71\x32\xff\xaf\xf7\x14\x99\x66\xb9\x30\x46\x1e\x1b\x78\xf6\x29\xba\x97\x42\xee\xa6\xd3\xd8\xf4\x31\x60\xb5\x5e\xe5\x5c\xfa\x44\xbc\x3d\x06\x2f\xe3\x88\x1d\x36\x39\x57\x3f\x03\x00\x1f\x72\x42\x41\x2f\x01\x00\x00"),
+		},
+		"/bundle": &vfsgen۰DirInfo{
+			name:    "bundle",
+			modTime: time.Date(2026, 10, 19, 16, 20, 26, 587553507, time.UTC),
+		},
+		"/bundle/catalog.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "catalog.yaml",
+			modTime:          time.Date(2026, 10, 19, 16, 20, 26, 593602517, time.UTC),
+			uncompressedSize: 720,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\xcf\x6e\xc2\x30\x0c\x87\xef\x7d\x0a\x0b\xae\xc3\xb4\x74\xfc\x69\xde\x60\xda\x61\xd2\xb4\x17\x48\x13\x17\x22\xda\xa4\x72\x92\x4a\xbc\xfd\xd4\x52\x2a\xd1\x81\xc4\x6e\x96\xfd\xfb\x3e\x1f\xec\x25\x7c\x34\xf2\x48\x1e\xda\x58\xd7\xa4\xa1\xbc\xc0\x39\x96\x24\x75\x03\x95\x63\x20\xa9\x4e\xf0\x19\x4b\x62\x4b\x81\x3c\x34\xc6\x3a\x86\x8e\xd8\x1b\x67\xdf\x40\x5a\x0d\xe1\x44\xc9\x12\xbe\x5a\xb2\x3f\xae\x8a\xd0\xb2\xeb\x8c\x26\xf6\xe0\xaa\x2b\x6e\x6c\xc5\xd2\x07\x8e\x2a\x44\x26\x68\x6b\x19\x2a\xc7\x0d\x26\xe7\xc9\x2b\x12\x80\x2e\xc3\xcd\xb6\x2f\x00\x28\x28\x2d\x20\xc7\x2d\xbe\xaf\xd2\xa1\xa3\x1c\x93\xb6\x5e\xf4\xa9\x02\xf3\xa1\xd7\xca\xe8\x49\xc0\x22\xc7\xc3\x62\xe4\x77\x73\x7e\xf7\x12\x5f\xdc\xf8\xfd\x9c\xdf\x3f\xe0\xb3\x14\xb3\x67\x82\xc3\x5c\x50\xfc\x53\x50\xcc\x05\x59\xfa\xc8\x90\x3d\x35\xe4\xe9\x1f\xc3\xe6\x65\xc3\xed\x78\xdf\x74\x34\x3e\xf0\x45\x00\x8f\x15\xba\x96\x6c\x70\x55\x44\xc7\xc7\x29\x37\x1c\xae\x36\x65\x67\x38\x5c\xd7\x7a\x17\x59\x91\x00\xdd\x48\xd5\x19\x25\x79\x3d\x8e\x87\xe9\xf8\x38\x02\x52\xdc\xe3\x2e\x01\xe8\xad\x3e\x48\x75\xbe\xa7\x03\x31\xcb\xfe\x49\x56\xb7\x4d\xab\x29\xb9\x9e\xaa\x7b\xe5\x06\x53\x4c\x93\xdf\x01\x00\x01\x2e\x00\x86\xd0\x02\x00\x00"),
+		},
+		"/cni": &vfsgen۰DirInfo{
+			name:    "cni",
+			modTime: time.Date(2026, 10, 19, 19, 2, 20, 667350115, time.UTC),
+		},
+		"/cni/catalog.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "catalog.yaml",
+			modTime:          time.Date(2026, 10, 19, 19, 2, 20, 802179526, time.UTC),
+			uncompressedSize: 1203,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x54\xc1\x8e\xe4\x34\x10\xbd\xe7\x2b\x9e\x26\x87\x01\x69\x36\x8d\x76\x05\x48\x61\x34\x37\x0e\x48\x0c\xac\x56\x33\x5c\x91\xdb\xae\xc4\xa6\x63\x3b\x72\x95\x3b\xdb\x6a\xf5\xbf\xa3\x24\xce\x6e\x76\xe0\xd4\x69\xbf\xf2\xab\xaa\x57\xaf\x5c\xe3\x0f\x92\x29\xa6\x13\xc6\x21\xf7\x2e\x30\x38\x8f\x63\x4c\x42\x06\xc7\x0b\xc2\xc9\x34\x55\x5d\xd5\x78\xb1\x04\xaf\x82\xeb\x88\x05\xb1\x03\x29\x6d\x71\xa6\xc4\x2e\x06\x38\x86\x58\x42\x0e\x3e\x1a\xd7\x39\x32\xc8\x23\x4b\x22\xe5\xbf\xdc\x79\x00\xf9\x23\x19\x43\x06\x8a\xab\x1a\x8f\x6b\xba\xa7\xc3\x63\x21\x79\x6a\x2e\xca\x0f\x0d\x38\xe6\xa4\x89\x91\x48\xc7\x64\x18\x93\xa5\x44\x70\x82\x49\x31\x4c\x9c\xc2\x10\xd5\xcc\xd2\xa5\xe8\xa1\x82\x81\x93\x99\x8f\xad\x7a\xff\xe3\x4f\xd0\x96\xf4\x89\xb3\x7f\xc0\x64\x9d\xb6\x73\xfd\xe5\x0c\x47\xea\x62\x22\xd0\x99\xd2\x05\x99\xa9\x41\xa2\x71\x50\x9a\x3c\x05\x61\xa8\x44\x4b\x13\x31\x0c\x97\xaa\x86\xb6\x2a\xf4\xc4\xf0\xca\x10\x24\xae\xfd\xbd\x6d\xaa\x2d\x6c\x71\x30\x60\x49\x2e\xf4\xf0\x79\xd6\x47\xeb\x9c\xe0\x02\x9c\x3c\xcc\x35\x56\x35\x1c\x6f\xe9\x0c\x26\x27\x16\x81\x26\x24\x0a\x86\xd2\x22\x09\x14\x84\x3e\xcb\x41\xc8\x8f\x83\x12\x5a\x83\xe6\xac\xa1\xcc\x87\x49\xc4\x85\x9e\x11\xbb\xaa\x5e\x10\x3d\x64\x16\x4a\xf8\xae\x73\x34\x98\x19\x80\x0e\xae\xf9\xa8\x92\xf2\xfc\x7d\x83\x3f\xc3\x70\x59\x02\xc7\x68\xc0\xf9\x18\x48\xf8\x01\xcf\x2f\xaf\xab\x6e\x5e\xf5\x54\xd5\x48\xd4\x3b\x96\x74\x81\x57\x17\x1c\x09\x5b\x05\x65\xf0\xbf\x7e\x56\x7e\x1c\xa8\x5d\xfe\x00\x5a\x0d\x4e\xc7\x76\xf9\x06\x0c\x75\x2a\x0f\xf2\xd7\x3a\xc2\x16\xe7\x0f\xcd\xfb\x9f\x9b\x0f\x05\x5d\x32\x7c\x2a\xf4\x2d\x4c\xd4\x27\x4a\x8d\x8b\x05\x2e\x46\xe3\xe7\x97\xd7\x16\x92\x32\x95\xf3\x62\x08\xde\x92\xbc\x7b\x43\x5b\x1c\xb2\xc1\xd8\xe0\xaf\x07\x40\x4e\x43\x0b\x2b\x32\x72\x7b\x38\x24\x35\x35\xbd\x13\x9b\x8f\x99\x29\xe9\x18\x84\x82\x34\x3a\xfa\xc3\x98\xe2\x3f\xa4\x65\x6d\xea\x50\x7e\x0a\xdd\x61\x9b\x32\x17\x60\x31\xe8\x2e\xc7\x6a\xb9\x16\x8f\xeb\xc7\x22\xff\x37\x0c\xcb\x85\xa7\xdd\x8d\xbd\xe1\xf6\xd5\xbe\x9b\x1d\xd4\xe2\xfe\x4c\x62\xff\xf6\x92\x5b\xdc\xfd\x70\x77\xbf\x0b\xc0\x6c\x97\x6f\x03\xae\xd7\xe6\xf9\xe5\xf5\x76\xbb\xbb\xff\x2f\xd1\x17\xa5\xb7\x96\xfe\x87\xea\x7a\x6d\x7e\xdb\xcf\xe7\x76\xdb\x82\xef\x97\x51\x7f\x2c\xcf\xc1\x64\x23\xd3\xd7\xe5\xdd\x44\x81\x55\x8c\x10\x05\x47\xa2\xb0\xae\xd8\xec\xe3\x5e\xb9\xc0\xb2\xdb\x94\xcc\x52\xd5\x25\x10\x83\x63\x21\x83\x79\xa3\x7f\x99\x57\x70\x35\xa7\x12\x8b\x98\xf0\xfa\xe9\xf7\x59\x42\xd9\x3f\x34\x33\x19\x29\xd3\x54\xe5\x71\x6a\x71\xbd\x55\xff\x0e\x00\x20\x90\x5a\x9b\xb3\x04\x00\x00"),
 		},
 		"/housekeeper": &vfsgen۰DirInfo{
 			name:    "housekeeper",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 455484311, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/housekeeper/1housekeeper.io_updates.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "1housekeeper.io_updates.yaml",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 454484302, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 2294,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x54\x4d\x93\xe3\x44\x0c\xbd\xe7\x57\xa8\x8a\xc3\x5e\x88\xc3\xc0\x65\xf1\x6d\x6b\x80\xaa\x14\xcb\x32\x35\x99\xd9\xbb\xec\x56\x9c\x26\xed\xee\x46\x52\xa7\x76\xa0\xf8\xef\x54\xb7\x9d\x89\xed\xd9\xf9\xe0\x80\x6f\x96\xd4\x7a\x92\x9e\x9e\x30\xda\xcf\xc4\x62\x83\xaf\x01\xa3\xa5\x2f\x4a\x3e\xff\x49\x75\x7c\x2f\x95\x0d\x9b\xd3\xd5\xea\x68\xbd\xa9\xe1\x3a\x89\x86\xfe\x96\x24\x24\x6e\xe9\x27\xda\x5b\x6f\xd5\x06\xbf\xea\x49\xd1\xa0\x62\xbd\x02\x40\xef\x83\x62\x36\x4b\xfe\x05\x68\x83\x57\x0e\xce\x11\xaf\x3b\xf2\xd5\x31\x35\xd4\x24\xeb\x0c\x71\x49\x7e\x86\x3e\x7d\x57\xfd\x58\x7d\xbf\x02\x68\x99\xca\xf3\x3b\xdb\x93\x28\xf6\xb1\x06\x9f\x9c\x5b\x01\x78\xec\xa9\x86\x14\x0d\x2a\x49\x75\x08\x49\xe8\x48\x14\x4b\xa2\x95\x44\x6a\x33\x60\xc7\x21\xc5\x1a\x16\xde\xe1\xf1\x58\xd1\xd0\xcd\x7d\xc9\x53\x0c\xce\x8a\xfe\x3a\x31\x7e\xb4\xa2\xc5\x11\x5d\x62\x74\x8f\x98\xc5\x26\xd6\x77\xc9\x21\x9f\xad\x2b\x00\x69\x43\xa4\x1a\x3e\x65\x88\x88\x2d\x99\x15\xc0\xd8\x58\x81\x5c\x8f\xa5\x9f\xae\xd0\xc5\x03\x5e\x0d\x79\xda\x03\xf5\x38\x54\x04\x10\x22\xf9\x0f\x37\xdb\xcf\x3f\xec\x66\x66\x00\x43\xd2\xb2\x8d\x5a\x86\x34\x94\x07\x56\x40\x0f\x04\x43\x28\xec\x03\x97\xdf\xb1\x48\xf8\x70\xb3\x7d\x7c\x1d\x39\x44\x62\xb5\xe7\xd6\x87\x6f\x42\xf9\xc4\xba\xc0\x7a\x97\xcb\x19\xa2\xc0\x64\xae\x69\x40\x1d\x1b\x23\x33\x76\x00\x61\x0f\x7a\xb0\x02\x4c\x91\x49\xc8\x0f\xec\xcf\x12\x43\x0e\x42\x0f\xa1\xf9\x83\x5a\xad\x60\x47\x9c\xd3\x80\x1c\x42\x72\x26\xaf\xc8\x89\x58\x81\xa9\x0d\x9d\xb7\x7f\x3d\xe6\x16\xd0\x50\x40\x5d\xee\x4c\x17\x39\xad\x57\x62\x8f\x0e\x4e\xe8\x12\x7d\x0b\xe8\x0d\xf4\xf8\x00\x4c\x19\x05\x92\x9f\xe4\x2b\x21\x52\xc1\x6f\x81\x09\xac\xdf\x87\x1a\x0e\xaa\x51\xea\xcd\xa6\xb3\x7a\x5e\xf5\x36\xf4\x7d\xf2\x56\x1f\x36\x65\x6b\x6d\x93\x34\xb0\x6c\x0c\x9d\xc8\x6d\xc4\x76\x6b\xe4\xf6\x60\x95\x5a\x4d\x4c\x1b\x8c\x76\x5d\x4a\xf7\x65\xdd\xab\xde\x7c\xc3\xa3\x38\xe4\xdd\xac\x56\x7d\xc8\xfb\x21\xca\xd6\x77\x13\x47\x59\xc4\x17\x18\xc8\x3b\x99\xc9\xc6\xf1\xe9\xd0\xc5\x65\xd0\xd9\x94\xa7\x73\xfb\xf3\xee\x0e\xce\xd0\x85\x8c\xe5\xf4\xcb\xdc\x2f\x0f\xe5\x42\x41\x1e\x98\xf5\x7b\xe2\x81\xc4\x3d\x87\xbe\xe4\x24\x6f\x62\xb0\x5e\xcb\x4f\xeb\x2c\xf9\xe5\xf8\x25\x35\xbd\xd5\xcc\xfb\x9f\x89\x44\x33\x57\x15\x5c\x17\xfd\x43\x73\x5e\x47\x53\xc1\xd6\xc3\x35\xf6\xe4\xae\x51\xe8\x7f\x27\x20\x4f\x5a\xd6\x79\xb0\x6f\xa3\x60\x7a\xba\x96\xc1\xc3\xd4\x26\x8e\xf3\x8d\x79\x86\xaf\x41\x9d\xbb\x48\xed\x4c\x30\x86\xc4\x72\x5e\x69\xcd\xda\x0d\xfb\xe9\xe5\x79\x59\xa7\x65\x47\x52\x43\x5f\x15\xeb\xd3\x75\xb9\xbb\xa8\x13\x92\x90\xc9\xe2\x49\xb1\x63\x34\x04\xc7\xf7\x8b\x79\xbc\x30\x93\xb2\x31\xb2\xed\xb1\xa3\xfb\xdb\x8f\x6f\x41\xb5\x39\x16\x12\xbb\x27\xb8\xbf\xef\xfe\x13\x2c\x9d\x6c\xab\x37\xc1\xfc\x12\xb8\xa5\xd7\x90\xb7\x7b\x50\xce\xc2\xdf\xe7\xe8\xe1\x6d\x99\x78\x0c\xe6\x39\xd4\x26\x04\x47\xb8\xbc\x4e\x3d\x7e\xb9\xf7\x78\x42\xeb\xb0\x71\xaf\xe2\x7e\x4a\x7d\x43\x9c\x99\xf4\xc1\x14\x92\x51\x01\x99\xa0\xa1\xac\xc8\xb1\x75\x03\x38\x54\x23\xd8\x13\xa8\xed\xe9\xb9\x9a\xf2\x1d\xeb\x88\x67\xde\x2c\xaa\xbc\x35\xf3\x5a\xd6\xd3\x75\x58\x78\x2e\x8c\x2d\x1c\xb3\x99\x2e\x7c\xf3\xc6\xdf\xa4\x01\x45\x4d\xf2\xba\x0a\x4a\xd8\x4c\x07\xa1\x91\x7c\x73\x5e\x16\xc2\x57\x51\x9f\x18\x87\x44\x75\xa1\x7f\x30\x68\x60\xec\x68\x6a\x49\xcd\xe3\x31\x3e\x57\x3b\xd6\x0e\x7f\xff\xb3\xfa\x37\x00\x00\xff\xff\x66\xcf\xb8\xbd\xf6\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x54\x4b\x93\xe3\x34\x10\xbe\xe7\x57\x74\x15\x87\xbd\x10\x87\x81\xcb\xe2\xdb\xd6\x00\x55\x29\x96\x65\x6a\x32\xb3\xf7\xb6\xd5\x71\x44\x64\x49\x74\xb7\x52\x3b\x50\xfc\x77\x4a\xb2\x33\xb1\x3d\x3b\x0f\x0e\xe8\xa6\x7e\x7d\xfd\xfa\x1a\xa3\xfd\x4c\x2c\x36\xf8\x1a\x30\x5a\xfa\xa2\xe4\xf3\x4f\xaa\xe3\x7b\xa9\x6c\xd8\x9c\xae\x56\x47\xeb\x4d\x0d\xd7\x49\x34\xf4\xb7\x24\x21\x71\x4b\x3f\xd1\xde\x7a\xab\x36\xf8\x55\x4f\x8a\x06\x15\xeb\x15\x00\x7a\x1f\x14\xb3\x58\xf2\x17\xa0\x0d\x5e\x39\x38\x47\xbc\xee\xc8\x57\xc7\xd4\x50\x93\xac\x33\xc4\x25\xf8\x19\xfa\xf4\x5d\xf5\x63\xf5\xfd\x0a\xa0\x65\x2a\xee\x77\xb6\x27\x51\xec\x63\x0d\x3e\x39\xb7\x02\xf0\xd8\x53\x0d\x29\x1a\x54\x92\xea\x10\x92\xd0\x91\x28\x96\x40\x2b\x89\xd4\x66\xc0\x8e\x43\x8a\x35\x2c\xb4\x83\xf3\x98\xd1\x50\xcd\x7d\x89\x53\x04\xce\x8a\xfe\x3a\x11\x7e\xb4\xa2\x45\x11\x5d\x62\x74\x8f\x98\x45\x26\xd6\x77\xc9\x21\x9f\xa5\x2b\x00\x69\x43\xa4\x1a\x3e\x65\x88\x88\x2d\x99\x15\xc0\x58\x58\x81\x5c\x8f\xa9\x9f\xae\xd0\xc5\x03\x5e\x0d\x71\xda\x03\xf5\x38\x64\x04\x10\x22\xf9\x0f\x37\xdb\xcf\x3f\xec\x66\x62\x00\x43\xd2\xb2\x8d\x5a\x9a\x34\xa4\x07\x56\x40\x0f\x04\x83\x29\xec\x03\x97\xef\x98\x24\x7c\xb8\xd9\x3e\x7a\x47\x0e\x91\x58\xed\xb9\xf4\xe1\x4d\x46\x3e\x91\x2e\xb0\xde\xe5\x74\x06\x2b\x30\x79\xd6\x34\xa0\x8e\x85\x91\x19\x2b\x80\xb0\x07\x3d\x58\x01\xa6\xc8\x24\xe4\x87\xe9\xcf\x02\x43\x36\x42\x0f\xa1\xf9\x83\x5a\xad\x60\x47\x9c\xc3\x80\x1c\x42\x72\x26\xaf\xc8\x89\x58\x81\xa9\x0d\x9d\xb7\x7f\x3d\xc6\x16\xd0\x50\x40\x5d\xae\x4c\x17\x31\xad\x57\x62\x8f\x0e\x4e\xe8\x12\x7d\x0b\xe8\x0d\xf4\xf8\x00\x4c\x19\x05\x92\x9f\xc4\x2b\x26\x52\xc1\x6f\x81\x09\xac\xdf\x87\x1a\x0e\xaa\x51\xea\xcd\xa6\xb3\x7a\x5e\xf5\x36\xf4\x7d\xf2\x56\x1f\x36\x65\x6b\x6d\x93\x34\xb0\x6c\x0c\x9d\xc8\x6d\xc4\x76\x6b\xe4\xf6\x60\x95\x5a\x4d\x4c\x1b\x8c\x76\x5d\x52\xf7\x65\xdd\xab\xde\x7c\xc3\x23\x39\xe4\xdd\x2c\x57\x7d\x88\x54\x83\x28\x5b\xdf\x4d\x14\x65\x11\x5f\x98\x40\xde\x49\xb0\x02\x38\xba\x0e\x55\x5c\x1a\x9d\x45\xb9\x3b\xb7\x3f\xef\xee\xe0\x0c\x5d\x86\xb1\xec\x7e\xe9\xfb\xc5\x51\x2e\x23\xc8\x0d\xb3\x7e\x4f\x5c\xfc\x60\xcf\xa1\x2f\x31\xc9\x9b\x18\xac\xd7\xf2\x69\x9d\x25\xbf\x6c\xbf\xa4\xa6\xb7\x2a\xc0\xf4\x67\x22\xd1\x3c\xab\x0a\xae\x0b\xff\xa1\x39\xaf\xa3\xa9\x60\xeb\xe1\x1a\x7b\x72\xd7\x28\xf4\xbf\x0f\x20\x77\x5a\xd6\xb9\xb1\x6f\x1b\xc1\xf4\x74\x2d\x8d\x87\xae\x4d\x14\xe7\x1b\x03\xf0\x02\x3b\x77\x91\xda\x19\x61\x0c\x89\x65\x32\x20\x8a\x4a\x99\x08\x93\xcb\xf3\x32\x4f\xf3\xcb\xf7\xf2\xab\x64\x7d\xba\x2e\x77\x17\x76\x42\x12\x32\xa0\x01\x52\xec\x18\x0d\xc1\xf1\xbd\xbc\x7b\xe2\xfe\x4c\x4f\xf2\x0b\xb2\xed\xb1\xa3\xfb\xdb\x8f\x6f\x41\xb5\xd9\x16\x12\xbb\x27\xb8\xbf\xef\xfe\x13\x2c\x9d\x6c\xab\x37\xc1\xfc\x12\xb8\xa5\xd7\x90\xb7\x7b\x50\xce\xc4\xdf\x67\xeb\xc1\xb7\x74\x3c\x06\xf3\x1c\x6a\x13\x82\x23\x5c\x5e\xa7\x1e\xbf\xdc\x7b\x3c\xa1\x75\xd8\xb8\x57\x71\x3f\xa5\xbe\x21\xce\x93\xf4\xc1\x94\x21\xa3\x02\x32\x41\x43\x99\x91\x63\xe9\x06\x70\xc8\x46\xb0\x27\x50\xdb\xd3\x73\x39\x59\xaf\xd4\x11\xcf\xb4\x99\x54\x79\x6b\xe6\xb9\xac\xa7\xeb\xb0\xd0\x5c\x26\xb6\x50\xcc\x7a\xba\xd0\xcd\x0b\x7f\x13\x07\x14\x35\xc9\xeb\x2c\x28\x66\x33\x1e\x84\x46\xf2\xcd\x79\x99\x08\x5f\x45\x7d\x22\x1c\x02\xd5\x65\xfc\x83\x40\x03\x63\x47\x53\x49\x6a\x1e\x8f\xf1\x39\xdb\x31\x77\xf8\xfb\x9f\xd5\xbf\x03\x00\x66\xcf\xb8\xbd\xf6\x08\x00\x00"),
 		},
 		"/housekeeper/2namespace.yaml": &vfsgen۰FileInfo{
 			name:    "2namespace.yaml",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 454484302, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x4e\x61\x6d\x65\x73\x70\x61\x63\x65\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x68\x6f\x75\x73\x65\x6b\x65\x65\x70\x65\x72\x2d\x73\x79\x73\x74\x65\x6d"),
 		},
 		"/housekeeper/3role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "3role.yaml",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 455484311, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 773,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x92\x41\x6b\xfb\x30\x0c\xc5\xef\xfe\x14\xa2\xf7\xa4\xfc\x6f\x7f\x72\xdd\x61\xf7\x31\x76\x57\xe3\xb7\x56\xd4\xb1\x8d\x64\x77\xd0\x4f\x3f\xea\x66\x30\x92\x6e\x8c\x9c\xfc\x90\xd1\xef\x3d\xd9\xe2\x2c\x6f\x50\x93\x14\x07\xd2\x03\x8f\x3d\xd7\x72\x4a\x2a\x57\x2e\x92\x62\x7f\xfe\x6f\xbd\xa4\xfd\xe5\x9f\x3b\x4b\xf4\x03\x3d\x85\x6a\x05\xfa\x92\x02\xdc\x84\xc2\x9e\x0b\x0f\x8e\x68\x54\xb4\x86\x57\x99\x60\x85\xa7\x3c\x50\xac\x21\x38\xa2\xc8\x13\x06\xaa\xd9\x73\x41\x37\x71\xe4\x23\xb4\xd3\x5b\xbf\xd6\x00\x1b\x5c\x47\x9c\xe5\x59\x53\xcd\x76\x23\x75\x74\x4a\xd5\x70\x06\x32\xb4\x97\xe4\x88\x14\x96\xaa\x8e\x98\xef\xef\x2c\x73\x44\x17\xe8\x61\x2e\xb6\x00\x68\xd2\x23\x60\x96\x47\x94\x76\x06\xb1\xbb\xc8\x5c\xc6\xd3\x37\x4a\x93\x1f\xad\xb8\x35\xc7\xfe\x5d\x22\x07\xb9\x42\x17\x91\x66\x87\xcd\x5c\x2b\x5c\xea\x82\xf9\x35\xd0\x6a\x8e\x95\xcb\x6e\xb7\x26\xc7\xe4\xf1\x03\x70\xd3\x0b\x3d\xf2\xc8\xc9\xff\x66\xf1\x67\xc6\x1e\x17\x19\x6f\x2b\xf5\xf0\x9f\x57\x18\xce\xd9\xd6\x20\xcf\x98\x52\x34\x94\x45\xa4\xc5\x8a\x7c\x06\x00\x00\xff\xff\xe4\x20\x3c\x4b\x05\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x92\xb1\x6e\xf3\x30\x0c\x84\x77\x3d\x05\x91\xdd\x0e\xfe\xed\x87\xd7\x0e\xdd\x8b\xa2\x3b\x63\x5d\x13\x22\xb2\x24\x90\x52\x0a\xe4\xe9\x8b\x28\x1e\x0a\x3b\x29\x0a\x4f\x3a\x50\xe0\x77\x27\x91\x9c\xe5\x03\x6a\x92\xe2\x40\x7a\xe0\xb1\xe7\x5a\x4e\x49\xe5\xca\x45\x52\xec\xcf\xff\xad\x97\xb4\xbf\xfc\x73\x67\x89\x7e\xa0\x97\x50\xad\x40\xdf\x52\x80\x9b\x50\xd8\x73\xe1\xc1\x11\x8d\x8a\xd6\xf0\x2e\x13\xac\xf0\x94\x07\x8a\x35\x04\x47\x14\x79\xc2\x40\x35\x7b\x2e\xe8\x26\x8e\x7c\x84\x76\x7a\xeb\xd7\x1a\x60\x83\xeb\x88\xb3\xbc\x6a\xaa\xd9\x6e\xa4\x8e\x4e\xa9\x1a\xce\x40\x86\xf6\x92\x1c\x91\xc2\x52\xd5\x11\xf3\xfd\x9d\x65\x8e\xe8\x02\x3d\xcc\xc5\x16\x00\x4d\x7a\x04\xcc\xf2\x88\xd2\xce\x20\x76\x17\x99\xcb\x78\xfa\x41\x69\xf2\xab\x15\xb7\xe6\xd8\x7f\x4a\xe4\x20\x57\xe8\x22\xd2\xec\xb0\x99\x6b\x85\x4b\x5d\x30\x8f\x78\xf2\x8e\x95\xcb\x6e\xb7\x26\xc7\xe4\xf1\x04\xb8\xe9\x87\x1e\x79\xe4\xe4\x7f\xb3\xf8\x33\x63\x8f\x8b\x8c\xb7\x95\x7a\x38\xe7\x15\x86\x73\xb6\x35\xc8\x33\xa6\x14\x0d\x65\x11\x69\xb1\x22\xdf\x03\x00\xe4\x20\x3c\x4b\x05\x03\x00\x00"),
 		},
 		"/housekeeper/4role_binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "4role_binding.yaml",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 455484311, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 286,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x8d\xbd\x4a\x03\x41\x14\x85\xfb\x79\x8a\x79\x81\x5d\xb1\x93\xe9\xd4\xc2\x3e\x82\xfd\xdd\x99\x93\xe4\xba\xbb\x73\x87\xfb\x13\xd0\xa7\x97\x40\xb4\x11\xd2\x9d\x03\xdf\xc7\x47\x83\x3f\xa0\xc6\xd2\x4b\xd6\x85\xea\x4c\xe1\x67\x51\xfe\x26\x67\xe9\xf3\xfa\x64\x33\xcb\xc3\xe5\x31\xad\xdc\x5b\xc9\xaf\x5b\x98\x43\x0f\xb2\xe1\x85\x7b\xe3\x7e\x4a\x3b\x9c\x1a\x39\x95\x94\x73\xa7\x1d\x25\xc7\x68\xe4\x98\x76\xea\x74\x82\x4e\x2a\x1b\x96\x1b\x7c\xdd\x07\x1c\xaf\x2c\x0d\x7e\x53\x89\x71\xa7\x9b\x72\xfe\x97\xbd\x57\x49\x16\xcb\x27\xaa\x5b\x49\xd3\xcd\x7c\x87\x5e\xb8\xe2\xb9\x56\x89\xee\x7f\x72\xc3\x91\x62\xfb\xfd\x36\xa8\xa2\xe4\xb3\x84\x61\x05\x06\x74\xb2\x2f\x73\xec\x3f\x01\x00\x00\xff\xff\x54\x83\xe7\x45\x1e\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x8d\xbd\x4a\x03\x41\x14\x85\xfb\x79\x8a\x79\x81\x5d\xb1\x93\xe9\xd4\xc2\x3e\x82\xfd\xdd\x99\x93\xe4\xba\xbb\x73\x87\xfb\x13\xd0\xa7\x97\x40\xb4\x11\xd2\x9d\x03\xdf\xc7\x47\x83\x3f\xa0\xc6\xd2\x4b\xd6\x85\xea\x4c\xe1\x67\x51\xfe\x26\x67\xe9\xf3\xfa\x64\x33\xcb\xc3\xe5\x31\xad\xdc\x5b\xc9\xaf\x5b\x98\x43\x0f\xb2\xe1\x85\x7b\xe3\x7e\x4a\x3b\x9c\x1a\x39\x95\x94\x73\xa7\x1d\x25\xc7\x68\xe4\x98\x76\xea\x74\x82\x4e\x2a\x1b\x96\x1b\x7c\xdd\x07\x1c\xaf\x2c\x0d\x7e\x53\x89\x71\xa7\x9b\x72\xfe\x97\xbd\x57\x49\x16\xcb\x27\xaa\x5b\x49\xd3\xcd\x7c\x87\x5e\xb8\xe2\xb9\x56\x89\xee\x7f\x72\xc3\x91\x62\xfb\xfd\x36\xa8\xa2\xe4\xb3\x84\x61\x05\x06\x74\xb2\x2f\x73\xec\x3f\x03\x00\x54\x83\xe7\x45\x1e\x01\x00\x00"),
 		},
 		"/housekeeper/5deployment.yaml.template": &vfsgen۰CompressedFileInfo{
 			name:             "5deployment.yaml.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 455484311, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 985,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\x49\x6b\x1c\x41\x0c\x85\xef\xf3\x2b\x44\xdf\x7b\x96\xe4\x56\xb7\x90\x98\x10\x48\xc8\x80\x49\xee\x72\xf5\xcb\xb8\x18\xd5\x12\xa9\xda\x76\x63\xfc\xdf\x43\xdb\xb3\x74\x1b\x82\x3d\x3a\xaa\x9e\x3e\x49\xa5\xc7\x25\xfc\x86\x5a\xc8\xc9\x11\x97\x62\xab\xbb\xcd\x62\x1f\x52\xe7\xe8\x0b\x8a\xe4\x21\x22\xd5\x45\x44\xe5\x8e\x2b\xbb\x05\x51\xe2\x08\x47\xb7\xb9\x37\xec\x81\x02\x6d\x73\x81\x72\xcd\xda\x46\x4e\xbc\x83\x1e\x44\x56\xd8\xbf\x52\xda\x60\x15\x71\x41\x24\x7c\x03\xb1\x11\x47\xe4\x73\xaa\x9a\xa5\x2d\xc2\xe9\x2d\xb2\x15\xf8\xb1\xca\x20\xf0\x35\xeb\x0b\x21\x72\xf5\xb7\xdf\x27\xc8\xcb\xa0\x44\x8a\x22\xc1\xb3\x39\xda\x2c\x88\x2a\x62\x11\xae\x38\xc0\x27\xbb\x8f\x21\xb3\x3e\x97\x76\x22\x3a\xae\x70\xac\xe5\x90\xa0\x27\x5e\x4b\x3e\xc7\xc8\xa9\x3b\x37\x68\x69\xf5\x26\x74\x8c\x10\x79\x07\x47\x8f\x8f\xcb\x9f\x07\xc9\xb7\x31\xf3\x4b\xe5\xe9\x69\x2e\xda\xf6\x22\xdb\x2c\xc1\x0f\x8e\x3e\xc9\x3d\x0f\x76\x7a\x7f\xd7\x75\x5f\xc2\xe0\x7b\x0d\x75\xf8\x9c\x53\xc5\x43\x3d\x0f\x4c\xc4\x22\xf9\x7e\xab\xe1\x2e\x08\x76\xb8\x32\xcf\xc2\xf5\xd9\x62\x7f\x58\x0c\x27\xa5\xc2\x72\xaf\x1e\x36\x2d\x96\x10\x43\x9d\x65\x88\x7c\xe9\x1d\x6d\xd6\xeb\x38\xcb\x46\xc4\xac\x83\xa3\x8f\xeb\x1f\x61\xf2\xa0\xf8\xdb\xc3\x2e\x43\x7c\x38\x23\x2a\x34\x86\xf4\x3c\xef\x57\x65\x8f\x2d\x34\xe4\xee\x1a\x3e\xa7\x6e\x74\xc8\xfa\xa0\x4b\xb9\xc3\xf5\xcc\x87\xc7\x6c\xab\x59\xb0\xdc\xf7\x37\xd0\x84\x0a\x5b\x86\xbc\x7a\x65\x93\xa6\x39\x76\xcb\x32\xfe\x6f\xc8\xc9\xa6\x27\xdf\x63\x70\xd4\xfc\x0f\x16\xd9\x2a\xb4\x99\x6c\x72\xbc\x92\xa3\xe6\xea\x21\x58\xb5\xe6\x5f\x00\x00\x00\xff\xff\x47\x90\x58\x37\xd9\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\x4f\x6b\x1c\x31\x0c\xc5\xef\xfb\x29\xc4\xdc\x67\xff\xb4\x37\xdf\x4a\x1b\x4a\xa1\xa5\x0b\xa1\xbd\x2b\x9e\xd7\x8d\x59\xdb\x72\x25\x4f\x92\x21\xe4\xbb\x97\x49\xf6\xcf\x4c\xa0\x24\xeb\xa3\xf4\xf4\x93\x64\x3d\x2e\xe1\x37\xd4\x82\x64\x47\x5c\x8a\xad\xee\x36\x8b\x7d\xc8\x9d\xa3\x2f\x28\x51\x86\x84\x5c\x17\x09\x95\x3b\xae\xec\x16\x44\x99\x13\x1c\xdd\x4a\x6f\xd8\x03\x05\xda\x4a\x81\x72\x15\x6d\x13\x67\xde\x41\x0f\x22\x2b\xec\x5f\x29\x6d\xb0\x8a\xb4\x20\x8a\x7c\x83\x68\x23\x8e\xc8\x4b\xae\x2a\xb1\x2d\x91\xf3\x5b\x64\x2b\xf0\x63\x95\x21\xc2\x57\xd1\x17\x42\xe2\xea\x6f\xbf\x4f\x90\x97\x41\x89\x14\x25\x06\xcf\xe6\x68\xb3\x20\xaa\x48\x25\x72\xc5\x01\x3e\xd9\x9d\x68\x3e\xfa\xe5\x9d\x88\x8e\x2b\x1c\x6b\x39\x64\xe8\x89\xd7\x92\x97\x94\x38\x77\xe7\x06\x2d\xad\xde\x84\x8e\x2f\x24\xde\xc1\xd1\xe3\xe3\xf2\xe7\x41\xf2\x6d\x8c\xfc\xd2\xf8\xf4\x34\x17\x6d\xfb\x18\xb7\x12\x83\x1f\x1c\x7d\x8a\xf7\x3c\xd8\x29\xff\xae\xeb\xbe\x3c\x83\xef\x35\xd4\xe1\xb3\xe4\x8a\x87\x7a\x1e\x98\x88\x63\x94\xfb\xad\x86\xbb\x10\xb1\xc3\x95\x79\x8e\x5c\x9f\x2d\xf6\x87\xa3\xe1\xa4\x54\x98\xf4\xea\x61\xd3\xe2\x18\x52\xa8\xb3\x08\x91\x2f\xbd\xa3\xcd\x7a\x9d\x66\xd1\x84\x24\x3a\x38\xfa\xb8\xfe\x11\x26\x09\xc5\xdf\x1e\x76\x19\xe2\xc3\x19\x51\xa1\x29\xe4\xe7\x79\xbf\x2a\x7b\x6c\xa1\x41\xba\x6b\x78\xc9\xdd\xe8\x90\xf5\x41\x97\xa5\xc3\xf5\xcc\x87\xc7\x68\xab\x12\xb1\xdc\xf7\x37\xd0\x8c\x0a\x5b\x06\x59\xbd\xb2\x49\xd3\x1c\xbb\x49\x1c\xff\x37\x48\xb6\xe9\xc9\xf7\x18\x1c\x35\xff\x83\x25\xb6\x0a\x6d\x26\x9b\x1c\xaf\xe4\xa8\xb9\x7a\x08\x56\xad\xf9\x37\x00\x47\x90\x58\x37\xd9\x03\x00\x00"),
 		},
 		"/housekeeper/6daemonset.yaml.template": &vfsgen۰CompressedFileInfo{
 			name:             "6daemonset.yaml.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 455484311, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 1138,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x93\x41\x6b\x1b\x31\x10\x85\xef\xfe\x15\x83\xef\xeb\x25\x57\xdd\x42\x93\x42\xa1\x71\x4d\x43\x7b\x2d\x93\xdd\x67\xaf\xd8\x91\x46\x48\xda\x4d\x4d\xc8\x7f\x2f\x8a\x5d\x7b\xd7\x14\xe2\x40\x75\x9c\x79\xf3\xcd\x3c\x31\xc3\xc1\xfe\x44\x4c\x56\xbd\x21\x0e\x21\xd5\xe3\xcd\xa2\xb7\xbe\x35\x74\xc7\x70\xea\x1f\x91\x17\x0e\x99\x5b\xce\x6c\x16\x44\x9e\x1d\x0c\x75\x3a\x24\xf4\x40\x40\xac\x1a\xf5\x39\xaa\x08\x62\xe5\xd8\xf3\x0e\xf1\x28\x4b\x81\x9b\x0b\x6d\xda\xa7\x0c\xb7\x20\x12\x7e\x82\xa4\x02\x24\x3a\x02\xaa\x20\xec\xdf\x67\xa7\x80\xa6\xd4\x25\x08\x9a\xac\xf1\xc0\x70\x9c\x9b\xee\xeb\x04\xfa\x51\x2c\x51\x86\x0b\xc2\x19\x47\xe0\xc4\x73\x79\x32\x63\x7f\x9c\x4e\xf4\x77\xf0\xf2\xb2\x0a\x22\x67\xab\x7e\x82\xac\xa8\xc7\xde\xd0\xd2\x6b\x8b\x2a\xaa\x60\xd5\x0f\x4f\x88\x1e\x19\x69\x65\xb5\x76\x9c\x32\xe2\xf2\xa4\x27\xd2\x50\x28\x1a\x0d\x2d\xef\x7f\xdb\x94\xd3\x34\x89\xed\x16\x4d\x36\xb4\x5c\xeb\x63\xd3\xa1\x1d\x04\xcb\xab\x7b\x3d\x6b\xec\xff\x4f\xaf\xf2\x1b\x6c\x3d\xe2\xd9\x69\x75\xed\x16\x9d\xfe\xda\x39\xf6\xad\x99\x34\xac\xa8\xbe\xb6\xda\x3a\xde\xc1\xd0\xcb\xcb\xea\xd3\x49\xf5\xa5\xc4\x7e\x44\x79\x7d\xbd\xd0\x6d\x06\x91\x8d\x8a\x6d\xf6\x86\x6e\xe5\x99\xf7\xe9\x2c\x18\x55\x06\x87\x07\x1d\x7c\x4e\xf3\x59\x0e\x76\x86\xb0\x8b\xdc\xa2\x6a\xdf\x0e\x67\x22\x20\x72\xa5\x68\xc3\xb9\x33\x54\x8f\x1c\x6b\xdf\xb7\xe7\x3c\xfc\xf8\x2f\xdc\xfa\xdb\xdd\xfd\xaf\xf5\xed\xc3\xfd\x8c\x34\xb2\x0c\xf8\x1c\xd5\x99\x59\x98\xb6\x16\xd2\x7e\xc7\xf6\x22\x4c\xd3\xfb\x1e\x6f\x2e\x92\x6f\x45\x87\xb1\xca\x76\xae\xca\x36\xac\xd9\x61\x31\x35\x3c\xdb\xd0\x77\x9c\x76\x9a\x0e\x36\x67\x8d\xc2\xcc\xf8\x9f\x00\x00\x00\xff\xff\x09\x7d\x30\x1a\x72\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x93\x41\x8b\xdb\x30\x10\x85\xef\xfe\x15\x83\xef\x8e\xd9\xab\x6e\x4b\x77\x0b\x85\x6e\x1a\xba\xb4\xd7\x32\x6b\xbf\xc4\xc2\x23\x8d\x90\x64\x6f\xc3\xb2\xff\xbd\xb8\x49\x13\x3b\x2d\x6c\x5a\xaa\xe3\xe8\xcd\x37\xf3\xc4\x13\x07\xfb\x15\x31\x59\xf5\x86\x38\x84\x54\x8f\x37\x45\x6f\x7d\x6b\xe8\x8e\xe1\xd4\x3f\x22\x17\x0e\x99\x5b\xce\x6c\x0a\x22\xcf\x0e\x86\x3a\x1d\x12\x7a\x20\x20\x56\x8d\xfa\x1c\x55\x04\xb1\x72\xec\x79\x87\x78\x94\xa5\xc0\xcd\x85\x36\xed\x53\x86\x2b\x88\x84\x9f\x20\x69\x02\x12\x1d\x01\x55\x10\xf6\x6f\xb3\x53\x40\x33\xf5\x25\x08\x9a\xac\xf1\xc0\x70\x9c\x9b\xee\xe3\x0c\xfa\xb7\x58\xa2\x0c\x17\x84\x33\x8e\xc0\x99\x67\xa2\xe5\xc2\xff\x42\x27\xfa\xb5\xf8\x74\xb2\x0a\x22\x67\xab\x7e\x86\xac\xa8\xc7\xde\x50\xe9\xb5\x45\x15\x55\xb0\xea\x87\x27\x44\x8f\x8c\xb4\xb2\x5a\x3b\x4e\x19\xb1\x3c\xe9\x89\x34\x4c\x14\x8d\x86\xca\xfb\xef\x36\xe5\x34\xbf\xc4\x76\x8b\x26\x1b\x2a\xd7\xfa\xd8\x74\x68\x07\x41\x79\xf5\xac\x67\x8d\xfd\xff\x99\x35\xbd\x06\x5b\x8f\x78\x76\x5a\x5d\x9b\xa2\xd3\x5b\x3b\xc7\xbe\x35\xe7\x0a\x55\x54\x5f\xdb\x6d\x1d\xef\x60\xe8\xe5\x65\xf5\xee\xa4\xfa\x30\xd5\xbe\x44\x79\x7d\xbd\xd0\x6d\x06\x91\x8d\x8a\x6d\xf6\x86\x6e\xe5\x99\xf7\xe9\x2c\x18\x55\x06\x87\x07\x1d\x7c\x4e\xcb\x5d\x0e\x76\x86\xb0\x8b\xdc\xa2\x6a\x7f\x7e\x9c\x99\x80\xc8\x4d\x4d\x1b\xce\x9d\xa1\x7a\xe4\x58\xfb\xbe\x3d\xdf\xc3\x8f\x7f\xc2\xad\x3f\xdd\xdd\x7f\x5b\xdf\x3e\xdc\x2f\x48\x23\xcb\x80\xf7\x51\x9d\x59\x94\x69\x6b\x21\xed\x67\x6c\x2f\xca\x34\xff\xdf\xe3\x4d\x41\xbf\x37\x1d\xd6\x9a\xd2\xb9\x9a\xd2\xb0\x66\x87\x62\x6e\x78\x91\xd0\x37\x9c\x76\x9a\x0e\x36\x17\x83\xc2\xc2\xf8\x8f\x01\x00\x09\x7d\x30\x1a\x72\x04\x00\x00"),
 		},
 		"/kickstart": &vfsgen۰DirInfo{
 			name:    "kickstart",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 457484330, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/kickstart/controlplane": &vfsgen۰DirInfo{
 			name:    "controlplane",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 456484321, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/kickstart/controlplane/kickstart.cfg.template": &vfsgen۰CompressedFileInfo{
 			name:             "kickstart.cfg.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 456484321, time.UTC),
-			uncompressedSize: 965,
+			modTime:          time.Date(2026, 10, 19, 17, 23, 56, 954628831, time.UTC),
+			uncompressedSize: 1370,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x50\x4d\x6f\xe5\x36\x0c\xbc\xeb\x57\x10\x31\x82\x9c\x64\xf7\x50\x14\xbd\x18\x68\x90\x0f\x34\x68\x1b\x04\x4d\xd3\xdd\xd3\x2e\xf4\x24\x3e\x5b\xb0\x4c\x1a\x12\xed\x17\xc7\x78\xff\x7d\x21\xc7\xf9\xc4\x9e\x3c\x1a\x72\xc6\x9c\x29\x26\x8c\xc9\x33\xd5\x97\x57\xff\x5f\xfd\xad\x7c\x43\x1c\xd1\xf9\xd4\x81\xd6\x4c\x61\xd6\x63\xc2\x3a\x39\xa3\xcc\x28\x3c\x98\x28\xa0\xb5\xcc\x03\xd6\x61\xea\x55\x01\x77\x26\x8a\x17\xcf\x04\x36\xa0\x89\x9e\x1a\xf0\xb4\xe7\xd8\x9b\x4c\xaa\x95\xdc\x54\xc4\x84\xa0\xb5\x27\x2f\xc1\xec\x30\xa8\x02\x1e\x12\x42\x13\xcd\xd0\x7a\x6b\x02\x78\x4a\x62\x42\x50\xaf\x8c\x2a\xe0\x2f\x9c\x77\x6c\xa2\x83\x60\x66\x1e\x25\xa9\xee\x85\xd0\x7a\xb2\x1d\xce\xbd\x19\x6a\x4b\xa0\xf5\xe3\xb6\x51\x9f\x59\x3a\x53\x05\xdc\xcf\x49\xb0\x87\x60\xa8\x19\x4d\x83\x2a\x03\x78\x6a\xbf\x5f\xdc\x96\x0f\xff\x5d\xeb\xdf\x95\x2a\xe0\x16\xe5\xc0\xb1\xfb\x70\x32\x6d\x1c\x68\xbd\x63\x96\x21\xb2\x70\xed\x5a\x3b\x80\xd6\x0e\x27\x6f\xb1\x46\x1a\x7e\x4d\xbf\xe4\x2c\xc3\xf4\x5b\x9d\x8b\x01\xad\x8d\x15\x3f\x19\xc1\xf7\x06\x2d\x27\x21\xd3\x63\xbd\x2c\xe5\x9f\x1b\x3e\x1e\x55\x01\xff\x32\x0b\x0c\x26\xa5\x03\x47\xa7\x62\xfe\xcf\x21\xfb\x25\x1b\xe7\x41\xd0\xc1\xb2\x94\x77\xdb\xf8\x59\x30\x12\x48\x8b\x70\x8f\x32\x0e\x70\xde\x20\x09\x30\xc1\xde\xc7\x24\x90\xef\x54\x2b\xcc\x08\xb4\x46\x32\xbb\x80\xaa\x80\x4b\x06\x62\x01\xcb\xb4\xf7\xcd\x18\x71\xf5\xf8\x0a\x5f\x3c\x39\x3e\x6c\x15\xa9\xd4\xf9\xe1\xf1\xad\xb1\x84\x31\xa7\x4c\xea\x05\xe4\xe0\x3e\x65\x47\x57\x9f\xd8\x36\x32\xcd\xee\xe4\x6d\x5f\x7c\x8f\x4f\x4c\xa8\x5e\x00\x9c\x27\x6f\xaa\xfb\xd6\x50\xd3\x1a\x0f\x5a\x8f\x62\x95\x3a\x1d\x8c\xed\x4c\x83\x49\xfd\xf1\xad\xf7\xe4\x7b\x13\x34\xd2\xe4\x23\x53\x8f\x24\x4a\x9d\x22\xb9\xbc\xc6\x29\x47\x08\xdc\xd4\xd5\x64\x62\x15\xb8\xa9\xba\xa4\x33\x5d\x06\x6e\x54\xdf\x39\x1f\x41\x0f\x50\xa1\xd8\x8a\x3a\xb7\xf7\x01\x53\xd5\x32\x77\xcf\xe8\xd3\x46\x5a\xaf\x74\xdb\xb7\xea\xc6\x1d\x06\x94\x72\x0b\x57\x3a\xa5\x96\xc5\xef\xa1\xbc\x49\x97\x6c\x3b\x8c\xa0\x8f\xc7\x4f\x16\x6e\x1d\xa8\x65\x41\x72\xeb\xf8\x55\x72\x93\xc6\x60\xdc\x4f\x24\x7e\x1d\x7c\x94\x44\x43\x0d\x42\x79\x9d\xaf\x3c\x1e\xd5\xb2\x94\x17\x4c\x82\x24\xdb\x23\x17\x86\xff\xb0\x5b\x9f\x48\xee\x83\xea\xb9\x6c\xb7\x09\xdf\xad\xe4\xda\x7e\x04\x00\x00\xff\xff\xef\xff\xc4\x92\xc5\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\x5d\x6f\xe3\x2a\x10\x7d\xe7\x57\x8c\x1a\x55\x7d\x22\xf9\x05\x79\xe8\xbd\xed\x95\xae\xb6\x5b\x55\xdb\xee\xc7\x2b\x31\x13\x82\x4c\x66\x10\xe0\xa4\x5e\xcb\xff\x7d\x85\x8d\x13\xa7\xcd\x3e\x65\x3c\x67\xce\x01\xce\x81\x2c\x0e\x18\xa2\x65\x5a\x3f\x3c\xfe\x78\x7c\x12\xd6\x10\x07\xd4\x36\xd6\x20\x25\x93\x6b\x65\x13\x71\xdd\x75\xcb\xff\x29\x26\xe5\xdc\xf2\xc1\xc6\xba\xef\x45\xd7\x49\xb0\x5b\x38\xb5\x5f\x54\x48\x36\x59\xa6\x58\xc0\xa0\xc8\xe0\x5f\xf1\x65\x99\x42\xd2\x53\xe5\x22\xf6\xbd\x50\x4d\x62\xaf\x42\x02\x29\x53\xeb\x2f\x56\xbe\x2f\xd0\x5b\xeb\xf1\x82\xbe\x80\x93\x3a\x54\x0e\x55\xb0\x64\xc0\xd2\x96\xc3\x5e\xe5\xa6\x18\x9a\x45\x95\x98\x10\xa4\xb4\x64\x93\x53\x1b\x74\x9f\x4e\xf2\x0f\x73\x72\xac\x34\x86\xfb\x60\xe2\x20\x7f\x6e\x41\xc5\xb4\xb5\xa6\x09\xa3\xf0\xe6\x0c\x48\xa9\xbc\x47\xd2\xeb\x9b\xd9\x96\x3f\x6a\xdd\x5c\xec\xfa\x7b\x44\x30\x41\xf9\x9d\xad\x94\x03\x3b\x72\xc4\xa9\x23\x16\xf0\x05\xdb\x0d\xab\xa0\xc1\xa9\x96\x9b\x14\x45\x3d\x35\xa4\x3c\x54\x35\xb6\x7b\xe5\xe7\x16\x4d\xf3\x7d\x0f\x52\xbe\x17\xd2\xfa\xee\xea\xc4\x9d\x58\xc0\x6b\x1b\x13\xee\xc1\x29\x32\x8d\x32\x28\x72\x01\xb3\xe9\x27\x45\xa6\xef\x85\x58\xc0\x33\xa6\x23\x87\xfa\xc2\xd6\xae\x2b\x21\x4f\xa0\xec\x7b\x41\xa5\x86\x29\x64\x24\x3d\x00\x0b\xf8\xc6\x9c\xc0\xab\x18\x8f\x1c\xb4\x08\xcc\xc9\x1f\x73\x14\xb1\x0a\xad\x4f\xa8\x33\xe5\xa5\xc0\x23\xa1\x21\x48\x3b\x84\x57\x4c\x8d\x87\x7b\x83\x94\x80\x09\xb6\x36\xc4\x04\xd9\x7b\x31\x94\xb9\x02\x29\x91\xd4\xc6\xa1\x58\xc0\x03\x03\x71\x3a\x45\x85\x83\xc6\x2f\xf8\x69\x49\xf3\xb1\x9c\x59\xc4\xda\xfa\xf7\x29\xfc\x3c\xbe\x7c\x7e\x7b\x79\xc5\x90\x9f\xc3\xb0\x78\xf1\x26\x62\x38\xd8\x0a\xa3\x98\x0a\x90\x52\xdb\x98\x97\xd2\xeb\x9b\x6a\x17\x98\x5a\x7d\x99\x6b\x61\x26\xbb\xc7\xdf\x4c\x28\xa6\x62\xee\xec\x5b\xe9\x0d\x49\x35\xa9\x3a\x5d\xc3\xf9\x2e\xf2\x75\x4d\x3e\x8e\x9f\x39\xe7\x39\x38\x38\x9b\xc3\xb9\xf5\xaa\xaa\x95\xc1\x78\xfd\xdd\x8d\xd8\x95\x57\x27\x6e\x91\xf4\xe7\x87\x1c\x70\x14\x0d\xf9\x99\x38\x36\xeb\xd5\x41\x85\x95\x63\xb3\xaa\xa3\xf4\x01\x97\x8e\x8d\xe8\xba\x0f\x84\x93\xd6\x69\x53\x1c\xd3\x35\x01\x8e\x69\x50\xd8\xd7\xda\x06\x90\x1e\x56\x98\xaa\x15\xd5\x7a\x6b\x1d\xc6\xd5\x8e\xb9\x1e\xab\x0f\x13\x71\x30\x55\x97\xdf\x55\xdd\x6c\xd0\x61\x5a\x96\x54\x96\x5a\x9c\xaf\xe3\x7f\x99\x3f\x1e\xf8\x5f\xa6\x84\x94\xca\xc7\x2e\xe3\x5f\x59\x4f\xf7\xb2\xef\x67\xac\x31\x35\x3d\x77\xea\xfc\xef\x74\xe1\x10\xc7\x22\xf8\xa9\x33\x1d\x7f\x70\xe3\xcf\x00\x03\x0b\x2c\xd7\x5a\x05\x00\x00"),
 		},
 		"/kickstart/master": &vfsgen۰DirInfo{
 			name:    "master",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 457484330, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/kickstart/master/kickstart.cfg.template": &vfsgen۰CompressedFileInfo{
 			name:             "kickstart.cfg.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 457484330, time.UTC),
-			uncompressedSize: 965,
+			modTime:          time.Date(2026, 10, 19, 17, 23, 56, 877211950, time.UTC),
+			uncompressedSize: 1370,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x50\x4d\x6f\xe5\x36\x0c\xbc\xeb\x57\x10\x31\x82\x9c\x64\xf7\x50\x14\xbd\x18\x68\x90\x0f\x34\x68\x1b\x04\x4d\xd3\xdd\xd3\x2e\xf4\x24\x3e\x5b\xb0\x4c\x1a\x12\xed\x17\xc7\x78\xff\x7d\x21\xc7\xf9\xc4\x9e\x3c\x1a\x72\xc6\x9c\x29\x26\x8c\xc9\x33\xd5\x97\x57\xff\x5f\xfd\xad\x7c\x43\x1c\xd1\xf9\xd4\x81\xd6\x4c\x61\xd6\x63\xc2\x3a\x39\xa3\xcc\x28\x3c\x98\x28\xa0\xb5\xcc\x03\xd6\x61\xea\x55\x01\x77\x26\x8a\x17\xcf\x04\x36\xa0\x89\x9e\x1a\xf0\xb4\xe7\xd8\x9b\x4c\xaa\x95\xdc\x54\xc4\x84\xa0\xb5\x27\x2f\xc1\xec\x30\xa8\x02\x1e\x12\x42\x13\xcd\xd0\x7a\x6b\x02\x78\x4a\x62\x42\x50\xaf\x8c\x2a\xe0\x2f\x9c\x77\x6c\xa2\x83\x60\x66\x1e\x25\xa9\xee\x85\xd0\x7a\xb2\x1d\xce\xbd\x19\x6a\x4b\xa0\xf5\xe3\xb6\x51\x9f\x59\x3a\x53\x05\xdc\xcf\x49\xb0\x87\x60\xa8\x19\x4d\x83\x2a\x03\x78\x6a\xbf\x5f\xdc\x96\x0f\xff\x5d\xeb\xdf\x95\x2a\xe0\x16\xe5\xc0\xb1\xfb\x70\x32\x6d\x1c\x68\xbd\x63\x96\x21\xb2\x70\xed\x5a\x3b\x80\xd6\x0e\x27\x6f\xb1\x46\x1a\x7e\x4d\xbf\xe4\x2c\xc3\xf4\x5b\x9d\x8b\x01\xad\x8d\x15\x3f\x19\xc1\xf7\x06\x2d\x27\x21\xd3\x63\xbd\x2c\xe5\x9f\x1b\x3e\x1e\x55\x01\xff\x32\x0b\x0c\x26\xa5\x03\x47\xa7\x62\xfe\xcf\x21\xfb\x25\x1b\xe7\x41\xd0\xc1\xb2\x94\x77\xdb\xf8\x59\x30\x12\x48\x8b\x70\x8f\x32\x0e\x70\xde\x20\x09\x30\xc1\xde\xc7\x24\x90\xef\x54\x2b\xcc\x08\xb4\x46\x32\xbb\x80\xaa\x80\x4b\x06\x62\x01\xcb\xb4\xf7\xcd\x18\x71\xf5\xf8\x0a\x5f\x3c\x39\x3e\x6c\x15\xa9\xd4\xf9\xe1\xf1\xad\xb1\x84\x31\xa7\x4c\xea\x05\xe4\xe0\x3e\x65\x47\x57\x9f\xd8\x36\x32\xcd\xee\xe4\x6d\x5f\x7c\x8f\x4f\x4c\xa8\x5e\x00\x9c\x27\x6f\xaa\xfb\xd6\x50\xd3\x1a\x0f\x5a\x8f\x62\x95\x3a\x1d\x8c\xed\x4c\x83\x49\xfd\xf1\xad\xf7\xe4\x7b\x13\x34\xd2\xe4\x23\x53\x8f\x24\x4a\x9d\x22\xb9\xbc\xc6\x29\x47\x08\xdc\xd4\xd5\x64\x62\x15\xb8\xa9\xba\xa4\x33\x5d\x06\x6e\x54\xdf\x39\x1f\x41\x0f\x50\xa1\xd8\x8a\x3a\xb7\xf7\x01\x53\xd5\x32\x77\xcf\xe8\xd3\x46\x5a\xaf\x74\xdb\xb7\xea\xc6\x1d\x06\x94\x72\x0b\x57\x3a\xa5\x96\xc5\xef\xa1\xbc\x49\x97\x6c\x3b\x8c\xa0\x8f\xc7\x4f\x16\x6e\x1d\xa8\x65\x41\x72\xeb\xf8\x55\x72\x93\xc6\x60\xdc\x4f\x24\x7e\x1d\x7c\x94\x44\x43\x0d\x42\x79\x9d\xaf\x3c\x1e\xd5\xb2\x94\x17\x4c\x82\x24\xdb\x23\x17\x86\xff\xb0\x5b\x9f\x48\xee\x83\xea\xb9\x6c\xb7\x09\xdf\xad\xe4\xda\x7e\x04\x00\x00\xff\xff\xef\xff\xc4\x92\xc5\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\x5d\x6f\xe3\x2a\x10\x7d\xe7\x57\x8c\x1a\x55\x7d\x22\xf9\x05\x79\xe8\xbd\xed\x95\xae\xb6\x5b\x55\xdb\xee\xc7\x2b\x31\x13\x82\x4c\x66\x10\xe0\xa4\x5e\xcb\xff\x7d\x85\x8d\x13\xa7\xcd\x3e\x65\x3c\x67\xce\x01\xce\x81\x2c\x0e\x18\xa2\x65\x5a\x3f\x3c\xfe\x78\x7c\x12\xd6\x10\x07\xd4\x36\xd6\x20\x25\x93\x6b\x65\x13\x71\xdd\x75\xcb\xff\x29\x26\xe5\xdc\xf2\xc1\xc6\xba\xef\x45\xd7\x49\xb0\x5b\x38\xb5\x5f\x54\x48\x36\x59\xa6\x58\xc0\xa0\xc8\xe0\x5f\xf1\x65\x99\x42\xd2\x53\xe5\x22\xf6\xbd\x50\x4d\x62\xaf\x42\x02\x29\x53\xeb\x2f\x56\xbe\x2f\xd0\x5b\xeb\xf1\x82\xbe\x80\x93\x3a\x54\x0e\x55\xb0\x64\xc0\xd2\x96\xc3\x5e\xe5\xa6\x18\x9a\x45\x95\x98\x10\xa4\xb4\x64\x93\x53\x1b\x74\x9f\x4e\xf2\x0f\x73\x72\xac\x34\x86\xfb\x60\xe2\x20\x7f\x6e\x41\xc5\xb4\xb5\xa6\x09\xa3\xf0\xe6\x0c\x48\xa9\xbc\x47\xd2\xeb\x9b\xd9\x96\x3f\x6a\xdd\x5c\xec\xfa\x7b\x44\x30\x41\xf9\x9d\xad\x94\x03\x3b\x72\xc4\xa9\x23\x16\xf0\x05\xdb\x0d\xab\xa0\xc1\xa9\x96\x9b\x14\x45\x3d\x35\xa4\x3c\x54\x35\xb6\x7b\xe5\xe7\x16\x4d\xf3\x7d\x0f\x52\xbe\x17\xd2\xfa\xee\xea\xc4\x9d\x58\xc0\x6b\x1b\x13\xee\xc1\x29\x32\x8d\x32\x28\x72\x01\xb3\xe9\x27\x45\xa6\xef\x85\x58\xc0\x33\xa6\x23\x87\xfa\xc2\xd6\xae\x2b\x21\x4f\xa0\xec\x7b\x41\xa5\x86\x29\x64\x24\x3d\x00\x0b\xf8\xc6\x9c\xc0\xab\x18\x8f\x1c\xb4\x08\xcc\xc9\x1f\x73\x14\xb1\x0a\xad\x4f\xa8\x33\xe5\xa5\xc0\x23\xa1\x21\x48\x3b\x84\x57\x4c\x8d\x87\x7b\x83\x94\x80\x09\xb6\x36\xc4\x04\xd9\x7b\x31\x94\xb9\x02\x29\x91\xd4\xc6\xa1\x58\xc0\x03\x03\x71\x3a\x45\x85\x83\xc6\x2f\xf8\x69\x49\xf3\xb1\x9c\x59\xc4\xda\xfa\xf7\x29\xfc\x3c\xbe\x7c\x7e\x7b\x79\xc5\x90\x9f\xc3\xb0\x78\xf1\x26\x62\x38\xd8\x0a\xa3\x98\x0a\x90\x52\xdb\x98\x97\xd2\xeb\x9b\x6a\x17\x98\x5a\x7d\x99\x6b\x61\x26\xbb\xc7\xdf\x4c\x28\xa6\x62\xee\xec\x5b\xe9\x0d\x49\x35\xa9\x3a\x5d\xc3\xf9\x2e\xf2\x75\x4d\x3e\x8e\x9f\x39\xe7\x39\x38\x38\x9b\xc3\xb9\xf5\xaa\xaa\x95\xc1\x78\xfd\xdd\x8d\xd8\x95\x57\x27\x6e\x91\xf4\xe7\x87\x1c\x70\x14\x0d\xf9\x99\x38\x36\xeb\xd5\x41\x85\x95\x63\xb3\xaa\xa3\xf4\x01\x97\x8e\x8d\xe8\xba\x0f\x84\x93\xd6\x69\x53\x1c\xd3\x35\x01\x8e\x69\x50\xd8\xd7\xda\x06\x90\x1e\x56\x98\xaa\x15\xd5\x7a\x6b\x1d\xc6\xd5\x8e\xb9\x1e\xab\x0f\x13\x71\x30\x55\x97\xdf\x55\xdd\x6c\xd0\x61\x5a\x96\x54\x96\x5a\x9c\xaf\xe3\x7f\x99\x3f\x1e\xf8\x5f\xa6\x84\x94\xca\xc7\x2e\xe3\x5f\x59\x4f\xf7\xb2\xef\x67\xac\x31\x35\x3d\x77\xea\xfc\xef\x74\xe1\x10\xc7\x22\xf8\xa9\x33\x1d\x7f\x70\xe3\xcf\x00\x03\x0b\x2c\xd7\x5a\x05\x00\x00"),
 		},
 		"/kickstart/worker": &vfsgen۰DirInfo{
 			name:    "worker",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 457484330, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/kickstart/worker/kickstart.cfg.template": &vfsgen۰CompressedFileInfo{
 			name:             "kickstart.cfg.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 457484330, time.UTC),
-			uncompressedSize: 1136,
+			modTime:          time.Date(2026, 10, 19, 17, 23, 56, 916625364, time.UTC),
+			uncompressedSize: 1605,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x52\xc1\x8e\xdb\x36\x10\xbd\xcf\x57\x0c\x6c\x07\xbb\x7b\xa0\xdd\x16\x45\x91\x8b\x8a\x06\xd9\x04\x08\xda\x06\x41\xb7\x69\x7b\x6a\x40\x93\x63\x89\x10\x35\x23\x90\x23\xef\x3a\x8a\xfe\xbd\xa0\x56\x76\xba\x8b\x9c\xf4\xf4\xe6\xbd\xc7\x99\x21\xd7\x47\x4a\x39\x08\x57\xb7\x6f\xfe\x7a\xf3\x1b\x84\x9a\x25\x91\x0f\xb9\x45\x63\x84\xe3\xc9\x0c\x99\xaa\xec\x2d\xd8\x41\xa5\xb7\x49\xd1\x18\x3d\xf5\x54\xc5\x63\x07\x6b\xfc\x60\x93\x06\x0d\xc2\xe8\x22\xd9\x14\xb8\xc6\xc0\x07\x49\x9d\x2d\x24\xcc\xe4\xe2\x62\x61\x42\x63\x02\x07\x8d\x76\x4f\x11\xd6\xf8\x31\x13\xd6\xc9\xf6\x4d\x70\x36\x62\xe0\xac\x36\x46\xb8\x30\xb0\xc6\x5f\xe9\xb4\x17\x9b\x3c\x46\x7b\x92\x41\x33\xb4\x67\xc2\x98\xa3\x6b\xe9\xd4\xd9\xbe\x72\x8c\xc6\x3c\x2c\x8a\xea\xca\xf1\x15\xac\xf1\xee\x94\x95\x3a\x8c\x96\xeb\xc1\xd6\x04\x05\xe0\xe7\xe6\xd3\xeb\xf7\xdb\x8f\x7f\xbe\x35\x2f\x01\xd6\xf8\x9e\xf4\x5e\x52\xfb\xa4\x65\x5e\x38\x34\x66\x2f\xa2\x7d\x12\x95\xca\x37\xae\x47\x63\x3c\x1d\x83\xa3\x8a\xb8\xff\x31\x7f\x57\x66\xe9\x8f\x3f\x55\x65\x31\x68\x8c\x75\x1a\x8e\x56\x09\xd6\xf8\x87\x88\x62\x6f\x73\xbe\x97\xe4\x21\x95\x94\xfb\xa2\xce\x2e\x9d\x7a\x25\x8f\xe3\xb8\xfd\xb0\x94\xa7\xa9\x18\x06\x46\x6d\x08\xef\x48\x87\x1e\x5f\xd5\xc4\x8a\xc2\x78\x08\x29\x2b\x96\x2e\x60\x86\x05\xa1\x31\xc4\x76\x1f\xcb\x39\xb7\x82\x2c\x8a\x4e\xf8\x10\xea\x21\xd1\x9c\xf1\x0f\xfe\x1d\xd8\xcb\xfd\xb2\x00\xc8\x6d\xe8\x1f\xbe\xee\x23\x53\x2a\x33\x64\x38\x83\x32\x56\xc8\x25\xd1\x57\x2b\xd7\x24\xe1\x93\x5f\x7d\xd5\x6b\xe8\xe8\xb3\x30\xc1\x19\xe0\xab\x1c\xec\xee\xae\xb1\x5c\x37\x36\xa0\x31\x83\x3a\x80\x17\xbd\x75\xad\xad\x29\xc3\x2f\xff\x76\x81\x43\x67\xa3\x21\x3e\x86\x24\xdc\x11\x2b\xc0\x0b\x62\x5f\x64\x92\xcb\x08\x51\xea\x6a\x77\xb4\x69\x17\xa5\xde\xb5\xd9\x14\x7a\x1b\xa5\x2e\xe7\x92\xce\x73\x34\x92\x95\x6d\x47\xd0\x27\x3a\x84\x87\x6a\xd5\xbe\xcc\x66\x05\xc9\xb2\x97\xee\x53\xd6\xf2\xd6\xaa\xcd\xf5\x30\x04\x5f\x13\xe3\x17\xd4\x84\xc6\xe3\x95\xb9\xc2\x2f\xd8\x90\xf5\x68\x1c\x6e\xae\xaf\xbf\xff\x01\x0d\x6e\xc6\xf5\x63\xcc\x74\x73\x73\x03\xe7\xe8\x6a\xb5\x19\x17\x7a\x33\x3e\x09\x9e\x56\x40\xae\x11\xdc\x9c\xa5\xf8\x33\xee\x48\xdd\xee\xd2\xd5\x19\x38\x8d\x98\x49\xcd\x45\x78\xb1\x00\x74\xad\x0f\x09\x4d\xff\x68\xe5\xd6\x1f\x42\xa4\xbc\x6b\x44\xda\x47\xf4\x4c\x91\xe7\x95\xfb\xe5\xbb\x6b\x87\x3d\x45\xd2\xed\x72\x53\x5b\x0f\x30\x8e\xe1\x80\xdb\x77\xf9\x56\x5c\x4b\x09\xcd\x34\x3d\x8b\xf0\x73\x01\xc6\x91\xd8\xcf\xe5\x8b\xe5\x5d\x1e\xa2\xf5\xdf\xb0\x84\xb9\xf0\xd4\x92\x2c\xd7\x84\xdb\xb7\xa5\xcb\x69\x82\x71\xdc\xbe\x16\x56\x62\x5d\x7e\xca\xed\xd3\xef\xe2\xe7\x5f\x62\xff\xc4\xf5\xf8\x72\xfc\x62\xfc\x9f\xa4\xbc\x81\xff\x02\x00\x00\xff\xff\xe6\xa2\xf2\x8b\x70\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\x4d\x6f\xe3\x46\x0c\xbd\xcf\xaf\x20\x6c\x2f\xb2\x39\x8c\x8d\xf6\xd4\x8b\x0a\xa4\xcd\x16\x2d\xba\x5d\x04\x4d\xfa\x71\x2b\xc6\x1a\x5a\x1e\x68\x4c\x0e\x66\xa8\x24\xaa\x56\xff\xbd\x90\x34\x52\xec\x24\x3d\x99\xe2\xe3\x7b\xa2\xf8\x48\xaf\x1f\x31\x26\xc7\x54\xdc\x7e\xfa\xf3\xd3\x67\xe5\x2a\xe2\x88\xd6\xa5\x1a\xb4\x66\xf2\xad\x6e\x12\x16\x5d\xb7\xfd\x85\x92\x18\xef\xb7\xb7\x2e\xd5\x7d\xaf\xba\x4e\x83\x3b\xc0\x92\xbe\x33\x51\x9c\x38\xa6\x94\xc1\x68\xa8\xc2\xff\xc5\xb7\xb9\x0a\xc9\xce\x91\x4f\xd8\xf7\xca\x34\xc2\xc1\x44\x01\xad\xa5\x0d\x17\x6f\xbe\xc9\xd0\x43\x1b\xf0\x82\xbe\x86\x45\x1d\x4a\x8f\x26\x3a\xaa\xc0\xd1\x81\xe3\xc9\x0c\x49\x35\x26\xb3\x2a\x31\x21\x68\xed\xc8\x89\x37\x7b\xf4\x6f\xbe\xe4\x07\x66\xf1\x6c\x2c\xc6\x9b\x58\xa5\x51\xfe\x25\x05\x25\xd3\xc1\x55\x4d\x9c\x84\xf7\x2f\x80\xd6\x26\x04\x24\x5b\xac\xce\x5a\x7e\xad\xb5\xba\xe8\xfa\x8f\x84\x50\x45\x13\x8e\xae\x34\x1e\xdc\xc4\x51\x4b\x46\xad\xe1\x57\x6c\xf7\x6c\xa2\x05\x6f\x5a\x6e\x24\xa9\x7a\x4e\x68\xfd\x58\xd6\xd8\x9e\x4c\x38\x1f\xd1\x5c\xdf\xf7\xa0\xf5\x73\x26\x15\x57\xef\x56\x5c\xa9\x35\xdc\xb7\x49\xf0\x04\xde\x50\xd5\x98\x0a\xd5\x10\xc0\x59\xf5\x67\x43\x55\xdf\x2b\xb5\x86\x2f\x28\x4f\x1c\xeb\x8b\xb1\x76\x5d\x36\x79\x06\x75\xdf\x2b\xca\x31\xcc\x26\x23\xd9\x11\x58\xc3\xef\xcc\x02\xc1\xa4\xf4\xc4\xd1\xaa\xc8\x2c\xe1\x69\xb0\x22\x95\xb1\x0d\x82\x76\xa0\xdc\x65\x78\x22\x34\x04\x72\x44\xb8\x47\x69\x02\xdc\x54\x48\x02\x4c\x70\x70\x31\x09\x0c\xb3\x57\x63\x38\x44\xa0\x35\x92\xd9\x7b\x54\x6b\xb8\x65\x20\x96\xc5\x2a\x1c\x35\xfe\x86\xbf\x1c\x59\x7e\xca\xdf\xac\x52\xed\xc2\xf3\x6c\xfe\x50\xbe\xfd\xf2\x70\x77\x8f\x71\x38\x87\xf1\xe5\x79\x36\x09\xe3\xa3\x2b\x31\xa9\x39\x00\xad\xad\x4b\xc3\xab\x6c\xb1\x2a\x8f\x91\xa9\xb5\x97\xbe\x66\xa6\xb8\x13\xfe\xcb\x84\x6a\x0e\xce\x27\xfb\x90\x73\xa3\x53\x8d\x94\xcb\x1a\x9e\x77\x31\xac\xab\x84\x34\x3d\x0e\x3e\x9f\x83\xe3\x64\x07\x73\x3e\x04\x53\xd6\xa6\xc2\xf4\xfe\xdd\x4d\xd8\x3b\x57\xa7\x3e\x20\xd9\xb7\x87\x1c\x71\x12\x8d\xc3\x99\x78\xae\x8a\xdd\xa3\x89\x3b\xcf\xd5\xae\x4e\x3a\x44\xdc\x7a\xae\x54\xd7\xbd\x22\x2c\x5a\x4b\x53\x9c\xe4\x3d\x01\x4e\x92\x15\xe6\xb9\xff\xcc\x49\xc8\x9c\x30\x6f\xc9\x3d\xca\x68\xd8\x31\xa7\x55\x88\x78\x70\xcf\xc5\xaa\xfe\x2e\xe9\x95\x8a\x86\x2c\x9f\xfe\x49\x32\x5c\x79\xb1\xf9\xd8\x34\xce\x56\x48\xf0\x15\x24\x82\xb6\x70\xa5\xaf\xe0\x2b\x1c\xd1\x58\xd0\x25\x6c\x3e\x7e\xfc\xe6\x5b\xd0\xb0\xe9\xd6\x93\x4c\x7f\x7d\x7d\xad\x66\xe9\x62\xb5\xe9\x72\x7a\xd3\x5d\x08\xf7\x2b\x85\xe5\x91\x61\x33\x97\xc2\xf7\xb0\x43\x29\x77\x4b\x57\x73\x50\x8a\x87\x84\xa2\x97\xc2\x85\xa2\x66\x87\x4e\xb5\x75\x11\x74\x98\x14\xa8\xb6\x07\xe7\x31\xed\x8e\xcc\xf5\x14\xbd\xaa\x48\xe3\xfe\xd8\xfc\xbb\xab\x9b\x3d\x7a\x94\x6d\x5e\xc0\xad\x55\x2f\x97\xf7\xd3\xc0\x9f\xbc\xfd\x91\x49\x90\x24\x3f\x1c\x07\xfc\x37\xb6\xf3\x09\xf6\xfd\x19\x6b\x5a\x50\x7b\xbe\x14\x2f\x7f\xc4\x17\xcb\xc0\x29\x0b\xbe\xc9\xcc\x4e\x8f\xc6\xff\x37\x00\xcc\x36\xf6\x00\x45\x06\x00\x00"),
 		},
 		"/terraform": &vfsgen۰DirInfo{
 			name:    "terraform",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 461484369, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64": &vfsgen۰DirInfo{
 			name:    "aarch64",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/generalos": &vfsgen۰DirInfo{
 			name:    "generalos",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 459484350, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/generalos/libvirt": &vfsgen۰DirInfo{
 			name:    "libvirt",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 459484350, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/generalos/libvirt/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 458484340, time.UTC),
-			uncompressedSize: 4453,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49413671, time.UTC),
+			uncompressedSize: 4771,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x58\x7d\x6f\xdb\x36\x13\xff\xdf\x9f\xe2\x1e\xe2\x01\xd2\x06\x33\x95\x76\x45\x87\x06\x55\xda\x2e\xed\xb6\x00\xeb\xda\xa5\xed\x3a\xa0\x08\x04\x46\x3a\xdb\x44\x28\x52\x25\x29\x29\x5e\xe6\xef\x3e\x90\xa2\x64\x49\x96\xd3\x34\x06\x12\x59\xd4\xef\x7e\xf7\xc2\x7b\xa1\x6c\x51\x6b\xb6\x50\x3a\x87\x9b\x19\x80\xc6\xaf\x25\xd7\x98\x25\x85\x56\x15\xcf\x50\x1b\xbf\x0c\x20\xf8\x65\xc5\xb5\x85\x38\xdc\x03\x18\x55\xea\x14\x21\x06\x92\xe5\x2c\xad\x78\xca\x74\x14\x50\x24\x40\x2a\xd4\x86\x2b\xe9\x30\x47\xf4\x27\xfa\xb4\x59\xdf\xcc\xdc\xdf\x66\x36\x6b\x75\x00\x69\xe5\x3c\x79\xa9\xb9\x93\xb8\xb9\xa1\xef\x05\xb3\xce\x34\xfa\xe9\xfc\x6c\xb3\x21\x4e\xa6\x62\x9a\xb3\x4b\x81\x40\x52\x51\x1a\x8b\x3a\xe1\x59\x23\x66\xd7\x05\x3a\xfa\x18\x8c\xd5\x5c\x2e\x67\x00\x19\x2e\x58\x29\x6c\x60\x3b\x6d\x04\xce\x5e\xef\x50\x71\x69\x2c\x93\x29\x26\xa9\x2a\xa5\xbd\x23\xdd\x5b\xe6\xd8\xe8\xa9\x13\xd9\xcf\xb8\x52\xc6\x4a\x96\xe3\x98\x54\x70\x63\x1f\x34\xcc\x0f\x07\xd4\x5b\xe6\xdf\x82\xe8\x66\xb3\x87\x9b\x17\xf7\x60\x3d\x7b\x3f\xe6\xeb\x02\x59\x54\x4f\x92\x94\x67\xfa\x8e\x01\xe8\x76\xe7\xf4\xec\xf5\xf9\xfe\xed\x71\xac\x4b\x66\xb1\x66\xeb\xef\x25\xfe\xb5\x11\xbb\x65\xbf\x8a\xf2\x1e\x21\x38\x7d\xff\x69\x6f\x4c\x35\xcb\xef\xc1\x78\xfe\xea\xed\x5e\xc6\x8c\x9b\xab\x7b\x50\xbe\xe6\xe6\x6a\x2f\x67\x5d\xcb\x7b\x50\x7e\xfe\xfc\xc7\x5e\xc6\x54\xa8\x32\xe3\x92\xdb\x7b\xf0\xfe\xac\x94\x3d\x55\x72\xc1\x97\x0d\xbd\xc6\xd0\x1b\xda\xba\x4e\x0a\xa5\x04\x01\xd2\x5c\x1c\xbf\xcb\x6b\xb7\xdb\xff\xbf\xa9\x98\xa6\xdb\x52\xde\xcc\x3d\xa6\xb5\xc0\x35\x17\xae\xdd\x6d\xc1\xec\xca\xdd\x46\x55\xd3\x66\xda\x56\x13\xf1\x9c\x2d\xd1\x44\x3b\x44\x64\xda\x92\x4a\x89\xd2\x55\x23\x69\xbf\x74\xd6\xc0\xb4\x3d\x01\xe7\x4c\x50\x4a\x84\x90\x6c\xbd\xa2\xfe\x9f\x23\x98\xf5\x5b\x62\x3f\x8b\xdf\x7d\x38\x73\x36\x6e\xbe\x69\xd2\x36\x53\x7c\x1f\x82\xee\x13\x83\xb3\x6a\xd8\xa5\xb6\x66\x6f\x51\xc1\xfc\x9d\xe6\xf3\xc5\x4b\x50\x2e\x33\xbc\xbe\xd8\xcc\xbd\x9e\x19\xc0\x25\x33\x18\xb4\x27\x3c\xeb\x39\xd6\xac\xd1\x70\xe1\xd9\xd6\xf9\xad\xae\xfd\x41\xe0\xff\x0c\xad\x1a\x98\xe4\x74\x0f\xcc\x81\x43\x78\x74\xf4\xf8\xc9\xf0\x32\x1d\xa8\x2e\x47\x43\x4d\x91\x51\xd2\x6e\xa3\xf6\xcd\x80\xdd\x35\x56\x94\x1b\x45\x06\xee\xdf\xe2\x79\x69\x50\x27\x19\xb3\x0c\x62\x70\x17\x6a\x31\x2f\x04\xb3\x98\x2c\xb8\x40\xda\x3d\xa6\x87\x54\xa3\xcc\x50\x63\x36\xd0\xe6\xbc\xf6\xe2\x64\x20\x48\x80\x74\xa2\x23\x47\xf7\xf8\xd9\x8a\x43\xdc\x7d\x75\x44\x0f\x86\xe0\x36\x78\x03\x1b\x7e\x80\x1b\x68\x43\x31\xa6\x9f\x0c\x11\x6c\x1e\x4e\xef\x96\x44\x5b\x2b\xed\xb6\xa9\xfb\x76\x33\xbd\x07\xfd\x72\x93\xe8\x4f\x0f\xb9\xca\xb6\x28\xc9\xfc\x5a\xa6\x72\xc6\xe5\x3e\x49\x2a\x54\xca\x7c\xe7\x60\x59\xa6\xd1\x18\x34\x10\xc3\x97\x01\xaa\x1d\x70\x17\x33\x47\xb7\x4a\x8b\x70\x98\x41\xe9\xba\xa1\xab\x00\xab\x4b\xf4\xc7\x13\x07\x90\x66\xf4\xdc\xab\x0e\x10\x00\xaf\x30\x51\x52\xac\x47\x82\x5a\x95\x16\x5b\x59\xa7\x6f\xbb\x55\x3b\xa6\x78\x4c\x18\x8f\x53\x98\xf0\xa8\xa5\x66\xa5\x55\xc6\x32\x7f\x10\xf3\x2a\x27\x43\xdf\x44\x8a\x00\x59\xa2\x44\xcd\x84\x32\x93\x6d\xe5\x8e\x7d\xe5\x4e\x69\x30\x03\x58\x70\x9d\xd7\x4c\x63\xaf\x1f\x45\xa5\xd1\x91\x59\x31\x8d\x11\x66\x57\x8f\x23\xc6\x74\xba\x7a\xfa\x24\xfa\xf3\xcd\xdb\x4f\xc9\x9b\x5f\xce\xe6\xc5\x42\x30\xb3\xa2\x9a\xd5\x6e\xe7\x64\xa5\x59\x1e\xe2\xe6\x12\xb6\xa3\x19\x37\xfd\xaf\x98\x97\x91\x47\x47\x77\x2a\xe3\xe4\xaf\x57\xe7\x1f\xe8\x22\x6b\x0e\xa0\xbd\xf2\xd8\x6b\x61\xc5\xb4\x99\xb7\xc0\x91\x99\xee\x00\x9b\x16\x65\x30\xd4\xa7\x6a\x0c\xc4\x69\x9e\x17\xcc\x18\xbb\xd2\xaa\x5c\xae\x5a\x64\xe5\xa0\xb7\x04\xbd\x28\xc7\x81\xcc\x31\x57\x7a\xbd\x57\x42\xb3\x7c\x2c\xd1\xd5\xf2\x4e\x7b\x1e\xf6\x4c\xda\xdd\xd2\x43\xca\xb3\x1d\xc5\x2c\x5d\x71\x89\xfd\x89\xe2\xa7\xbc\x5f\xfd\xb8\x2e\xfc\x0c\xeb\x27\x61\x0b\x0b\xd9\xdf\x9e\x1a\x7a\xf2\x57\x55\x4e\x5c\xe2\x5e\xe7\x22\xc4\xeb\xda\xf8\xe3\xc3\xf3\xe7\xf3\x37\xef\x3e\x86\x17\x85\xe7\x2f\xdc\xf3\xf0\xba\x10\x93\x47\xf4\x88\xc0\x8b\x93\xf6\xe1\xb5\x11\xc7\xc6\xae\x05\x9a\x15\xa2\x1d\xc2\x66\x30\xf9\xb9\xce\x85\x34\xc7\xd7\x46\xc4\x64\x65\x6d\x71\x1c\x45\x75\x5d\xd3\xfa\x47\xaa\xf4\x32\x7a\xf4\xec\xd9\xb3\xe8\xef\x0f\xbf\x47\x1f\x35\x93\xc6\xcd\x68\x72\xd2\xf1\x78\x6d\xaa\xb4\x45\x69\x41\xe5\xdc\xce\xaf\x73\x31\xcf\x30\x15\x4c\x33\xeb\xd5\xae\xd1\x10\x70\x31\x93\xb6\xb9\x89\x46\xd2\x5d\x7e\xe5\xcc\xa6\xab\x98\x48\x95\xe1\x83\x87\xff\xbe\x3c\xec\xa9\x09\xd0\x54\x15\xeb\x93\x81\x0f\x7e\x99\x15\x85\x58\x77\xe9\x67\xc0\xa0\xc0\xd4\xf6\x99\xa2\x01\x55\xb4\xcb\xd5\xac\xb5\x14\x27\xa3\x38\xdd\x6e\x70\xd4\x34\x90\x28\xc3\x8a\xa7\x68\x22\x3f\xb4\x5f\x36\x77\xf1\x41\x9a\x69\x95\x1f\x5c\x44\x96\xe9\x25\xda\xe8\xe5\x65\x69\x76\x1d\x63\xd6\x6a\x7e\x59\x5a\xf4\xdd\x24\x26\x63\x50\x80\x55\x4c\x94\x38\x57\x8b\xce\xc3\x03\x93\x1a\x7e\x30\xe5\x5e\xc7\xf8\x9d\x3e\x36\xa0\x6d\xfe\x34\xb0\x26\xf7\x9a\x3e\xcf\xcd\x55\xc8\xcd\x5b\x0e\x43\xbe\x7c\xa6\x8a\x06\xc0\x99\x3c\xaa\x04\x80\xba\x96\xd3\xe5\x5b\xd7\x72\x4c\xe1\xcd\x08\x53\x32\xe1\xd2\xa2\x5e\xb0\x14\x83\x4d\xed\x7a\x77\x48\x1d\x0d\x58\xda\x5d\x9b\x53\x08\x6c\xe7\xf7\x77\xb5\xef\xfe\xe0\x9c\x92\xe3\xc5\x70\xee\xff\xcf\x4d\xe6\x52\x08\x02\x2f\x9a\x31\xbb\x0f\x79\x01\xc7\xe0\x80\x4d\x58\x18\xb7\xc9\x42\xe9\x44\x20\x33\x38\x1a\x9c\x4b\xcd\x8a\x15\x4f\xdb\xd1\xd9\x6f\x27\x31\x90\x4a\xa6\x24\xfc\x16\x61\x2c\xca\xa4\x7d\x43\x08\x66\x93\x96\x25\x55\xd2\x28\x81\xd3\x24\x85\x5d\x87\x21\xe0\xb3\x37\x29\x94\x9f\xa5\xe4\x88\xb4\xbf\x4a\x84\xda\x27\x6e\xfc\x36\x63\xd3\x27\x69\x2f\xf0\x4d\x75\xd0\x6e\xb8\xd2\x43\xba\xb3\x79\xf4\x88\x76\xe1\x9c\x6d\xfe\x0b\x00\x00\xff\xff\x96\xe2\x72\x7f\x65\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x58\xed\x6e\xdb\xb8\x12\xfd\xaf\xa7\x98\xcb\x7b\x81\x7c\xa0\x96\xd2\xde\xa0\x8b\x06\x71\xda\x22\x6d\x77\x03\x6c\x3f\x36\x49\xb7\x0b\x14\x81\xc0\x88\x63\x9b\x08\x45\xaa\x24\x25\xc7\x9b\xf5\xbb\x2f\x48\x51\xb2\x25\xcb\xd9\x24\x3f\xd6\x40\xab\x88\x9a\x39\x73\x38\x9a\x39\x43\xdb\xa2\xd6\x74\xa2\x74\x0e\x77\x11\x80\xc6\x1f\x25\xd7\xc8\xd2\x42\xab\x8a\x33\xd4\xc6\x2f\x03\x08\x7e\x5d\x71\x6d\x61\x1c\xee\x01\x8c\x2a\x75\x86\x30\x06\xc2\x72\x9a\x55\x3c\xa3\x3a\x09\x56\x24\x98\x54\xa8\x0d\x57\xd2\xd9\x1c\xc4\x3f\xc5\x2f\xeb\xf5\x65\xe4\xfe\x2d\xa3\xa8\x89\x01\xa4\xf1\xf3\xe0\xa5\xe6\xce\xe3\xee\x2e\xfe\x22\xa8\x75\xd4\xe2\xaf\xe7\x67\xcb\x25\x71\x3e\x15\xd5\x9c\x5e\x0b\x04\x92\x89\xd2\x58\xd4\x29\x67\xb5\x9b\x5d\x14\x08\x00\x30\x06\x63\x35\x97\xd3\x08\x80\xe1\x84\x96\xc2\x06\xb4\xd3\xda\xe1\xec\xdd\x06\x14\x97\xc6\x52\x99\x61\x9a\xa9\x52\xda\x07\xc2\x7d\xa4\x0e\x2d\x3e\x75\x2e\xdb\x11\x67\xca\x58\x49\x73\xec\x83\x0a\x6e\xec\x6e\x8d\xbc\xd7\x81\x5e\x21\xff\x12\x5c\x97\xcb\x2d\xd8\xbc\x78\x02\xea\xd9\x97\xad\x78\xc6\x52\xcb\xb3\x54\xa2\x9d\x2b\x7d\xf3\x04\xec\x0b\x0f\xf0\xa9\xf6\x5f\x2e\xb7\xbc\xaf\xa2\x3a\x4c\x33\xce\xf4\x03\xf3\xdc\x16\xc1\xe9\xd9\xbb\xf3\xed\x55\xe0\x50\xa7\xd4\xe2\x9c\x2e\x1e\x0b\xfc\x73\xed\x76\x4f\x59\x14\xe5\x13\xb2\x71\xfa\xe5\xeb\xd6\x54\x6b\x9a\x3f\x01\xf1\xfc\xed\xc7\xad\x88\x8c\x9b\xa7\xbc\xb2\x77\xdc\xdc\x6c\xc5\x9c\xcf\xe5\x13\x20\xbf\x7d\xfb\x54\x23\xfe\x17\xae\xa9\xc1\x97\x87\x80\x32\x53\x0c\x19\x94\x06\xf5\x88\x51\x4b\x41\x4d\x00\x69\x36\x83\x26\xd4\x33\x08\x62\xc0\xe0\x7a\x01\xf2\x86\x81\x9d\x69\x55\x4e\x67\x70\xf9\x21\xfd\xfd\xed\x79\xda\x72\x72\x18\xa9\xc3\x18\x22\xdc\x3e\xec\xd2\xbe\x87\xb8\x7b\xf4\xfd\x2a\x02\x30\x28\x0d\xb7\xbc\x42\x18\x83\xd5\x25\x6e\x49\x49\x68\x8e\x34\x53\x72\xc2\xa7\x4f\xc8\x4e\xe8\x8e\x53\xef\x5f\xe7\x49\x63\xd0\xd2\x46\x07\xd3\x42\x29\x41\x80\xd4\x17\x17\xc2\xe9\x00\x8c\x81\xfc\xef\xae\xa2\x3a\x5e\x49\xdf\x72\xe4\x6d\x1a\x12\x4e\x8c\xb9\x76\xb7\x05\xb5\x33\x77\x9b\x54\xb5\x2c\x37\xd2\x9c\xf0\x9c\x4e\xd1\x24\x1b\x40\x64\x98\x49\xa5\x44\xe9\xd4\x8b\x34\x7f\xb4\x6c\x60\x98\x4f\xb0\x73\x14\x94\x12\x21\x2b\xab\x5d\xc5\xfe\x3f\x07\x10\xad\x8f\x90\xf5\x76\xfc\x7c\x71\xe6\x38\x2e\xff\x91\xd2\xaa\xe4\xbd\x6e\x43\xfb\x19\x83\x63\xd5\x55\xf5\x15\xed\x95\x55\xa0\xbf\x21\xd6\xdf\xbd\x47\xcc\x25\xc3\xdb\xab\xe5\xc8\xc7\x89\xc0\x57\x73\x88\x9e\x72\xb6\xb6\xb1\x7a\x2d\x0e\x17\xce\x56\x9b\x5f\xc5\xda\x9e\x04\xfe\x67\x97\x55\x87\x92\x8b\xdd\xa1\x03\xfb\xf0\xfc\xe0\xc5\x61\xf7\x32\x9c\xa8\x4c\xa8\x92\x71\xc9\x6d\x10\x07\xd2\x2e\xfc\x0b\x59\x8b\xb9\x51\xe4\x51\x89\x68\x9b\xb7\xb1\xb4\x7a\xb1\x5b\x2b\x08\x43\x27\x20\xbb\x9d\xb0\xad\x79\x27\xee\xde\x33\x20\xc4\xf5\x5e\xb7\x51\xfb\x9b\xeb\x3e\xed\x20\xc0\x7f\xc6\x40\x64\x29\x04\x81\xd7\x30\xe1\x02\x77\x1f\xea\xb9\x07\x47\xe0\x1c\x87\xdf\x46\x3b\x56\x49\x67\xc0\xae\xd2\x3b\xd8\x4e\x12\xfd\x69\x2a\x57\x6c\x65\x25\xa9\x5f\x63\x2a\xa7\x5c\x6e\xf3\x8c\x85\xca\xa8\x57\x06\xca\x98\x46\x63\xd0\x38\xa9\xeb\x58\x35\x93\xf8\x2a\x72\x70\xb3\xac\xf0\x8c\x00\x50\x3a\xd5\x63\x8d\x12\x02\x2c\xbd\x81\x34\xbd\xe7\x00\x2b\x13\x00\x1f\x30\x55\x52\x2c\x7a\x8e\x5a\x95\x16\x1b\x5f\x17\x6f\x55\x6d\x1b\x54\xbc\x4d\x98\xe3\x43\x36\xe1\x51\x03\x4d\x4b\xab\x8c\xa5\xda\x36\x21\x07\x53\x5f\x67\x8a\x00\x99\xa2\x44\x4d\x85\x32\x83\x0d\xf0\xc0\x0e\xe8\x9b\x0d\xd6\x7f\x04\x30\xe1\x3a\x9f\x53\x8d\x2b\x37\x92\x94\x46\x27\x66\x46\x35\x26\xc8\x6e\x5e\x24\x94\xea\x6c\xf6\xf2\x30\xf9\xed\xfd\xc7\xaf\xe9\xfb\x0f\x67\xa3\x62\x22\xa8\x99\xc5\x9a\xce\x89\x0b\x5c\x69\x9a\x87\xbc\xb9\x4a\x6c\x61\xfa\xa2\xfe\x03\xf3\x32\xf1\xd6\xc9\x83\x9a\xd3\x8d\xd4\x8b\x78\xc2\x88\x87\xb6\x98\x17\x82\x5a\xbc\x8f\x61\x45\xb5\x19\x35\x86\x3d\x9a\xcb\x08\x20\x2b\xca\x40\xd4\x97\xea\x18\x88\x8b\x3c\x2a\xa8\x31\x61\x92\x37\x96\x95\x33\xbd\x27\xe9\x45\xd9\x4f\x64\x8e\xb9\xd2\x8b\xad\x1e\x9a\xe6\x7d\x8f\x56\xe8\x36\x54\xa7\xab\x89\x71\x7b\x1b\xef\xc7\x9c\x6d\x04\xa6\xd9\x8c\x4b\x5c\xd7\x3e\x3f\xc8\xfd\xea\xe5\xa2\xf0\x33\x6a\xbd\x08\x57\xca\x55\x62\x33\x93\xbb\xda\x79\x53\xe5\x24\x8a\x00\x6e\x73\x11\xf2\x75\x6b\xfc\x09\xe1\xf8\x78\xf4\xfe\xf3\x65\x54\x1b\x1e\xbf\x76\xcf\xc3\xd7\xa7\x31\x79\x1e\x1f\x10\x78\x7d\xd2\x3c\xbc\x35\xe2\xc8\xd8\x85\x40\x33\x43\xb4\x5d\xb3\x08\x06\x3f\xb7\xb9\x90\xe6\xe8\xd6\x88\x31\x99\x59\x5b\x1c\x25\xc9\x7c\x3e\x8f\xe7\xff\x8f\x95\x9e\x26\xcf\x5f\xbd\x7a\x95\xfc\x71\xf1\x6b\x72\xa9\xa9\x34\x6e\x06\x93\x93\x16\xc7\x47\x53\xa5\x2d\x4a\x0b\x2a\xe7\x76\x74\x9b\x8b\x11\xc3\x4c\x50\x4d\xad\x0f\xbb\x40\x43\xc0\xe5\x4c\xda\xfa\x26\xe9\x79\xb7\xf5\x95\x53\x9b\xcd\xc6\x44\x3a\x2d\xdf\xfb\xeb\xcd\xfe\x5a\x98\x60\x9a\xa9\x62\x71\xd2\xd9\x83\x5f\xa6\x45\x21\x16\x6d\xf9\x19\x30\x28\x30\xb3\xeb\x48\x49\x07\x2a\xd9\xc4\xaa\xd7\x1a\x88\x93\x5e\x9e\xee\x27\x9c\xd4\x02\x92\x30\xac\x78\x86\x26\xf1\x43\xf9\x4d\x7d\x37\xde\xc9\x98\x56\xf9\xce\x55\x62\xa9\x9e\xa2\x4d\xde\x5c\x97\x66\x73\x63\xd4\x5a\xcd\xaf\x4b\x8b\x5e\x4d\xc6\xa4\x6f\x14\xcc\x2a\x2a\x4a\x1c\xa9\x49\xbb\xc3\x1d\x93\x19\xbe\x33\xb4\xbd\x16\xf1\x91\x7b\x3c\x4e\xba\xf5\x53\x9b\xd5\xb5\x57\xeb\x3c\x37\x37\xa1\x36\xef\x39\xec\xf8\xf6\x19\x6a\x1a\x00\x47\xb9\xd7\x09\x00\xf3\xb9\x1c\x6e\xdf\xf9\x5c\xf6\x21\x3c\x8d\x66\xd0\x72\x69\x51\x4f\x68\x86\x81\x53\xb3\xde\x1e\x42\x7b\x03\x36\x6e\xaf\xf5\xb1\x02\xa0\xd1\xc0\xc7\xc9\xf7\xfa\xe0\x1c\xf2\xe3\xc5\xf6\x63\xc3\xf7\xfb\x2c\xaf\x9a\x63\x82\x4f\x0b\xe5\x36\x9d\x28\x9d\x0a\xa4\x06\xfb\x31\xba\xdf\xc8\x37\xe3\xb9\xec\x92\x26\x61\x53\x4d\x8b\x19\xcf\x9a\x41\xbb\x2e\x3e\x63\x20\x95\xcc\x48\xf8\x25\xc7\x58\x94\x69\xf3\x7d\x21\x6c\xb2\x45\xc9\x94\x34\x4a\xe0\x30\x48\x61\x17\x35\x48\x5d\xeb\x69\xa1\xfc\xe4\x25\x07\xa4\xf9\x4d\x27\x28\x05\x71\xc3\xba\x1e\xb2\xbe\xa4\xd7\x5e\x53\xdd\x4b\x71\x3b\x8a\xe3\xfd\x78\xe3\x55\xc7\x07\x71\x9b\xfc\x68\xf9\xf7\x00\x09\x53\xfb\x24\xa3\x12\x00\x00"),
 		},
 		"/terraform/aarch64/generalos/libvirt/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 459484350, time.UTC),
-			uncompressedSize: 3752,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49295584, time.UTC),
+			uncompressedSize: 4070,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\x7f\x6f\xdb\x36\x13\xfe\xdf\x9f\xe2\x5e\xe2\x05\xd2\x06\x33\x95\x76\x45\x87\x06\x55\xda\x2e\xed\xb0\x00\xeb\xda\xf5\xc7\x3a\xa0\x08\x04\x46\x3a\xdb\x44\x28\x52\x25\x29\xc9\x9e\xe7\xef\x3e\x90\xa2\x64\x49\x91\xb3\x34\x06\x0c\x5b\xe4\xdd\x73\xcf\x9d\x9e\xe3\x49\x16\xb5\x66\x0b\xa5\x73\xd8\xce\x00\x34\x7e\x2b\xb9\xc6\x2c\x29\xb4\xaa\x78\x86\xda\xf8\x65\x00\xc1\xaf\x2a\xae\x2d\xc4\xe1\x1a\xc0\xa8\x52\xa7\x08\x31\x90\x2c\x67\x69\xc5\x53\xa6\xa3\x60\x45\x82\x49\x85\xda\x70\x25\x9d\xcd\x09\xfd\x89\x3e\x6d\xd6\x77\x33\xf7\xdd\xcd\x66\x6d\x0c\x20\xad\x9f\x07\x2f\x35\x77\x1e\xdb\x2d\x7d\x2f\x98\x75\xd4\xe8\xe7\x0f\x17\xbb\x1d\x71\x3e\x15\xd3\x9c\x5d\x09\x04\x92\x8a\xd2\x58\xd4\x09\xcf\x1a\x37\xbb\x29\xd0\xc1\xc7\x60\xac\xe6\x72\x39\x03\xc8\x70\xc1\x4a\x61\x03\xda\x79\xe3\x70\xf1\xfa\x06\x14\x97\xc6\x32\x99\x62\x92\xaa\x52\x06\x16\x43\xdf\x2f\x4a\x5f\xa3\xa6\xe7\x6e\xff\xb0\xfb\x4a\x19\x2b\x59\x8e\x63\x42\x82\x1b\xfb\xa0\x61\xf5\x70\x00\xbd\x47\xfe\x35\xb8\xee\x76\x07\xb0\x79\x71\x0f\xd4\x8b\xf7\x07\xf1\xd2\xa2\xbc\x07\xe0\xf9\xfb\xcf\x07\x11\x35\xcb\xef\x81\xf8\xe1\xd5\xdb\x83\x88\x19\x37\xd7\xf7\x80\x7c\xcd\xcd\xf5\x41\xcc\xba\x96\xf7\x80\xfc\xf2\xe5\xf7\xc3\x95\x14\xaa\xcc\xb8\xe4\xf6\x1e\xb8\x3f\x2b\x65\xcf\x95\x5c\xf0\x65\x03\xaf\x31\xb4\x55\xdb\x12\x49\xa5\x44\xe9\x14\x45\xda\x3f\x2e\x86\x53\x8a\x0f\x41\xfe\xbf\xad\x98\xa6\xfb\x5e\xd8\xcd\x83\xdd\x0c\xa0\x50\x4a\x1c\xb2\x72\x7b\xce\x66\xdf\xc5\xfd\x7e\x7b\xf7\xf1\x22\x67\x4b\x0c\x4a\xbf\x85\xd4\xfe\x0e\xf9\xd6\x81\xde\x27\x06\x17\x73\xd8\x5a\x7b\xea\x7d\xbb\x40\xef\x46\x1b\x7d\xf5\x3e\x94\xcb\x0c\xd7\x97\xbb\xb9\x8f\x35\x03\xb8\x62\x06\x03\x83\xc4\xa3\xdd\xad\x0c\x13\x11\x27\x0b\xc2\xff\x1e\xf3\x1b\x90\x73\x2c\x06\xc4\xe0\x18\x1e\x9d\x3c\x7e\x32\xfc\x99\x2e\x5b\xa7\x94\xa0\x6c\x32\x92\xce\xbe\x86\xff\x59\xbc\xbb\x56\x8d\x72\xa3\x86\x45\xb8\x35\xfb\xd2\xa0\x4e\x32\x66\x19\xc4\xe0\x7e\xa8\xc5\xbc\x10\xcc\x62\xb2\xe0\x02\x69\xb7\x4d\x8f\xa9\x46\x99\xa1\xc6\x6c\x10\xcf\xe5\xed\xdd\xc9\xc0\x91\x00\xe9\x5c\x47\xa9\x1e\xc8\xb4\x75\x87\xb8\xfb\xeb\x80\x1e\x0c\x8d\xdb\xf2\x0d\x38\xfc\x00\x5b\x68\x8b\x31\x86\x9f\x2c\x12\xec\x1e\x4e\xdf\xaf\x4c\xe5\x8c\x4b\x02\x64\x89\x12\x35\x13\xca\x4c\x6b\xfd\x8e\x52\xbf\x0b\x97\x19\xc0\x82\xeb\xbc\x66\x1a\x7b\x72\x8d\x4a\xa3\x23\xb3\x62\x1a\x23\xcc\xae\x1f\x47\x8c\xe9\x74\xf5\xf4\x49\xf4\xc7\x9b\xb7\x9f\x93\x37\xbf\x5c\xcc\x8b\x85\x60\x66\x45\x35\xab\xdd\x5d\x94\x95\x66\x79\x18\xd4\xae\x6a\x1d\x4c\xd5\xcc\xe8\x76\x4e\x47\xdf\x30\x2f\x23\x6f\x1d\xdd\x49\x4d\xc9\x9f\xaf\x3e\x7c\xa4\x8b\xac\x19\xe4\xbd\x7b\x74\x90\x61\xc5\xb4\x99\xb7\x86\x23\x9a\xee\x41\x20\x2d\xca\x40\x34\x57\x99\x47\x72\x91\xe7\x05\x33\xc6\xae\xb4\x2a\x97\xab\xd6\xb2\x72\xa6\xb7\x14\xbd\x28\xc7\x85\xcc\x31\x57\x7a\x73\xd0\x43\xb3\x7c\xec\xd1\x09\xaa\xf3\x98\x6e\x5d\xda\x5d\xd2\x63\xca\xb3\x1b\x81\x59\xba\xe2\x12\xfb\x07\xce\x76\x4b\xdf\x36\xab\x9f\x36\x85\x3f\x58\x01\x58\x69\x95\xb1\x4c\xef\xa3\x59\x5d\x62\x6f\x84\xf4\xfc\xaf\xab\x9c\xcc\x66\x00\xeb\x5c\x84\x7a\xad\x8d\x9f\x25\xcf\x9f\xcf\xdf\xbc\xfb\x14\x1e\xb8\x9e\xbf\x70\xfb\xe1\xb1\x2b\x26\x8f\xe8\x09\x81\x17\x67\xed\xe6\xda\x88\x53\x63\x37\x02\xcd\x0a\xd1\x0e\xcd\x66\x30\xf9\x59\xe7\x42\x9a\xd3\xb5\x11\x31\x59\x59\x5b\x9c\x46\x51\x5d\xd7\xb4\xfe\x91\x2a\xbd\x8c\x1e\x3d\x7b\xf6\x2c\xfa\xeb\xe3\x6f\xd1\x27\xcd\xa4\x71\x83\x83\x9c\x75\x38\x3e\x9a\x2a\x6d\x51\x5a\x50\x39\xb7\xf3\x75\x2e\xe6\x19\xa6\x82\x69\x66\x7d\xd8\x0d\x1a\x02\xae\x66\xd2\x36\x17\xd1\xc8\xbb\xd3\x57\xce\x6c\xba\x8a\x89\x54\x19\x3e\x78\xf8\xcf\xcb\xe3\x5e\x98\x60\x9a\xaa\x62\x73\x36\xc8\xc1\x2f\xb3\xa2\x10\x9b\x4e\x7e\x06\x0c\x0a\x4c\x6d\x1f\x29\x1a\x40\x45\x37\xb1\x9a\xb5\x16\xe2\x6c\x54\xa7\xdb\x09\x47\xcd\x01\x12\x65\x58\xf1\x14\x4d\xe4\x67\xc7\xcb\xe6\x2a\x3e\x4a\x33\xad\xf2\xa3\xcb\xc8\x32\xbd\x44\x1b\xbd\xbc\x2a\xcd\xcd\xc4\x98\xb5\x9a\x5f\x95\x16\xfd\x69\x12\x93\xb1\x51\x30\xab\x98\x28\x71\xae\x16\x5d\x86\x47\x26\x35\xfc\x68\x2a\xbd\x0e\xf1\x3b\x73\x6c\x8c\xf6\xfa\x69\xcc\x1a\xed\xed\x9c\x34\x5d\x76\x41\x9b\x61\x3a\xf3\xac\xd7\x41\xcd\x1a\xf5\xed\x33\xd5\x34\x00\x8e\xf2\xa8\x13\x00\xea\x5a\x4e\xb7\x6f\x5d\xcb\x31\x84\xa7\x21\xd1\xd6\x4a\x5f\x27\x5c\x5a\xd4\x0b\x96\x62\xe0\xd4\xae\xdf\xf6\xec\x24\x31\xbc\xbb\x74\xf3\xe3\xbb\x4e\x6e\x00\x96\x65\x1a\x8d\x41\x33\xe9\xc7\x8b\xe1\xdc\xf9\x5f\x0c\x44\x96\x42\x10\x78\x01\x5f\x6f\xb3\xbc\x84\x53\x70\x86\x4d\x45\x18\xb7\xc9\x42\xe9\x44\x20\x33\xb8\xaf\x95\xcf\x7e\xa9\x59\xb1\xe2\x69\xfb\xda\xd6\x3f\x49\x62\x20\x95\x4c\x49\x78\x9d\x33\x16\x65\xe2\xb7\x63\x20\x81\x36\x69\x51\x52\x25\x8d\x12\x38\x0d\x52\xd8\x4d\x38\xff\xbd\x70\x93\x42\xf9\xf7\x42\x72\x42\xda\x17\xbb\xd0\xf6\x84\x17\xd5\x93\x66\x62\x7a\x7d\xf6\xc4\xd0\x34\x06\xed\xe6\x2a\x3d\xa6\x37\xee\x1b\x3d\xa1\x5d\x39\x67\xbb\x7f\x03\x00\x00\xff\xff\xfd\x31\x0b\x05\xa8\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\xfb\x6f\xdb\x36\x10\xfe\x5d\x7f\xc5\x8d\x1b\x90\x07\x6a\x29\xed\x82\x0e\x0d\xa2\xb4\x45\xda\x62\x01\xd6\xc7\x9a\x74\x1d\x50\x04\x02\x23\x9d\x6d\x22\x14\xa9\x92\x94\x64\x2f\xf3\xff\x3e\x90\xa2\x64\xcb\x96\xbd\xd4\x06\x8a\x46\xe4\xdd\x77\x1f\x3f\xdd\x43\x34\xa8\x14\x1d\x4b\x95\xc3\x43\x00\xa0\xf0\x7b\xc9\x14\x66\x49\xa1\x64\xc5\x32\x54\xda\x2d\x03\x70\x76\x57\x31\x65\x20\xf6\xcf\x00\x5a\x96\x2a\x45\x88\x81\x64\x39\x4d\x2b\x96\x52\x15\x79\x2b\xe2\x4d\x2a\x54\x9a\x49\x61\x6d\x4e\xc2\xdf\xc2\xe7\xcd\xfa\x22\xb0\xff\x16\x41\xd0\xc6\x00\xd2\xfa\x39\xf0\x52\x31\xeb\xf1\xf0\x10\x7e\xe2\xd4\x58\x6a\xe1\x97\xcf\x57\x8b\x05\xb1\x3e\x15\x55\x8c\xde\x71\x04\x92\xf2\x52\x1b\x54\x09\xcb\x1a\x37\x33\x2f\x10\x00\x20\x06\x6d\x14\x13\x93\x00\x20\xc3\x31\x2d\xb9\xf1\x68\x97\x8d\xc3\xd5\x9b\x0d\x28\x26\xb4\xa1\x22\xc5\x24\x95\xa5\xf0\x2c\xfa\xbe\x5f\xa5\xba\x47\x15\x5e\xda\xfd\xed\xee\x53\xa9\x8d\xa0\x39\xae\x13\xe2\x4c\x9b\xc3\x86\xd5\x51\x0f\x7a\x89\xfc\xbb\x77\x5d\x2c\xb6\x60\xb3\x62\x0f\xd4\xab\x4f\x5b\xf1\xb4\xa1\x86\xa5\x89\x40\x53\x4b\x75\xbf\x07\xf6\xb5\x03\xf8\xd0\xf8\x6f\x0d\x93\x16\xe5\x1e\xd8\x97\x9f\xbe\x6c\x45\x54\x34\xdf\x03\xf1\xf3\xeb\xf7\x5b\x11\x33\xa6\xf7\x11\xe0\x0d\xd3\xdb\xcf\x5d\xd7\x62\x0f\xc8\xaf\x5f\x3f\x34\x88\x3f\xc3\x1d\xd5\xf8\xfc\x14\x50\xa4\x32\xc3\x0c\x4a\x8d\x6a\x94\x51\x43\x41\x8e\x01\x69\x3a\x85\x36\xd4\x13\xf0\x75\x94\xc1\xdd\x1c\xc4\x7d\x06\x66\xaa\x64\x39\x99\xc2\xcd\xbb\xe4\xaf\xd7\x9f\x93\x8e\x93\xc5\x48\x2c\xc6\x10\xe1\x6e\xb3\x4f\x7b\x07\x71\xbb\xf5\xed\x36\x00\xd0\x28\x34\x33\xac\x42\x88\xc1\xa8\x12\xb7\x48\xe2\x53\x2d\x49\xa5\x18\xb3\xc9\x1e\xea\xf8\x5c\xbb\x74\xfe\x8d\x4e\x0a\x7d\x1b\x6a\x5b\x48\x52\x49\x5e\xda\x0a\x24\xed\x1f\x36\x8c\xad\x2c\x17\x85\xfc\xf2\x50\x51\x15\x2e\x7b\xc7\x62\xe4\xed\x02\x80\x42\x4a\xbe\xcd\xca\xee\x59\x9b\x65\xd7\x5b\xed\x4f\x1f\xaf\xaf\x72\x3a\x41\xdf\x19\x76\x90\x5a\xa6\x9a\x6b\x35\xb0\xf2\x8b\xc1\xc6\xec\xb7\xa2\x25\xf5\x55\x3b\x4f\x6f\xa3\xed\x7c\x73\x3e\x21\x13\x19\xce\x6e\x17\x23\x17\x2b\x00\x97\x49\x9e\x41\xe2\xd0\x1e\x27\xc3\x40\xc4\x41\x41\xd8\x3f\xeb\xfc\x7a\xe4\x2c\x8b\x1e\x31\x38\x86\xa7\x27\xcf\x4e\xfb\xff\x0d\xcb\x96\x72\x59\x66\x4c\x30\xe3\x4b\x94\x74\x0b\x83\x1a\x3e\x4e\xc1\xc7\xea\x17\x32\x2d\x07\xe4\xd8\x29\x46\x57\x44\xad\xad\x51\xf3\xc3\xa6\x92\x33\xb4\x85\x7c\xd8\x0b\xdc\x99\xf7\x22\x1f\x3d\x01\x42\x6c\x0d\xf4\x0b\x66\xfd\x78\xfd\xdd\x1e\x02\xfc\x14\x03\x11\x25\xe7\x04\x5e\xc2\x98\x71\x3c\x7c\xac\xe7\x11\x9c\x81\x75\x1c\x7e\x1f\x99\xcc\x29\x13\x04\xc8\x04\x05\x2a\xca\xa5\x1e\xce\xe5\x47\xa6\x72\x0c\xff\xff\x1a\x02\x80\x31\x53\x79\x4d\x15\xae\xbc\x81\xa8\xd4\x2a\xd2\x53\xaa\x30\xc2\xec\xfe\x59\x44\xa9\x4a\xa7\xcf\x4f\xa3\x3f\xdf\xbe\xff\x92\xbc\x7d\x77\x35\x2a\xc6\x9c\xea\x69\xa8\x68\x4d\x6c\xe0\x4a\xd1\xe6\xfb\x06\x9c\x1c\x1d\x4c\xd5\x7c\xb3\xb4\xdf\x2d\xd1\x77\xcc\xcb\xc8\x59\x47\x8f\xca\x11\xdb\x5f\xaf\xc3\x71\x46\x1c\xb4\xc1\xbc\xe0\xd4\xe0\x2e\x86\x15\x55\x7a\xd4\x1a\xae\xd1\x5c\x04\x00\x69\x51\x7a\xa2\xb9\xcc\x1c\x92\x8d\x3c\x2a\xa8\xd6\xbe\xad\xb7\x96\x95\x35\xdd\x21\x7a\x51\xae\x0b\x99\x63\x2e\xd5\x7c\xab\x87\xa2\xf9\xba\x47\x57\x6f\xb0\x9c\x05\x43\xa5\x19\x76\x8f\xe1\x71\xc8\xb2\x8d\xc0\x34\x9d\x32\x81\xab\x35\xf4\xf0\x10\xbe\x6f\x56\x6f\xe6\x85\x6b\x9c\x00\xb4\x34\x52\x1b\xaa\x96\xd1\xdc\x34\x59\x1d\x46\x9d\xff\x7d\x95\x93\x20\x00\x98\xe5\xdc\xeb\x35\xd3\x6e\x5c\x9c\x9f\x8f\xde\x7e\xbc\x09\x1a\xc3\xf3\x97\x76\xdf\x7f\x86\xc6\xe4\x69\x78\x42\xe0\xe5\x45\xbb\x39\xd3\xfc\x4c\x9b\x39\x47\x3d\x45\x34\x7d\xb3\x00\x06\x7f\xb3\x9c\x0b\x7d\x36\xd3\x3c\x26\x53\x63\x8a\xb3\x28\xaa\xeb\x3a\xac\x7f\x0d\xa5\x9a\x44\x4f\x5f\xbc\x78\x11\xfd\x7d\xfd\x47\x74\xa3\xa8\xd0\x76\x30\x90\x8b\x0e\xc7\x45\x93\xa5\x29\x4a\x03\x32\x67\x66\x34\xcb\xf9\x28\xc3\x94\x53\x45\x8d\x0b\x3b\x47\x4d\xc0\x6a\x26\x4c\xf3\x10\xad\x79\x77\xf9\x95\x53\x93\x4e\x63\x22\x6c\x43\x39\xfa\xf7\xd5\xf1\x4a\x18\x6f\x9a\xca\x62\x7e\xd1\x3b\x83\x5b\xa6\x45\xc1\xe7\x5d\xfa\x69\xd0\xc8\x31\x35\xab\x48\x51\x0f\x2a\xda\xc4\x6a\xd6\x5a\x88\x8b\x35\x9d\x76\x13\x8e\x9a\x06\x12\x65\x58\xb1\x14\x75\xe4\x66\xc3\xab\xe6\x29\x3e\x48\x33\x25\xf3\x83\xdb\xc8\x50\x35\x41\x13\xbd\xba\x2b\xf5\xe6\xc1\xa8\x31\x8a\xdd\x95\x06\x5d\x37\x89\xc9\xba\x91\x37\xab\x28\x2f\x71\x24\xc7\xdd\x09\x0f\x74\xaa\xd9\xc1\xd0\xf1\x3a\xc4\x1f\x3c\xe3\x79\xd4\xcf\x9f\xc6\xac\xc9\xbd\x45\x10\x00\xd8\xd3\xf9\xdc\xf4\xd3\x97\x65\x2b\x15\xd4\xac\x85\xae\x7c\x86\x8a\x06\xc0\x52\x5e\xab\x04\x80\xba\x16\xc3\xe5\x5b\xd7\x62\x1d\xc2\xd1\x68\xbb\x3d\x13\x06\xd5\x98\xa6\xe8\x39\xb5\xeb\xbb\xbe\x8d\x04\xfa\xbb\x5c\xdb\xfe\x7e\xac\x73\x03\xd0\x2c\x53\xa8\x35\xea\x41\x3f\x56\x6c\x1f\x5b\xdf\x76\x59\xde\xb6\x63\xca\x29\x42\x99\x49\xc6\x52\x25\x1c\xa9\xc6\xf5\x18\xfd\x7b\xce\x66\x3c\x2b\x2c\x69\xb5\x9a\x28\x5a\x4c\x59\xda\x5e\x7a\x57\xfb\x4e\x0c\xa4\x12\x29\xf1\x97\x61\x6d\x50\x24\x6e\x3b\x06\xe2\x0f\xd9\xa1\xa4\x52\x68\xc9\x71\x18\xa4\x30\xf3\x06\xa4\x49\xf3\xa4\x90\xee\x56\x4d\x4e\x48\x7b\x2d\xf6\x4d\x82\xb0\xa2\x3a\x6d\xe6\xab\xcb\xe6\x95\xd4\x69\xca\x28\xec\xa6\x70\x78\x1c\x6e\xbc\xe5\xf0\x24\xec\xc4\x0f\x16\xff\x0d\x00\xa5\xf6\xb0\x8c\xe6\x0f\x00\x00"),
 		},
 		"/terraform/aarch64/generalos/openstack": &vfsgen۰DirInfo{
 			name:    "openstack",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/generalos/openstack/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 459484350, time.UTC),
-			uncompressedSize: 4738,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49021322, time.UTC),
+			uncompressedSize: 4796,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x45\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\xd2\xc9\x21\x4c\x89\x1c\xff\xa8\x69\x03\x7d\xf7\x81\x22\x25\x5b\xb2\x14\x3b\x6e\x02\x14\x45\x01\xea\x78\xf7\xfb\xdd\x1d\x79\xbc\x73\x34\x4a\x49\x72\x2e\x0b\x78\x08\x00\x24\xfe\x67\xa8\xc4\x2c\x11\x92\x57\x34\x43\xa9\x1a\x31\x00\x17\x58\x2a\x4d\xd2\x25\x9c\x79\x09\x80\xe2\x46\xa6\x08\x67\x10\x76\x20\x07\xad\xdd\x41\x67\xf0\xb6\x5b\x85\xde\xae\x42\xa9\x28\x2f\xad\xe1\x2c\x8a\xa3\xd8\xc9\xeb\xc0\xfe\xaf\x83\xa0\x85\x80\x70\x65\xd9\x70\x1a\x85\x32\x29\x49\x81\x00\xd6\xf6\xe1\x21\xba\x62\x44\x5b\xda\xe8\x46\xa1\xb4\x3b\x75\x6d\xc1\x04\x51\xea\x33\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\xbf\x9b\xad\x8f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x97\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x7e\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\xde\x11\xee\x03\xb1\x68\xd1\x85\x35\x99\x46\xbc\xe3\x4a\xdb\x3c\x0d\x41\x19\x55\xfa\x95\x43\x7e\xdd\x83\x5e\x21\xff\xe9\x4d\xeb\x7a\xca\x5b\x61\xf6\x80\xbd\xb8\xba\x99\x44\x94\xa4\xd8\x03\xf1\xfa\xfc\xc3\x24\x62\x46\xd5\x72\x0f\xc8\xf7\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\xe7\xd4\xdd\xa3\x3f\x98\xb5\x6e\xaf\x66\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x32\xf6\xf9\x1a\xc0\x3f\xbc\xc4\xe9\x0b\x61\xab\x31\x23\x9a\xec\x91\x94\xdf\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x16\x32\x4b\x4a\xd4\x4f\x75\xfd\xd2\xdb\x7e\x44\xfd\x99\xcb\xe5\xb4\xe7\x54\xec\xe1\xf3\xe5\xd5\xd0\x57\xbc\xdf\xdf\xd7\xdf\xef\xc7\x7c\x95\xe8\x9f\xd4\xd5\xcb\x97\xa4\xbc\x10\x46\x63\x92\x33\x52\x71\x99\x54\xb3\x10\x42\xb7\x76\x9c\x4d\xa5\xfb\xd7\xa6\x22\x32\xea\x3f\x01\x01\x80\x7f\x30\x37\x15\xda\x8a\xfe\xd4\x68\x46\xb4\xcc\xf0\xfe\xdf\x00\xa0\x4a\x85\x51\xa3\x88\xc2\x0c\x75\x25\x29\x60\x14\x5c\x92\x62\xa8\x6b\x2b\x68\x54\xd7\x6e\x0c\x95\xa9\x4a\x84\xb9\x65\x34\x6d\xda\x8b\x34\x38\x99\xa0\x5b\xc6\xd3\xa5\xd2\x5c\x92\x05\x26\x15\x67\xa6\xc0\xa4\x9a\x87\x10\xba\xf5\x7a\x96\x1e\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x96\xd3\x54\x98\x2e\x24\x37\xc2\x9d\x67\xfb\xe5\x7c\x5d\x1d\x58\xd3\x41\x7e\x7c\xb0\x54\xab\x26\x51\x1f\x14\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xdd\xb4\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x73\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x83\x80\xe6\x29\x67\xcd\x39\xa4\xc2\xf5\xea\x94\x66\x72\xcd\xc5\x38\x6a\xfe\xbd\x6d\x3a\x79\xfd\x28\xdb\xc1\xe1\x08\x9b\x17\x0e\xd8\x68\x5a\x7c\x33\xdd\x69\x3c\x42\xe7\x85\xcf\x1f\xdc\xd1\xd1\x7c\x84\xae\x95\x3e\x3f\xdf\x6c\x7e\xf2\xf3\xd8\xe1\xcd\x5f\x2c\xc2\xc3\x51\xc2\x56\xfa\xfc\x7c\xc7\xe3\x29\x3d\x7e\xb9\x9c\x1e\xc6\xb3\xa3\xd3\xb1\x18\x3b\xf9\x8b\x70\xbe\x8b\xc7\x39\xdf\xbd\xd4\x49\xce\xe3\x38\x1e\xe3\x9c\xcf\x4e\x8e\x4f\xbe\x17\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xa7\x47\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\x3d\x66\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x55\xcf\xf1\x76\x60\xec\x39\xfe\x13\x3c\x40\x1b\xd1\x4e\x91\x42\xfd\xda\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\xef\x31\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\x1f\xce\x20\x2c\x0d\x63\x21\xfc\xfa\xb8\xe2\x2f\x60\xd5\x1e\xbb\x82\xde\x4d\x5a\x2e\x92\x9c\x71\xa2\x69\xb9\xa0\xa2\x1d\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x36\xae\xf5\xa1\x73\xfb\xc8\xd8\x31\x12\xa5\x78\x4a\x89\xf6\x35\x90\xaf\x8b\x36\x0b\x61\xc2\x8f\x16\x2f\xa1\x02\xce\x60\x7b\x90\x91\x0f\x31\x7a\x13\x91\x2c\x93\xa8\xd4\x46\x65\x74\x99\xcd\x7a\x88\x23\x95\xdb\xb9\x13\xbd\x89\x68\xf6\xa4\x61\xcb\x0f\x85\x44\x6b\x92\xde\xb9\x04\xf4\x44\x3b\x27\xe0\x99\xdc\x05\xf0\xf4\x34\x6b\xb8\xb6\xcd\xb2\x91\x5b\x8d\x07\xce\x8d\x16\x46\xaf\xff\xba\x29\x73\xee\x22\xaa\x08\x33\xd8\xfd\xbd\xa6\xab\x88\xc1\xe9\x6d\x71\xde\x1f\x6e\x14\x47\x6b\x15\xe4\x2a\xea\x9b\xaf\x83\xab\x9c\xff\x03\x00\x00\xff\xff\x81\x9a\x0c\xab\x82\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6d\x6f\xdb\xb6\x13\x7f\xaf\x4f\x71\x7f\xfd\xf7\xa2\x29\x6a\x55\xb1\xd3\x24\x1b\x10\x0c\x41\xba\x87\x00\x6b\x11\x04\xcd\x5e\x2c\x18\x04\x5a\x3a\xdb\x84\x29\x91\xe3\x83\x9a\x34\xf0\x77\x1f\x48\x4a\xb2\x65\xd3\x8e\x93\x26\xc0\x50\x14\xa0\x8e\x77\xbf\xdf\xdd\xf1\xc8\x3b\x47\xa3\x94\x64\xc2\x65\x09\x0f\x11\x80\xc4\x7f\x0c\x95\x58\x64\x42\xf2\x9a\x16\x28\x95\x13\x03\x70\x81\x95\xd2\x24\x9f\xc3\x59\x23\x01\x50\xdc\xc8\x1c\xe1\x0c\xe2\x0e\x64\xd0\xda\x0d\x3a\x83\xf7\xdd\x2a\x6e\xec\x6a\x94\x8a\xf2\xca\x1a\x0e\x93\x34\x49\xbd\x7c\x11\xd9\xff\x8b\x28\x6a\x21\x20\x5e\x5a\x3a\x4e\xa3\x50\x66\x15\x29\x11\xc0\xda\x3e\x3c\x24\x57\x8c\x68\x4b\x9b\xdc\x28\x94\x76\x67\xb1\xb0\x60\x82\x28\xf5\x95\xcb\x02\x36\x15\xaf\x9a\x2d\xaf\xa8\xb1\x22\x95\xf6\x98\x6b\x8a\x5f\xdc\xd6\xe7\x0e\x93\x18\x3d\xcb\x8c\x64\x01\xcc\x73\xa3\x67\x37\xd7\x7f\x78\x3d\x89\x53\x1b\x1b\x04\xf4\xae\xdd\x96\x55\x5b\x44\x51\x4d\x24\x25\x63\x86\x10\xe7\xcc\x28\x8d\x32\xa3\x85\x0f\x53\xdf\x0b\xf4\xd6\x4a\x4b\x5a\x4d\x23\x80\x02\x27\xc4\x30\xdd\x00\x5e\x78\x83\xcb\x8f\x1b\x50\xd4\xa6\xab\xca\x31\xcb\xb9\xa9\xf4\x9e\x70\x9f\x88\x45\x4b\x2e\xac\xc9\x76\xc4\x19\x57\xda\xe6\x69\x1d\x94\x51\xa5\xdf\x78\xe4\x83\x1e\xf4\x12\xf9\xf7\xc6\x74\xb1\xd8\xe6\xad\x30\xcf\x80\xbd\xb8\xba\xd9\x8a\x28\x49\xf9\x0c\xc4\xeb\xf3\x4f\x5b\x11\x0b\xaa\xe6\xcf\x80\xfc\x48\xd5\x7c\x2b\x26\x57\xb4\x24\x53\xdc\xf3\x9c\xba\x3a\xfa\x8d\x59\xeb\xb6\x34\x7b\xd0\xa4\x26\x94\x91\x31\x65\x54\xdf\x67\xdf\x78\xf5\x64\xec\xf3\x15\x80\xbf\x78\xd5\x32\xfc\x1f\xc6\x44\xe1\xf1\x11\x60\x95\xf3\x02\x0b\x77\x17\x07\x05\xd1\x04\xf8\x04\x90\xe4\x33\x68\xa3\x7a\x07\xcd\x05\x2e\x60\x7c\x0f\xd5\xbc\x00\x3d\x93\xdc\x4c\x67\xf0\xe5\xd7\xec\xcf\xf3\xeb\xac\x0b\xdf\xdd\x67\x8b\x11\xca\x4d\xb7\xd9\x8f\x60\x47\xda\xed\xd6\xed\xdf\x11\x80\xc2\x4a\x51\x4d\x6b\x84\x33\xd0\xd2\xe0\x7a\xf6\xb5\x7d\x2b\x58\x56\xa1\x7e\x6a\x76\x2e\x1b\xdb\xcf\xa8\xbf\x72\x39\xdf\x7e\x5b\xa8\x78\x46\xad\x5c\x5e\xad\x57\x0a\xde\x3d\xdf\xd7\x5f\xee\x42\xbe\x4a\x6c\x5e\xed\xe5\xe3\x9a\xe5\xbc\x14\x46\x63\x36\x61\xa4\xe6\x32\xab\x87\x31\xc4\x7e\xed\x39\xdd\x63\xd2\xe4\xbe\x26\x32\xe9\xbf\x32\x11\x40\xf3\x26\x6f\x2a\xb4\x8f\xc6\xad\xd3\x4c\x68\x55\xe0\x9d\x3d\xa1\x3a\x17\x46\x05\x11\x85\x59\xd7\x95\xa4\x84\x20\xb8\x24\xe5\xba\xae\xbd\xa4\x41\x5d\xbb\xb1\xae\x4c\x55\x26\xcc\x98\xd1\xdc\x75\x30\x69\x70\x6b\x82\xc6\x8c\xe7\x73\xa5\xb9\x24\x53\xcc\x6a\xce\x4c\x89\x59\x3d\x8a\x21\xf6\xeb\xd5\x2c\xed\xcc\xd0\x9e\xd9\x51\xf4\x1b\xee\x11\xc1\x23\xa7\xa9\x30\x9f\x4a\x6e\x84\x3f\xcf\xf6\xcb\xfb\xba\x3c\x30\xd7\xa4\x7e\x78\xb0\x54\xcb\x3e\xb4\x18\x94\xae\x22\x63\x57\x5d\x2a\x97\x54\xe8\xa6\x61\xb7\x38\x30\xe1\x12\xe6\xa7\x0a\x5a\xcd\x08\x40\x1a\x86\x0e\x1e\x60\x22\x79\x99\x09\x2e\xfd\xad\x1c\x0e\x9d\x50\xf3\x56\xb4\x22\xa4\xc2\xce\x1a\x9a\xe7\x9c\xb9\x73\xc8\x45\xec\xe4\x39\x2d\xe4\x8a\x8b\x69\xe2\xfe\xbd\x77\xc3\xc2\x62\x27\xdb\xe0\x30\xc0\x36\x38\x0c\xb1\xd1\xbc\xfc\x6e\xba\xd3\x34\x40\x77\x9a\x86\xe8\x5e\x20\xb8\xa3\xa3\x51\x80\xae\x95\xbe\x3c\xdf\x70\x74\xf2\x63\xe8\xf0\x46\xaf\x16\xe1\x61\x90\xb0\x95\xbe\x3c\xdf\x71\x38\xa5\xc7\xaf\x97\xd3\xc3\x74\x78\x74\x1a\x8a\xb1\x93\xbf\x0a\xe7\x87\x34\xcc\xf9\xe1\xb5\x4e\x72\x94\xa6\x69\x88\x73\x34\x3c\x39\x3e\xf9\xaf\x70\x9a\x62\x2f\xce\x47\x1e\xdb\xee\x99\x76\x8f\x6d\xfb\xb5\xde\x3e\x01\xc2\x9d\x6c\xb3\x8f\x6e\xd3\xdc\xd6\x32\xdc\x1c\x99\xf5\xec\xd7\x2c\x9b\x59\x33\x02\x68\xda\x7c\xbf\x07\xec\xd7\x98\x30\x37\xd2\x4e\x96\xae\x05\x28\x6f\x79\xbb\xb3\xf5\x24\xed\x3a\xb1\x80\x16\x64\x63\x44\x6d\xe9\x37\x36\xda\x9f\x7c\x6e\xcc\x5c\x89\x4b\xcb\xfb\x37\x7e\x1c\x2d\xd0\x4e\xa3\x6f\x7a\xce\x77\x26\x3d\xef\x0f\xde\x41\x1c\x1f\x44\x11\x40\xe5\xe7\xa1\xa6\x7a\x42\x59\x58\xce\x5c\xbe\xc0\xe8\x1d\x16\x19\x15\x59\x7d\xb4\x9e\x28\x2a\x7a\x24\xf0\xbf\x33\x88\x2b\xc3\x58\x0c\x3f\xef\x56\xfc\x09\xac\xda\xae\xca\x6a\xdc\xa4\xd5\x34\x9b\x30\x4e\x34\xad\xa6\x54\xb4\xb3\x99\xfd\x16\x7b\x8c\x1d\x82\x73\xd6\xc6\xb5\x3a\x4b\x3e\x3e\x09\x76\x8c\x44\x29\x9e\x53\xa2\x9b\xd2\x9e\xac\x8a\x36\xeb\x7b\x8b\x1f\x2d\x5e\x46\x05\x9c\xc1\xe3\x41\x26\x4d\x88\xc9\xdb\x84\x14\x85\x44\xa5\x36\x0a\xbe\xcb\x6c\xd1\x43\x0c\x5c\xc8\xce\x9d\xe4\x6d\x42\x8b\x27\xcd\x50\xcd\xac\x47\xb4\x26\xf9\xcc\x27\xa0\x27\xda\x3b\x01\x2f\xe4\x2e\x40\x43\x4f\x0b\x80\x1e\x50\x78\x44\x4d\xfc\x2a\x1c\x38\x37\x5a\x18\xbd\xfa\xa3\xa5\x9a\x70\x1f\x51\x4d\x98\xc1\xee\x2f\x3d\xdd\x8d\xa0\xe2\x29\xce\x37\x87\x9b\xa4\xc9\xca\x0d\xf2\x37\xea\xbb\xcb\xc1\xdf\x9c\x7f\x07\x00\xad\x20\xa0\x37\xbc\x12\x00\x00"),
 		},
 		"/terraform/aarch64/generalos/openstack/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
-			uncompressedSize: 4719,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 48823064, time.UTC),
+			uncompressedSize: 4777,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\x8f\x37\x62\x65\x4b\xd5\x1f\x27\x77\xc1\xdf\xbd\xc8\x92\xbd\x6b\xaf\x9d\xdd\xec\x25\x50\x8e\x03\x79\x34\xf3\xfb\xcd\x8c\x34\x9a\xd9\x68\x94\x92\xe4\x5c\x16\xf0\x18\x00\x48\xfc\xc7\x50\x89\x59\x22\x24\xaf\x68\x86\x52\x35\x62\x00\x2e\xb0\x54\x9a\xa4\x4b\x38\xf3\x12\x00\xc5\x8d\x4c\x11\xce\x20\xec\x40\x0e\x5a\xbb\x83\xce\xe0\x7d\xb7\x0a\xbd\x5d\x85\x52\x51\x5e\x5a\xc3\x59\x14\x47\xb1\x93\xd7\x81\xfd\x5f\x07\x41\x0b\x01\xe1\xca\xb2\xe1\x34\x0a\x65\x52\x92\x02\x01\xac\xed\xe3\x63\x74\xc5\x88\xb6\xb4\xd1\x8d\x42\x69\x77\xea\xda\x82\x09\xa2\xd4\x3d\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\x3f\x9a\xad\x4f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x9b\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x71\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\x76\x70\x7d\xdb\x3f\xb9\x5c\xa2\x8c\x2e\xec\xfe\xb4\xf9\x1d\x57\xda\x26\x65\xe8\x10\xa3\x4a\xbf\x71\x5e\xbd\xed\x41\xaf\x90\x7f\xf5\xa6\x75\x3d\xe5\x9a\x30\x7b\xc0\x5e\x5c\xdd\x4c\x22\x4a\x52\xec\x81\x78\x7d\xfe\xfb\x24\x62\x46\xd5\x72\x0f\xc8\x8f\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\x67\xdc\x5d\x9a\x5f\x98\xb5\x6e\xef\x61\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x36\xf6\xf9\x1a\xc0\x5f\xbc\xc4\xe9\x0b\x61\x4b\x2f\x23\x9a\xec\x91\x94\x9f\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x56\x2d\x4b\x4a\xd4\xcf\x75\xfd\xd2\xdb\x7e\x42\x7d\xcf\xe5\x72\xda\x73\x2a\xf6\xf0\xf9\xf2\x6a\xe8\x2b\x3e\xec\xef\xeb\xcf\x0f\x63\xbe\x4a\xf4\xef\xe7\xea\x99\x4b\x52\x5e\x08\xa3\x31\xc9\x19\xa9\xb8\x4c\xaa\x59\x08\xa1\x5b\x3b\xce\xa6\xac\xfd\xd3\x52\x11\x19\xf5\xeb\x3d\x00\xf0\xaf\xe3\xa6\x42\x5b\xd1\x9f\x1b\xcd\x88\x96\x19\x3e\xfc\x1d\x00\x54\xa9\x30\x6a\x14\x51\x98\xa1\xae\x24\x05\x8c\x82\x4b\x52\x0c\x75\x6d\x05\x8d\xea\xda\x8d\xa1\x32\x55\x89\x30\xb7\x8c\xa6\x4d\x2f\x91\x06\x27\x13\x74\xcb\x78\xba\x54\x9a\x4b\xb2\xc0\xa4\xe2\xcc\x14\x98\x54\xf3\x10\x42\xb7\x5e\xcf\xd2\x93\x19\xda\x31\x3b\x8a\x7e\xc5\x1d\x22\xd8\x72\x9a\x0a\xd3\x85\xe4\x46\xb8\xf3\x6c\xbf\x9c\xaf\xab\x03\x6b\xda\xc5\xff\x1f\x2d\xd5\xaa\x23\xd4\x07\xf7\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xad\xb3\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x4d\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x5d\x5f\xf3\x94\xb3\xe6\x1c\x52\xe1\x1a\x73\x4a\x33\xb9\xe6\x62\x1c\x35\xff\xde\x37\x6d\xbb\x7e\x92\xed\xe0\x70\x84\xcd\x0b\x07\x6c\x34\x2d\xbe\x99\xee\x34\x1e\xa1\xf3\xc2\x97\x0f\xee\xe8\x68\x3e\x42\xd7\x4a\x5f\x9e\x6f\x36\x3f\xf9\x7e\xec\xf0\xe6\xaf\x16\xe1\xe1\x28\x61\x2b\x7d\x79\xbe\xe3\xf1\x94\x1e\xbf\x5e\x4e\x0f\xe3\xd9\xd1\xe9\x58\x8c\x9d\xfc\x55\x38\x3f\xc4\xe3\x9c\x1f\x5e\xeb\x24\xe7\x71\x1c\x8f\x71\xce\x67\x27\xc7\x27\xff\x15\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xe7\x27\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\xf1\x65\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x4d\xcf\xf1\x76\x60\xec\x39\xfe\x1d\x3c\x42\x1b\xd1\x4e\x91\x42\xfd\xd6\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\x1f\x30\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\xff\x9d\x41\x58\x1a\xc6\x42\xf8\xf1\x69\xc5\x1f\xc0\xaa\x3d\x75\x05\xbd\x9b\xb4\x5c\x24\x39\xe3\x44\xd3\x72\x41\x45\x3b\xc4\xd9\x6f\xb1\xc3\x7c\x22\x38\x67\x6d\x5c\xeb\x43\xe7\xf6\x91\xb1\x63\x24\x4a\xf1\x94\x12\xed\x6b\x20\x5f\x17\x6d\x16\xc2\x84\x1f\x2d\x5e\x42\x05\x9c\xc1\xf6\x20\x23\x1f\x62\xf4\x2e\x22\x59\x26\x51\xa9\x8d\xca\xe8\x32\x9b\xf5\x10\x47\x2a\xb7\x73\x27\x7a\x17\xd1\xec\x59\xc3\x96\x1f\x0a\x89\xd6\x24\xbd\x73\x09\xe8\x89\x76\x4e\xc0\x0b\xb9\x0b\xe0\xe9\x69\xd6\x70\x6d\x9b\x65\x23\xb7\x1a\x0f\x9c\x1b\x2d\x8c\x5e\xff\x75\x53\xe6\xdc\x45\x54\x11\x66\xb0\xfb\xe3\x4c\x57\x11\x83\xd3\xdb\xe2\xbc\x3f\xdc\x28\x8e\xd6\x2a\xc8\x55\xd4\x37\x5f\x07\x57\x39\xff\x06\x00\x00\xff\xff\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6d\x6b\x23\xb7\x13\x7f\xbf\x9f\x62\xfe\xfb\xef\x8b\xe4\x38\xef\x6d\xec\x5c\x92\x16\x42\x09\xb9\x3e\x04\xda\x23\x84\x4b\x0b\x0d\x65\x91\x77\xc7\xb6\xb0\xbc\x52\xf5\xb0\x49\x2e\xf8\xbb\x17\x49\xbb\x6b\xaf\x2d\x3b\x8e\x2f\x81\x72\x1c\x68\x47\x33\xbf\xdf\xcc\x68\xa4\x19\x47\xa3\x94\x64\xc4\xe5\x0c\x9e\x22\x00\x89\xff\x18\x2a\xb1\xc8\x84\xe4\x15\x2d\x50\x2a\x27\x06\xe0\x02\x4b\xa5\x49\x3e\x85\xf3\x5a\x02\xa0\xb8\x91\x39\xc2\x39\xc4\x2d\x48\xaf\xb1\xeb\xb5\x06\x1f\xda\x55\x5c\xdb\x55\x28\x15\xe5\xa5\x35\xec\x27\x69\x92\x7a\xf9\x3c\xb2\xff\xe7\x51\xd4\x40\x40\xbc\xb0\x74\x9c\x46\xa1\xcc\x4a\x32\x43\x00\x6b\xfb\xf4\x94\x5c\x33\xa2\x2d\x6d\x72\xab\x50\xda\x9d\xf9\xdc\x82\x09\xa2\xd4\x3d\x97\x05\xac\x2b\x5e\xd7\x5b\x5e\x51\x63\x49\x4a\xed\x31\x57\x14\xbf\xb8\xad\xcf\x2d\x26\x31\x7a\x92\x19\xc9\x02\x98\x17\x46\x4f\x6e\x6f\x7e\xf3\x7a\x12\xc7\x36\x36\x08\xe8\xdd\xb8\x2d\xab\x36\x8f\xa2\x8a\x48\x4a\x86\x0c\x21\xce\x99\x51\x1a\x65\x46\x0b\x1f\xa6\x7e\x14\xe8\xad\x95\x96\xb4\x1c\x47\x00\x05\x8e\x88\x61\xba\x06\xbc\xf4\x06\x57\x9f\xd6\xa0\xa8\x4d\x57\x99\x63\x96\x73\x53\x6a\x0f\xd7\xb5\xfd\x93\xcb\x29\xca\xe4\xd2\xee\x6f\x36\x9f\x70\xa5\x6d\x52\x56\x1d\x62\x54\xe9\x03\xef\xd5\x61\x07\x7a\x81\xfc\x6b\x6d\x3a\x9f\x6f\x72\x4d\x98\x3d\x60\x2f\xaf\x6f\x37\x22\x4a\x32\xdb\x03\xf1\xe6\xe2\xf7\x8d\x88\x05\x55\xd3\x3d\x20\x3f\x51\x35\xdd\x88\xc9\x15\x9d\x91\x31\xee\x78\xc6\x6d\xd1\xfc\xc2\xac\x75\x53\x87\x1d\x68\x52\x11\xca\xc8\x90\x32\xaa\x1f\xb3\xaf\xbc\x7c\x31\xf6\xc5\x12\xc0\x5f\xbc\x6c\x18\xfe\x0f\x43\xa2\xf0\xe4\x18\xb0\xcc\x79\x81\x85\xbb\x78\xbd\x82\x68\x02\x7c\x04\x48\xf2\x09\x34\x51\xbd\x87\xfa\xb6\x16\x30\x7c\x84\x72\x5a\x80\x9e\x48\x6e\xc6\x13\xf8\xf2\x73\xf6\xc7\xc5\x4d\xd6\x86\xef\x2e\xaf\xc5\x08\xe5\xa6\xdd\xec\x46\xb0\x25\xed\x76\xeb\xee\xef\x08\x40\x61\xa9\xa8\xa6\x15\xc2\x39\x68\x69\x70\x35\xfb\xda\x3e\x0c\x2c\x2b\x51\xbf\x34\x3b\x57\xb5\xed\x67\xd4\xf7\x5c\x4e\x37\xdf\x16\x2a\xf6\xa8\x95\xab\xeb\xd5\x4a\xc1\x87\xfd\x7d\xfd\xe9\x21\xe4\xab\xc4\xfa\x89\x5e\xbc\xa4\x59\xce\x67\xc2\x68\xcc\x46\x8c\x54\x5c\x66\x55\x3f\x86\xd8\xaf\x3d\xa7\x7b\x39\xea\xdc\x57\x44\x26\xdd\x27\x25\x02\xa8\x1f\xe0\x75\x85\xe6\xd1\xb8\x73\x9a\x09\x2d\x0b\x7c\xb0\x27\x54\xe5\xc2\xa8\x20\xa2\x30\xab\xba\x92\xcc\x20\x08\x2e\xc9\x6c\x55\xd7\x5e\xd2\xa0\xae\xdd\x58\x55\xa6\x2a\x13\x66\xc8\x68\xee\xda\x95\x34\xb8\x31\x41\x43\xc6\xf3\xa9\xd2\x5c\x92\x31\x66\x15\x67\x66\x86\x59\x35\x88\x21\xf6\xeb\xe5\x2c\x6d\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x33\xa7\xa9\x30\x1f\x4b\x6e\x84\x3f\xcf\xe6\xcb\xfb\xba\x38\x30\xd7\x91\xbe\x7b\xb2\x54\x8b\xa6\x33\xef\xdd\xbb\x8a\x8c\x5d\x75\xa9\x5c\x52\xa1\xeb\xee\xdc\xe0\xc0\x88\x4b\x98\x9e\x29\x68\x34\x23\x00\x69\x18\x3a\x78\x80\x91\xe4\xb3\x4c\x70\xe9\x6f\x65\xbf\xef\x84\x9a\x37\xa2\x25\x21\x15\x76\xb0\xd0\x3c\xe7\xcc\x9d\x43\x2e\x62\x27\xcf\x69\x21\x97\x5c\x4c\x13\xf7\xef\x83\x9b\x0c\xe6\x5b\xd9\x7a\x47\x01\xb6\xde\x51\x88\x8d\xe6\xb3\x6f\xa6\x3b\x4b\x03\x74\x67\x69\x88\xee\x15\x82\x3b\x3e\x1e\x04\xe8\x1a\xe9\xeb\xf3\xf5\x07\xa7\xdf\x87\x0e\x6f\xf0\x66\x11\x1e\x05\x09\x1b\xe9\xeb\xf3\x9d\x84\x53\x7a\xf2\x76\x39\x3d\x4a\xfb\xc7\x67\xa1\x18\x5b\xf9\x9b\x70\x7e\x4c\xc3\x9c\x1f\xdf\xea\x24\x07\x69\x9a\x86\x38\x07\xfd\xd3\x93\xd3\xff\x0a\xa7\x29\x76\xe2\x7c\xe6\xb1\x6d\x9f\x69\xf7\xd8\x36\x5f\xab\xed\x13\x20\xdc\xc9\xd6\xfb\xe8\x26\xcd\x4d\x2d\xc3\xcd\x91\x59\xc7\x7e\xc5\xb2\x9e\x35\x23\x80\xba\xcd\x77\x7b\xc0\x6e\x8d\x09\x73\x23\xed\x64\xe9\x5a\x80\xf2\x96\x77\x5b\x5b\x4f\xd2\xac\x13\x0b\x68\x41\xd6\x46\xd4\x86\x7e\x6d\xa3\xf9\x7d\xe7\xc6\xcc\xa5\xb8\xb4\x7c\x3c\xf0\xe3\x68\x81\x76\x1a\x3d\xe8\x38\xdf\x9a\x74\xbc\x3f\x7c\x0f\x71\x7c\x18\x45\x00\xa5\x9f\x87\xea\xea\x09\x65\x61\x31\x73\xf9\x02\xa3\x0f\x58\x64\x54\x64\xd5\xf1\x6a\xa2\xa8\xe8\x90\xc0\xff\xce\x21\x2e\x0d\x63\x31\xfc\xb8\x5d\xf1\x07\xb0\x6a\xdb\x2a\xab\x76\x93\x96\xe3\x6c\xc4\x38\xd1\xb4\x1c\x53\xd1\xcc\x66\xf6\x5b\xec\x30\x76\x08\xce\x59\x13\xd7\xf2\x2c\xf9\xfc\x24\xd8\x32\x12\xa5\x78\x4e\x89\xae\x4b\x7b\xb4\x2c\x5a\xaf\xef\x0d\x7e\x34\x78\x19\x15\x70\x0e\xcf\x07\x99\xd4\x21\x26\xef\x12\x52\x14\x12\x95\x5a\x2b\xf8\x36\xb3\x45\x07\x31\x70\x21\x5b\x77\x92\x77\x09\x2d\x5e\x34\x43\xd5\xb3\x1e\xd1\x9a\xe4\x13\x9f\x80\x8e\x68\xe7\x04\xbc\x92\xbb\x00\x35\x3d\x2d\x00\x3a\x40\xe1\x11\x35\xf1\xab\x70\xe0\xdc\x68\x61\xf4\xf2\x8f\x96\x72\xc4\x7d\x44\x15\x61\x06\xdb\x3f\xeb\xb4\x37\x82\x8a\x97\x38\x5f\x1f\x6e\x92\x26\x4b\x37\xc8\xdf\xa8\x6f\x2e\x07\x7f\x73\xfe\x1d\x00\x1b\xcb\xa8\x6c\xa9\x12\x00\x00"),
 		},
 		"/terraform/aarch64/nestos": &vfsgen۰DirInfo{
 			name:    "nestos",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 461484369, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/nestos/libvirt": &vfsgen۰DirInfo{
 			name:    "libvirt",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/nestos/libvirt/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
-			uncompressedSize: 3229,
+			modTime:          time.Date(2026, 10, 19, 17, 0, 35, 52149500, time.UTC),
+			uncompressedSize: 3378,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\xdf\x6f\xe3\x36\x0c\x7e\xf7\x5f\xc1\x09\x7b\xb8\x2b\x56\xb7\x1b\x0e\x1b\x30\x20\x18\xb6\x14\xd8\xf2\x70\x58\xd1\xed\x9e\x0e\x85\xa1\xda\x8c\x43\xc4\x96\x3c\x49\x76\x97\x05\xfe\xdf\x07\xc9\x92\x7f\x24\x76\x2f\x97\x02\x8d\x6d\x99\xfc\xf8\x89\xe4\x47\xd9\xa0\x52\x7c\x2b\x55\x09\xc7\x08\x40\xe1\x3f\x35\x29\xcc\x92\x4a\xc9\x86\x32\x54\xda\x2d\x03\x14\xf4\xd2\x90\x32\xb0\xf2\xcf\x00\x5a\xd6\x2a\x45\x58\x01\xcb\x4a\x9e\x36\x94\x72\x75\xe7\xad\x98\x37\x69\x50\x69\x92\xc2\xda\xdc\xc7\x3f\xc5\x3f\x76\xeb\x6d\x64\xff\xdb\x28\x0a\x31\x80\x05\x3f\x07\x5e\x2b\xb2\x1e\xc7\x63\xfc\x58\x70\x63\xa9\xc5\x9f\x9e\x36\x6d\xcb\xac\x4f\xc3\x15\xf1\x97\x02\x81\xa5\x45\xad\x0d\xaa\x84\xb2\xce\xcd\x1c\x2a\xb4\xf0\x2b\xd0\x46\x91\xc8\x23\x80\x0c\xb7\xbc\x2e\x8c\x47\x5b\x77\x0e\x9b\x87\x33\x28\x12\xda\x70\x91\x62\x92\xca\x5a\x98\x0b\xe1\x3e\x72\x8b\x16\xaf\xad\xcb\x32\xe2\x4e\x6a\x23\x78\x89\xa7\xa0\x05\x69\xf3\xae\x43\x7e\x3f\x81\x1e\x90\xff\xf0\xae\x6d\xbb\x80\x4d\xd5\x15\xa8\x9b\xc7\x53\xbc\x3e\x91\x55\xf3\x21\x49\x29\x53\x17\x26\xa0\xaf\xce\x7a\xf3\xf0\xb4\x5c\x1e\x8b\x9a\x73\x83\xaf\xfc\xf0\xb5\xc0\xbf\x77\x6e\x6f\xd4\xab\xaa\xaf\x48\xc1\xfa\xf1\xd3\x62\x4e\x15\x2f\xaf\x40\x7c\xfa\xf5\xe3\x22\x62\x46\x7a\x7f\x05\xe4\x03\xe9\xfd\x72\xe5\x73\x71\x05\xe4\x6f\x52\x9a\xb5\x14\x5b\xca\x3b\x60\x85\x5e\xc3\x41\x7f\x49\x25\x65\xc1\x80\x75\x17\x8b\x6f\xfb\xcf\x56\xe5\xdb\x63\xc3\x55\x3c\x48\xae\xbd\x75\x36\x81\x81\x1d\x02\xa4\xec\x63\xc5\xcd\xce\x3e\xde\x35\xdd\x38\x08\x23\xe1\x8e\x4a\x9e\xa3\xbe\x3b\x03\x62\xf3\x4c\x1a\x59\xd4\x56\x35\x2c\xdc\xf4\x6c\x60\x9e\x8f\xb7\xb3\x14\xa4\x2c\x7c\x4a\x86\x5d\xc5\xee\xc7\x02\x44\xe3\xd1\x35\xee\xb6\x3f\xff\xda\x58\x8e\xed\x17\x29\x0d\x15\x75\xf3\x02\xfa\xbf\x15\x58\x56\xd3\x69\x32\xd0\x1e\xac\x3c\xfd\xb3\x21\xf1\xd9\x79\xc4\x24\x32\xfc\xf7\xb9\xbd\x75\x71\x22\x80\x17\xae\xd1\x47\x4f\x28\x1b\x6d\xac\x5b\x8b\xfd\x85\xb2\x61\xf3\x43\xac\xe5\x24\xd0\x7f\x53\x56\x13\x4a\x36\xf6\x84\x0e\xdc\xc0\xf7\xf7\x3f\x7c\x98\x5e\xe6\x13\x45\xb9\x20\x43\x52\x30\x60\xc3\xed\x38\x5d\x5f\xc8\xd3\xc5\x09\xea\xd1\x47\x1b\x7f\x63\xc7\xa9\x14\x06\x85\xd5\x84\xc1\xb2\x2a\xb8\xc1\x2d\x15\xf8\x6e\x12\x89\x72\x31\x09\xf2\x1d\x1c\x21\x44\x3f\xe5\x3d\xcb\x0a\xda\xf7\xf3\x59\x11\x68\x5e\xa5\xda\x33\x60\xfd\xdd\x71\xd2\x1c\xb3\x6d\x2d\xd0\x9d\xa6\xa5\xcc\x06\x2b\xc1\xdd\x5a\x26\x4b\x4e\x62\xc9\x33\x2e\x64\xca\x9d\x42\x79\x96\x29\xd4\x1a\x35\xac\xe0\xf3\xc4\x2a\x0c\xfc\xe7\xc8\xc2\xed\xd2\xca\x1f\xee\x28\xec\xbc\xb1\x9d\x66\x54\x8d\xee\xb8\xb6\x06\x42\x9f\xbc\x77\xa1\xbd\x09\x80\x0b\x98\x48\x51\x1c\x4e\x1c\x95\xac\x0d\x06\x5f\x1b\x6f\xe8\xb7\x33\x2a\xce\xc6\x1f\x17\x73\x36\xfe\x55\x80\xe6\xb5\x91\xda\x70\xf7\x61\xe2\x42\xce\xa6\xbe\xcb\x94\xcb\xbc\x36\x52\xcf\x6a\xf7\x42\xf1\x5e\xd4\x03\x16\xbd\xaa\xfd\x86\x5d\xe9\x56\xc0\xac\xe5\x6d\xc5\xb5\x36\x3b\x25\xeb\x7c\xc7\xa2\xee\x53\xa8\xb1\xa6\x6f\xf0\xa8\xea\x53\xec\x12\x4b\xa9\x0e\x8b\x1e\x8a\x97\x67\x6c\xa4\x42\xa9\x7b\x61\x8e\x44\x12\x96\xe2\xfe\xe6\x26\xa6\xec\x2c\x22\x4f\x77\x24\x70\x3c\xc2\xdc\xb1\xe2\x56\xff\x3e\x54\x6e\x68\x8e\xab\x11\xcc\x7c\x1b\x84\x63\x6a\xe4\xbf\x6f\x4a\xe6\xba\x8a\xf4\xde\x67\xea\x8d\x11\x67\xad\x66\x99\xb9\x2e\xf0\x82\x4a\x48\x18\x54\x5b\x9e\xa2\x07\x0c\xeb\xfd\xb9\x71\xa2\xc5\xb8\xbf\x76\x03\x02\x06\xa9\x7f\x55\xb1\xc7\x1a\x9b\xf3\xa3\x6a\x3a\x22\xbe\xb1\x22\xae\x8b\x82\xc1\x2f\x9d\x22\x97\x2c\x9f\xe1\x67\xb0\x86\x2e\xc6\x2b\x27\x93\x6c\xa5\x4a\x0a\xe4\x1a\x4f\x34\x96\x2b\x5e\xed\x28\x0d\x2a\x1b\x27\x7c\x05\xac\x11\x29\xf3\x9f\xf1\xda\xa0\x48\xc2\xa1\xed\x69\xb3\x80\x92\x4a\xa1\x65\x81\xf3\x20\x95\x39\x74\x20\x86\xab\x1c\xed\x7c\x75\xb2\x63\xf7\x2c\x7c\xd0\xcb\xda\x54\xb5\x01\x66\x95\xda\x89\xac\xe1\x45\x8d\xa3\xc4\x77\x4a\x8c\x3b\x1d\xc6\x37\xf1\x59\xe5\xe2\xfb\xb8\xcf\x65\xd4\xfe\x1f\x00\x00\xff\xff\x8e\xbd\x3a\xb4\x9d\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\xdf\x6f\xe3\x36\x0c\x7e\xcf\x5f\xc1\x09\x7b\xb8\x2b\x56\xb7\x1b\x0e\x1b\x30\x20\x18\xb6\x16\xd8\xf2\x70\x5b\xd1\xdb\x3d\x15\x85\xa1\xda\x8c\x43\xd4\x96\x3c\x49\x76\x97\x05\xfe\xdf\x07\xc9\x92\x7f\xc4\x76\x2e\x97\x02\x8d\x6d\x85\xfc\xf8\x89\xe4\x47\xc5\x06\x95\xe2\x5b\xa9\x0a\x38\xac\x00\x14\xfe\x53\x91\xc2\x34\x2e\x95\xac\x29\x45\xa5\xdd\x32\x40\x4e\x2f\x35\x29\x03\x6b\xff\x0c\xa0\x65\xa5\x12\x84\x35\xb0\xb4\xe0\x49\x4d\x09\x57\x37\xde\x8a\x79\x93\x1a\x95\x26\x29\xac\xcd\x6d\xf4\x53\xf4\x63\xbb\xde\xac\xec\x7f\xb3\x5a\x85\x18\xc0\x82\x9f\x03\xaf\x14\x59\x8f\xc3\x21\x7a\xc8\xb9\xb1\xd4\xa2\xcf\x8f\x9b\xa6\x61\xd6\xa7\xe6\x8a\xf8\x4b\x8e\xc0\x92\xbc\xd2\x06\x55\x4c\x69\xeb\x66\xf6\x25\x02\x00\xac\x41\x1b\x45\x22\x5b\x01\xa4\xb8\xe5\x55\x6e\x3c\xda\x5d\xeb\xb0\xb9\x9f\x40\x91\xd0\x86\x8b\x04\xe3\x44\x56\xc2\x9c\x09\xf7\x91\x5b\xb4\xe8\xce\xba\x2c\x23\xee\xa4\x36\x82\x17\x78\x0c\x9a\x93\x36\xef\x5a\xe4\xf7\x23\xe8\x1e\xf9\x0f\xef\xda\x34\x0b\xd8\x54\x5e\x80\xba\x79\x58\xc4\xd3\x86\x1b\x4a\x62\x81\xe6\x4d\xaa\xd7\x0b\xb0\x3f\x39\x80\x3f\x5b\xff\xa6\x59\xa8\x57\x59\x7f\x88\x13\x4a\xd5\x99\x79\xee\x9a\xe0\x6e\x73\xff\xb8\xdc\x05\x16\x35\xe3\x06\xdf\xf8\xfe\x6b\x81\x7f\x6f\xdd\x4e\xb4\x45\x59\x5d\x90\x8d\xbb\x87\xcf\x8b\xa9\x56\xbc\xb8\x00\xf1\xf1\xd7\x8f\x8b\x88\x29\xe9\x4b\x4a\x76\x4f\xfa\x75\xb9\xc1\x32\x71\x01\xe4\x6f\x52\x9a\x3b\x29\xb6\x94\xb5\xc0\x0a\xfd\xa8\x08\x32\x8f\x4b\x29\x73\x06\xac\xbd\x58\x7c\xdb\xe6\xb6\x2a\xdf\x1e\x6a\xae\xa2\x5e\xd9\xcd\xb5\xb3\x09\x0c\xec\xac\x21\x65\x1f\x4b\x6e\x76\xf6\xf1\xa6\x6e\xa7\x4e\x98\x3c\x37\x54\xf0\x0c\xf5\xcd\x04\x88\xcd\x33\xa9\x65\x5e\x59\x71\xb2\x70\xd3\xb1\x81\x79\x3e\xde\xce\x52\x90\x32\xf7\x29\xe9\x77\x15\xb9\x0f\x0b\xb0\x1a\x4e\xc8\x61\xb7\xfd\xf5\x69\x63\x39\x36\x5f\xa4\xd4\x57\xd4\x8d\x25\xe8\xfe\xd6\x60\x59\x8d\x87\x56\x4f\xbb\xb7\xf2\xf4\x27\xb3\xe8\xc9\x79\x44\x24\x52\xfc\xf7\xb9\xb9\x76\x71\x56\x00\x2f\x5c\xa3\x8f\x1e\x53\x3a\xd8\x58\xbb\x16\xf9\x0b\xa5\xfd\xe6\xfb\x58\xcb\x49\xa0\xff\xc6\xac\x46\x94\x6c\xec\x11\x1d\xb8\x82\xef\x6f\x7f\xf8\x30\xbe\xcc\x27\x8a\x32\x41\x86\xa4\x60\xc0\xfa\xdb\x61\xba\xbe\x90\xa7\xb3\x13\xd4\xa1\x0f\x36\x7e\x62\xc7\x89\x14\x06\x85\x81\x35\x18\x2c\xca\x9c\x1b\xdc\x52\x8e\xef\x46\x91\x28\x13\xa3\x20\xdf\xc1\x01\x42\xf4\x63\xde\xb3\xac\xa0\x79\x3f\x9f\x95\x6e\x7a\xb3\xd1\x1c\xef\x9b\x63\xb6\xad\x05\xba\x43\xbb\x90\x69\x6f\x25\xb8\x5b\x4b\x65\xc1\x49\x2c\x79\x46\xb9\x4c\xb8\x53\x28\x4f\x53\x85\x5a\xa3\x86\x35\x3c\x8d\xac\xc2\xc0\x7f\x5e\x59\xb8\x5d\x52\x3a\x46\x00\x28\xec\xbc\xb1\x9d\x66\x54\x85\xee\x57\x81\x35\x10\xfa\xe8\x7b\x80\xde\x04\xc0\x05\x8c\xa5\xc8\xf7\x47\x8e\x4a\x56\x06\x83\xaf\x8d\xd7\xf7\xdb\x84\x8a\xb3\xf1\xc7\xc5\x9c\x8d\xff\x2a\x40\xf3\xca\x48\x6d\xb8\x32\x21\xe4\x6c\xea\xdb\x4c\xb9\xcc\x6b\x23\xf5\xac\x76\xcf\x14\xef\x59\x3d\x60\xd1\xcb\xca\x6f\xd8\x95\x6e\x0d\xcc\x5a\x5e\x97\x5c\x6b\xb3\x53\xb2\xca\x76\xcc\xed\x01\xa0\xb6\xa6\x27\x78\x94\xd5\x31\x76\x81\x85\x54\xfb\x45\x0f\xc5\x8b\x09\x1b\xa9\x50\xea\x4e\x98\x03\x91\x84\xa5\xa8\xbb\xb9\x8a\x28\x9d\x44\xe4\xc9\x8e\x04\x0e\x47\x98\x3b\x56\xdc\xea\xdf\xfb\xd2\x0d\xcd\x61\x35\x82\x99\x6f\x83\x70\x4c\x0d\xfc\x5f\xeb\x82\xb9\xae\x22\xfd\xea\x33\x75\x62\xc4\x59\xab\x59\x66\xae\x0b\xbc\xa0\x62\x12\x06\xd5\x96\x27\xe8\x01\xc3\x7a\x77\x6e\x1c\x69\x31\xea\xae\xed\x80\x80\x5e\xea\x5f\x55\xec\xa1\xc6\xe6\xfc\xa8\x1c\x79\xc0\x37\x56\xc4\x55\x9e\x33\xf8\x05\x9e\x4e\x59\x3e\xc3\xcf\x60\x0d\x5d\x8c\x37\x4e\x26\xde\x4a\x15\xe7\xc8\xf5\x64\x18\x8d\x7f\x23\x4e\xe3\xd9\x4a\xb0\x90\xb0\x4c\xf1\x72\x47\x49\xd0\xe4\xb0\x3c\x6b\x60\xb5\x48\x98\x7f\xb7\xd0\x06\x45\x1c\x8e\x78\xbf\xc9\x0e\x25\x91\x42\xcb\x1c\xe7\x41\x4a\xb3\x6f\x41\x0c\x57\x19\xda\x69\xec\x44\xca\x6e\x59\x78\xcb\x90\x95\x29\x2b\x03\xcc\xea\xba\x95\x64\xcd\xf3\x0a\x07\x65\x6a\x75\x1b\xb5\xaa\x8d\xae\xa2\x49\x9d\xa3\xdb\xa8\xcb\xfc\xaa\xf9\x7f\x00\xca\x9a\x20\x27\x32\x0d\x00\x00"),
 		},
 		"/terraform/aarch64/nestos/libvirt/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 460484359, time.UTC),
-			uncompressedSize: 2528,
+			modTime:          time.Date(2026, 10, 19, 17, 0, 35, 47503079, time.UTC),
+			uncompressedSize: 2677,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x51\x8f\xe3\x34\x10\x7e\xcf\xaf\x18\x2c\x1e\xee\x56\xac\x59\xd0\x09\x24\xa4\x0a\xc1\xde\x03\xfb\x70\x62\x75\x70\xe2\xe1\x74\x8a\xbc\xc9\x34\x1d\x35\xb1\xcd\xd8\xce\xb1\x54\xf9\xef\xc8\x89\xd3\x26\xdb\xb4\xf4\x5a\xa9\x6a\xea\xcc\x7c\xf3\xf9\xf3\x37\xb6\x3d\x32\xab\xb5\xe1\x06\x76\x19\x00\xe3\xdf\x81\x18\xcb\xdc\xb2\x69\xa9\x44\x76\xfd\x30\x40\x4d\x4f\x2d\xb1\x87\x55\xfa\x0f\xe0\x4c\xe0\x02\x61\x05\xa2\x6c\x54\xd1\x52\xa1\xf8\xdb\x14\x25\x52\x48\x8b\xec\xc8\xe8\x18\x73\x27\x7f\x94\x3f\x0c\xe3\x5d\x16\xbf\x5d\x96\x8d\x35\x40\x8c\x79\x3d\x78\x60\x8a\x19\xbb\x9d\x7c\xac\x95\x8f\xd4\xe4\x87\xf7\x0f\x5d\x27\x62\x4e\xab\x98\xd4\x53\x8d\x20\x8a\x3a\x38\x8f\x9c\x53\x39\xa4\xf9\x67\x8b\x11\x7e\x05\xce\x33\xe9\x2a\x03\x28\x71\xad\x42\xed\x13\xda\xfd\x90\xf0\xf0\xf6\x08\x8a\xb4\xf3\x4a\x17\x98\x17\x26\xe8\xc4\x62\x9e\xfb\x97\xe1\x2d\xb2\xbc\x8f\xef\x4f\xa7\x6f\x8c\xf3\x5a\x35\xf8\x92\x50\x4d\xce\xbf\x1a\x58\xbd\x9e\x41\x1f\x90\x7f\x4b\xa9\x5d\x77\x02\x9b\xec\x15\xa8\x0f\x8f\x27\xf1\x0a\x1b\xae\x00\xbc\x7f\xfc\x70\x12\x91\x55\x73\x05\xe2\xfb\x5f\xde\x9d\x44\x2c\xc9\x6d\xaf\x80\x7c\x4b\x6e\x7b\x5a\xc7\x4a\x5f\x01\xf9\xab\x31\xfe\xde\xe8\x35\x55\x03\x30\x63\xb2\xff\x68\xdd\xbc\x35\x75\x88\x2b\x2f\xc6\x87\x58\x23\xae\x68\x5f\x42\x7c\xbd\x6b\x15\xcb\x83\x67\xbb\xdb\x14\x97\x01\x58\x63\xea\x53\x51\xf1\x5d\x8c\x39\x74\xdb\xb4\x2f\x7e\xff\xe3\xa1\x51\x15\x26\x47\x9e\x21\x75\x50\xb2\xb7\x38\x4c\x3e\x2b\x88\x35\xe7\x2d\x70\xa0\x3e\x8d\x4b\xf4\x8e\xec\xfe\xb1\xcf\x91\xa4\x4b\xfc\xe7\x53\x77\xdb\xd7\xca\x00\x9e\x94\xc3\xc4\x20\xef\xd1\x2e\x93\x61\xa1\xe2\xa2\x20\xf4\xef\x4b\x7e\x33\x72\x91\xc5\x8c\x18\xdc\xc0\x77\x77\xdf\xbf\x99\xff\x2c\xcb\x46\x95\x26\x4f\x46\x0b\x10\x87\xc7\xa9\x78\xff\xa3\xd9\xc5\x52\xed\xd1\x27\xd3\x3f\x3b\xeb\xc2\x68\x8f\x3a\x7a\xd3\x63\x63\x6b\xe5\x71\x4d\x35\xbe\x9a\xd5\xa2\x4a\xcf\xca\x7c\x03\x3b\x18\xeb\xbf\x64\xbe\xc8\x0b\xba\xd7\xcb\xba\x94\xa6\x51\x14\x55\xd1\xe8\xbc\x71\xcb\x86\xba\xd0\x4f\x97\x10\x89\xe8\x36\xa4\x13\xa7\x31\x65\x6f\xa1\x18\x79\x6b\x95\x73\x7e\xc3\x26\x54\x1b\x91\x0d\x47\x4a\x1b\x43\xcf\xf0\xb0\xe1\x25\x76\x83\x8d\xe1\xe7\x93\x19\xac\x9a\x23\x36\x86\xd1\xb8\xbd\x3f\xfa\xbd\x63\x6e\x19\xb9\x7f\xb8\x91\x54\x1e\x55\x54\xc5\x86\x34\x4e\x1d\xbe\xdb\xc9\x77\xc3\xe8\x9f\xcf\xb6\xef\x64\x00\x15\xbc\x71\x5e\xb1\xdf\x87\x79\x0e\x38\xd9\xb3\x26\xf9\xdb\xb6\x11\x59\xdc\xb5\xc8\x6d\x93\x52\xa9\xe7\xa8\x9c\xf0\x1b\xc6\x64\x8c\x5a\x64\xd6\x45\x0c\x8d\xfe\xb3\xe1\x6d\x4e\xda\x23\xaf\x55\x81\x09\x70\x1c\x3f\xb7\x9d\x69\x4c\xc7\xfe\xde\x6a\x5f\xb4\xce\x00\xaa\x2c\x19\x9d\x43\xb7\x98\x47\x76\x6e\xd1\xaf\x56\x20\x74\xa8\x6b\x01\x3f\xc3\xc7\x73\x91\x9f\xe0\x27\x88\x81\x7d\x8d\xcf\x8a\x7c\xbe\x36\x9c\xd7\xa8\x1c\x1e\x74\xed\x67\x5f\xb1\xb2\x1b\x2a\xc6\x1b\xcf\x54\xeb\x15\x88\x56\x17\x22\xdd\x84\x9c\x47\x9d\xf7\xaf\x57\x20\x12\x6d\x31\xa2\x14\x46\x3b\x53\xe3\x32\x88\xf5\xcf\x03\x88\x57\x5c\xa1\xcf\xad\xe9\xaf\x54\xe2\x4e\x8c\x77\x22\x13\xbc\x0d\x1e\x04\xd9\xf6\xcd\xd0\x5f\xad\xaa\x03\x4e\x56\x72\x68\x42\x39\xb4\xa0\xbc\x91\x47\x8b\x26\xef\xe4\x5e\xcb\xac\xfb\x2f\x00\x00\xff\xff\x56\x79\xfb\xa1\xe0\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x5f\x6f\xa4\x36\x10\x7f\xe7\x53\x4c\xad\x3e\xdc\x45\x0d\x4d\xab\x53\x2b\x55\x42\x55\x9b\x7b\x68\x1e\xae\x8d\xee\x7a\xea\xc3\xe9\x84\x1c\x98\x65\x47\x01\xdb\xb5\xc7\xa4\xe9\x8a\xef\x5e\x19\xcc\x2e\x64\x61\xbb\xb7\x91\xa2\x10\x33\xbf\x3f\x8c\xe7\x07\x66\xb4\x56\x6e\xb4\x6d\x60\x97\x00\x58\xfc\xdb\x93\xc5\x32\x37\x56\xb7\x54\xa2\x75\xfd\x32\x40\x4d\x0f\x2d\x59\x86\x2c\xfe\x0f\xe0\xb4\xb7\x05\x42\x06\xa2\x6c\x64\xd1\x52\x21\xed\xb7\xb1\x4a\xc4\x92\x16\xad\x23\xad\x42\xcd\x4d\xfa\x63\xfa\xc3\xb0\xde\x25\xe1\xb7\x4b\x92\x51\x03\xc4\x88\xeb\xc9\xbd\xa5\x80\xd8\xed\xd2\xfb\x5a\x72\xb0\x96\x7e\x7c\x7f\xd7\x75\x22\x60\x5a\x69\x49\x3e\xd4\x08\xa2\xa8\xbd\x63\xb4\x39\x95\x03\x8c\x9f\x0d\x02\x00\x64\xe0\xd8\x92\xaa\x12\x80\x12\x37\xd2\xd7\x1c\xd9\x6e\x07\xc0\xdd\xdb\x23\x2a\x52\x8e\xa5\x2a\x30\x2f\xb4\x57\xd1\xc5\x1c\xfb\x97\xb6\x8f\x68\xd3\xdb\x70\x7f\x1d\xbe\xd5\x8e\x95\x6c\xf0\xa5\xa1\x9a\x1c\xbf\x1a\x5c\xbd\x9e\x51\x1f\x98\x7f\x8b\xd0\xae\x5b\xe1\x26\x73\x01\xeb\xdd\xfd\x2a\x9f\x63\xc9\x54\xe4\x0a\xf9\x49\xdb\xc7\x0b\xb8\x3f\xf4\x04\xbf\x0f\xf8\x55\x99\xc2\xf8\x0b\xb8\x6f\xef\x3f\xae\x32\x5a\xd9\x5c\xc0\xf8\xfe\x97\x77\xab\x8c\x25\xb9\x4b\x1a\xf0\x96\xdc\xfa\x73\x53\xa5\x2e\xa0\xfc\x55\x6b\xbe\xd5\x6a\x43\xd5\x40\x6c\x31\xa6\x6c\x4c\x48\xde\xea\xda\x87\x01\x13\xe3\x45\xd0\x08\x83\xd3\x4b\x88\xaf\x77\xad\xb4\xe9\x21\x1a\xdd\x75\xac\x4b\x00\x8c\xd6\xf5\x5a\x55\xb8\x17\x6a\x0e\xa1\x9e\xc6\xef\x8f\x0f\x77\x8d\xac\x30\x0e\xfe\x09\x53\x87\x4e\xf6\x49\x82\xc9\x4f\x06\x41\x73\x9e\xb4\x83\xf5\x69\x5d\xb4\x77\x94\xaa\x4f\x3d\x26\x25\x55\xe2\x3f\x9f\xbb\xeb\x5e\x2b\x01\x78\x90\x0e\xa3\x83\xbc\x67\x3b\xaf\x0d\x0b\x8a\x8b\x0d\xa1\x7f\x5f\xfa\x9b\x99\x0b\x2e\x66\xc6\xe0\x0a\xbe\xbb\xf9\xfe\xcd\xfc\xcf\x72\xdb\xa8\x52\xc4\xa4\x95\x00\x71\xb8\x9c\x36\xef\x7f\x7a\x76\x76\xab\xf6\xec\x93\xc7\x3f\xf9\xd4\x85\x56\x8c\x8a\x21\x03\xc6\xc6\xd4\x92\x71\x43\x35\xbe\x9a\x69\x51\xa5\x66\x32\xdf\xc0\x0e\x46\xfd\x97\xce\x17\x7d\x41\xf7\x7a\xb9\x2f\xa5\x6e\x24\x85\xae\x28\x74\xac\xdd\xf2\x40\x9d\x39\x4f\xe7\x18\x09\xec\xc6\xf7\x2a\x00\x8d\x2e\xfb\x11\x0a\x95\xd7\x46\x3a\xc7\x5b\xab\x7d\xb5\x15\xc9\xf0\xe5\x6a\x43\xe9\x09\x1f\xc6\xbf\xe4\x6e\xb0\xd1\xf6\x79\x15\x61\x65\x73\xe4\x46\x5b\xd4\x6e\x3f\x1f\xfd\xbb\x63\x3e\x32\xe9\xfe\xe2\x2a\xa5\xf2\x48\x51\x16\x5b\x52\x38\x9d\xf0\xdd\x2e\x7d\x37\xac\xfe\xf9\x6c\xfa\x24\x03\x48\xcf\xda\xb1\xb4\xbc\x2f\x63\xeb\x71\xf2\xce\x9a\xe0\x1f\xdb\x46\x24\x09\x40\x98\xf7\xd8\xa9\x98\x39\x2a\x27\xfe\x86\xb5\x34\x54\x2d\x3a\xeb\x02\x47\xfc\xe6\xe4\xa4\x18\xed\x46\x16\x18\x09\xc7\xf5\x53\xaf\x33\x85\xf1\x74\xb1\x1f\xb5\x2f\xda\x67\x00\x59\x96\x16\x9d\x43\xb7\x88\x23\x33\x43\xc0\x57\x19\x08\xe5\xeb\x5a\xc0\xcf\xf0\xe9\x54\xe5\x67\xf8\x09\x42\x61\xaf\xf1\x24\x89\xf3\x8d\xb6\x79\x8d\xd2\x1d\x85\x61\xfe\xe5\x3d\xd6\x0b\x9b\x20\xc6\x5e\x55\x56\x9a\x2d\x15\xe3\x31\x6c\xba\x33\x19\x88\x56\x15\x22\x1e\xcf\x1c\xa3\xca\xfb\xdb\x19\x88\xf8\x90\x7b\x96\x42\x2b\xa7\x6b\x5c\x26\x31\xfc\x3c\x90\xb0\xb4\x15\x72\x6e\x74\x7f\xce\x13\x37\x62\x3c\xa8\x69\xcf\xc6\x33\x08\x32\xed\x9b\x21\x8d\xad\xac\x3d\x4e\xf6\x7d\x88\x6c\x3a\x04\x36\xbd\x4a\x8f\xb6\x38\xbd\x49\xf7\x9d\x4f\xba\xff\x06\x00\xc4\x80\x2c\x74\x75\x0a\x00\x00"),
 		},
 		"/terraform/aarch64/nestos/openstack": &vfsgen۰DirInfo{
 			name:    "openstack",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 461484369, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/aarch64/nestos/openstack/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 461484369, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 4738,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x45\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\xd2\xc9\x21\x4c\x89\x1c\xff\xa8\x69\x03\x7d\xf7\x81\x22\x25\x5b\xb2\x14\x3b\x6e\x02\x14\x45\x01\xea\x78\xf7\xfb\xdd\x1d\x79\xbc\x73\x34\x4a\x49\x72\x2e\x0b\x78\x08\x00\x24\xfe\x67\xa8\xc4\x2c\x11\x92\x57\x34\x43\xa9\x1a\x31\x00\x17\x58\x2a\x4d\xd2\x25\x9c\x79\x09\x80\xe2\x46\xa6\x08\x67\x10\x76\x20\x07\xad\xdd\x41\x67\xf0\xb6\x5b\x85\xde\xae\x42\xa9\x28\x2f\xad\xe1\x2c\x8a\xa3\xd8\xc9\xeb\xc0\xfe\xaf\x83\xa0\x85\x80\x70\x65\xd9\x70\x1a\x85\x32\x29\x49\x81\x00\xd6\xf6\xe1\x21\xba\x62\x44\x5b\xda\xe8\x46\xa1\xb4\x3b\x75\x6d\xc1\x04\x51\xea\x33\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\xbf\x9b\xad\x8f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x97\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x7e\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\xde\x11\xee\x03\xb1\x68\xd1\x85\x35\x99\x46\xbc\xe3\x4a\xdb\x3c\x0d\x41\x19\x55\xfa\x95\x43\x7e\xdd\x83\x5e\x21\xff\xe9\x4d\xeb\x7a\xca\x5b\x61\xf6\x80\xbd\xb8\xba\x99\x44\x94\xa4\xd8\x03\xf1\xfa\xfc\xc3\x24\x62\x46\xd5\x72\x0f\xc8\xf7\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\xe7\xd4\xdd\xa3\x3f\x98\xb5\x6e\xaf\x66\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x32\xf6\xf9\x1a\xc0\x3f\xbc\xc4\xe9\x0b\x61\xab\x31\x23\x9a\xec\x91\x94\xdf\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x16\x32\x4b\x4a\xd4\x4f\x75\xfd\xd2\xdb\x7e\x44\xfd\x99\xcb\xe5\xb4\xe7\x54\xec\xe1\xf3\xe5\xd5\xd0\x57\xbc\xdf\xdf\xd7\xdf\xef\xc7\x7c\x95\xe8\x9f\xd4\xd5\xcb\x97\xa4\xbc\x10\x46\x63\x92\x33\x52\x71\x99\x54\xb3\x10\x42\xb7\x76\x9c\x4d\xa5\xfb\xd7\xa6\x22\x32\xea\x3f\x01\x01\x80\x7f\x30\x37\x15\xda\x8a\xfe\xd4\x68\x46\xb4\xcc\xf0\xfe\xdf\x00\xa0\x4a\x85\x51\xa3\x88\xc2\x0c\x75\x25\x29\x60\x14\x5c\x92\x62\xa8\x6b\x2b\x68\x54\xd7\x6e\x0c\x95\xa9\x4a\x84\xb9\x65\x34\x6d\xda\x8b\x34\x38\x99\xa0\x5b\xc6\xd3\xa5\xd2\x5c\x92\x05\x26\x15\x67\xa6\xc0\xa4\x9a\x87\x10\xba\xf5\x7a\x96\x1e\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x96\xd3\x54\x98\x2e\x24\x37\xc2\x9d\x67\xfb\xe5\x7c\x5d\x1d\x58\xd3\x41\x7e\x7c\xb0\x54\xab\x26\x51\x1f\x14\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xdd\xb4\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x73\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x83\x80\xe6\x29\x67\xcd\x39\xa4\xc2\xf5\xea\x94\x66\x72\xcd\xc5\x38\x6a\xfe\xbd\x6d\x3a\x79\xfd\x28\xdb\xc1\xe1\x08\x9b\x17\x0e\xd8\x68\x5a\x7c\x33\xdd\x69\x3c\x42\xe7\x85\xcf\x1f\xdc\xd1\xd1\x7c\x84\xae\x95\x3e\x3f\xdf\x6c\x7e\xf2\xf3\xd8\xe1\xcd\x5f\x2c\xc2\xc3\x51\xc2\x56\xfa\xfc\x7c\xc7\xe3\x29\x3d\x7e\xb9\x9c\x1e\xc6\xb3\xa3\xd3\xb1\x18\x3b\xf9\x8b\x70\xbe\x8b\xc7\x39\xdf\xbd\xd4\x49\xce\xe3\x38\x1e\xe3\x9c\xcf\x4e\x8e\x4f\xbe\x17\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xa7\x47\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\x3d\x66\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x55\xcf\xf1\x76\x60\xec\x39\xfe\x13\x3c\x40\x1b\xd1\x4e\x91\x42\xfd\xda\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\xef\x31\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\x1f\xce\x20\x2c\x0d\x63\x21\xfc\xfa\xb8\xe2\x2f\x60\xd5\x1e\xbb\x82\xde\x4d\x5a\x2e\x92\x9c\x71\xa2\x69\xb9\xa0\xa2\x1d\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x36\xae\xf5\xa1\x73\xfb\xc8\xd8\x31\x12\xa5\x78\x4a\x89\xf6\x35\x90\xaf\x8b\x36\x0b\x61\xc2\x8f\x16\x2f\xa1\x02\xce\x60\x7b\x90\x91\x0f\x31\x7a\x13\x91\x2c\x93\xa8\xd4\x46\x65\x74\x99\xcd\x7a\x88\x23\x95\xdb\xb9\x13\xbd\x89\x68\xf6\xa4\x61\xcb\x0f\x85\x44\x6b\x92\xde\xb9\x04\xf4\x44\x3b\x27\xe0\x99\xdc\x05\xf0\xf4\x34\x6b\xb8\xb6\xcd\xb2\x91\x5b\x8d\x07\xce\x8d\x16\x46\xaf\xff\xba\x29\x73\xee\x22\xaa\x08\x33\xd8\xfd\xbd\xa6\xab\x88\xc1\xe9\x6d\x71\xde\x1f\x6e\x14\x47\x6b\x15\xe4\x2a\xea\x9b\xaf\x83\xab\x9c\xff\x03\x00\x00\xff\xff\x81\x9a\x0c\xab\x82\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x59\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\x12\xe5\x10\xa6\x44\x8e\x7f\xd4\xb4\x86\xbe\xfb\x40\x52\x94\x2d\x9b\x72\x1c\x37\x01\x8a\xa2\x00\x75\xbc\xfb\xfd\xee\x8e\x3c\xde\x39\x0a\x0b\x81\x4a\x26\x2a\x58\x46\x00\x02\xff\xa7\x89\xc0\x45\xc6\x05\x6b\x48\x81\x85\xb4\x62\x00\xc6\x71\x2d\x15\xca\x17\x70\xde\x49\x00\x24\xd3\x22\xc7\x70\x0e\x71\x0f\x32\xf1\x76\x93\xde\xe0\x6d\xbf\x8a\x3b\xbb\x06\x0b\x49\x58\x6d\x0c\xa7\x49\x9a\xa4\x4e\xde\x46\xe6\x7f\x1b\x45\x1e\x02\xe2\x95\xa5\xe5\xd4\x12\x8b\xac\x46\x15\x06\x30\xb6\xcb\x65\x72\x4d\x91\x32\xb4\xc9\xad\xc4\xc2\xec\xb4\xad\x01\xe3\x48\xca\xcf\x4c\x14\xb0\xad\x78\xdd\x6d\x39\x45\x85\x6b\x54\x2b\x87\xb9\xa1\xf8\xb7\xdd\xfa\xd8\x63\x22\xad\xee\x33\x2d\x68\x00\xf3\x42\xab\xfb\xdb\x9b\xbf\x9c\x9e\xc0\x73\x13\x1b\x04\xf4\x6e\xec\x96\x51\x6b\xa3\xa8\x41\x82\xa0\x3b\x8a\x21\xce\xa9\x96\x0a\x8b\x8c\x14\x2e\x4c\xf5\x85\x63\x67\x2d\x95\x20\xf5\x3c\x02\x28\x70\x89\x34\x55\x1d\xe0\xa5\x33\xb8\x7a\xbf\x05\x45\x4c\xba\xea\x1c\x67\x39\xd3\xb5\xda\x13\xee\x03\x32\x68\xc9\xa5\x31\x19\x47\xbc\x67\x52\x99\x3c\x6d\x82\x52\x22\xd5\x2b\x87\xfc\x7a\x00\xbd\x42\xfe\xb3\x33\x6d\xdb\x31\x6f\xb9\x3e\x00\xf6\xf2\xfa\x76\x14\x51\xa0\xea\x00\xc4\x9b\x8b\x0f\xa3\x88\x05\x91\x8b\x03\x20\xdf\x13\xb9\x18\xc5\x64\x92\x54\x68\x8e\xf7\x3c\xa7\xfe\x1e\xfd\x41\x8d\xb5\xbf\x9a\x03\x68\xd4\x20\x42\xd1\x1d\xa1\x44\x7d\xc9\xbe\xb2\xfa\xc9\xd8\x17\x6b\x00\xff\xb0\x1a\x8f\x5f\x08\x53\x8d\x05\x52\xe8\x80\xa4\xfc\xc6\x98\xba\x64\x75\x49\xe6\xdb\xa9\x51\xa6\x90\x69\x56\x63\xf5\x54\xd7\xaf\x3a\xdb\x8f\x58\x7d\x66\x62\x31\xee\x39\xe1\x07\xf8\x7c\x75\xbd\xe9\x2b\x7e\x38\xdc\xd7\xdf\x1f\x42\xbe\x0a\xdc\x3d\xa9\xab\x97\x2f\xcb\x59\xc5\xb5\xc2\x59\x49\x51\xc3\x44\xd6\x4c\x63\x88\xdd\xda\x71\xda\x4a\xef\x5e\x9b\x06\x89\x64\xf8\x04\x44\x00\xdd\x83\xb9\xad\xe0\x2b\xfa\x93\xd5\x4c\x48\x5d\xe0\x87\x7f\x23\x80\x26\xe7\x5a\x06\x11\xb9\xde\xd4\x15\xa8\x82\x20\xb8\x40\xd5\xa6\xae\xa9\xa0\xa0\xae\xd9\xd8\x54\x26\x32\xe3\xfa\x8e\x92\xdc\xb6\x17\xa1\xf1\x68\x82\xee\x28\xcb\x17\x52\x31\x81\xe6\x38\x6b\x18\xd5\x15\xce\x9a\x59\x0c\xb1\x5b\xaf\x67\x69\x67\x86\xf6\xcc\x8e\x24\x5f\xf1\x1e\x11\x3c\x72\x9a\x12\xe7\x73\xc1\x34\x77\xe7\xe9\xbf\x9c\xaf\xab\x03\xb3\x1d\xe4\xc7\xa5\xa1\x5a\x35\x89\x76\x52\xd9\x1b\x19\xdb\xdb\x25\x73\x41\xb8\xea\xba\xa9\xc7\x81\x92\x09\x58\x9c\x49\xf0\x9a\x11\x80\xd0\x14\x5b\x78\x80\x52\xb0\x2a\xe3\x4c\x28\x4b\x30\x9d\x5a\xa1\x62\x5e\xb4\x26\x24\xdc\x0c\x02\x8a\xe5\x8c\xda\x73\xc8\x79\x6c\xe5\x39\x29\xc4\x9a\x8b\x69\x62\xff\xbd\xb5\x9d\xbc\xdd\xc9\x36\x39\x0a\xb0\x4d\x8e\x42\x6c\x24\xaf\xbe\x99\xee\x2c\x0d\xd0\x9d\xa5\x21\xba\x67\x08\xee\xf8\x78\x16\xa0\xf3\xd2\xe7\xe7\x9b\xce\x4e\x7f\x0e\x1d\xde\xec\xc5\x22\x3c\x0a\x12\x7a\xe9\xf3\xf3\x9d\x84\x53\x7a\xf2\x72\x39\x3d\x4a\xa7\xc7\x67\xa1\x18\x7b\xf9\x8b\x70\xbe\x4b\xc3\x9c\xef\x5e\xea\x24\x67\x69\x9a\x86\x38\x67\xd3\xd3\x93\xd3\xef\x85\x53\x17\x7b\x71\x3e\xf2\xd8\xf6\xcf\xb4\x7d\x6c\xfd\xd7\x66\xfb\x04\x08\x77\xb2\xed\x3e\x3a\xa6\x39\xd6\x32\xec\x90\x97\x0d\xec\x37\x2c\xbb\x41\x30\x02\xe8\xda\xfc\xb0\x07\xec\xd7\x98\x70\xae\x85\x19\xfb\x6c\x0b\x90\xce\xf2\xd3\xce\xd6\x93\xf8\x75\x62\x00\x0d\xc8\xd6\xfc\xe8\xe9\xb7\x36\xfc\xef\x31\x33\x02\xae\x67\x44\xe1\x8a\x53\xa4\x70\x49\x28\x7e\x35\x70\xdc\x0f\x8c\x03\xc7\x7f\x82\x25\xf8\x88\xf6\x8a\x14\xda\xd7\x51\x04\x50\xbb\xc1\xa9\xbb\x66\xa1\x74\xad\x86\x33\x77\x13\xc9\x03\x2e\x32\xc2\xb3\xe6\x78\x93\x87\xf0\x21\xc3\x0f\xe7\x10\xd7\x9a\xd2\x18\x7e\xdd\xad\xf8\x0b\x18\xb5\x5d\x57\xb0\x73\x93\xd4\xf3\xac\xa4\x0c\x29\x52\xcf\x09\xf7\x43\x9c\xf9\xe6\x7b\xcc\x27\x9c\x31\xea\xe3\x5a\x1f\x3a\x1f\x1f\x19\x7b\x46\x24\x25\xcb\x09\x52\x5d\x0d\x94\xeb\xa2\xed\x42\x18\xf1\xc3\xe3\x65\x84\xc3\x39\x3c\x1e\x64\xd2\x85\x98\xbc\x49\x50\x51\x08\x2c\xe5\x56\x65\xf4\x99\x2d\x06\x88\x81\xca\xed\xdd\x49\xde\x24\xa4\x78\xd2\xb0\xd5\x0d\x85\x48\x29\x94\xdf\xbb\x04\x0c\x44\x7b\x27\xe0\x99\xdc\x05\xe8\xe8\x49\x01\x30\x00\x0a\xcf\xb2\x89\x5b\x85\x03\x67\x5a\x71\xad\xd6\x7f\xdd\xd4\x25\x73\x11\x35\x88\x6a\xdc\xff\xbd\xa6\xaf\x08\xc2\x9f\xe2\x7c\x77\xb8\x49\x9a\xac\x55\x90\xab\xa8\x6f\xbe\x0e\xae\x72\xfe\x1f\x00\x81\x9a\x0c\xab\x82\x12\x00\x00"),
 		},
 		"/terraform/aarch64/nestos/openstack/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 461484369, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 4719,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\x8f\x37\x62\x65\x4b\xd5\x1f\x27\x77\xc1\xdf\xbd\xc8\x92\xbd\x6b\xaf\x9d\xdd\xec\x25\x50\x8e\x03\x79\x34\xf3\xfb\xcd\x8c\x34\x9a\xd9\x68\x94\x92\xe4\x5c\x16\xf0\x18\x00\x48\xfc\xc7\x50\x89\x59\x22\x24\xaf\x68\x86\x52\x35\x62\x00\x2e\xb0\x54\x9a\xa4\x4b\x38\xf3\x12\x00\xc5\x8d\x4c\x11\xce\x20\xec\x40\x0e\x5a\xbb\x83\xce\xe0\x7d\xb7\x0a\xbd\x5d\x85\x52\x51\x5e\x5a\xc3\x59\x14\x47\xb1\x93\xd7\x81\xfd\x5f\x07\x41\x0b\x01\xe1\xca\xb2\xe1\x34\x0a\x65\x52\x92\x02\x01\xac\xed\xe3\x63\x74\xc5\x88\xb6\xb4\xd1\x8d\x42\x69\x77\xea\xda\x82\x09\xa2\xd4\x3d\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\x3f\x9a\xad\x4f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x9b\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x71\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\x76\x70\x7d\xdb\x3f\xb9\x5c\xa2\x8c\x2e\xec\xfe\xb4\xf9\x1d\x57\xda\x26\x65\xe8\x10\xa3\x4a\xbf\x71\x5e\xbd\xed\x41\xaf\x90\x7f\xf5\xa6\x75\x3d\xe5\x9a\x30\x7b\xc0\x5e\x5c\xdd\x4c\x22\x4a\x52\xec\x81\x78\x7d\xfe\xfb\x24\x62\x46\xd5\x72\x0f\xc8\x8f\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\x67\xdc\x5d\x9a\x5f\x98\xb5\x6e\xef\x61\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x36\xf6\xf9\x1a\xc0\x5f\xbc\xc4\xe9\x0b\x61\x4b\x2f\x23\x9a\xec\x91\x94\x9f\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x56\x2d\x4b\x4a\xd4\xcf\x75\xfd\xd2\xdb\x7e\x42\x7d\xcf\xe5\x72\xda\x73\x2a\xf6\xf0\xf9\xf2\x6a\xe8\x2b\x3e\xec\xef\xeb\xcf\x0f\x63\xbe\x4a\xf4\xef\xe7\xea\x99\x4b\x52\x5e\x08\xa3\x31\xc9\x19\xa9\xb8\x4c\xaa\x59\x08\xa1\x5b\x3b\xce\xa6\xac\xfd\xd3\x52\x11\x19\xf5\xeb\x3d\x00\xf0\xaf\xe3\xa6\x42\x5b\xd1\x9f\x1b\xcd\x88\x96\x19\x3e\xfc\x1d\x00\x54\xa9\x30\x6a\x14\x51\x98\xa1\xae\x24\x05\x8c\x82\x4b\x52\x0c\x75\x6d\x05\x8d\xea\xda\x8d\xa1\x32\x55\x89\x30\xb7\x8c\xa6\x4d\x2f\x91\x06\x27\x13\x74\xcb\x78\xba\x54\x9a\x4b\xb2\xc0\xa4\xe2\xcc\x14\x98\x54\xf3\x10\x42\xb7\x5e\xcf\xd2\x93\x19\xda\x31\x3b\x8a\x7e\xc5\x1d\x22\xd8\x72\x9a\x0a\xd3\x85\xe4\x46\xb8\xf3\x6c\xbf\x9c\xaf\xab\x03\x6b\xda\xc5\xff\x1f\x2d\xd5\xaa\x23\xd4\x07\xf7\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xad\xb3\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x4d\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x5d\x5f\xf3\x94\xb3\xe6\x1c\x52\xe1\x1a\x73\x4a\x33\xb9\xe6\x62\x1c\x35\xff\xde\x37\x6d\xbb\x7e\x92\xed\xe0\x70\x84\xcd\x0b\x07\x6c\x34\x2d\xbe\x99\xee\x34\x1e\xa1\xf3\xc2\x97\x0f\xee\xe8\x68\x3e\x42\xd7\x4a\x5f\x9e\x6f\x36\x3f\xf9\x7e\xec\xf0\xe6\xaf\x16\xe1\xe1\x28\x61\x2b\x7d\x79\xbe\xe3\xf1\x94\x1e\xbf\x5e\x4e\x0f\xe3\xd9\xd1\xe9\x58\x8c\x9d\xfc\x55\x38\x3f\xc4\xe3\x9c\x1f\x5e\xeb\x24\xe7\x71\x1c\x8f\x71\xce\x67\x27\xc7\x27\xff\x15\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xe7\x27\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\xf1\x65\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x4d\xcf\xf1\x76\x60\xec\x39\xfe\x1d\x3c\x42\x1b\xd1\x4e\x91\x42\xfd\xd6\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\x1f\x30\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\xff\x9d\x41\x58\x1a\xc6\x42\xf8\xf1\x69\xc5\x1f\xc0\xaa\x3d\x75\x05\xbd\x9b\xb4\x5c\x24\x39\xe3\x44\xd3\x72\x41\x45\x3b\xc4\xd9\x6f\xb1\xc3\x7c\x22\x38\x67\x6d\x5c\xeb\x43\xe7\xf6\x91\xb1\x63\x24\x4a\xf1\x94\x12\xed\x6b\x20\x5f\x17\x6d\x16\xc2\x84\x1f\x2d\x5e\x42\x05\x9c\xc1\xf6\x20\x23\x1f\x62\xf4\x2e\x22\x59\x26\x51\xa9\x8d\xca\xe8\x32\x9b\xf5\x10\x47\x2a\xb7\x73\x27\x7a\x17\xd1\xec\x59\xc3\x96\x1f\x0a\x89\xd6\x24\xbd\x73\x09\xe8\x89\x76\x4e\xc0\x0b\xb9\x0b\xe0\xe9\x69\xd6\x70\x6d\x9b\x65\x23\xb7\x1a\x0f\x9c\x1b\x2d\x8c\x5e\xff\x75\x53\xe6\xdc\x45\x54\x11\x66\xb0\xfb\xe3\x4c\x57\x11\x83\xd3\xdb\xe2\xbc\x3f\xdc\x28\x8e\xd6\x2a\xc8\x55\xd4\x37\x5f\x07\x57\x39\xff\x06\x00\x00\xff\xff\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\xcf\x6e\xc4\xca\x96\xaa\x3f\x4e\xee\x82\xbf\x7b\x91\x64\x7b\xd7\xbb\xf2\x66\xb3\x97\x40\x39\x0e\xe4\xd1\xcc\xef\x37\x33\xd2\x68\x66\xa3\x51\x4a\x32\xe3\xb2\x84\xc7\x08\x40\xe2\x3f\x86\x4a\x2c\x32\x21\x79\x4d\x0b\x94\xca\x89\x01\xb8\xc0\x4a\x69\x92\x2f\xe0\xac\x95\x00\x28\x6e\x64\x8e\x70\x06\x71\x0f\x72\xd0\xd9\x1d\xf4\x06\xef\xfb\x55\xdc\xda\xd5\x28\x15\xe5\x95\x35\x9c\x24\x69\x92\x7a\x79\x13\xd9\xff\x4d\x14\x75\x10\x10\x2f\x2d\x1d\xa7\x51\x28\xb3\x8a\x94\x08\x60\x6d\x1f\x1f\x93\x2b\x46\xb4\xa5\x4d\x6e\x14\x4a\xbb\xd3\x34\x16\x4c\x10\xa5\xee\xb9\x2c\x60\x53\xf1\xaa\xdd\xf2\x8a\x1a\x2b\x52\x69\x8f\xb9\xa6\xf8\x87\xdb\xfa\xd4\x63\x12\xa3\xef\x32\x23\x59\x00\xf3\xdc\xe8\xbb\x9b\xeb\xdf\xbc\x9e\xc4\xb9\x8d\x0d\x02\x7a\xd7\x6e\xcb\xaa\x35\x51\x54\x13\x49\xc9\x2d\x43\x88\x73\x66\x94\x46\x99\xd1\xc2\x87\xa9\xbf\x08\xf4\xd6\x4a\x4b\x5a\xcd\x23\x80\x02\x67\xc4\x30\xdd\x02\x5e\x78\x83\xcb\x8f\x1b\x50\xd4\xa6\xab\xca\x31\xcb\xb9\xa9\xb4\x87\x1b\xda\xfe\xc9\xe5\x02\x65\x72\x61\xf7\xc7\xcd\xef\xb8\xd2\x36\x29\xeb\x0e\x31\xaa\xf4\x1b\xef\xd5\xdb\x01\xf4\x12\xf9\xd7\xd6\xb4\x69\xc6\x5c\x13\x66\x0f\xd8\x8b\xab\x9b\x51\x44\x49\xca\x3d\x10\xaf\xcf\x7f\x1f\x45\x2c\xa8\x5a\xec\x01\xf9\x91\xaa\xc5\x28\x26\x57\xb4\x24\x73\xdc\xf1\x8c\xfb\x4b\xf3\x0b\xb3\xd6\xdd\x3d\x1c\x40\x93\x9a\x50\x46\x6e\x29\xa3\xfa\x4b\xf6\x95\x57\xcf\xc6\x3e\x5f\x01\xf8\x8b\x57\x38\x7e\x21\x6c\xe9\x15\x44\x93\x3d\x92\xf2\x13\xe7\xfa\x82\x57\x33\x3a\xdf\x4c\x8d\xb6\x55\xcb\xb2\x0a\xf5\x73\x5d\xbf\x6c\x6d\x3f\xa1\xbe\xe7\x72\x31\xee\x39\x15\x7b\xf8\x7c\x79\xb5\xee\x2b\x3e\xec\xef\xeb\xcf\x0f\x21\x5f\x25\xb6\xef\xe7\xf2\x99\xcb\x72\x5e\x0a\xa3\x31\x9b\x31\x52\x73\x99\xd5\x93\x18\x62\xbf\xf6\x9c\xae\xac\xdb\xa7\xa5\x26\x32\x19\xd6\x7b\x04\xd0\xbe\x8e\x9b\x0a\x5d\x45\x7f\x76\x9a\x09\xad\x0a\x7c\xf8\x3b\x02\xa8\x73\x61\x54\x10\x51\x98\x75\x5d\x49\x4a\x08\x82\x4b\x52\xae\xeb\xda\x0a\x0a\xea\xda\x8d\x75\x65\xaa\x32\x61\x6e\x19\xcd\x5d\x2f\x91\x06\x47\x13\x74\xcb\x78\xbe\x50\x9a\x4b\x32\xc7\xac\xe6\xcc\x94\x98\xd5\xd3\x18\x62\xbf\x5e\xcd\xd2\xd6\x0c\xed\x98\x1d\x45\xbf\xe2\x0e\x11\x3c\x71\x9a\x0a\xf3\xb9\xe4\x46\xf8\xf3\xec\xbe\xbc\xaf\xcb\x03\x73\xed\xe2\xff\x8f\x96\x6a\xd9\x11\x9a\x83\x7b\x77\x23\x63\x77\xbb\x54\x2e\xa9\xd0\x6d\xeb\xec\x70\x60\xc6\x25\x2c\x4e\x15\x74\x9a\x11\x80\x34\x0c\x1d\x3c\xc0\x4c\xf2\x32\x13\x5c\x6a\x47\x30\x99\x38\xa1\xe6\x9d\x68\x45\x48\x85\xed\xfa\x9a\xe7\x9c\xb9\x73\xc8\x45\xec\xe4\x39\x2d\xe4\x8a\x8b\x69\xe2\xfe\xbd\x77\x6d\xbb\xd9\xca\x76\x70\x18\x60\x3b\x38\x0c\xb1\xd1\xbc\xfc\x66\xba\xd3\x34\x40\x77\x9a\x86\xe8\x5e\x20\xb8\xa3\xa3\x69\x80\xae\x93\xbe\x3c\xdf\x64\x7a\xf2\x7d\xe8\xf0\xa6\xaf\x16\xe1\x61\x90\xb0\x93\xbe\x3c\xdf\x71\x38\xa5\xc7\xaf\x97\xd3\xc3\x74\x72\x74\x1a\x8a\xb1\x97\xbf\x0a\xe7\x87\x34\xcc\xf9\xe1\xb5\x4e\x72\x9a\xa6\x69\x88\x73\x3a\x39\x39\x3e\xf9\xaf\x70\x9a\x62\x27\xce\x27\x1e\xdb\xfe\x99\x76\x8f\x6d\xf7\xb5\xde\x3e\x01\xc2\x9d\x6c\xb3\x8f\x8e\x69\x8e\xb5\x0c\x37\xe4\x65\x03\xfb\x35\xcb\x76\x10\x8c\x00\xda\x36\x3f\xec\x01\xbb\x35\x26\xcc\x8d\xb4\x63\x9f\x6b\x01\xca\x5b\x7e\xde\xda\x7a\x92\x6e\x9d\x58\x40\x0b\xb2\x31\x3f\x76\xf4\x1b\x1b\xdd\x8f\x2f\x3b\x02\xae\x66\x44\x63\x29\x18\xd1\x38\xa3\x0c\xdf\x0c\x1c\xef\x06\xc6\x81\xe3\xdf\xc1\x23\x74\x11\xed\x14\x29\x34\x6f\xa3\x08\xa0\xf2\x83\x53\x7b\xcd\x42\xe9\x5a\x0e\x67\xfe\x26\xd2\x07\x2c\x32\x2a\xb2\xfa\x68\x9d\x87\x8a\x21\xc3\xff\xce\x20\xae\x0c\x63\x31\xfc\xb8\x5d\xf1\x07\xb0\x6a\xdb\xae\x60\xeb\x26\xad\xe6\xd9\x8c\x71\xa2\x69\x35\xa7\xa2\x1b\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x2e\xae\xd5\xa1\xf3\xe9\x91\xb1\x67\x24\x4a\xf1\x9c\x12\xdd\xd6\xc0\x6c\x55\xb4\x59\x08\x23\x7e\x74\x78\x19\x15\x70\x06\x4f\x07\x99\xb4\x21\x26\xef\x12\x52\x14\x12\x95\xda\xa8\x8c\x3e\xb3\xc5\x00\x31\x50\xb9\xbd\x3b\xc9\xbb\x84\x16\xcf\x1a\xb6\xda\xa1\x90\x68\x4d\xf2\x3b\x9f\x80\x81\x68\xe7\x04\xbc\x90\xbb\x00\x2d\x3d\x2d\x00\x06\x40\xe1\x59\x36\xf1\xab\x70\xe0\xdc\x68\x61\xf4\xea\xaf\x9b\x6a\xc6\x7d\x44\x35\x61\x06\xfb\x3f\xce\xf4\x15\x41\xc5\x73\x9c\x6f\x0f\x37\x49\x93\x95\x0a\xf2\x15\xf5\xcd\xd7\xc1\x57\xce\xbf\x03\x00\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
 		},
 		"/terraform/x86_64": &vfsgen۰DirInfo{
 			name:    "x86_64",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/generalos": &vfsgen۰DirInfo{
 			name:    "generalos",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/generalos/libvirt": &vfsgen۰DirInfo{
 			name:    "libvirt",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/generalos/libvirt/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
-			uncompressedSize: 3400,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49809124, time.UTC),
+			uncompressedSize: 3718,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\xdf\x6b\xe4\x36\x10\x7e\xf7\x5f\x31\x15\x7d\xb8\x0b\x8d\x92\x96\xa3\x85\xc2\x52\xda\x04\xda\x3c\x1c\x0d\x69\xef\xe9\x08\x46\xb1\x67\x77\x45\x64\xc9\xd5\x0f\xa7\xdb\xc5\xff\x7b\x91\x2c\xf9\xd7\xda\xb9\xdc\x1e\x5c\x64\xcb\x33\xdf\x7c\x9a\xd1\x37\xd2\x5a\xd4\x9a\x6d\x95\xae\xe0\x98\x01\x68\xfc\xc7\x71\x8d\x65\x5e\x6b\xd5\xf0\x12\xb5\x09\xd3\x00\x82\x3f\x35\x5c\x5b\xd8\xc4\x77\x00\xa3\x9c\x2e\x10\x36\x40\xca\x8a\x15\x0d\x2f\x98\xbe\x8a\x56\x24\x9a\x34\xa8\x0d\x57\xd2\xdb\x5c\xd3\x9f\xe8\x8f\xdd\x7c\x9b\xf9\xff\x6d\x96\xa5\x18\x40\x92\x5f\x00\x77\x9a\x7b\x8f\xe3\x91\xde\x0b\x66\x3d\x35\xfa\xe9\xe1\xae\x6d\x89\xf7\x69\x98\xe6\xec\x49\x20\x90\x42\x38\x63\x51\xe7\xbc\xec\xdc\xec\xa1\x46\x0f\xbf\x01\x63\x35\x97\xbb\x0c\xa0\xc4\x2d\x73\xc2\x46\xb4\x9b\xce\xe1\xee\xf6\x04\x8a\x4b\x63\x99\x2c\x30\x2f\x94\x93\xf6\x8d\x70\x1f\x99\x47\xa3\x37\xde\x65\x1d\x71\xaf\x8c\x95\xac\xc2\x39\xa8\xe0\xc6\xbe\xeb\x90\xdf\x4f\xa0\x07\xe4\x3f\xa2\x6b\xdb\xae\x60\xf3\xfa\x0c\xd4\xbb\xfb\x39\x5e\x9f\xc8\xba\xf9\x90\x17\xbc\xd4\x6f\x4c\x40\x5f\x9d\x9b\xbb\xdb\x87\xf5\xf2\x78\xd4\x1d\xb3\xf8\xc2\x0e\x5f\x0b\xfc\x7b\xe7\xf6\x4a\xbd\x6a\x77\x46\x0a\x6e\xee\x3f\xad\xe6\x54\xb3\xea\x0c\xc4\x87\x5f\x3f\xae\x22\x96\xdc\x3c\x9f\x01\x79\xcb\xcd\xf3\x2a\x66\x21\x94\x2b\xb9\xe4\xf6\x0c\xe0\xdf\x94\xb2\x37\x4a\x6e\xf9\xae\x83\xd7\x18\x95\x9c\x54\x98\xd7\x4a\x09\x02\xa4\x1b\x3c\xbe\xdf\x85\xbe\x36\xdf\x1e\x1b\xa6\xe9\x20\xbc\xf6\x32\xd8\x24\x06\xbe\x15\x70\xed\x5f\x6b\x66\xf7\xfe\xf5\xaa\xe9\x9a\x42\x6a\x0c\x57\xbc\x62\x3b\x34\x57\x27\x40\x64\x99\x49\xa3\x84\xf3\xda\x21\xe9\xa1\x67\x03\xcb\x7c\xa2\x9d\xa7\xa0\x94\x88\x29\x19\x56\x45\xc3\x1f\x0f\x90\x8d\x1b\xd8\x78\xcf\xfd\xf9\xd7\x9d\xe7\xd8\x7e\x91\xd2\x50\xd7\xd0\x35\xa0\xff\xb7\x01\xcf\x6a\xda\x53\x06\xda\x83\x55\xa4\x7f\xd2\x2a\x3e\x07\x0f\xca\x65\x89\xff\x3e\xb6\x97\x21\x4e\x06\xf0\xc4\x0c\xc6\xe8\x39\x2f\x47\x0b\xeb\xe6\x68\x1c\x78\x39\x2c\x7e\x88\xb5\x9e\x04\xfe\xdf\x94\xd5\x84\x92\x8f\x3d\xa1\x03\x17\xf0\xfd\xf5\x0f\x1f\xa6\xc3\x72\xa2\xfa\x3d\x1a\x15\x40\x66\x9b\x76\xc8\xda\x17\x13\xf6\xd6\x5c\x51\x6e\x14\x99\x2c\xff\x95\x95\x3b\x83\x3a\x2f\x99\x65\xb0\x01\x3f\x50\x8b\x55\x2d\x98\xc5\x7c\xcb\x05\xd2\xfe\x33\xbd\xa0\x1a\x65\x89\x1a\xcb\x49\x34\xbf\xea\xe0\x4e\x26\x8e\x04\x48\xef\x3a\x5b\xe8\xca\x3a\x93\x3b\x6c\xfa\x47\x0f\xf4\x6e\x6a\x9c\x92\x37\xe1\xf0\x1d\x1c\x21\xa5\x62\x0e\xbf\x98\x22\x68\xdf\x2f\x57\x4b\xa2\x7d\x51\xda\x97\xa9\x7f\x3a\x2e\xd7\x60\x2c\x37\x89\xe1\xac\xaf\x54\x39\x58\x49\x16\xe6\x4a\x55\x31\x2e\xd7\x3c\xa9\x50\x05\x0b\x9d\x83\x95\xa5\x46\x63\xd0\xc0\x06\x3e\x4f\xac\xd2\x71\xf4\x98\x79\xb8\x7d\x51\xc7\xab\x07\x4a\xdf\x0d\xbd\x02\xac\x76\x18\x2e\x13\xde\x40\x9a\xd9\xf7\x10\x3a\x9a\x00\x84\x80\xb9\x92\xe2\x30\x73\xd4\xca\x59\x4c\xbe\x3e\xde\x50\xaa\x13\x2a\xc1\x26\x1e\x66\x4b\x36\xf1\x53\x82\x66\xce\x2a\x63\x59\xb8\x36\x85\x90\x8b\xa9\xef\x32\x45\x80\xec\x50\xa2\x66\x42\x99\xc5\xb6\xf2\xc6\xbe\xf2\xa6\x6d\xe0\xd1\x6b\x17\xd7\x1c\xaa\xb7\x01\xe2\x2d\x2f\x6b\x66\x8c\xdd\x6b\xe5\x76\x7b\x92\x75\x77\xb5\xc6\x9b\xbe\xc2\xa3\x76\x73\xec\x0a\x2b\xa5\x0f\xab\x1e\x9a\x55\x27\x6c\xd2\xf6\x3e\xe9\x58\xd3\x36\x42\xfb\x57\x7a\x41\x79\x79\x12\x98\x15\x7b\x2e\x71\xdc\x64\xc3\xc1\x17\x66\xff\x3e\xd4\xa1\xad\x8f\xeb\x92\xcc\xe2\x86\x48\x07\xe9\xc8\xff\xb9\xa9\x48\xd8\x5f\xdc\x3c\xc7\x84\xbd\xd2\x84\x03\xc7\x25\x66\x61\x3f\x44\x69\xe5\x5c\x5a\xd4\x5b\x56\x60\x04\x4c\xf3\xfd\xc9\x36\x53\x25\xed\xc7\xae\x75\xc1\x20\xfa\xaf\xaa\xf9\x58\x6d\x4b\x7e\xbc\x9e\x36\x8b\x6f\xbc\x9c\x9d\x10\x04\x7e\xe9\xb4\xb9\x66\xf9\x08\x3f\x83\x37\x0c\x31\x5e\x18\xb7\xf9\x56\xe9\x5c\x20\x33\x38\x53\xdb\x4e\xb3\x7a\xcf\x8b\xa4\xb7\x71\xc2\x37\x40\x1a\x59\x90\xf8\x73\xc3\x58\x94\x79\xba\x56\x44\xda\x24\xa1\x14\x4a\x1a\x25\x70\x19\xa4\xb6\x87\x0e\xc4\x32\xbd\x43\xdf\xf9\x83\x00\xc9\x35\x49\x3f\x3c\x94\xb3\xb5\xb3\x40\xbc\x66\x3b\xad\x35\x4c\x38\x1c\x25\xbe\xd3\x24\xed\x15\x49\x2f\xe8\x49\xf1\xe8\x35\xed\xd3\x99\xb5\xff\x07\x00\x00\xff\xff\x02\xa3\xa5\xb2\x48\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x57\x5d\x4f\xe4\x36\x14\x7d\xcf\xaf\xb8\x75\xfb\xc0\xa2\xc5\xd0\x0a\x6d\xa5\x4a\xa3\x6a\x05\x6a\xcb\xc3\xb6\x88\xdd\xed\x0b\x42\x91\x89\xef\xcc\x58\x24\x76\xea\x8f\xd0\x29\xca\x7f\xaf\xec\xd8\xc9\x64\x26\x99\xc2\x3c\x14\x09\x42\xec\x7b\xcf\x3d\xbe\x1f\xc7\x33\x16\xb5\x66\x4b\xa5\x2b\x78\xc9\x00\x34\xfe\xe5\x84\x46\x9e\xd7\x5a\x35\x82\xa3\x36\x61\x19\xa0\x14\x8f\x8d\xd0\x16\x16\xf1\x1d\xc0\x28\xa7\x0b\x84\x05\x10\x5e\xb1\xa2\x11\x05\xd3\xe7\xd1\x8a\x44\x93\x06\xb5\x11\x4a\x7a\x9b\x0b\xfa\x23\xfd\xd0\xad\xb7\x99\xff\x6d\xb3\x2c\xc5\x00\x92\xfc\x02\xb8\xd3\xc2\x7b\xbc\xbc\xd0\xdb\x92\x59\x4f\x8d\x7e\xbd\xbb\x69\x5b\xe2\x7d\x1a\xa6\x05\x7b\x2c\x11\x48\x51\x3a\x63\x51\xe7\x82\x77\x6e\x76\x53\x23\x00\xc0\x02\x8c\xd5\x42\xae\x32\x00\x8e\x4b\xe6\x4a\x1b\xd1\xae\x3a\x87\x9b\xeb\x3d\x28\x21\x8d\x65\xb2\xc0\xbc\x50\x4e\xda\x57\xc2\x7d\x62\x1e\x8d\x5e\x79\x97\x79\xc4\xb5\x32\x56\xb2\x0a\x77\x41\x4b\x61\xec\x49\x87\xfc\x6e\x04\x3d\x20\xff\x16\x5d\xdb\x76\x06\x5b\xd4\x47\xa0\xde\xdc\xce\xe2\x19\xcb\xac\x28\x72\x89\xf6\x59\xe9\xa7\x23\xb0\x3f\x07\x80\xdf\x3b\xff\xb6\x9d\xa9\x57\xdd\x5c\xe6\x85\xe0\xfa\x95\x79\xee\x9b\xe0\xea\xe6\xfa\x6e\xbe\x0b\x3c\xea\x8a\x59\x7c\x66\x9b\xb7\x02\xff\xda\xb9\x1d\x68\x8b\xda\x1d\x91\x8d\xab\xdb\xaf\xb3\xa9\xd6\xac\x3a\x02\xf1\xee\xe3\xa7\x59\x44\x2e\xcc\x31\x25\xbb\x16\x26\x56\xea\x5b\x78\x64\x06\x3f\x5c\x02\xca\x42\x71\xe4\xe0\x0c\xea\x33\xce\x2c\x03\xb5\x04\x64\xc5\x1a\x52\xb0\xf7\x10\x47\x97\xc3\xe3\x06\xe4\x13\x07\xbb\xd6\xca\xad\xd6\xf0\xe5\x97\xfc\xcf\x8f\x77\x79\xcf\xca\x63\xe4\x1e\x63\x8a\x72\xbf\x39\xe6\x7d\x80\xb9\xdf\xba\x7f\xc8\x00\x0c\x4a\x23\xac\x68\x10\x16\x60\xb5\xc3\x99\xa4\xc4\x56\xce\x0b\x25\x97\x62\x75\x44\x7a\x62\x2f\x5f\x05\xff\x2e\x4f\x1a\xa3\xf2\x25\xd5\xca\x6b\xa5\x4a\x02\xa4\x7b\xf8\x10\x7e\x6a\x61\x01\xe4\xbb\x97\x86\x69\x3a\x08\x55\x7b\x16\x6c\x12\x09\x2f\x9d\x42\xfb\xd7\x9a\xd9\xb5\x7f\x3d\x6f\x3a\x11\x4d\x42\x7a\x2e\x2a\xb6\x42\x73\xbe\x07\x44\xa6\x99\x34\xaa\x74\x5e\x6b\x48\xfa\xa7\x67\x03\xd3\x7c\xa2\x9d\xa7\xa0\x54\x19\xb3\x32\x9c\x8a\x86\x3f\x1e\x20\xdb\x16\xfc\xed\xe1\xf9\xe3\xf3\x8d\xe7\xd8\xfe\x27\xa5\xa1\x41\x83\xca\x42\xff\xb3\x00\xcf\x6a\xac\xc1\x03\xed\xc1\x2a\xd2\xdf\x93\xd6\xfb\xe0\x41\x85\xe4\xf8\xf7\x43\x7b\x16\xe2\x64\x10\xba\x39\x46\xcf\x05\xdf\x3a\x58\xb7\x46\xe3\x43\xf0\xe1\xf0\x43\xac\xf9\x24\x88\x7f\xc6\xac\x46\x94\x7c\xec\x11\x1d\x38\x85\xef\x2f\x7e\xb8\x1c\x3f\xa6\x13\x55\x94\xca\x71\x21\x85\x8d\xa3\x4c\xfa\x85\xff\x21\x6b\x54\x18\x45\xde\x94\x88\x7e\x78\x93\xa5\xd5\x9b\x93\x4e\x41\x38\x7a\x01\x39\x19\x85\xed\xcd\x47\x71\xdf\xbd\x07\x42\xfc\xec\x8d\x07\x75\xf7\x70\xe3\xdd\x11\x02\x7c\xb3\x00\x22\x5d\x59\x12\xf8\x19\x96\xa2\xc4\x93\xd7\x7a\xbe\x83\x9f\xc0\x3b\x4e\x57\xa3\xbf\x04\xc9\xe8\x3a\x1c\xd2\x3b\x39\x4e\x12\xc3\x67\x9f\x4a\xf1\xc1\x4a\xb2\xb0\xc6\x55\xc5\x84\x9c\xf3\xa4\xa5\x2a\x58\x50\x06\xc6\xb9\x46\x63\xd0\x78\xa9\x1b\x59\xa5\x7b\xf3\x21\xf3\x70\xeb\xa2\x0e\x8c\x00\x50\x7a\xd5\xe3\x49\x09\x01\xda\x60\x20\xcd\xce\x3e\xc0\x60\x02\x10\x02\xe6\x4a\x96\x9b\x1d\x47\xad\x9c\xc5\xe4\xeb\xe3\x0d\xdd\xb6\x47\x25\xd8\xc4\x5b\x77\xca\x26\x6e\x25\x68\xe6\xac\x32\x96\x69\x9b\x42\x4e\xa6\xbe\xcb\x14\x01\xb2\x42\x89\x9a\x95\xca\x4c\x0e\xc0\x2b\x27\x60\xd7\x6c\xb2\xff\x3d\x7a\xed\xe2\x99\x43\xf5\x16\x40\xbc\xe5\x59\xcd\x8c\x89\x97\x1b\x09\xc7\x00\x68\xbc\xe9\x01\x1e\xb5\xdb\xc5\xae\xb0\x52\x7a\x33\xeb\xa1\x59\xb5\xc7\x26\xcd\xfe\xde\x20\x8e\x65\x82\xf6\xaf\xf4\x94\x0a\xbe\x17\x98\x15\x6b\x21\x71\x5b\x0e\xc2\xdd\x16\x56\xbf\x6c\xea\x20\xdb\xdb\x75\x19\x86\xd9\x61\xba\xa6\xc6\x72\xf2\xd4\x54\x24\xf4\x97\x30\x4f\x31\x61\x07\x44\x36\x70\x9c\x62\x16\xfa\x21\x4d\xa7\x90\x16\xf5\x92\x15\x18\x01\xd3\x7a\x7f\x73\xed\x4c\x25\xed\x9f\x9d\x16\x01\xa4\xaa\xbe\xad\xe6\xdb\xd3\x36\xe5\x27\xea\x79\xad\xb9\x3f\x64\xf9\x90\xb4\x05\x00\xe0\x99\x09\x9b\x2f\x95\xce\x4b\x64\x06\x77\x63\x8c\x3f\x74\xef\xc7\xf3\x95\x20\x29\x61\x2b\xcd\xea\xb5\x28\xd2\x74\x6e\x97\x67\x01\xa4\x91\x05\x89\x5f\xd6\x8c\x45\x99\xa7\x0f\x19\xf1\x90\x3d\x4a\xa1\xa4\x51\x25\x4e\x83\xd4\x76\xd3\x81\x58\xa6\x57\xe8\x85\x3f\x8c\x2b\xb9\x20\xe9\x6b\x9b\x72\xb6\x76\x16\x88\x9f\xf0\x6e\x32\x1b\x56\x3a\xdc\x2a\x53\x37\xc1\xb4\x9f\x5f\x7a\x4a\xf7\x4a\x4d\x2f\x68\x9f\xfc\xac\xfd\x77\x00\xf3\xaf\x15\xf6\x86\x0e\x00\x00"),
 		},
 		"/terraform/x86_64/generalos/libvirt/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
-			uncompressedSize: 2699,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49715514, time.UTC),
+			uncompressedSize: 3017,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x51\x8f\xdc\x34\x10\x7e\xcf\xaf\x18\x2c\x1e\xda\x13\x67\x0e\x54\x81\x84\x14\x21\xb8\x3e\x70\x0f\x15\xa7\x42\xc5\x43\x55\x45\xbe\x78\x76\xd7\x5a\xc7\x0e\x63\x3b\xe5\x58\xe5\xbf\x23\x27\x4e\x36\xd9\x4d\x96\xeb\x9e\x74\xda\xc4\x9e\xef\x9b\xcf\x9f\x67\x1c\x7b\x24\x12\x1b\x4b\x15\x1c\x32\x00\xc2\xbf\x83\x22\x94\x45\x4d\xb6\x51\x12\xc9\x75\xc3\x00\x5a\x3d\x35\x8a\x3c\xe4\xe9\x1d\xc0\xd9\x40\x25\x42\x0e\x4c\x56\xa2\x6c\x54\x29\xe8\xdb\x14\xc5\x52\x48\x83\xe4\x94\x35\x31\xe6\x8e\xff\xc8\x7f\xe8\xc7\xdb\x2c\xfe\xb7\x59\x36\xe4\x00\x36\xe0\x3a\xf2\x40\x2a\x22\x0e\x07\xfe\xa8\x85\x8f\xd2\xf8\x87\xf7\x0f\x6d\xcb\x22\xa6\x11\xa4\xc4\x93\x46\x60\xa5\x0e\xce\x23\x15\x4a\xf6\x30\xff\x5c\x63\xa4\xcf\xc1\x79\x52\x66\x9b\x01\x48\xdc\x88\xa0\x7d\x62\xbb\xef\x01\x0f\x6f\xcf\xa8\x94\x71\x5e\x98\x12\x8b\xd2\x06\x93\x54\xcc\xb1\x7f\x59\xda\x23\xf1\xfb\x38\xbf\x0e\xdf\x59\xe7\x8d\xa8\xf0\x54\x90\x56\xce\xbf\xea\x55\xbd\x9e\x51\x1f\x99\x7f\x4b\xd0\xb6\x5d\xe1\x56\xf5\x15\xac\x0f\x8f\xab\x7c\x65\x1d\xae\x20\xbc\x7f\xfc\xb0\xca\x48\xa2\xba\x82\xf1\xfd\x2f\xef\x56\x19\xa5\x72\xfb\x2b\x28\xdf\x2a\xb7\x5f\x5f\xb7\xb6\x41\x2a\xa3\xfc\x15\xc4\xbf\x5a\xeb\xef\xad\xd9\xa8\x6d\x4f\x4f\x98\x9a\x60\x28\xe0\xa2\xb1\x3a\xc4\xfd\x67\xc3\x43\xcc\x11\xf7\xb5\x4b\xc1\xbe\x3e\x34\x82\xf8\xb1\x72\xdb\xdb\x14\x97\x01\xd4\xd6\xea\xb5\xa8\x38\x17\x63\x8e\x3d\x37\xed\x8e\xdf\xff\x78\xa8\xc4\x16\x53\x5d\x5e\x10\x75\xf4\xb3\x2b\x74\x98\xfc\xe5\x10\x73\xce\x1b\xe1\x28\x7d\x1a\x97\xe4\x9d\x15\xfd\xc7\x0e\xc3\x95\x91\xf8\xcf\xa7\xf6\xb6\xcb\x95\x01\x3c\x09\x87\x49\x41\xd1\xb1\xbd\xcc\x86\x85\x8c\x8b\x86\xa8\x7f\x4f\xf5\xcd\xc4\x45\x15\x33\x61\x70\x03\xdf\xdd\x7d\xff\x66\xfe\xb3\x6c\xdb\x58\x29\xa9\x0e\xd9\x49\xe9\x1c\x3d\xfc\x5f\xf3\x5e\xea\x1a\x57\xce\xce\x4d\xb8\xb8\xfa\xe0\x90\x0a\x29\xbc\x80\x1c\xe2\x0f\xf7\x58\xd5\x5a\x78\x2c\x36\x4a\x23\x1f\xa7\xf9\x0d\x27\x34\x12\x09\xe5\x2c\x5f\x5c\x77\x07\x67\x33\x20\x03\x36\x42\x4f\x96\xba\xb2\xd2\x01\x0e\xf9\xf8\x18\x89\x5e\xcd\x83\x07\xfb\x66\x1a\xbe\x81\x03\x0c\x66\x9c\xd2\x2f\x9a\x04\xed\xeb\xe5\xfd\x92\xb6\x12\xca\x30\x60\x5b\x34\x48\x42\x5b\xb7\x5c\xeb\x2f\x2c\xf5\x97\x68\x89\xec\x75\x48\x9f\xc4\xca\xca\xae\xba\x63\xe4\x6d\x2d\x9c\xf3\x3b\xb2\x61\xbb\x63\x59\xff\xcd\x6b\x62\xe8\x05\x1d\x75\x38\xe5\xae\xb0\xb2\xf4\xbc\x8a\x20\x51\x9d\xa9\x19\x3c\x1e\x11\xcb\xd5\xcc\xc7\x57\x7e\xc3\x95\x3c\x4b\x2c\xca\x9d\x32\x38\xed\xc1\xc3\x81\xbf\xeb\x47\xff\x7c\xae\xbb\xb3\x06\x40\x04\x6f\x9d\x17\x74\xcc\xe6\x29\xe0\xe4\x54\x9d\xe0\xf7\x4d\xc5\xb2\x78\xae\x2a\xb7\x4f\x86\xa5\x53\x41\xc9\x89\xcc\x7e\x8c\x77\x1a\x97\x94\xb5\x91\xc3\xa0\xff\x6c\x69\x5f\x28\xe3\x91\x36\xa2\xc4\x44\x38\x8c\x5f\x3a\x70\x0d\xa6\xeb\xc9\x58\x74\x5f\xb4\xdd\x00\x42\x4a\x42\xe7\xd0\x2d\xe2\x54\x3d\x2f\xd6\xaf\x72\x60\x26\x68\xcd\xe0\x67\xf8\x78\x29\xf2\x13\xfc\x04\x31\xb0\xcb\xf1\x59\x28\x5f\x6c\x2c\x15\x1a\x85\xc3\xa3\xaf\xdd\xea\xb7\x24\xea\x9d\x2a\x87\x9b\xd9\xd4\xeb\x1c\x58\x63\x4a\x96\x6e\x6c\xce\xa3\x29\xba\xe9\x1c\x58\x92\xcd\x06\x96\xd2\x1a\x67\x35\x2e\x93\xd4\xfe\xb9\x27\xf1\x82\xb6\xe8\x8b\xda\x76\x57\x3f\x76\xc7\x86\xbb\x9b\x0d\xbe\x0e\x1e\x98\xaa\x9b\x37\x7d\x9b\x35\x42\x07\x9c\xec\x64\xdf\x8e\x7c\x6c\x46\x7e\xc3\xcf\xf6\x8d\xdf\xf1\xd1\xce\xac\xfd\x2f\x00\x00\xff\xff\xfb\x83\xbf\x12\x8b\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x71\x7b\x48\x82\x86\xcb\x86\xa0\x03\x06\x18\x43\x91\x62\x58\x1e\xba\x05\x69\xbb\x3d\x04\x81\xc0\x88\x67\x9b\x88\x44\x6a\x47\x52\x9d\x67\xe8\xbb\x0f\x94\x28\x59\xb2\x25\xcf\xb5\x01\xc3\x16\x79\xbf\x3f\x3a\xde\x1d\xe8\x90\x48\x2c\x0d\x15\xb0\x4d\x00\x08\xff\xf6\x8a\x50\xa6\x25\x99\x4a\x49\x24\xdb\x2c\x03\xe4\xea\xa5\x52\xe4\x60\x11\x9f\x01\xac\xf1\x94\x21\x2c\x80\xc9\x42\x64\x95\xca\x04\x7d\x1f\xa3\x58\x0c\xa9\x90\xac\x32\x3a\xc4\xdc\xf0\x9f\xf8\xdb\x76\xbd\x4e\xc2\xb7\x4e\x92\x4e\x03\x58\x87\x6b\xc8\x3d\xa9\x80\xd8\x6e\xf9\x43\x2e\x5c\xb0\xc6\x3f\x3f\xde\xd7\x35\x0b\x98\x4a\x90\x12\x2f\x39\x02\xcb\x72\x6f\x1d\x52\xaa\x64\x0b\x73\x9b\x12\x01\x00\x16\x60\x1d\x29\xbd\x4a\x00\x24\x2e\x85\xcf\x5d\x64\xbb\x6b\x01\xf7\xef\x0f\xa8\x94\xb6\x4e\xe8\x0c\xd3\xcc\x78\x1d\x5d\x8c\xb1\x7f\x19\x7a\x45\xe2\x77\x61\x7f\x1e\xbe\x36\xd6\x69\x51\xe0\xbe\xa1\x5c\x59\x77\xd1\xba\xba\x1c\x51\xef\x98\x7f\x8b\xd0\xba\x9e\xe1\x56\xe5\x19\xac\xf7\x0f\xb3\x7c\xd6\x09\xa7\xb2\x54\xa3\xfb\x62\xe8\xf5\x0c\xee\x8f\x0d\xc1\xef\x2d\x7e\x56\x26\x2b\xfd\x19\xdc\x77\x0f\x9f\x67\x19\x49\x14\x67\x30\x3e\xbe\xfb\x30\xcb\x28\x95\x3d\x27\x01\xef\x95\x8d\xef\xfd\x2d\xbc\x08\x8b\x6f\x6f\x01\x75\x66\x24\x4a\xf0\x16\xe9\x5a\x0a\x27\xc0\x2c\x01\x45\xb6\x86\x4e\xec\x0d\xc4\xaa\x97\xf0\xb2\x01\xfd\x2a\xc1\xad\xc9\xf8\xd5\x1a\x3e\xfd\x9a\xfe\xf9\xee\x31\xed\x5d\x05\x8e\x34\x70\x4c\x59\xee\x37\xc7\xbe\x8f\x38\x0f\x5b\x4f\xcf\x09\x80\x45\x6d\x95\x53\x15\xc2\x02\x1c\x79\x9c\x49\x4a\x2c\x8c\x34\x33\x7a\xa9\x56\x67\xa4\x27\x56\xc6\x5d\x83\x6f\xf3\x44\x18\x87\x46\xd7\xf0\x69\x65\x72\x1f\xfa\x85\x75\x7f\x82\x4c\xe8\x83\x46\x85\x7d\xb7\xad\x04\xf1\x5d\xa7\xd7\xd7\x31\x2e\x01\x28\x8d\xc9\xe7\xa2\xc2\x5e\x88\xd9\xcd\xa8\xe1\x34\xf9\xe3\xe3\x7d\x21\x56\x18\xfb\xf8\x88\xa9\x5d\x61\x34\x83\x01\x06\x9f\x05\x04\xcd\xf1\xe0\xd8\x59\x1f\xc6\x45\x7b\x07\x43\xe2\xa9\xc1\x70\xa5\x25\xfe\xf3\x5c\x5f\x37\x5a\x09\x34\x95\x14\x1d\xa4\x0d\xdb\x69\x69\x98\x50\x9c\x4c\x88\xfa\x77\xdf\xdf\xc8\x5c\x70\x31\x32\x06\x57\xf0\xc3\xcd\x8f\xb7\xe3\x9f\xe9\xb4\x65\xb9\xf1\x52\x69\xe5\x62\x43\xb1\x7e\x61\x32\x87\xa7\x65\xf0\xd4\xfc\x71\x65\xcd\x44\x3a\x8e\x26\xa3\x6f\xa2\x2e\xd6\xd1\xe6\xa2\xed\x64\x89\xa1\x91\x2f\x46\xc2\x7d\xf8\x48\xf9\xf2\x0d\x30\x16\x7a\x60\xdc\x30\xfb\xaf\x37\xde\x1d\x31\xc0\x37\x0b\x60\xda\xe7\x39\x83\x5f\x60\xa9\x72\xbc\x38\x15\x79\x09\x3f\x43\x00\x4e\x9f\x87\x34\x85\x50\x9a\x01\x5b\xa1\x46\x12\xb9\xb1\xd3\xb5\x7c\x62\x29\x2f\xe0\xff\x8f\x21\xb0\x97\xbe\x51\x01\x28\x8c\x6c\xaa\x37\x44\x5e\x97\xc2\xda\x38\xe9\x58\xd2\xde\x01\xaa\x10\x7a\xc4\x47\xe9\xf7\xb9\x0b\x2c\x0c\x6d\x66\x11\x24\x8a\x03\x37\x5d\x09\xc2\x6e\x3c\x4e\x55\x2b\xef\x1f\xf9\x15\x57\xf2\x40\x58\x64\x6b\xa5\x71\x58\x56\xdb\x2d\xff\xd0\xae\x7e\xda\x94\xcd\x2c\x01\x10\xde\x19\xeb\x04\xed\xd4\x9a\x01\x3b\x9c\xcf\x3d\xfe\xb5\x2a\x58\x92\x00\x04\xf5\x98\xb0\xd8\xf5\x4a\x0e\x6c\xb6\x6b\xbc\xf1\x38\xe5\xac\x4e\x06\xa5\xa7\xb4\x43\x5a\x8a\x0c\x23\x61\xb7\x7e\x6c\xa0\x6a\x8c\xd7\xb5\xee\x40\xbf\xee\xb8\x01\x84\x94\x84\xd6\xa2\x9d\xc4\xa9\x72\xbe\xd6\x9f\x8e\x45\x3e\x77\xb5\x0d\x00\xf0\x45\x28\x97\x2e\x0d\xa5\x39\x0a\x8b\xfb\x1a\xe3\xab\xcc\xa1\x5e\x38\x04\xd6\xe5\x6a\x45\xa2\x5c\xab\xac\xbb\xd7\x0e\x4f\x66\x01\xac\xd2\x19\x8b\xf7\x5d\xeb\x50\xa7\xcd\xf6\x02\x58\x7c\xc9\x9e\x25\x33\xda\x9a\x1c\xa7\x49\x4a\xb7\x69\x49\x9c\xa0\x15\xba\xb4\x34\xcd\xc5\x99\xdd\xb0\xee\xe6\x6b\xbc\x2b\xbd\x03\xa6\xca\xea\xb6\x6d\xca\x4a\xe4\x1e\x07\xe7\xde\x36\x2f\xef\x5b\x97\x5f\xf1\x83\x53\xe6\x37\xbc\x4f\x7e\x52\xff\x37\x00\xbb\x7c\x17\x84\xc9\x0b\x00\x00"),
 		},
 		"/terraform/x86_64/generalos/openstack": &vfsgen۰DirInfo{
 			name:    "openstack",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/generalos/openstack/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
-			uncompressedSize: 4738,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49619700, time.UTC),
+			uncompressedSize: 4796,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x45\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\xd2\xc9\x21\x4c\x89\x1c\xff\xa8\x69\x03\x7d\xf7\x81\x22\x25\x5b\xb2\x14\x3b\x6e\x02\x14\x45\x01\xea\x78\xf7\xfb\xdd\x1d\x79\xbc\x73\x34\x4a\x49\x72\x2e\x0b\x78\x08\x00\x24\xfe\x67\xa8\xc4\x2c\x11\x92\x57\x34\x43\xa9\x1a\x31\x00\x17\x58\x2a\x4d\xd2\x25\x9c\x79\x09\x80\xe2\x46\xa6\x08\x67\x10\x76\x20\x07\xad\xdd\x41\x67\xf0\xb6\x5b\x85\xde\xae\x42\xa9\x28\x2f\xad\xe1\x2c\x8a\xa3\xd8\xc9\xeb\xc0\xfe\xaf\x83\xa0\x85\x80\x70\x65\xd9\x70\x1a\x85\x32\x29\x49\x81\x00\xd6\xf6\xe1\x21\xba\x62\x44\x5b\xda\xe8\x46\xa1\xb4\x3b\x75\x6d\xc1\x04\x51\xea\x33\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\xbf\x9b\xad\x8f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x97\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x7e\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\xde\x11\xee\x03\xb1\x68\xd1\x85\x35\x99\x46\xbc\xe3\x4a\xdb\x3c\x0d\x41\x19\x55\xfa\x95\x43\x7e\xdd\x83\x5e\x21\xff\xe9\x4d\xeb\x7a\xca\x5b\x61\xf6\x80\xbd\xb8\xba\x99\x44\x94\xa4\xd8\x03\xf1\xfa\xfc\xc3\x24\x62\x46\xd5\x72\x0f\xc8\xf7\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\xe7\xd4\xdd\xa3\x3f\x98\xb5\x6e\xaf\x66\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x32\xf6\xf9\x1a\xc0\x3f\xbc\xc4\xe9\x0b\x61\xab\x31\x23\x9a\xec\x91\x94\xdf\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x16\x32\x4b\x4a\xd4\x4f\x75\xfd\xd2\xdb\x7e\x44\xfd\x99\xcb\xe5\xb4\xe7\x54\xec\xe1\xf3\xe5\xd5\xd0\x57\xbc\xdf\xdf\xd7\xdf\xef\xc7\x7c\x95\xe8\x9f\xd4\xd5\xcb\x97\xa4\xbc\x10\x46\x63\x92\x33\x52\x71\x99\x54\xb3\x10\x42\xb7\x76\x9c\x4d\xa5\xfb\xd7\xa6\x22\x32\xea\x3f\x01\x01\x80\x7f\x30\x37\x15\xda\x8a\xfe\xd4\x68\x46\xb4\xcc\xf0\xfe\xdf\x00\xa0\x4a\x85\x51\xa3\x88\xc2\x0c\x75\x25\x29\x60\x14\x5c\x92\x62\xa8\x6b\x2b\x68\x54\xd7\x6e\x0c\x95\xa9\x4a\x84\xb9\x65\x34\x6d\xda\x8b\x34\x38\x99\xa0\x5b\xc6\xd3\xa5\xd2\x5c\x92\x05\x26\x15\x67\xa6\xc0\xa4\x9a\x87\x10\xba\xf5\x7a\x96\x1e\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x96\xd3\x54\x98\x2e\x24\x37\xc2\x9d\x67\xfb\xe5\x7c\x5d\x1d\x58\xd3\x41\x7e\x7c\xb0\x54\xab\x26\x51\x1f\x14\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xdd\xb4\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x73\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x83\x80\xe6\x29\x67\xcd\x39\xa4\xc2\xf5\xea\x94\x66\x72\xcd\xc5\x38\x6a\xfe\xbd\x6d\x3a\x79\xfd\x28\xdb\xc1\xe1\x08\x9b\x17\x0e\xd8\x68\x5a\x7c\x33\xdd\x69\x3c\x42\xe7\x85\xcf\x1f\xdc\xd1\xd1\x7c\x84\xae\x95\x3e\x3f\xdf\x6c\x7e\xf2\xf3\xd8\xe1\xcd\x5f\x2c\xc2\xc3\x51\xc2\x56\xfa\xfc\x7c\xc7\xe3\x29\x3d\x7e\xb9\x9c\x1e\xc6\xb3\xa3\xd3\xb1\x18\x3b\xf9\x8b\x70\xbe\x8b\xc7\x39\xdf\xbd\xd4\x49\xce\xe3\x38\x1e\xe3\x9c\xcf\x4e\x8e\x4f\xbe\x17\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xa7\x47\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\x3d\x66\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x55\xcf\xf1\x76\x60\xec\x39\xfe\x13\x3c\x40\x1b\xd1\x4e\x91\x42\xfd\xda\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\xef\x31\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\x1f\xce\x20\x2c\x0d\x63\x21\xfc\xfa\xb8\xe2\x2f\x60\xd5\x1e\xbb\x82\xde\x4d\x5a\x2e\x92\x9c\x71\xa2\x69\xb9\xa0\xa2\x1d\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x36\xae\xf5\xa1\x73\xfb\xc8\xd8\x31\x12\xa5\x78\x4a\x89\xf6\x35\x90\xaf\x8b\x36\x0b\x61\xc2\x8f\x16\x2f\xa1\x02\xce\x60\x7b\x90\x91\x0f\x31\x7a\x13\x91\x2c\x93\xa8\xd4\x46\x65\x74\x99\xcd\x7a\x88\x23\x95\xdb\xb9\x13\xbd\x89\x68\xf6\xa4\x61\xcb\x0f\x85\x44\x6b\x92\xde\xb9\x04\xf4\x44\x3b\x27\xe0\x99\xdc\x05\xf0\xf4\x34\x6b\xb8\xb6\xcd\xb2\x91\x5b\x8d\x07\xce\x8d\x16\x46\xaf\xff\xba\x29\x73\xee\x22\xaa\x08\x33\xd8\xfd\xbd\xa6\xab\x88\xc1\xe9\x6d\x71\xde\x1f\x6e\x14\x47\x6b\x15\xe4\x2a\xea\x9b\xaf\x83\xab\x9c\xff\x03\x00\x00\xff\xff\x81\x9a\x0c\xab\x82\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6d\x6f\xdb\xb6\x13\x7f\xaf\x4f\x71\x7f\xfd\xf7\xa2\x29\x6a\x55\xb1\xd3\x24\x1b\x10\x0c\x41\xba\x87\x00\x6b\x11\x04\xcd\x5e\x2c\x18\x04\x5a\x3a\xdb\x84\x29\x91\xe3\x83\x9a\x34\xf0\x77\x1f\x48\x4a\xb2\x65\xd3\x8e\x93\x26\xc0\x50\x14\xa0\x8e\x77\xbf\xdf\xdd\xf1\xc8\x3b\x47\xa3\x94\x64\xc2\x65\x09\x0f\x11\x80\xc4\x7f\x0c\x95\x58\x64\x42\xf2\x9a\x16\x28\x95\x13\x03\x70\x81\x95\xd2\x24\x9f\xc3\x59\x23\x01\x50\xdc\xc8\x1c\xe1\x0c\xe2\x0e\x64\xd0\xda\x0d\x3a\x83\xf7\xdd\x2a\x6e\xec\x6a\x94\x8a\xf2\xca\x1a\x0e\x93\x34\x49\xbd\x7c\x11\xd9\xff\x8b\x28\x6a\x21\x20\x5e\x5a\x3a\x4e\xa3\x50\x66\x15\x29\x11\xc0\xda\x3e\x3c\x24\x57\x8c\x68\x4b\x9b\xdc\x28\x94\x76\x67\xb1\xb0\x60\x82\x28\xf5\x95\xcb\x02\x36\x15\xaf\x9a\x2d\xaf\xa8\xb1\x22\x95\xf6\x98\x6b\x8a\x5f\xdc\xd6\xe7\x0e\x93\x18\x3d\xcb\x8c\x64\x01\xcc\x73\xa3\x67\x37\xd7\x7f\x78\x3d\x89\x53\x1b\x1b\x04\xf4\xae\xdd\x96\x55\x5b\x44\x51\x4d\x24\x25\x63\x86\x10\xe7\xcc\x28\x8d\x32\xa3\x85\x0f\x53\xdf\x0b\xf4\xd6\x4a\x4b\x5a\x4d\x23\x80\x02\x27\xc4\x30\xdd\x00\x5e\x78\x83\xcb\x8f\x1b\x50\xd4\xa6\xab\xca\x31\xcb\xb9\xa9\xf4\x9e\x70\x9f\x88\x45\x4b\x2e\xac\xc9\x76\xc4\x19\x57\xda\xe6\x69\x1d\x94\x51\xa5\xdf\x78\xe4\x83\x1e\xf4\x12\xf9\xf7\xc6\x74\xb1\xd8\xe6\xad\x30\xcf\x80\xbd\xb8\xba\xd9\x8a\x28\x49\xf9\x0c\xc4\xeb\xf3\x4f\x5b\x11\x0b\xaa\xe6\xcf\x80\xfc\x48\xd5\x7c\x2b\x26\x57\xb4\x24\x53\xdc\xf3\x9c\xba\x3a\xfa\x8d\x59\xeb\xb6\x34\x7b\xd0\xa4\x26\x94\x91\x31\x65\x54\xdf\x67\xdf\x78\xf5\x64\xec\xf3\x15\x80\xbf\x78\xd5\x32\xfc\x1f\xc6\x44\xe1\xf1\x11\x60\x95\xf3\x02\x0b\x77\x17\x07\x05\xd1\x04\xf8\x04\x90\xe4\x33\x68\xa3\x7a\x07\xcd\x05\x2e\x60\x7c\x0f\xd5\xbc\x00\x3d\x93\xdc\x4c\x67\xf0\xe5\xd7\xec\xcf\xf3\xeb\xac\x0b\xdf\xdd\x67\x8b\x11\xca\x4d\xb7\xd9\x8f\x60\x47\xda\xed\xd6\xed\xdf\x11\x80\xc2\x4a\x51\x4d\x6b\x84\x33\xd0\xd2\xe0\x7a\xf6\xb5\x7d\x2b\x58\x56\xa1\x7e\x6a\x76\x2e\x1b\xdb\xcf\xa8\xbf\x72\x39\xdf\x7e\x5b\xa8\x78\x46\xad\x5c\x5e\xad\x57\x0a\xde\x3d\xdf\xd7\x5f\xee\x42\xbe\x4a\x6c\x5e\xed\xe5\xe3\x9a\xe5\xbc\x14\x46\x63\x36\x61\xa4\xe6\x32\xab\x87\x31\xc4\x7e\xed\x39\xdd\x63\xd2\xe4\xbe\x26\x32\xe9\xbf\x32\x11\x40\xf3\x26\x6f\x2a\xb4\x8f\xc6\xad\xd3\x4c\x68\x55\xe0\x9d\x3d\xa1\x3a\x17\x46\x05\x11\x85\x59\xd7\x95\xa4\x84\x20\xb8\x24\xe5\xba\xae\xbd\xa4\x41\x5d\xbb\xb1\xae\x4c\x55\x26\xcc\x98\xd1\xdc\x75\x30\x69\x70\x6b\x82\xc6\x8c\xe7\x73\xa5\xb9\x24\x53\xcc\x6a\xce\x4c\x89\x59\x3d\x8a\x21\xf6\xeb\xd5\x2c\xed\xcc\xd0\x9e\xd9\x51\xf4\x1b\xee\x11\xc1\x23\xa7\xa9\x30\x9f\x4a\x6e\x84\x3f\xcf\xf6\xcb\xfb\xba\x3c\x30\xd7\xa4\x7e\x78\xb0\x54\xcb\x3e\xb4\x18\x94\xae\x22\x63\x57\x5d\x2a\x97\x54\xe8\xa6\x61\xb7\x38\x30\xe1\x12\xe6\xa7\x0a\x5a\xcd\x08\x40\x1a\x86\x0e\x1e\x60\x22\x79\x99\x09\x2e\xfd\xad\x1c\x0e\x9d\x50\xf3\x56\xb4\x22\xa4\xc2\xce\x1a\x9a\xe7\x9c\xb9\x73\xc8\x45\xec\xe4\x39\x2d\xe4\x8a\x8b\x69\xe2\xfe\xbd\x77\xc3\xc2\x62\x27\xdb\xe0\x30\xc0\x36\x38\x0c\xb1\xd1\xbc\xfc\x6e\xba\xd3\x34\x40\x77\x9a\x86\xe8\x5e\x20\xb8\xa3\xa3\x51\x80\xae\x95\xbe\x3c\xdf\x70\x74\xf2\x63\xe8\xf0\x46\xaf\x16\xe1\x61\x90\xb0\x95\xbe\x3c\xdf\x71\x38\xa5\xc7\xaf\x97\xd3\xc3\x74\x78\x74\x1a\x8a\xb1\x93\xbf\x0a\xe7\x87\x34\xcc\xf9\xe1\xb5\x4e\x72\x94\xa6\x69\x88\x73\x34\x3c\x39\x3e\xf9\xaf\x70\x9a\x62\x2f\xce\x47\x1e\xdb\xee\x99\x76\x8f\x6d\xfb\xb5\xde\x3e\x01\xc2\x9d\x6c\xb3\x8f\x6e\xd3\xdc\xd6\x32\xdc\x1c\x99\xf5\xec\xd7\x2c\x9b\x59\x33\x02\x68\xda\x7c\xbf\x07\xec\xd7\x98\x30\x37\xd2\x4e\x96\xae\x05\x28\x6f\x79\xbb\xb3\xf5\x24\xed\x3a\xb1\x80\x16\x64\x63\x44\x6d\xe9\x37\x36\xda\x9f\x7c\x6e\xcc\x5c\x89\x4b\xcb\xfb\x37\x7e\x1c\x2d\xd0\x4e\xa3\x6f\x7a\xce\x77\x26\x3d\xef\x0f\xde\x41\x1c\x1f\x44\x11\x40\xe5\xe7\xa1\xa6\x7a\x42\x59\x58\xce\x5c\xbe\xc0\xe8\x1d\x16\x19\x15\x59\x7d\xb4\x9e\x28\x2a\x7a\x24\xf0\xbf\x33\x88\x2b\xc3\x58\x0c\x3f\xef\x56\xfc\x09\xac\xda\xae\xca\x6a\xdc\xa4\xd5\x34\x9b\x30\x4e\x34\xad\xa6\x54\xb4\xb3\x99\xfd\x16\x7b\x8c\x1d\x82\x73\xd6\xc6\xb5\x3a\x4b\x3e\x3e\x09\x76\x8c\x44\x29\x9e\x53\xa2\x9b\xd2\x9e\xac\x8a\x36\xeb\x7b\x8b\x1f\x2d\x5e\x46\x05\x9c\xc1\xe3\x41\x26\x4d\x88\xc9\xdb\x84\x14\x85\x44\xa5\x36\x0a\xbe\xcb\x6c\xd1\x43\x0c\x5c\xc8\xce\x9d\xe4\x6d\x42\x8b\x27\xcd\x50\xcd\xac\x47\xb4\x26\xf9\xcc\x27\xa0\x27\xda\x3b\x01\x2f\xe4\x2e\x40\x43\x4f\x0b\x80\x1e\x50\x78\x44\x4d\xfc\x2a\x1c\x38\x37\x5a\x18\xbd\xfa\xa3\xa5\x9a\x70\x1f\x51\x4d\x98\xc1\xee\x2f\x3d\xdd\x8d\xa0\xe2\x29\xce\x37\x87\x9b\xa4\xc9\xca\x0d\xf2\x37\xea\xbb\xcb\xc1\xdf\x9c\x7f\x07\x00\xad\x20\xa0\x37\xbc\x12\x00\x00"),
 		},
 		"/terraform/x86_64/generalos/openstack/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 462484378, time.UTC),
-			uncompressedSize: 4719,
+			modTime:          time.Date(2026, 10, 19, 19, 9, 55, 49519049, time.UTC),
+			uncompressedSize: 4777,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\x8f\x37\x62\x65\x4b\xd5\x1f\x27\x77\xc1\xdf\xbd\xc8\x92\xbd\x6b\xaf\x9d\xdd\xec\x25\x50\x8e\x03\x79\x34\xf3\xfb\xcd\x8c\x34\x9a\xd9\x68\x94\x92\xe4\x5c\x16\xf0\x18\x00\x48\xfc\xc7\x50\x89\x59\x22\x24\xaf\x68\x86\x52\x35\x62\x00\x2e\xb0\x54\x9a\xa4\x4b\x38\xf3\x12\x00\xc5\x8d\x4c\x11\xce\x20\xec\x40\x0e\x5a\xbb\x83\xce\xe0\x7d\xb7\x0a\xbd\x5d\x85\x52\x51\x5e\x5a\xc3\x59\x14\x47\xb1\x93\xd7\x81\xfd\x5f\x07\x41\x0b\x01\xe1\xca\xb2\xe1\x34\x0a\x65\x52\x92\x02\x01\xac\xed\xe3\x63\x74\xc5\x88\xb6\xb4\xd1\x8d\x42\x69\x77\xea\xda\x82\x09\xa2\xd4\x3d\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\x3f\x9a\xad\x4f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x9b\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x71\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\x76\x70\x7d\xdb\x3f\xb9\x5c\xa2\x8c\x2e\xec\xfe\xb4\xf9\x1d\x57\xda\x26\x65\xe8\x10\xa3\x4a\xbf\x71\x5e\xbd\xed\x41\xaf\x90\x7f\xf5\xa6\x75\x3d\xe5\x9a\x30\x7b\xc0\x5e\x5c\xdd\x4c\x22\x4a\x52\xec\x81\x78\x7d\xfe\xfb\x24\x62\x46\xd5\x72\x0f\xc8\x8f\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\x67\xdc\x5d\x9a\x5f\x98\xb5\x6e\xef\x61\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x36\xf6\xf9\x1a\xc0\x5f\xbc\xc4\xe9\x0b\x61\x4b\x2f\x23\x9a\xec\x91\x94\x9f\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x56\x2d\x4b\x4a\xd4\xcf\x75\xfd\xd2\xdb\x7e\x42\x7d\xcf\xe5\x72\xda\x73\x2a\xf6\xf0\xf9\xf2\x6a\xe8\x2b\x3e\xec\xef\xeb\xcf\x0f\x63\xbe\x4a\xf4\xef\xe7\xea\x99\x4b\x52\x5e\x08\xa3\x31\xc9\x19\xa9\xb8\x4c\xaa\x59\x08\xa1\x5b\x3b\xce\xa6\xac\xfd\xd3\x52\x11\x19\xf5\xeb\x3d\x00\xf0\xaf\xe3\xa6\x42\x5b\xd1\x9f\x1b\xcd\x88\x96\x19\x3e\xfc\x1d\x00\x54\xa9\x30\x6a\x14\x51\x98\xa1\xae\x24\x05\x8c\x82\x4b\x52\x0c\x75\x6d\x05\x8d\xea\xda\x8d\xa1\x32\x55\x89\x30\xb7\x8c\xa6\x4d\x2f\x91\x06\x27\x13\x74\xcb\x78\xba\x54\x9a\x4b\xb2\xc0\xa4\xe2\xcc\x14\x98\x54\xf3\x10\x42\xb7\x5e\xcf\xd2\x93\x19\xda\x31\x3b\x8a\x7e\xc5\x1d\x22\xd8\x72\x9a\x0a\xd3\x85\xe4\x46\xb8\xf3\x6c\xbf\x9c\xaf\xab\x03\x6b\xda\xc5\xff\x1f\x2d\xd5\xaa\x23\xd4\x07\xf7\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xad\xb3\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x4d\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x5d\x5f\xf3\x94\xb3\xe6\x1c\x52\xe1\x1a\x73\x4a\x33\xb9\xe6\x62\x1c\x35\xff\xde\x37\x6d\xbb\x7e\x92\xed\xe0\x70\x84\xcd\x0b\x07\x6c\x34\x2d\xbe\x99\xee\x34\x1e\xa1\xf3\xc2\x97\x0f\xee\xe8\x68\x3e\x42\xd7\x4a\x5f\x9e\x6f\x36\x3f\xf9\x7e\xec\xf0\xe6\xaf\x16\xe1\xe1\x28\x61\x2b\x7d\x79\xbe\xe3\xf1\x94\x1e\xbf\x5e\x4e\x0f\xe3\xd9\xd1\xe9\x58\x8c\x9d\xfc\x55\x38\x3f\xc4\xe3\x9c\x1f\x5e\xeb\x24\xe7\x71\x1c\x8f\x71\xce\x67\x27\xc7\x27\xff\x15\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xe7\x27\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\xf1\x65\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x4d\xcf\xf1\x76\x60\xec\x39\xfe\x1d\x3c\x42\x1b\xd1\x4e\x91\x42\xfd\xd6\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\x1f\x30\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\xff\x9d\x41\x58\x1a\xc6\x42\xf8\xf1\x69\xc5\x1f\xc0\xaa\x3d\x75\x05\xbd\x9b\xb4\x5c\x24\x39\xe3\x44\xd3\x72\x41\x45\x3b\xc4\xd9\x6f\xb1\xc3\x7c\x22\x38\x67\x6d\x5c\xeb\x43\xe7\xf6\x91\xb1\x63\x24\x4a\xf1\x94\x12\xed\x6b\x20\x5f\x17\x6d\x16\xc2\x84\x1f\x2d\x5e\x42\x05\x9c\xc1\xf6\x20\x23\x1f\x62\xf4\x2e\x22\x59\x26\x51\xa9\x8d\xca\xe8\x32\x9b\xf5\x10\x47\x2a\xb7\x73\x27\x7a\x17\xd1\xec\x59\xc3\x96\x1f\x0a\x89\xd6\x24\xbd\x73\x09\xe8\x89\x76\x4e\xc0\x0b\xb9\x0b\xe0\xe9\x69\xd6\x70\x6d\x9b\x65\x23\xb7\x1a\x0f\x9c\x1b\x2d\x8c\x5e\xff\x75\x53\xe6\xdc\x45\x54\x11\x66\xb0\xfb\xe3\x4c\x57\x11\x83\xd3\xdb\xe2\xbc\x3f\xdc\x28\x8e\xd6\x2a\xc8\x55\xd4\x37\x5f\x07\x57\x39\xff\x06\x00\x00\xff\xff\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6d\x6b\x23\xb7\x13\x7f\xbf\x9f\x62\xfe\xfb\xef\x8b\xe4\x38\xef\x6d\xec\x5c\x92\x16\x42\x09\xb9\x3e\x04\xda\x23\x84\x4b\x0b\x0d\x65\x91\x77\xc7\xb6\xb0\xbc\x52\xf5\xb0\x49\x2e\xf8\xbb\x17\x49\xbb\x6b\xaf\x2d\x3b\x8e\x2f\x81\x72\x1c\x68\x47\x33\xbf\xdf\xcc\x68\xa4\x19\x47\xa3\x94\x64\xc4\xe5\x0c\x9e\x22\x00\x89\xff\x18\x2a\xb1\xc8\x84\xe4\x15\x2d\x50\x2a\x27\x06\xe0\x02\x4b\xa5\x49\x3e\x85\xf3\x5a\x02\xa0\xb8\x91\x39\xc2\x39\xc4\x2d\x48\xaf\xb1\xeb\xb5\x06\x1f\xda\x55\x5c\xdb\x55\x28\x15\xe5\xa5\x35\xec\x27\x69\x92\x7a\xf9\x3c\xb2\xff\xe7\x51\xd4\x40\x40\xbc\xb0\x74\x9c\x46\xa1\xcc\x4a\x32\x43\x00\x6b\xfb\xf4\x94\x5c\x33\xa2\x2d\x6d\x72\xab\x50\xda\x9d\xf9\xdc\x82\x09\xa2\xd4\x3d\x97\x05\xac\x2b\x5e\xd7\x5b\x5e\x51\x63\x49\x4a\xed\x31\x57\x14\xbf\xb8\xad\xcf\x2d\x26\x31\x7a\x92\x19\xc9\x02\x98\x17\x46\x4f\x6e\x6f\x7e\xf3\x7a\x12\xc7\x36\x36\x08\xe8\xdd\xb8\x2d\xab\x36\x8f\xa2\x8a\x48\x4a\x86\x0c\x21\xce\x99\x51\x1a\x65\x46\x0b\x1f\xa6\x7e\x14\xe8\xad\x95\x96\xb4\x1c\x47\x00\x05\x8e\x88\x61\xba\x06\xbc\xf4\x06\x57\x9f\xd6\xa0\xa8\x4d\x57\x99\x63\x96\x73\x53\x6a\x0f\xd7\xb5\xfd\x93\xcb\x29\xca\xe4\xd2\xee\x6f\x36\x9f\x70\xa5\x6d\x52\x56\x1d\x62\x54\xe9\x03\xef\xd5\x61\x07\x7a\x81\xfc\x6b\x6d\x3a\x9f\x6f\x72\x4d\x98\x3d\x60\x2f\xaf\x6f\x37\x22\x4a\x32\xdb\x03\xf1\xe6\xe2\xf7\x8d\x88\x05\x55\xd3\x3d\x20\x3f\x51\x35\xdd\x88\xc9\x15\x9d\x91\x31\xee\x78\xc6\x6d\xd1\xfc\xc2\xac\x75\x53\x87\x1d\x68\x52\x11\xca\xc8\x90\x32\xaa\x1f\xb3\xaf\xbc\x7c\x31\xf6\xc5\x12\xc0\x5f\xbc\x6c\x18\xfe\x0f\x43\xa2\xf0\xe4\x18\xb0\xcc\x79\x81\x85\xbb\x78\xbd\x82\x68\x02\x7c\x04\x48\xf2\x09\x34\x51\xbd\x87\xfa\xb6\x16\x30\x7c\x84\x72\x5a\x80\x9e\x48\x6e\xc6\x13\xf8\xf2\x73\xf6\xc7\xc5\x4d\xd6\x86\xef\x2e\xaf\xc5\x08\xe5\xa6\xdd\xec\x46\xb0\x25\xed\x76\xeb\xee\xef\x08\x40\x61\xa9\xa8\xa6\x15\xc2\x39\x68\x69\x70\x35\xfb\xda\x3e\x0c\x2c\x2b\x51\xbf\x34\x3b\x57\xb5\xed\x67\xd4\xf7\x5c\x4e\x37\xdf\x16\x2a\xf6\xa8\x95\xab\xeb\xd5\x4a\xc1\x87\xfd\x7d\xfd\xe9\x21\xe4\xab\xc4\xfa\x89\x5e\xbc\xa4\x59\xce\x67\xc2\x68\xcc\x46\x8c\x54\x5c\x66\x55\x3f\x86\xd8\xaf\x3d\xa7\x7b\x39\xea\xdc\x57\x44\x26\xdd\x27\x25\x02\xa8\x1f\xe0\x75\x85\xe6\xd1\xb8\x73\x9a\x09\x2d\x0b\x7c\xb0\x27\x54\xe5\xc2\xa8\x20\xa2\x30\xab\xba\x92\xcc\x20\x08\x2e\xc9\x6c\x55\xd7\x5e\xd2\xa0\xae\xdd\x58\x55\xa6\x2a\x13\x66\xc8\x68\xee\xda\x95\x34\xb8\x31\x41\x43\xc6\xf3\xa9\xd2\x5c\x92\x31\x66\x15\x67\x66\x86\x59\x35\x88\x21\xf6\xeb\xe5\x2c\x6d\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x33\xa7\xa9\x30\x1f\x4b\x6e\x84\x3f\xcf\xe6\xcb\xfb\xba\x38\x30\xd7\x91\xbe\x7b\xb2\x54\x8b\xa6\x33\xef\xdd\xbb\x8a\x8c\x5d\x75\xa9\x5c\x52\xa1\xeb\xee\xdc\xe0\xc0\x88\x4b\x98\x9e\x29\x68\x34\x23\x00\x69\x18\x3a\x78\x80\x91\xe4\xb3\x4c\x70\xe9\x6f\x65\xbf\xef\x84\x9a\x37\xa2\x25\x21\x15\x76\xb0\xd0\x3c\xe7\xcc\x9d\x43\x2e\x62\x27\xcf\x69\x21\x97\x5c\x4c\x13\xf7\xef\x83\x9b\x0c\xe6\x5b\xd9\x7a\x47\x01\xb6\xde\x51\x88\x8d\xe6\xb3\x6f\xa6\x3b\x4b\x03\x74\x67\x69\x88\xee\x15\x82\x3b\x3e\x1e\x04\xe8\x1a\xe9\xeb\xf3\xf5\x07\xa7\xdf\x87\x0e\x6f\xf0\x66\x11\x1e\x05\x09\x1b\xe9\xeb\xf3\x9d\x84\x53\x7a\xf2\x76\x39\x3d\x4a\xfb\xc7\x67\xa1\x18\x5b\xf9\x9b\x70\x7e\x4c\xc3\x9c\x1f\xdf\xea\x24\x07\x69\x9a\x86\x38\x07\xfd\xd3\x93\xd3\xff\x0a\xa7\x29\x76\xe2\x7c\xe6\xb1\x6d\x9f\x69\xf7\xd8\x36\x5f\xab\xed\x13\x20\xdc\xc9\xd6\xfb\xe8\x26\xcd\x4d\x2d\xc3\xcd\x91\x59\xc7\x7e\xc5\xb2\x9e\x35\x23\x80\xba\xcd\x77\x7b\xc0\x6e\x8d\x09\x73\x23\xed\x64\xe9\x5a\x80\xf2\x96\x77\x5b\x5b\x4f\xd2\xac\x13\x0b\x68\x41\xd6\x46\xd4\x86\x7e\x6d\xa3\xf9\x7d\xe7\xc6\xcc\xa5\xb8\xb4\x7c\x3c\xf0\xe3\x68\x81\x76\x1a\x3d\xe8\x38\xdf\x9a\x74\xbc\x3f\x7c\x0f\x71\x7c\x18\x45\x00\xa5\x9f\x87\xea\xea\x09\x65\x61\x31\x73\xf9\x02\xa3\x0f\x58\x64\x54\x64\xd5\xf1\x6a\xa2\xa8\xe8\x90\xc0\xff\xce\x21\x2e\x0d\x63\x31\xfc\xb8\x5d\xf1\x07\xb0\x6a\xdb\x2a\xab\x76\x93\x96\xe3\x6c\xc4\x38\xd1\xb4\x1c\x53\xd1\xcc\x66\xf6\x5b\xec\x30\x76\x08\xce\x59\x13\xd7\xf2\x2c\xf9\xfc\x24\xd8\x32\x12\xa5\x78\x4e\x89\xae\x4b\x7b\xb4\x2c\x5a\xaf\xef\x0d\x7e\x34\x78\x19\x15\x70\x0e\xcf\x07\x99\xd4\x21\x26\xef\x12\x52\x14\x12\x95\x5a\x2b\xf8\x36\xb3\x45\x07\x31\x70\x21\x5b\x77\x92\x77\x09\x2d\x5e\x34\x43\xd5\xb3\x1e\xd1\x9a\xe4\x13\x9f\x80\x8e\x68\xe7\x04\xbc\x92\xbb\x00\x35\x3d\x2d\x00\x3a\x40\xe1\x11\x35\xf1\xab\x70\xe0\xdc\x68\x61\xf4\xf2\x8f\x96\x72\xc4\x7d\x44\x15\x61\x06\xdb\x3f\xeb\xb4\x37\x82\x8a\x97\x38\x5f\x1f\x6e\x92\x26\x4b\x37\xc8\xdf\xa8\x6f\x2e\x07\x7f\x73\xfe\x1d\x00\x1b\xcb\xa8\x6c\xa9\x12\x00\x00"),
 		},
 		"/terraform/x86_64/nestos": &vfsgen۰DirInfo{
 			name:    "nestos",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/nestos/libvirt": &vfsgen۰DirInfo{
 			name:    "libvirt",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/nestos/libvirt/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
-			uncompressedSize: 3229,
+			modTime:          time.Date(2026, 10, 19, 17, 0, 35, 52741950, time.UTC),
+			uncompressedSize: 3378,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\xdf\x6f\xe3\x36\x0c\x7e\xf7\x5f\xc1\x09\x7b\xb8\x2b\x56\xb7\x1b\x0e\x1b\x30\x20\x18\xb6\x14\xd8\xf2\x70\x58\xd1\xed\x9e\x0e\x85\xa1\xda\x8c\x43\xc4\x96\x3c\x49\x76\x97\x05\xfe\xdf\x07\xc9\x92\x7f\x24\x76\x2f\x97\x02\x8d\x6d\x99\xfc\xf8\x89\xe4\x47\xd9\xa0\x52\x7c\x2b\x55\x09\xc7\x08\x40\xe1\x3f\x35\x29\xcc\x92\x4a\xc9\x86\x32\x54\xda\x2d\x03\x14\xf4\xd2\x90\x32\xb0\xf2\xcf\x00\x5a\xd6\x2a\x45\x58\x01\xcb\x4a\x9e\x36\x94\x72\x75\xe7\xad\x98\x37\x69\x50\x69\x92\xc2\xda\xdc\xc7\x3f\xc5\x3f\x76\xeb\x6d\x64\xff\xdb\x28\x0a\x31\x80\x05\x3f\x07\x5e\x2b\xb2\x1e\xc7\x63\xfc\x58\x70\x63\xa9\xc5\x9f\x9e\x36\x6d\xcb\xac\x4f\xc3\x15\xf1\x97\x02\x81\xa5\x45\xad\x0d\xaa\x84\xb2\xce\xcd\x1c\x2a\xb4\xf0\x2b\xd0\x46\x91\xc8\x23\x80\x0c\xb7\xbc\x2e\x8c\x47\x5b\x77\x0e\x9b\x87\x33\x28\x12\xda\x70\x91\x62\x92\xca\x5a\x98\x0b\xe1\x3e\x72\x8b\x16\xaf\xad\xcb\x32\xe2\x4e\x6a\x23\x78\x89\xa7\xa0\x05\x69\xf3\xae\x43\x7e\x3f\x81\x1e\x90\xff\xf0\xae\x6d\xbb\x80\x4d\xd5\x15\xa8\x9b\xc7\x53\xbc\x3e\x91\x55\xf3\x21\x49\x29\x53\x17\x26\xa0\xaf\xce\x7a\xf3\xf0\xb4\x5c\x1e\x8b\x9a\x73\x83\xaf\xfc\xf0\xb5\xc0\xbf\x77\x6e\x6f\xd4\xab\xaa\xaf\x48\xc1\xfa\xf1\xd3\x62\x4e\x15\x2f\xaf\x40\x7c\xfa\xf5\xe3\x22\x62\x46\x7a\x7f\x05\xe4\x03\xe9\xfd\x72\xe5\x73\x71\x05\xe4\x6f\x52\x9a\xb5\x14\x5b\xca\x3b\x60\x85\x5e\xc3\x41\x7f\x49\x25\x65\xc1\x80\x75\x17\x8b\x6f\xfb\xcf\x56\xe5\xdb\x63\xc3\x55\x3c\x48\xae\xbd\x75\x36\x81\x81\x1d\x02\xa4\xec\x63\xc5\xcd\xce\x3e\xde\x35\xdd\x38\x08\x23\xe1\x8e\x4a\x9e\xa3\xbe\x3b\x03\x62\xf3\x4c\x1a\x59\xd4\x56\x35\x2c\xdc\xf4\x6c\x60\x9e\x8f\xb7\xb3\x14\xa4\x2c\x7c\x4a\x86\x5d\xc5\xee\xc7\x02\x44\xe3\xd1\x35\xee\xb6\x3f\xff\xda\x58\x8e\xed\x17\x29\x0d\x15\x75\xf3\x02\xfa\xbf\x15\x58\x56\xd3\x69\x32\xd0\x1e\xac\x3c\xfd\xb3\x21\xf1\xd9\x79\xc4\x24\x32\xfc\xf7\xb9\xbd\x75\x71\x22\x80\x17\xae\xd1\x47\x4f\x28\x1b\x6d\xac\x5b\x8b\xfd\x85\xb2\x61\xf3\x43\xac\xe5\x24\xd0\x7f\x53\x56\x13\x4a\x36\xf6\x84\x0e\xdc\xc0\xf7\xf7\x3f\x7c\x98\x5e\xe6\x13\x45\xb9\x20\x43\x52\x30\x60\xc3\xed\x38\x5d\x5f\xc8\xd3\xc5\x09\xea\xd1\x47\x1b\x7f\x63\xc7\xa9\x14\x06\x85\xd5\x84\xc1\xb2\x2a\xb8\xc1\x2d\x15\xf8\x6e\x12\x89\x72\x31\x09\xf2\x1d\x1c\x21\x44\x3f\xe5\x3d\xcb\x0a\xda\xf7\xf3\x59\x11\x68\x5e\xa5\xda\x33\x60\xfd\xdd\x71\xd2\x1c\xb3\x6d\x2d\xd0\x9d\xa6\xa5\xcc\x06\x2b\xc1\xdd\x5a\x26\x4b\x4e\x62\xc9\x33\x2e\x64\xca\x9d\x42\x79\x96\x29\xd4\x1a\x35\xac\xe0\xf3\xc4\x2a\x0c\xfc\xe7\xc8\xc2\xed\xd2\xca\x1f\xee\x28\xec\xbc\xb1\x9d\x66\x54\x8d\xee\xb8\xb6\x06\x42\x9f\xbc\x77\xa1\xbd\x09\x80\x0b\x98\x48\x51\x1c\x4e\x1c\x95\xac\x0d\x06\x5f\x1b\x6f\xe8\xb7\x33\x2a\xce\xc6\x1f\x17\x73\x36\xfe\x55\x80\xe6\xb5\x91\xda\x70\xf7\x61\xe2\x42\xce\xa6\xbe\xcb\x94\xcb\xbc\x36\x52\xcf\x6a\xf7\x42\xf1\x5e\xd4\x03\x16\xbd\xaa\xfd\x86\x5d\xe9\x56\xc0\xac\xe5\x6d\xc5\xb5\x36\x3b\x25\xeb\x7c\xc7\xa2\xee\x53\xa8\xb1\xa6\x6f\xf0\xa8\xea\x53\xec\x12\x4b\xa9\x0e\x8b\x1e\x8a\x97\x67\x6c\xa4\x42\xa9\x7b\x61\x8e\x44\x12\x96\xe2\xfe\xe6\x26\xa6\xec\x2c\x22\x4f\x77\x24\x70\x3c\xc2\xdc\xb1\xe2\x56\xff\x3e\x54\x6e\x68\x8e\xab\x11\xcc\x7c\x1b\x84\x63\x6a\xe4\xbf\x6f\x4a\xe6\xba\x8a\xf4\xde\x67\xea\x8d\x11\x67\xad\x66\x99\xb9\x2e\xf0\x82\x4a\x48\x18\x54\x5b\x9e\xa2\x07\x0c\xeb\xfd\xb9\x71\xa2\xc5\xb8\xbf\x76\x03\x02\x06\xa9\x7f\x55\xb1\xc7\x1a\x9b\xf3\xa3\x6a\x3a\x22\xbe\xb1\x22\xae\x8b\x82\xc1\x2f\x9d\x22\x97\x2c\x9f\xe1\x67\xb0\x86\x2e\xc6\x2b\x27\x93\x6c\xa5\x4a\x0a\xe4\x1a\x4f\x34\x96\x2b\x5e\xed\x28\x0d\x2a\x1b\x27\x7c\x05\xac\x11\x29\xf3\x9f\xf1\xda\xa0\x48\xc2\xa1\xed\x69\xb3\x80\x92\x4a\xa1\x65\x81\xf3\x20\x95\x39\x74\x20\x86\xab\x1c\xed\x7c\x75\xb2\x63\xf7\x2c\x7c\xd0\xcb\xda\x54\xb5\x01\x66\x95\xda\x89\xac\xe1\x45\x8d\xa3\xc4\x77\x4a\x8c\x3b\x1d\xc6\x37\xf1\x59\xe5\xe2\xfb\xb8\xcf\x65\xd4\xfe\x1f\x00\x00\xff\xff\x8e\xbd\x3a\xb4\x9d\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x57\xdf\x6f\xe3\x36\x0c\x7e\xcf\x5f\xc1\x09\x7b\xb8\x2b\x56\xb7\x1b\x0e\x1b\x30\x20\x18\xb6\x16\xd8\xf2\x70\x5b\xd1\xdb\x3d\x15\x85\xa1\xda\x8c\x43\xd4\x96\x3c\x49\x76\x97\x05\xfe\xdf\x07\xc9\x92\x7f\xc4\x76\x2e\x97\x02\x8d\x6d\x85\xfc\xf8\x89\xe4\x47\xc5\x06\x95\xe2\x5b\xa9\x0a\x38\xac\x00\x14\xfe\x53\x91\xc2\x34\x2e\x95\xac\x29\x45\xa5\xdd\x32\x40\x4e\x2f\x35\x29\x03\x6b\xff\x0c\xa0\x65\xa5\x12\x84\x35\xb0\xb4\xe0\x49\x4d\x09\x57\x37\xde\x8a\x79\x93\x1a\x95\x26\x29\xac\xcd\x6d\xf4\x53\xf4\x63\xbb\xde\xac\xec\x7f\xb3\x5a\x85\x18\xc0\x82\x9f\x03\xaf\x14\x59\x8f\xc3\x21\x7a\xc8\xb9\xb1\xd4\xa2\xcf\x8f\x9b\xa6\x61\xd6\xa7\xe6\x8a\xf8\x4b\x8e\xc0\x92\xbc\xd2\x06\x55\x4c\x69\xeb\x66\xf6\x25\x02\x00\xac\x41\x1b\x45\x22\x5b\x01\xa4\xb8\xe5\x55\x6e\x3c\xda\x5d\xeb\xb0\xb9\x9f\x40\x91\xd0\x86\x8b\x04\xe3\x44\x56\xc2\x9c\x09\xf7\x91\x5b\xb4\xe8\xce\xba\x2c\x23\xee\xa4\x36\x82\x17\x78\x0c\x9a\x93\x36\xef\x5a\xe4\xf7\x23\xe8\x1e\xf9\x0f\xef\xda\x34\x0b\xd8\x54\x5e\x80\xba\x79\x58\xc4\xd3\x86\x1b\x4a\x62\x81\xe6\x4d\xaa\xd7\x0b\xb0\x3f\x39\x80\x3f\x5b\xff\xa6\x59\xa8\x57\x59\x7f\x88\x13\x4a\xd5\x99\x79\xee\x9a\xe0\x6e\x73\xff\xb8\xdc\x05\x16\x35\xe3\x06\xdf\xf8\xfe\x6b\x81\x7f\x6f\xdd\x4e\xb4\x45\x59\x5d\x90\x8d\xbb\x87\xcf\x8b\xa9\x56\xbc\xb8\x00\xf1\xf1\xd7\x8f\x8b\x88\x29\xe9\x4b\x4a\x76\x4f\xfa\x75\xb9\xc1\x32\x71\x01\xe4\x6f\x52\x9a\x3b\x29\xb6\x94\xb5\xc0\x0a\xfd\xa8\x08\x32\x8f\x4b\x29\x73\x06\xac\xbd\x58\x7c\xdb\xe6\xb6\x2a\xdf\x1e\x6a\xae\xa2\x5e\xd9\xcd\xb5\xb3\x09\x0c\xec\xac\x21\x65\x1f\x4b\x6e\x76\xf6\xf1\xa6\x6e\xa7\x4e\x98\x3c\x37\x54\xf0\x0c\xf5\xcd\x04\x88\xcd\x33\xa9\x65\x5e\x59\x71\xb2\x70\xd3\xb1\x81\x79\x3e\xde\xce\x52\x90\x32\xf7\x29\xe9\x77\x15\xb9\x0f\x0b\xb0\x1a\x4e\xc8\x61\xb7\xfd\xf5\x69\x63\x39\x36\x5f\xa4\xd4\x57\xd4\x8d\x25\xe8\xfe\xd6\x60\x59\x8d\x87\x56\x4f\xbb\xb7\xf2\xf4\x27\xb3\xe8\xc9\x79\x44\x24\x52\xfc\xf7\xb9\xb9\x76\x71\x56\x00\x2f\x5c\xa3\x8f\x1e\x53\x3a\xd8\x58\xbb\x16\xf9\x0b\xa5\xfd\xe6\xfb\x58\xcb\x49\xa0\xff\xc6\xac\x46\x94\x6c\xec\x11\x1d\xb8\x82\xef\x6f\x7f\xf8\x30\xbe\xcc\x27\x8a\x32\x41\x86\xa4\x60\xc0\xfa\xdb\x61\xba\xbe\x90\xa7\xb3\x13\xd4\xa1\x0f\x36\x7e\x62\xc7\x89\x14\x06\x85\x81\x35\x18\x2c\xca\x9c\x1b\xdc\x52\x8e\xef\x46\x91\x28\x13\xa3\x20\xdf\xc1\x01\x42\xf4\x63\xde\xb3\xac\xa0\x79\x3f\x9f\x95\x6e\x7a\xb3\xd1\x1c\xef\x9b\x63\xb6\xad\x05\xba\x43\xbb\x90\x69\x6f\x25\xb8\x5b\x4b\x65\xc1\x49\x2c\x79\x46\xb9\x4c\xb8\x53\x28\x4f\x53\x85\x5a\xa3\x86\x35\x3c\x8d\xac\xc2\xc0\x7f\x5e\x59\xb8\x5d\x52\x3a\x46\x00\x28\xec\xbc\xb1\x9d\x66\x54\x85\xee\x57\x81\x35\x10\xfa\xe8\x7b\x80\xde\x04\xc0\x05\x8c\xa5\xc8\xf7\x47\x8e\x4a\x56\x06\x83\xaf\x8d\xd7\xf7\xdb\x84\x8a\xb3\xf1\xc7\xc5\x9c\x8d\xff\x2a\x40\xf3\xca\x48\x6d\xb8\x32\x21\xe4\x6c\xea\xdb\x4c\xb9\xcc\x6b\x23\xf5\xac\x76\xcf\x14\xef\x59\x3d\x60\xd1\xcb\xca\x6f\xd8\x95\x6e\x0d\xcc\x5a\x5e\x97\x5c\x6b\xb3\x53\xb2\xca\x76\xcc\xed\x01\xa0\xb6\xa6\x27\x78\x94\xd5\x31\x76\x81\x85\x54\xfb\x45\x0f\xc5\x8b\x09\x1b\xa9\x50\xea\x4e\x98\x03\x91\x84\xa5\xa8\xbb\xb9\x8a\x28\x9d\x44\xe4\xc9\x8e\x04\x0e\x47\x98\x3b\x56\xdc\xea\xdf\xfb\xd2\x0d\xcd\x61\x35\x82\x99\x6f\x83\x70\x4c\x0d\xfc\x5f\xeb\x82\xb9\xae\x22\xfd\xea\x33\x75\x62\xc4\x59\xab\x59\x66\xae\x0b\xbc\xa0\x62\x12\x06\xd5\x96\x27\xe8\x01\xc3\x7a\x77\x6e\x1c\x69\x31\xea\xae\xed\x80\x80\x5e\xea\x5f\x55\xec\xa1\xc6\xe6\xfc\xa8\x1c\x79\xc0\x37\x56\xc4\x55\x9e\x33\xf8\x05\x9e\x4e\x59\x3e\xc3\xcf\x60\x0d\x5d\x8c\x37\x4e\x26\xde\x4a\x15\xe7\xc8\xf5\x64\x18\x8d\x7f\x23\x4e\xe3\xd9\x4a\xb0\x90\xb0\x4c\xf1\x72\x47\x49\xd0\xe4\xb0\x3c\x6b\x60\xb5\x48\x98\x7f\xb7\xd0\x06\x45\x1c\x8e\x78\xbf\xc9\x0e\x25\x91\x42\xcb\x1c\xe7\x41\x4a\xb3\x6f\x41\x0c\x57\x19\xda\x69\xec\x44\xca\x6e\x59\x78\xcb\x90\x95\x29\x2b\x03\xcc\xea\xba\x95\x64\xcd\xf3\x0a\x07\x65\x6a\x75\x1b\xb5\xaa\x8d\xae\xa2\x49\x9d\xa3\xdb\xa8\xcb\xfc\xaa\xf9\x7f\x00\xca\x9a\x20\x27\x32\x0d\x00\x00"),
 		},
 		"/terraform/x86_64/nestos/libvirt/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
-			uncompressedSize: 2528,
+			modTime:          time.Date(2026, 10, 19, 17, 0, 35, 52655630, time.UTC),
+			uncompressedSize: 2677,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x51\x8f\xe3\x34\x10\x7e\xcf\xaf\x18\x2c\x1e\xee\x56\xac\x59\xd0\x09\x24\xa4\x0a\xc1\xde\x03\xfb\x70\x62\x75\x70\xe2\xe1\x74\x8a\xbc\xc9\x34\x1d\x35\xb1\xcd\xd8\xce\xb1\x54\xf9\xef\xc8\x89\xd3\x26\xdb\xb4\xf4\x5a\xa9\x6a\xea\xcc\x7c\xf3\xf9\xf3\x37\xb6\x3d\x32\xab\xb5\xe1\x06\x76\x19\x00\xe3\xdf\x81\x18\xcb\xdc\xb2\x69\xa9\x44\x76\xfd\x30\x40\x4d\x4f\x2d\xb1\x87\x55\xfa\x0f\xe0\x4c\xe0\x02\x61\x05\xa2\x6c\x54\xd1\x52\xa1\xf8\xdb\x14\x25\x52\x48\x8b\xec\xc8\xe8\x18\x73\x27\x7f\x94\x3f\x0c\xe3\x5d\x16\xbf\x5d\x96\x8d\x35\x40\x8c\x79\x3d\x78\x60\x8a\x19\xbb\x9d\x7c\xac\x95\x8f\xd4\xe4\x87\xf7\x0f\x5d\x27\x62\x4e\xab\x98\xd4\x53\x8d\x20\x8a\x3a\x38\x8f\x9c\x53\x39\xa4\xf9\x67\x8b\x11\x7e\x05\xce\x33\xe9\x2a\x03\x28\x71\xad\x42\xed\x13\xda\xfd\x90\xf0\xf0\xf6\x08\x8a\xb4\xf3\x4a\x17\x98\x17\x26\xe8\xc4\x62\x9e\xfb\x97\xe1\x2d\xb2\xbc\x8f\xef\x4f\xa7\x6f\x8c\xf3\x5a\x35\xf8\x92\x50\x4d\xce\xbf\x1a\x58\xbd\x9e\x41\x1f\x90\x7f\x4b\xa9\x5d\x77\x02\x9b\xec\x15\xa8\x0f\x8f\x27\xf1\x0a\x1b\xae\x00\xbc\x7f\xfc\x70\x12\x91\x55\x73\x05\xe2\xfb\x5f\xde\x9d\x44\x2c\xc9\x6d\xaf\x80\x7c\x4b\x6e\x7b\x5a\xc7\x4a\x5f\x01\xf9\xab\x31\xfe\xde\xe8\x35\x55\x03\x30\x63\xb2\xff\x68\xdd\xbc\x35\x75\x88\x2b\x2f\xc6\x87\x58\x23\xae\x68\x5f\x42\x7c\xbd\x6b\x15\xcb\x83\x67\xbb\xdb\x14\x97\x01\x58\x63\xea\x53\x51\xf1\x5d\x8c\x39\x74\xdb\xb4\x2f\x7e\xff\xe3\xa1\x51\x15\x26\x47\x9e\x21\x75\x50\xb2\xb7\x38\x4c\x3e\x2b\x88\x35\xe7\x2d\x70\xa0\x3e\x8d\x4b\xf4\x8e\xec\xfe\xb1\xcf\x91\xa4\x4b\xfc\xe7\x53\x77\xdb\xd7\xca\x00\x9e\x94\xc3\xc4\x20\xef\xd1\x2e\x93\x61\xa1\xe2\xa2\x20\xf4\xef\x4b\x7e\x33\x72\x91\xc5\x8c\x18\xdc\xc0\x77\x77\xdf\xbf\x99\xff\x2c\xcb\x46\x95\x26\x4f\x46\x0b\x10\x87\xc7\xa9\x78\xff\xa3\xd9\xc5\x52\xed\xd1\x27\xd3\x3f\x3b\xeb\xc2\x68\x8f\x3a\x7a\xd3\x63\x63\x6b\xe5\x71\x4d\x35\xbe\x9a\xd5\xa2\x4a\xcf\xca\x7c\x03\x3b\x18\xeb\xbf\x64\xbe\xc8\x0b\xba\xd7\xcb\xba\x94\xa6\x51\x14\x55\xd1\xe8\xbc\x71\xcb\x86\xba\xd0\x4f\x97\x10\x89\xe8\x36\xa4\x13\xa7\x31\x65\x6f\xa1\x18\x79\x6b\x95\x73\x7e\xc3\x26\x54\x1b\x91\x0d\x47\x4a\x1b\x43\xcf\xf0\xb0\xe1\x25\x76\x83\x8d\xe1\xe7\x93\x19\xac\x9a\x23\x36\x86\xd1\xb8\xbd\x3f\xfa\xbd\x63\x6e\x19\xb9\x7f\xb8\x91\x54\x1e\x55\x54\xc5\x86\x34\x4e\x1d\xbe\xdb\xc9\x77\xc3\xe8\x9f\xcf\xb6\xef\x64\x00\x15\xbc\x71\x5e\xb1\xdf\x87\x79\x0e\x38\xd9\xb3\x26\xf9\xdb\xb6\x11\x59\xdc\xb5\xc8\x6d\x93\x52\xa9\xe7\xa8\x9c\xf0\x1b\xc6\x64\x8c\x5a\x64\xd6\x45\x0c\x8d\xfe\xb3\xe1\x6d\x4e\xda\x23\xaf\x55\x81\x09\x70\x1c\x3f\xb7\x9d\x69\x4c\xc7\xfe\xde\x6a\x5f\xb4\xce\x00\xaa\x2c\x19\x9d\x43\xb7\x98\x47\x76\x6e\xd1\xaf\x56\x20\x74\xa8\x6b\x01\x3f\xc3\xc7\x73\x91\x9f\xe0\x27\x88\x81\x7d\x8d\xcf\x8a\x7c\xbe\x36\x9c\xd7\xa8\x1c\x1e\x74\xed\x67\x5f\xb1\xb2\x1b\x2a\xc6\x1b\xcf\x54\xeb\x15\x88\x56\x17\x22\xdd\x84\x9c\x47\x9d\xf7\xaf\x57\x20\x12\x6d\x31\xa2\x14\x46\x3b\x53\xe3\x32\x88\xf5\xcf\x03\x88\x57\x5c\xa1\xcf\xad\xe9\xaf\x54\xe2\x4e\x8c\x77\x22\x13\xbc\x0d\x1e\x04\xd9\xf6\xcd\xd0\x5f\xad\xaa\x03\x4e\x56\x72\x68\x42\x39\xb4\xa0\xbc\x91\x47\x8b\x26\xef\xe4\x5e\xcb\xac\xfb\x2f\x00\x00\xff\xff\x56\x79\xfb\xa1\xe0\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x5f\x6f\xa4\x36\x10\x7f\xe7\x53\x4c\xad\x3e\xdc\x45\x0d\x4d\xab\x53\x2b\x55\x42\x55\x9b\x7b\x68\x1e\xae\x8d\xee\x7a\xea\xc3\xe9\x84\x1c\x98\x65\x47\x01\xdb\xb5\xc7\xa4\xe9\x8a\xef\x5e\x19\xcc\x2e\x64\x61\xbb\xb7\x91\xa2\x10\x33\xbf\x3f\x8c\xe7\x07\x66\xb4\x56\x6e\xb4\x6d\x60\x97\x00\x58\xfc\xdb\x93\xc5\x32\x37\x56\xb7\x54\xa2\x75\xfd\x32\x40\x4d\x0f\x2d\x59\x86\x2c\xfe\x0f\xe0\xb4\xb7\x05\x42\x06\xa2\x6c\x64\xd1\x52\x21\xed\xb7\xb1\x4a\xc4\x92\x16\xad\x23\xad\x42\xcd\x4d\xfa\x63\xfa\xc3\xb0\xde\x25\xe1\xb7\x4b\x92\x51\x03\xc4\x88\xeb\xc9\xbd\xa5\x80\xd8\xed\xd2\xfb\x5a\x72\xb0\x96\x7e\x7c\x7f\xd7\x75\x22\x60\x5a\x69\x49\x3e\xd4\x08\xa2\xa8\xbd\x63\xb4\x39\x95\x03\x8c\x9f\x0d\x02\x00\x64\xe0\xd8\x92\xaa\x12\x80\x12\x37\xd2\xd7\x1c\xd9\x6e\x07\xc0\xdd\xdb\x23\x2a\x52\x8e\xa5\x2a\x30\x2f\xb4\x57\xd1\xc5\x1c\xfb\x97\xb6\x8f\x68\xd3\xdb\x70\x7f\x1d\xbe\xd5\x8e\x95\x6c\xf0\xa5\xa1\x9a\x1c\xbf\x1a\x5c\xbd\x9e\x51\x1f\x98\x7f\x8b\xd0\xae\x5b\xe1\x26\x73\x01\xeb\xdd\xfd\x2a\x9f\x63\xc9\x54\xe4\x0a\xf9\x49\xdb\xc7\x0b\xb8\x3f\xf4\x04\xbf\x0f\xf8\x55\x99\xc2\xf8\x0b\xb8\x6f\xef\x3f\xae\x32\x5a\xd9\x5c\xc0\xf8\xfe\x97\x77\xab\x8c\x25\xb9\x4b\x1a\xf0\x96\xdc\xfa\x73\x53\xa5\x2e\xa0\xfc\x55\x6b\xbe\xd5\x6a\x43\xd5\x40\x6c\x31\xa6\x6c\x4c\x48\xde\xea\xda\x87\x01\x13\xe3\x45\xd0\x08\x83\xd3\x4b\x88\xaf\x77\xad\xb4\xe9\x21\x1a\xdd\x75\xac\x4b\x00\x8c\xd6\xf5\x5a\x55\xb8\x17\x6a\x0e\xa1\x9e\xc6\xef\x8f\x0f\x77\x8d\xac\x30\x0e\xfe\x09\x53\x87\x4e\xf6\x49\x82\xc9\x4f\x06\x41\x73\x9e\xb4\x83\xf5\x69\x5d\xb4\x77\x94\xaa\x4f\x3d\x26\x25\x55\xe2\x3f\x9f\xbb\xeb\x5e\x2b\x01\x78\x90\x0e\xa3\x83\xbc\x67\x3b\xaf\x0d\x0b\x8a\x8b\x0d\xa1\x7f\x5f\xfa\x9b\x99\x0b\x2e\x66\xc6\xe0\x0a\xbe\xbb\xf9\xfe\xcd\xfc\xcf\x72\xdb\xa8\x52\xc4\xa4\x95\x00\x71\xb8\x9c\x36\xef\x7f\x7a\x76\x76\xab\xf6\xec\x93\xc7\x3f\xf9\xd4\x85\x56\x8c\x8a\x21\x03\xc6\xc6\xd4\x92\x71\x43\x35\xbe\x9a\x69\x51\xa5\x66\x32\xdf\xc0\x0e\x46\xfd\x97\xce\x17\x7d\x41\xf7\x7a\xb9\x2f\xa5\x6e\x24\x85\xae\x28\x74\xac\xdd\xf2\x40\x9d\x39\x4f\xe7\x18\x09\xec\xc6\xf7\x2a\x00\x8d\x2e\xfb\x11\x0a\x95\xd7\x46\x3a\xc7\x5b\xab\x7d\xb5\x15\xc9\xf0\xe5\x6a\x43\xe9\x09\x1f\xc6\xbf\xe4\x6e\xb0\xd1\xf6\x79\x15\x61\x65\x73\xe4\x46\x5b\xd4\x6e\x3f\x1f\xfd\xbb\x63\x3e\x32\xe9\xfe\xe2\x2a\xa5\xf2\x48\x51\x16\x5b\x52\x38\x9d\xf0\xdd\x2e\x7d\x37\xac\xfe\xf9\x6c\xfa\x24\x03\x48\xcf\xda\xb1\xb4\xbc\x2f\x63\xeb\x71\xf2\xce\x9a\xe0\x1f\xdb\x46\x24\x09\x40\x98\xf7\xd8\xa9\x98\x39\x2a\x27\xfe\x86\xb5\x34\x54\x2d\x3a\xeb\x02\x47\xfc\xe6\xe4\xa4\x18\xed\x46\x16\x18\x09\xc7\xf5\x53\xaf\x33\x85\xf1\x74\xb1\x1f\xb5\x2f\xda\x67\x00\x59\x96\x16\x9d\x43\xb7\x88\x23\x33\x43\xc0\x57\x19\x08\xe5\xeb\x5a\xc0\xcf\xf0\xe9\x54\xe5\x67\xf8\x09\x42\x61\xaf\xf1\x24\x89\xf3\x8d\xb6\x79\x8d\xd2\x1d\x85\x61\xfe\xe5\x3d\xd6\x0b\x9b\x20\xc6\x5e\x55\x56\x9a\x2d\x15\xe3\x31\x6c\xba\x33\x19\x88\x56\x15\x22\x1e\xcf\x1c\xa3\xca\xfb\xdb\x19\x88\xf8\x90\x7b\x96\x42\x2b\xa7\x6b\x5c\x26\x31\xfc\x3c\x90\xb0\xb4\x15\x72\x6e\x74\x7f\xce\x13\x37\x62\x3c\xa8\x69\xcf\xc6\x33\x08\x32\xed\x9b\x21\x8d\xad\xac\x3d\x4e\xf6\x7d\x88\x6c\x3a\x04\x36\xbd\x4a\x8f\xb6\x38\xbd\x49\xf7\x9d\x4f\xba\xff\x06\x00\xc4\x80\x2c\x74\x75\x0a\x00\x00"),
 		},
 		"/terraform/x86_64/nestos/openstack": &vfsgen۰DirInfo{
 			name:    "openstack",
-			modTime: time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
+			modTime: time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 		},
 		"/terraform/x86_64/nestos/openstack/master.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "master.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 463484388, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 4738,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x45\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\xd2\xc9\x21\x4c\x89\x1c\xff\xa8\x69\x03\x7d\xf7\x81\x22\x25\x5b\xb2\x14\x3b\x6e\x02\x14\x45\x01\xea\x78\xf7\xfb\xdd\x1d\x79\xbc\x73\x34\x4a\x49\x72\x2e\x0b\x78\x08\x00\x24\xfe\x67\xa8\xc4\x2c\x11\x92\x57\x34\x43\xa9\x1a\x31\x00\x17\x58\x2a\x4d\xd2\x25\x9c\x79\x09\x80\xe2\x46\xa6\x08\x67\x10\x76\x20\x07\xad\xdd\x41\x67\xf0\xb6\x5b\x85\xde\xae\x42\xa9\x28\x2f\xad\xe1\x2c\x8a\xa3\xd8\xc9\xeb\xc0\xfe\xaf\x83\xa0\x85\x80\x70\x65\xd9\x70\x1a\x85\x32\x29\x49\x81\x00\xd6\xf6\xe1\x21\xba\x62\x44\x5b\xda\xe8\x46\xa1\xb4\x3b\x75\x6d\xc1\x04\x51\xea\x33\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\xbf\x9b\xad\x8f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x97\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x7e\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\xde\x11\xee\x03\xb1\x68\xd1\x85\x35\x99\x46\xbc\xe3\x4a\xdb\x3c\x0d\x41\x19\x55\xfa\x95\x43\x7e\xdd\x83\x5e\x21\xff\xe9\x4d\xeb\x7a\xca\x5b\x61\xf6\x80\xbd\xb8\xba\x99\x44\x94\xa4\xd8\x03\xf1\xfa\xfc\xc3\x24\x62\x46\xd5\x72\x0f\xc8\xf7\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\xe7\xd4\xdd\xa3\x3f\x98\xb5\x6e\xaf\x66\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x32\xf6\xf9\x1a\xc0\x3f\xbc\xc4\xe9\x0b\x61\xab\x31\x23\x9a\xec\x91\x94\xdf\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x16\x32\x4b\x4a\xd4\x4f\x75\xfd\xd2\xdb\x7e\x44\xfd\x99\xcb\xe5\xb4\xe7\x54\xec\xe1\xf3\xe5\xd5\xd0\x57\xbc\xdf\xdf\xd7\xdf\xef\xc7\x7c\x95\xe8\x9f\xd4\xd5\xcb\x97\xa4\xbc\x10\x46\x63\x92\x33\x52\x71\x99\x54\xb3\x10\x42\xb7\x76\x9c\x4d\xa5\xfb\xd7\xa6\x22\x32\xea\x3f\x01\x01\x80\x7f\x30\x37\x15\xda\x8a\xfe\xd4\x68\x46\xb4\xcc\xf0\xfe\xdf\x00\xa0\x4a\x85\x51\xa3\x88\xc2\x0c\x75\x25\x29\x60\x14\x5c\x92\x62\xa8\x6b\x2b\x68\x54\xd7\x6e\x0c\x95\xa9\x4a\x84\xb9\x65\x34\x6d\xda\x8b\x34\x38\x99\xa0\x5b\xc6\xd3\xa5\xd2\x5c\x92\x05\x26\x15\x67\xa6\xc0\xa4\x9a\x87\x10\xba\xf5\x7a\x96\x1e\xcd\xd0\x8e\xd9\x51\xf4\x2b\xee\x10\xc1\x96\xd3\x54\x98\x2e\x24\x37\xc2\x9d\x67\xfb\xe5\x7c\x5d\x1d\x58\xd3\x41\x7e\x7c\xb0\x54\xab\x26\x51\x1f\x14\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xdd\xb4\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x73\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x83\x80\xe6\x29\x67\xcd\x39\xa4\xc2\xf5\xea\x94\x66\x72\xcd\xc5\x38\x6a\xfe\xbd\x6d\x3a\x79\xfd\x28\xdb\xc1\xe1\x08\x9b\x17\x0e\xd8\x68\x5a\x7c\x33\xdd\x69\x3c\x42\xe7\x85\xcf\x1f\xdc\xd1\xd1\x7c\x84\xae\x95\x3e\x3f\xdf\x6c\x7e\xf2\xf3\xd8\xe1\xcd\x5f\x2c\xc2\xc3\x51\xc2\x56\xfa\xfc\x7c\xc7\xe3\x29\x3d\x7e\xb9\x9c\x1e\xc6\xb3\xa3\xd3\xb1\x18\x3b\xf9\x8b\x70\xbe\x8b\xc7\x39\xdf\xbd\xd4\x49\xce\xe3\x38\x1e\xe3\x9c\xcf\x4e\x8e\x4f\xbe\x17\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xa7\x47\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\x3d\x66\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x55\xcf\xf1\x76\x60\xec\x39\xfe\x13\x3c\x40\x1b\xd1\x4e\x91\x42\xfd\xda\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\xef\x31\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\x1f\xce\x20\x2c\x0d\x63\x21\xfc\xfa\xb8\xe2\x2f\x60\xd5\x1e\xbb\x82\xde\x4d\x5a\x2e\x92\x9c\x71\xa2\x69\xb9\xa0\xa2\x1d\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x36\xae\xf5\xa1\x73\xfb\xc8\xd8\x31\x12\xa5\x78\x4a\x89\xf6\x35\x90\xaf\x8b\x36\x0b\x61\xc2\x8f\x16\x2f\xa1\x02\xce\x60\x7b\x90\x91\x0f\x31\x7a\x13\x91\x2c\x93\xa8\xd4\x46\x65\x74\x99\xcd\x7a\x88\x23\x95\xdb\xb9\x13\xbd\x89\x68\xf6\xa4\x61\xcb\x0f\x85\x44\x6b\x92\xde\xb9\x04\xf4\x44\x3b\x27\xe0\x99\xdc\x05\xf0\xf4\x34\x6b\xb8\xb6\xcd\xb2\x91\x5b\x8d\x07\xce\x8d\x16\x46\xaf\xff\xba\x29\x73\xee\x22\xaa\x08\x33\xd8\xfd\xbd\xa6\xab\x88\xc1\xe9\x6d\x71\xde\x1f\x6e\x14\x47\x6b\x15\xe4\x2a\xea\x9b\xaf\x83\xab\x9c\xff\x03\x00\x00\xff\xff\x81\x9a\x0c\xab\x82\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\x09\x7b\x68\x8b\x59\x55\xec\x34\xc9\x06\x04\x43\x96\x0e\x5b\x80\xb5\x08\x82\xe5\x65\xc5\x20\x30\x12\xe5\x10\xa6\x44\x8e\x7f\xd4\xb4\x86\xbe\xfb\x40\x52\x94\x2d\x9b\x72\x1c\x37\x01\x8a\xa2\x00\x75\xbc\xfb\xfd\xee\x8e\x3c\xde\x39\x0a\x0b\x81\x4a\x26\x2a\x58\x46\x00\x02\xff\xa7\x89\xc0\x45\xc6\x05\x6b\x48\x81\x85\xb4\x62\x00\xc6\x71\x2d\x15\xca\x17\x70\xde\x49\x00\x24\xd3\x22\xc7\x70\x0e\x71\x0f\x32\xf1\x76\x93\xde\xe0\x6d\xbf\x8a\x3b\xbb\x06\x0b\x49\x58\x6d\x0c\xa7\x49\x9a\xa4\x4e\xde\x46\xe6\x7f\x1b\x45\x1e\x02\xe2\x95\xa5\xe5\xd4\x12\x8b\xac\x46\x15\x06\x30\xb6\xcb\x65\x72\x4d\x91\x32\xb4\xc9\xad\xc4\xc2\xec\xb4\xad\x01\xe3\x48\xca\xcf\x4c\x14\xb0\xad\x78\xdd\x6d\x39\x45\x85\x6b\x54\x2b\x87\xb9\xa1\xf8\xb7\xdd\xfa\xd8\x63\x22\xad\xee\x33\x2d\x68\x00\xf3\x42\xab\xfb\xdb\x9b\xbf\x9c\x9e\xc0\x73\x13\x1b\x04\xf4\x6e\xec\x96\x51\x6b\xa3\xa8\x41\x82\xa0\x3b\x8a\x21\xce\xa9\x96\x0a\x8b\x8c\x14\x2e\x4c\xf5\x85\x63\x67\x2d\x95\x20\xf5\x3c\x02\x28\x70\x89\x34\x55\x1d\xe0\xa5\x33\xb8\x7a\xbf\x05\x45\x4c\xba\xea\x1c\x67\x39\xd3\xb5\xda\x13\xee\x03\x32\x68\xc9\xa5\x31\x19\x47\xbc\x67\x52\x99\x3c\x6d\x82\x52\x22\xd5\x2b\x87\xfc\x7a\x00\xbd\x42\xfe\xb3\x33\x6d\xdb\x31\x6f\xb9\x3e\x00\xf6\xf2\xfa\x76\x14\x51\xa0\xea\x00\xc4\x9b\x8b\x0f\xa3\x88\x05\x91\x8b\x03\x20\xdf\x13\xb9\x18\xc5\x64\x92\x54\x68\x8e\xf7\x3c\xa7\xfe\x1e\xfd\x41\x8d\xb5\xbf\x9a\x03\x68\xd4\x20\x42\xd1\x1d\xa1\x44\x7d\xc9\xbe\xb2\xfa\xc9\xd8\x17\x6b\x00\xff\xb0\x1a\x8f\x5f\x08\x53\x8d\x05\x52\xe8\x80\xa4\xfc\xc6\x98\xba\x64\x75\x49\xe6\xdb\xa9\x51\xa6\x90\x69\x56\x63\xf5\x54\xd7\xaf\x3a\xdb\x8f\x58\x7d\x66\x62\x31\xee\x39\xe1\x07\xf8\x7c\x75\xbd\xe9\x2b\x7e\x38\xdc\xd7\xdf\x1f\x42\xbe\x0a\xdc\x3d\xa9\xab\x97\x2f\xcb\x59\xc5\xb5\xc2\x59\x49\x51\xc3\x44\xd6\x4c\x63\x88\xdd\xda\x71\xda\x4a\xef\x5e\x9b\x06\x89\x64\xf8\x04\x44\x00\xdd\x83\xb9\xad\xe0\x2b\xfa\x93\xd5\x4c\x48\x5d\xe0\x87\x7f\x23\x80\x26\xe7\x5a\x06\x11\xb9\xde\xd4\x15\xa8\x82\x20\xb8\x40\xd5\xa6\xae\xa9\xa0\xa0\xae\xd9\xd8\x54\x26\x32\xe3\xfa\x8e\x92\xdc\xb6\x17\xa1\xf1\x68\x82\xee\x28\xcb\x17\x52\x31\x81\xe6\x38\x6b\x18\xd5\x15\xce\x9a\x59\x0c\xb1\x5b\xaf\x67\x69\x67\x86\xf6\xcc\x8e\x24\x5f\xf1\x1e\x11\x3c\x72\x9a\x12\xe7\x73\xc1\x34\x77\xe7\xe9\xbf\x9c\xaf\xab\x03\xb3\x1d\xe4\xc7\xa5\xa1\x5a\x35\x89\x76\x52\xd9\x1b\x19\xdb\xdb\x25\x73\x41\xb8\xea\xba\xa9\xc7\x81\x92\x09\x58\x9c\x49\xf0\x9a\x11\x80\xd0\x14\x5b\x78\x80\x52\xb0\x2a\xe3\x4c\x28\x4b\x30\x9d\x5a\xa1\x62\x5e\xb4\x26\x24\xdc\x0c\x02\x8a\xe5\x8c\xda\x73\xc8\x79\x6c\xe5\x39\x29\xc4\x9a\x8b\x69\x62\xff\xbd\xb5\x9d\xbc\xdd\xc9\x36\x39\x0a\xb0\x4d\x8e\x42\x6c\x24\xaf\xbe\x99\xee\x2c\x0d\xd0\x9d\xa5\x21\xba\x67\x08\xee\xf8\x78\x16\xa0\xf3\xd2\xe7\xe7\x9b\xce\x4e\x7f\x0e\x1d\xde\xec\xc5\x22\x3c\x0a\x12\x7a\xe9\xf3\xf3\x9d\x84\x53\x7a\xf2\x72\x39\x3d\x4a\xa7\xc7\x67\xa1\x18\x7b\xf9\x8b\x70\xbe\x4b\xc3\x9c\xef\x5e\xea\x24\x67\x69\x9a\x86\x38\x67\xd3\xd3\x93\xd3\xef\x85\x53\x17\x7b\x71\x3e\xf2\xd8\xf6\xcf\xb4\x7d\x6c\xfd\xd7\x66\xfb\x04\x08\x77\xb2\xed\x3e\x3a\xa6\x39\xd6\x32\xec\x90\x97\x0d\xec\x37\x2c\xbb\x41\x30\x02\xe8\xda\xfc\xb0\x07\xec\xd7\x98\x70\xae\x85\x19\xfb\x6c\x0b\x90\xce\xf2\xd3\xce\xd6\x93\xf8\x75\x62\x00\x0d\xc8\xd6\xfc\xe8\xe9\xb7\x36\xfc\xef\x31\x33\x02\xae\x67\x44\xe1\x8a\x53\xa4\x70\x49\x28\x7e\x35\x70\xdc\x0f\x8c\x03\xc7\x7f\x82\x25\xf8\x88\xf6\x8a\x14\xda\xd7\x51\x04\x50\xbb\xc1\xa9\xbb\x66\xa1\x74\xad\x86\x33\x77\x13\xc9\x03\x2e\x32\xc2\xb3\xe6\x78\x93\x87\xf0\x21\xc3\x0f\xe7\x10\xd7\x9a\xd2\x18\x7e\xdd\xad\xf8\x0b\x18\xb5\x5d\x57\xb0\x73\x93\xd4\xf3\xac\xa4\x0c\x29\x52\xcf\x09\xf7\x43\x9c\xf9\xe6\x7b\xcc\x27\x9c\x31\xea\xe3\x5a\x1f\x3a\x1f\x1f\x19\x7b\x46\x24\x25\xcb\x09\x52\x5d\x0d\x94\xeb\xa2\xed\x42\x18\xf1\xc3\xe3\x65\x84\xc3\x39\x3c\x1e\x64\xd2\x85\x98\xbc\x49\x50\x51\x08\x2c\xe5\x56\x65\xf4\x99\x2d\x06\x88\x81\xca\xed\xdd\x49\xde\x24\xa4\x78\xd2\xb0\xd5\x0d\x85\x48\x29\x94\xdf\xbb\x04\x0c\x44\x7b\x27\xe0\x99\xdc\x05\xe8\xe8\x49\x01\x30\x00\x0a\xcf\xb2\x89\x5b\x85\x03\x67\x5a\x71\xad\xd6\x7f\xdd\xd4\x25\x73\x11\x35\x88\x6a\xdc\xff\xbd\xa6\xaf\x08\xc2\x9f\xe2\x7c\x77\xb8\x49\x9a\xac\x55\x90\xab\xa8\x6f\xbe\x0e\xae\x72\xfe\x1f\x00\x81\x9a\x0c\xab\x82\x12\x00\x00"),
 		},
 		"/terraform/x86_64/nestos/openstack/worker.tf.template": &vfsgen۰CompressedFileInfo{
 			name:             "worker.tf.template",
-			modTime:          time.Date(2024, 12, 2, 10, 50, 23, 464484398, time.UTC),
+			modTime:          time.Date(2024, 12, 3, 12, 31, 5, 0, time.UTC),
 			uncompressedSize: 4719,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\x8f\x37\x62\x65\x4b\xd5\x1f\x27\x77\xc1\xdf\xbd\xc8\x92\xbd\x6b\xaf\x9d\xdd\xec\x25\x50\x8e\x03\x79\x34\xf3\xfb\xcd\x8c\x34\x9a\xd9\x68\x94\x92\xe4\x5c\x16\xf0\x18\x00\x48\xfc\xc7\x50\x89\x59\x22\x24\xaf\x68\x86\x52\x35\x62\x00\x2e\xb0\x54\x9a\xa4\x4b\x38\xf3\x12\x00\xc5\x8d\x4c\x11\xce\x20\xec\x40\x0e\x5a\xbb\x83\xce\xe0\x7d\xb7\x0a\xbd\x5d\x85\x52\x51\x5e\x5a\xc3\x59\x14\x47\xb1\x93\xd7\x81\xfd\x5f\x07\x41\x0b\x01\xe1\xca\xb2\xe1\x34\x0a\x65\x52\x92\x02\x01\xac\xed\xe3\x63\x74\xc5\x88\xb6\xb4\xd1\x8d\x42\x69\x77\xea\xda\x82\x09\xa2\xd4\x3d\x97\x19\x6c\x2a\x5e\xf9\x2d\xa7\xa8\xb1\x24\xa5\x76\x98\x03\xc5\x3f\x9a\xad\x4f\x1d\x26\x31\xfa\x2e\x31\x92\x8d\x60\x9e\x1b\x7d\x77\x73\xfd\x9b\xd3\x93\xb8\xb0\xb1\xc1\x88\xde\x75\xb3\x65\xd5\xea\x20\xa8\x88\xa4\xe4\x96\x21\x84\x29\x33\x4a\xa3\x4c\x68\xe6\xc2\xd4\x5f\x04\x3a\x6b\xa5\x25\x2d\x17\x01\x40\x86\x39\x31\x4c\x7b\xc0\x0b\x67\x70\xf9\x71\x03\x8a\xda\x74\x95\x29\x26\x29\x37\xa5\x76\x70\x7d\xdb\x3f\xb9\x5c\xa2\x8c\x2e\xec\xfe\xb4\xf9\x1d\x57\xda\x26\x65\xe8\x10\xa3\x4a\xbf\x71\x5e\xbd\xed\x41\xaf\x90\x7f\xf5\xa6\x75\x3d\xe5\x9a\x30\x7b\xc0\x5e\x5c\xdd\x4c\x22\x4a\x52\xec\x81\x78\x7d\xfe\xfb\x24\x62\x46\xd5\x72\x0f\xc8\x8f\x54\x2d\x27\x31\xb9\xa2\x05\x59\xe0\x8e\x67\xdc\x5d\x9a\x5f\x98\xb5\x6e\xef\x61\x0f\x9a\x54\x84\x32\x72\x4b\x19\xd5\x5f\x92\xaf\xbc\x7c\x36\xf6\xf9\x1a\xc0\x5f\xbc\xc4\xe9\x0b\x61\x4b\x2f\x23\x9a\xec\x91\x94\x9f\x38\xd7\x17\xbc\xcc\xe9\x62\x33\x35\xda\x56\x2d\x4b\x4a\xd4\xcf\x75\xfd\xd2\xdb\x7e\x42\x7d\xcf\xe5\x72\xda\x73\x2a\xf6\xf0\xf9\xf2\x6a\xe8\x2b\x3e\xec\xef\xeb\xcf\x0f\x63\xbe\x4a\xf4\xef\xe7\xea\x99\x4b\x52\x5e\x08\xa3\x31\xc9\x19\xa9\xb8\x4c\xaa\x59\x08\xa1\x5b\x3b\xce\xa6\xac\xfd\xd3\x52\x11\x19\xf5\xeb\x3d\x00\xf0\xaf\xe3\xa6\x42\x5b\xd1\x9f\x1b\xcd\x88\x96\x19\x3e\xfc\x1d\x00\x54\xa9\x30\x6a\x14\x51\x98\xa1\xae\x24\x05\x8c\x82\x4b\x52\x0c\x75\x6d\x05\x8d\xea\xda\x8d\xa1\x32\x55\x89\x30\xb7\x8c\xa6\x4d\x2f\x91\x06\x27\x13\x74\xcb\x78\xba\x54\x9a\x4b\xb2\xc0\xa4\xe2\xcc\x14\x98\x54\xf3\x10\x42\xb7\x5e\xcf\xd2\x93\x19\xda\x31\x3b\x8a\x7e\xc5\x1d\x22\xd8\x72\x9a\x0a\xd3\x85\xe4\x46\xb8\xf3\x6c\xbf\x9c\xaf\xab\x03\x6b\xda\xc5\xff\x1f\x2d\xd5\xaa\x23\xd4\x07\xf7\xcd\x8d\x0c\x9b\xdb\xa5\x52\x49\x85\xf6\xad\xb3\xc5\x81\x9c\x4b\x58\x9e\x2a\x68\x35\xed\x49\x19\x86\xbe\x4d\xe7\x92\x17\x89\xe0\x52\x37\x04\xb3\x59\x23\xd4\xbc\x15\xad\x09\xa9\xb0\x5d\x5f\xf3\x94\xb3\xe6\x1c\x52\xe1\x1a\x73\x4a\x33\xb9\xe6\x62\x1c\x35\xff\xde\x37\x6d\xbb\x7e\x92\xed\xe0\x70\x84\xcd\x0b\x07\x6c\x34\x2d\xbe\x99\xee\x34\x1e\xa1\xf3\xc2\x97\x0f\xee\xe8\x68\x3e\x42\xd7\x4a\x5f\x9e\x6f\x36\x3f\xf9\x7e\xec\xf0\xe6\xaf\x16\xe1\xe1\x28\x61\x2b\x7d\x79\xbe\xe3\xf1\x94\x1e\xbf\x5e\x4e\x0f\xe3\xd9\xd1\xe9\x58\x8c\x9d\xfc\x55\x38\x3f\xc4\xe3\x9c\x1f\x5e\xeb\x24\xe7\x71\x1c\x8f\x71\xce\x67\x27\xc7\x27\xff\x15\x4e\x93\xed\xc4\xb9\xe5\xb1\xed\x9e\xe9\xe6\xb1\x6d\xbf\x86\xed\x13\x60\xbc\x93\x6d\xf6\xd1\x29\xcd\xa9\x96\xd1\x0c\x79\x49\xcf\x7e\x60\xe9\x07\xc1\x00\xc0\xb7\xf9\x7e\x0f\xd8\xad\x31\x61\x6a\xa4\x1d\xfb\x9a\x16\xa0\x9c\xe5\xe7\x27\x5b\x4f\xd4\xae\x23\x0b\x68\x41\x36\xe6\xc7\x96\x7e\x63\xa3\xfd\xf1\x65\x47\xc0\xf5\x8c\x68\x2c\x04\x23\x1a\x73\xca\xf0\x4d\xcf\xf1\x76\x60\xec\x39\xfe\x1d\x3c\x42\x1b\xd1\x4e\x91\x42\xfd\xd6\xde\xb1\xd2\x0d\x4e\xfe\x9a\x8d\xa5\x6b\x35\x9c\xb9\x9b\x48\x1f\x30\x4b\xa8\x48\xaa\xa3\x21\x0f\x15\x7d\x86\xff\x9d\x41\x58\x1a\xc6\x42\xf8\xf1\x69\xc5\x1f\xc0\xaa\x3d\x75\x05\xbd\x9b\xb4\x5c\x24\x39\xe3\x44\xd3\x72\x41\x45\x3b\xc4\xd9\x6f\xb1\xc3\x7c\x22\x38\x67\x6d\x5c\xeb\x43\xe7\xf6\x91\xb1\x63\x24\x4a\xf1\x94\x12\xed\x6b\x20\x5f\x17\x6d\x16\xc2\x84\x1f\x2d\x5e\x42\x05\x9c\xc1\xf6\x20\x23\x1f\x62\xf4\x2e\x22\x59\x26\x51\xa9\x8d\xca\xe8\x32\x9b\xf5\x10\x47\x2a\xb7\x73\x27\x7a\x17\xd1\xec\x59\xc3\x96\x1f\x0a\x89\xd6\x24\xbd\x73\x09\xe8\x89\x76\x4e\xc0\x0b\xb9\x0b\xe0\xe9\x69\xd6\x70\x6d\x9b\x65\x23\xb7\x1a\x0f\x9c\x1b\x2d\x8c\x5e\xff\x75\x53\xe6\xdc\x45\x54\x11\x66\xb0\xfb\xe3\x4c\x57\x11\x83\xd3\xdb\xe2\xbc\x3f\xdc\x28\x8e\xd6\x2a\xc8\x55\xd4\x37\x5f\x07\x57\x39\xff\x06\x00\x00\xff\xff\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x6f\x6b\xe4\x36\x13\x7f\xef\x4f\x31\x8f\x79\x5e\xdc\x1d\x8d\xcf\xd9\xcd\x25\x69\x21\x94\x34\x57\xda\x40\x7b\x84\xd0\x50\xe8\x51\x8c\x62\xcf\x6e\xc4\xca\x96\xaa\x3f\x4e\xee\x82\xbf\x7b\x91\x64\x7b\xd7\xbb\xf2\x66\xb3\x97\x40\x39\x0e\xe4\xd1\xcc\xef\x37\x33\xd2\x68\x66\xa3\x51\x4a\x32\xe3\xb2\x84\xc7\x08\x40\xe2\x3f\x86\x4a\x2c\x32\x21\x79\x4d\x0b\x94\xca\x89\x01\xb8\xc0\x4a\x69\x92\x2f\xe0\xac\x95\x00\x28\x6e\x64\x8e\x70\x06\x71\x0f\x72\xd0\xd9\x1d\xf4\x06\xef\xfb\x55\xdc\xda\xd5\x28\x15\xe5\x95\x35\x9c\x24\x69\x92\x7a\x79\x13\xd9\xff\x4d\x14\x75\x10\x10\x2f\x2d\x1d\xa7\x51\x28\xb3\x8a\x94\x08\x60\x6d\x1f\x1f\x93\x2b\x46\xb4\xa5\x4d\x6e\x14\x4a\xbb\xd3\x34\x16\x4c\x10\xa5\xee\xb9\x2c\x60\x53\xf1\xaa\xdd\xf2\x8a\x1a\x2b\x52\x69\x8f\xb9\xa6\xf8\x87\xdb\xfa\xd4\x63\x12\xa3\xef\x32\x23\x59\x00\xf3\xdc\xe8\xbb\x9b\xeb\xdf\xbc\x9e\xc4\xb9\x8d\x0d\x02\x7a\xd7\x6e\xcb\xaa\x35\x51\x54\x13\x49\xc9\x2d\x43\x88\x73\x66\x94\x46\x99\xd1\xc2\x87\xa9\xbf\x08\xf4\xd6\x4a\x4b\x5a\xcd\x23\x80\x02\x67\xc4\x30\xdd\x02\x5e\x78\x83\xcb\x8f\x1b\x50\xd4\xa6\xab\xca\x31\xcb\xb9\xa9\xb4\x87\x1b\xda\xfe\xc9\xe5\x02\x65\x72\x61\xf7\xc7\xcd\xef\xb8\xd2\x36\x29\xeb\x0e\x31\xaa\xf4\x1b\xef\xd5\xdb\x01\xf4\x12\xf9\xd7\xd6\xb4\x69\xc6\x5c\x13\x66\x0f\xd8\x8b\xab\x9b\x51\x44\x49\xca\x3d\x10\xaf\xcf\x7f\x1f\x45\x2c\xa8\x5a\xec\x01\xf9\x91\xaa\xc5\x28\x26\x57\xb4\x24\x73\xdc\xf1\x8c\xfb\x4b\xf3\x0b\xb3\xd6\xdd\x3d\x1c\x40\x93\x9a\x50\x46\x6e\x29\xa3\xfa\x4b\xf6\x95\x57\xcf\xc6\x3e\x5f\x01\xf8\x8b\x57\x38\x7e\x21\x6c\xe9\x15\x44\x93\x3d\x92\xf2\x13\xe7\xfa\x82\x57\x33\x3a\xdf\x4c\x8d\xb6\x55\xcb\xb2\x0a\xf5\x73\x5d\xbf\x6c\x6d\x3f\xa1\xbe\xe7\x72\x31\xee\x39\x15\x7b\xf8\x7c\x79\xb5\xee\x2b\x3e\xec\xef\xeb\xcf\x0f\x21\x5f\x25\xb6\xef\xe7\xf2\x99\xcb\x72\x5e\x0a\xa3\x31\x9b\x31\x52\x73\x99\xd5\x93\x18\x62\xbf\xf6\x9c\xae\xac\xdb\xa7\xa5\x26\x32\x19\xd6\x7b\x04\xd0\xbe\x8e\x9b\x0a\x5d\x45\x7f\x76\x9a\x09\xad\x0a\x7c\xf8\x3b\x02\xa8\x73\x61\x54\x10\x51\x98\x75\x5d\x49\x4a\x08\x82\x4b\x52\xae\xeb\xda\x0a\x0a\xea\xda\x8d\x75\x65\xaa\x32\x61\x6e\x19\xcd\x5d\x2f\x91\x06\x47\x13\x74\xcb\x78\xbe\x50\x9a\x4b\x32\xc7\xac\xe6\xcc\x94\x98\xd5\xd3\x18\x62\xbf\x5e\xcd\xd2\xd6\x0c\xed\x98\x1d\x45\xbf\xe2\x0e\x11\x3c\x71\x9a\x0a\xf3\xb9\xe4\x46\xf8\xf3\xec\xbe\xbc\xaf\xcb\x03\x73\xed\xe2\xff\x8f\x96\x6a\xd9\x11\x9a\x83\x7b\x77\x23\x63\x77\xbb\x54\x2e\xa9\xd0\x6d\xeb\xec\x70\x60\xc6\x25\x2c\x4e\x15\x74\x9a\x11\x80\x34\x0c\x1d\x3c\xc0\x4c\xf2\x32\x13\x5c\x6a\x47\x30\x99\x38\xa1\xe6\x9d\x68\x45\x48\x85\xed\xfa\x9a\xe7\x9c\xb9\x73\xc8\x45\xec\xe4\x39\x2d\xe4\x8a\x8b\x69\xe2\xfe\xbd\x77\x6d\xbb\xd9\xca\x76\x70\x18\x60\x3b\x38\x0c\xb1\xd1\xbc\xfc\x66\xba\xd3\x34\x40\x77\x9a\x86\xe8\x5e\x20\xb8\xa3\xa3\x69\x80\xae\x93\xbe\x3c\xdf\x64\x7a\xf2\x7d\xe8\xf0\xa6\xaf\x16\xe1\x61\x90\xb0\x93\xbe\x3c\xdf\x71\x38\xa5\xc7\xaf\x97\xd3\xc3\x74\x72\x74\x1a\x8a\xb1\x97\xbf\x0a\xe7\x87\x34\xcc\xf9\xe1\xb5\x4e\x72\x9a\xa6\x69\x88\x73\x3a\x39\x39\x3e\xf9\xaf\x70\x9a\x62\x27\xce\x27\x1e\xdb\xfe\x99\x76\x8f\x6d\xf7\xb5\xde\x3e\x01\xc2\x9d\x6c\xb3\x8f\x8e\x69\x8e\xb5\x0c\x37\xe4\x65\x03\xfb\x35\xcb\x76\x10\x8c\x00\xda\x36\x3f\xec\x01\xbb\x35\x26\xcc\x8d\xb4\x63\x9f\x6b\x01\xca\x5b\x7e\xde\xda\x7a\x92\x6e\x9d\x58\x40\x0b\xb2\x31\x3f\x76\xf4\x1b\x1b\xdd\x8f\x2f\x3b\x02\xae\x66\x44\x63\x29\x18\xd1\x38\xa3\x0c\xdf\x0c\x1c\xef\x06\xc6\x81\xe3\xdf\xc1\x23\x74\x11\xed\x14\x29\x34\x6f\xa3\x08\xa0\xf2\x83\x53\x7b\xcd\x42\xe9\x5a\x0e\x67\xfe\x26\xd2\x07\x2c\x32\x2a\xb2\xfa\x68\x9d\x87\x8a\x21\xc3\xff\xce\x20\xae\x0c\x63\x31\xfc\xb8\x5d\xf1\x07\xb0\x6a\xdb\xae\x60\xeb\x26\xad\xe6\xd9\x8c\x71\xa2\x69\x35\xa7\xa2\x1b\xe2\xec\xb7\xd8\x61\x3e\x11\x9c\xb3\x2e\xae\xd5\xa1\xf3\xe9\x91\xb1\x67\x24\x4a\xf1\x9c\x12\xdd\xd6\xc0\x6c\x55\xb4\x59\x08\x23\x7e\x74\x78\x19\x15\x70\x06\x4f\x07\x99\xb4\x21\x26\xef\x12\x52\x14\x12\x95\xda\xa8\x8c\x3e\xb3\xc5\x00\x31\x50\xb9\xbd\x3b\xc9\xbb\x84\x16\xcf\x1a\xb6\xda\xa1\x90\x68\x4d\xf2\x3b\x9f\x80\x81\x68\xe7\x04\xbc\x90\xbb\x00\x2d\x3d\x2d\x00\x06\x40\xe1\x59\x36\xf1\xab\x70\xe0\xdc\x68\x61\xf4\xea\xaf\x9b\x6a\xc6\x7d\x44\x35\x61\x06\xfb\x3f\xce\xf4\x15\x41\xc5\x73\x9c\x6f\x0f\x37\x49\x93\x95\x0a\xf2\x15\xf5\xcd\xd7\xc1\x57\xce\xbf\x03\x00\xf0\x12\x5c\x27\x6f\x12\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons"].(os.FileInfo),
 		fs["/bootconfig"].(os.FileInfo),
+		fs["/bootmenu"].(os.FileInfo),
+		fs["/bundle"].(os.FileInfo),
+		fs["/cni"].(os.FileInfo),
 		fs["/housekeeper"].(os.FileInfo),
 		fs["/kickstart"].(os.FileInfo),
 		fs["/terraform"].(os.FileInfo),
 	}
+	fs["/addons"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/catalog.yaml"].(os.FileInfo),
+		fs["/addons/dashboard"].(os.FileInfo),
+		fs["/addons/ingress-nginx"].(os.FileInfo),
+		fs["/addons/local-path-provisioner"].(os.FileInfo),
+		fs["/addons/metrics-server"].(os.FileInfo),
+		fs["/addons/nfs-csi"].(os.FileInfo),
+	}
+	fs["/addons/dashboard"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/dashboard/v2.7.0.yaml.template"].(os.FileInfo),
+	}
+	fs["/addons/ingress-nginx"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/ingress-nginx/v1.10.1.yaml.template"].(os.FileInfo),
+	}
+	fs["/addons/local-path-provisioner"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/local-path-provisioner/v0.0.27.yaml.template"].(os.FileInfo),
+	}
+	fs["/addons/metrics-server"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/metrics-server/v0.7.1.yaml.template"].(os.FileInfo),
+	}
+	fs["/addons/nfs-csi"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/nfs-csi/v4.7.0.yaml.template"].(os.FileInfo),
+	}
 	fs["/bootconfig"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/bootconfig/files"].(os.FileInfo),
 		fs["/bootconfig/systemd"].(os.FileInfo),
@@ -425,23 +567,23 @@ var Assets = func() http.FileSystem {
 		fs["/bootconfig/files/etc"].(os.FileInfo),
 	}
 	fs["/bootconfig/files/etc"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/bootconfig/files/etc/docker"].(os.FileInfo),
 		fs["/bootconfig/files/etc/hosts.template"].(os.FileInfo),
-		fs["/bootconfig/files/etc/isulad"].(os.FileInfo),
 		fs["/bootconfig/files/etc/nkdfiles"].(os.FileInfo),
+		fs["/bootconfig/files/etc/pki"].(os.FileInfo),
 		fs["/bootconfig/files/etc/sysctl.d"].(os.FileInfo),
 		fs["/bootconfig/files/etc/systemd"].(os.FileInfo),
-	}
-	fs["/bootconfig/files/etc/docker"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/bootconfig/files/etc/docker/daemon.json.template"].(os.FileInfo),
-	}
-	fs["/bootconfig/files/etc/isulad"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/bootconfig/files/etc/isulad/daemon.json.template"].(os.FileInfo),
+		fs["/bootconfig/files/etc/yum.repos.d"].(os.FileInfo),
 	}
 	fs["/bootconfig/files/etc/nkdfiles"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/bootconfig/files/etc/nkdfiles/init-config.yaml.template"].(os.FileInfo),
 		fs["/bootconfig/files/etc/nkdfiles/node-pivot.sh.template"].(os.FileInfo),
 	}
+	fs["/bootconfig/files/etc/pki"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bootconfig/files/etc/pki/rpm-gpg"].(os.FileInfo),
+	}
+	fs["/bootconfig/files/etc/pki/rpm-gpg"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bootconfig/files/etc/pki/rpm-gpg/RPM-GPG-KEY-nkd-packages.template"].(os.FileInfo),
+	}
 	fs["/bootconfig/files/etc/sysctl.d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/bootconfig/files/etc/sysctl.d/kubernetes.conf"].(os.FileInfo),
 	}
@@ -454,6 +596,9 @@ var Assets = func() http.FileSystem {
 	fs["/bootconfig/files/etc/systemd/system/kubelet.service.d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/bootconfig/files/etc/systemd/system/kubelet.service.d/10-kubeadm.conf.template"].(os.FileInfo),
 	}
+	fs["/bootconfig/files/etc/yum.repos.d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bootconfig/files/etc/yum.repos.d/nkd-packages.repo.template"].(os.FileInfo),
+	}
 	fs["/bootconfig/systemd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/bootconfig/systemd/init-cluster.service"].(os.FileInfo),
 		fs["/bootconfig/systemd/join-master.service.template"].(os.FileInfo),
@@ -462,6 +607,17 @@ var Assets = func() http.FileSystem {
 		fs["/bootconfig/systemd/release-image-pivot.service"].(os.FileInfo),
 		fs["/bootconfig/systemd/set-kernel-para.service"].(os.FileInfo),
 	}
+	fs["/bootmenu"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bootmenu/grub.cfg.template"].(os.FileInfo),
+		fs["/bootmenu/ipxe.cfg.template"].(os.FileInfo),
+		fs["/bootmenu/pxelinux.cfg.template"].(os.FileInfo),
+	}
+	fs["/bundle"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bundle/catalog.yaml"].(os.FileInfo),
+	}
+	fs["/cni"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/cni/catalog.yaml"].(os.FileInfo),
+	}
 	fs["/housekeeper"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/housekeeper/1housekeeper.io_updates.yaml"].(os.FileInfo),
 		fs["/housekeeper/2namespace.yaml"].(os.FileInfo),
@@ -37,6 +37,9 @@ func main() {
 		cmd.NewExtendCommand(),
 		cmd.NewVersionCommand(),
 		cmd.NewTemplateCommand(),
+		cmd.NewKubeconfigCommand(),
+		cmd.NewBundleCommand(),
+		cmd.NewAddonsCommand(),
 	} {
 		rootCmd.AddCommand(subCmd)
 	}
@@ -55,6 +58,8 @@ func newRootCmd() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&opts.Opts.RootOptDir, "dir", "/etc/nkd", "Assets directory")
 	cmd.PersistentFlags().StringVar(&opts.RootOpts.LogLevel, "log-level", "info", "log level (e.g. \"debug | info | warn | error\")")
+	cmd.PersistentFlags().StringVar(&opts.Opts.NKD.SecretKeyFile, "secret-key-file", "", "File containing the key material used to encrypt secrets in the assets directory (or set NKD_SECRET_PASSPHRASE)")
+	cmd.PersistentFlags().BoolVar(&opts.Opts.NKD.AllowPlaintextSecrets, "allow-plaintext-secrets", false, "Allow secrets to be persisted unencrypted when no secret key material is provided")
 	return cmd
 }
 
@@ -0,0 +1,276 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/data"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+var imageRegexp = regexp.MustCompile(`(?m)^\s*(?:-\s+)?image:\s*["']?([^\s"']+)`)
+
+// ApplySet 返回附加组件的对象所属的 apply set，对象带有 kubeclient.ApplySetLabel 标签
+func ApplySet(name string) string {
+	return "addon-" + name
+}
+
+// Params 渲染附加组件清单模板时使用的参数
+type Params struct {
+	Name          string
+	Version       string
+	ImageRegistry string
+	Values        map[string]string
+}
+
+// IsBuiltin 判断附加组件是否为内置的附加组件，未设置清单的附加组件使用目录中的清单
+func IsBuiltin(a *asset.AddonAsset) bool {
+	return len(a.Manifests) == 0
+}
+
+// NewParams 计算附加组件的模板参数，内置附加组件的值为目录中的默认值与集群配置中的值合并的结果
+func NewParams(a *asset.AddonAsset, catalog *Catalog) (*Params, error) {
+	if !IsBuiltin(a) {
+		return &Params{Name: a.Name, Version: a.Version, ImageRegistry: a.ImageRegistry, Values: a.Values}, nil
+	}
+
+	builtin, version, err := catalog.Lookup(a.Name, a.Version)
+	if err != nil {
+		return nil, err
+	}
+	params := &Params{
+		Name:          a.Name,
+		Version:       version,
+		ImageRegistry: builtin.ImageRegistry,
+		Values:        make(map[string]string, len(builtin.Values)),
+	}
+	if a.ImageRegistry != "" {
+		params.ImageRegistry = strings.TrimSuffix(a.ImageRegistry, "/")
+	}
+	for key, value := range builtin.Values {
+		params.Values[key] = value
+	}
+	for key, value := range a.Values {
+		if _, ok := builtin.Values[key]; !ok {
+			return nil, fmt.Errorf("unknown value %q of addon %s, supported values: %s", key, a.Name, strings.Join(sortedKeys(builtin.Values), ", "))
+		}
+		params.Values[key] = value
+	}
+	return params, nil
+}
+
+// Dependencies 返回附加组件依赖的附加组件，包括目录中声明的依赖
+func Dependencies(a *asset.AddonAsset, catalog *Catalog) []string {
+	var deps []string
+	if builtin, ok := catalog.Addons[a.Name]; ok && IsBuiltin(a) {
+		deps = append(deps, builtin.DependsOn...)
+	}
+	for _, dep := range a.DependsOn {
+		if !contains(deps, dep) {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+// Order 返回需要安装的附加组件，被依赖的附加组件排在前面，其余附加组件保持配置中的顺序
+func Order(conf *asset.ClusterAsset, catalog *Catalog) ([]*asset.AddonAsset, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var ordered []*asset.AddonAsset
+	var visit func(a *asset.AddonAsset) error
+	visit = func(a *asset.AddonAsset) error {
+		switch state[a.Name] {
+		case visiting:
+			return fmt.Errorf("addon %s has a circular dependency", a.Name)
+		case visited:
+			return nil
+		}
+		state[a.Name] = visiting
+		for _, name := range Dependencies(a, catalog) {
+			dep := conf.Addon(name)
+			if dep == nil || !dep.IsEnabled() {
+				return fmt.Errorf("addon %s depends on addon %s, which is not enabled", a.Name, name)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[a.Name] = visited
+		ordered = append(ordered, a)
+		return nil
+	}
+
+	for i := range conf.Addons {
+		if !conf.Addons[i].IsEnabled() {
+			continue
+		}
+		if err := visit(&conf.Addons[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// Validate 校验集群配置中的附加组件：内置附加组件的版本和值，以及附加组件之间的依赖
+func Validate(conf *asset.ClusterAsset) error {
+	if len(conf.Addons) == 0 {
+		return nil
+	}
+	catalog, err := LoadCatalog()
+	if err != nil {
+		return err
+	}
+	for i := range conf.Addons {
+		if _, err := NewParams(&conf.Addons[i], catalog); err != nil {
+			return err
+		}
+	}
+	_, err = Order(conf, catalog)
+	return err
+}
+
+// Render 渲染附加组件的清单，非内置附加组件的多个清单按顺序合并为一个清单
+func Render(a *asset.AddonAsset, catalog *Catalog) ([]byte, error) {
+	params, err := NewParams(a, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources [][]byte
+	if IsBuiltin(a) {
+		content, err := readTemplate(a.Name, params.Version)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, content)
+	}
+	for _, manifest := range a.Manifests {
+		content, err := utils.FetchManifest(manifest.Source, manifest.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, content)
+	}
+
+	var buf bytes.Buffer
+	for i, content := range sources {
+		if i > 0 {
+			buf.WriteString("\n---\n")
+		}
+		if err := execute(&buf, a.Name, content, params); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Images 返回集群中需要安装的内置附加组件引用的镜像
+func Images(conf *asset.ClusterAsset) ([]string, error) {
+	if len(conf.Addons) == 0 {
+		return nil, nil
+	}
+	catalog, err := LoadCatalog()
+	if err != nil {
+		return nil, err
+	}
+
+	var images []string
+	for i := range conf.Addons {
+		a := &conf.Addons[i]
+		if !a.IsEnabled() || !IsBuiltin(a) {
+			continue
+		}
+		content, err := Render(a, catalog)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range imageRegexp.FindAllStringSubmatch(string(content), -1) {
+			if !contains(images, match[1]) {
+				images = append(images, match[1])
+			}
+		}
+	}
+	return images, nil
+}
+
+func readTemplate(name string, version string) ([]byte, error) {
+	file, err := data.Assets.Open(path.Join("addons", name, version+".yaml.template"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open manifest template of addon %s %s", name, version)
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
+
+func execute(w io.Writer, name string, content []byte, params *Params) error {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"required": func(key string, value string) (string, error) {
+			if value == "" {
+				return "", fmt.Errorf("value %s of addon %s is required", key, name)
+			}
+			return value, nil
+		},
+		"split": func(s string) []string {
+			var items []string
+			for _, item := range strings.Split(s, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			return items
+		},
+	}).Parse(string(content))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse manifest template of addon %s", name)
+	}
+	if err := tmpl.Execute(w, params); err != nil {
+		return errors.Wrapf(err, "failed to render manifest of addon %s", name)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
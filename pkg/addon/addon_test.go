@@ -0,0 +1,198 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAddon(t *testing.T) {
+	if err := os.Chdir("../../data"); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	catalog, err := LoadCatalog()
+	if err != nil {
+		t.Fatalf("LoadCatalog failed: %v", err)
+	}
+
+	t.Run("Lookup Success", func(t *testing.T) {
+		for _, name := range catalog.Names() {
+			addon, version, err := catalog.Lookup(name, "")
+			if err != nil {
+				t.Fatalf("Lookup %s failed: %v", name, err)
+			}
+			if version != addon.DefaultVersion || addon.ImageRegistry == "" || addon.Description == "" {
+				t.Errorf("unexpected addon %s: %+v", name, addon)
+			}
+			for _, v := range addon.Versions {
+				if _, err := os.Stat("data/addons/" + name + "/" + v + ".yaml.template"); err != nil {
+					t.Errorf("manifest template of %s %s: %v", name, v, err)
+				}
+			}
+			for _, dep := range addon.DependsOn {
+				if _, ok := catalog.Addons[dep]; !ok {
+					t.Errorf("addon %s depends on unknown addon %s", name, dep)
+				}
+			}
+		}
+	})
+
+	t.Run("Lookup Fail", func(t *testing.T) {
+		if _, _, err := catalog.Lookup("prometheus", ""); err == nil {
+			t.Error("expected failure for an unsupported addon")
+		}
+		if _, _, err := catalog.Lookup("metrics-server", "v0.1.0"); err == nil {
+			t.Error("expected failure for an unsupported version")
+		}
+	})
+
+	t.Run("Render Success", func(t *testing.T) {
+		for _, name := range catalog.Names() {
+			a := &asset.AddonAsset{Name: name}
+			if name == "nfs-csi" {
+				a.Values = map[string]string{"server": "192.168.1.100", "share": "/srv/nfs"}
+			}
+			content, err := Render(a, catalog)
+			if err != nil {
+				t.Fatalf("Render %s failed: %v", name, err)
+			}
+			objects, err := kubeclient.DecodeManifest(content)
+			if err != nil {
+				t.Fatalf("invalid manifest of %s: %v", name, err)
+			}
+			if len(objects) == 0 {
+				t.Errorf("manifest of %s is empty", name)
+			}
+		}
+	})
+
+	t.Run("Render Values Success", func(t *testing.T) {
+		a := &asset.AddonAsset{
+			Name:          "ingress-nginx",
+			ImageRegistry: "hub.oepkgs.net/",
+			Values:        map[string]string{"replicas": "2", "httpNodePort": "30080"},
+		}
+		content, err := Render(a, catalog)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		for _, expected := range []string{"hub.oepkgs.net/ingress-nginx/controller:v1.10.1", "replicas: 2", "nodePort: 30080"} {
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("manifest does not contain %s", expected)
+			}
+		}
+	})
+
+	t.Run("Render Fail", func(t *testing.T) {
+		if _, err := Render(&asset.AddonAsset{Name: "nfs-csi"}, catalog); err == nil {
+			t.Error("expected failure for nfs-csi without a server")
+		}
+		if _, err := Render(&asset.AddonAsset{Name: "metrics-server", Values: map[string]string{"unknown": "1"}}, catalog); err == nil {
+			t.Error("expected failure for an unknown value")
+		}
+	})
+
+	t.Run("Custom Manifest Success", func(t *testing.T) {
+		content := []byte("image: {{.ImageRegistry}}/app:{{.Version}}\nreplicas: {{.Values.replicas}}\n")
+		path := filepath.Join(t.TempDir(), "app.yaml")
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			t.Fatalf("failed to write manifest: %v", err)
+		}
+		sum := sha256.Sum256(content)
+		a := &asset.AddonAsset{
+			Name:          "app",
+			Version:       "v1.0.0",
+			ImageRegistry: "registry.example.com",
+			Manifests:     []asset.AddonManifest{{Source: path, SHA256: hex.EncodeToString(sum[:])}, {Source: path}},
+			Values:        map[string]string{"replicas": "3"},
+		}
+		manifest, err := Render(a, catalog)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		expected := "image: registry.example.com/app:v1.0.0\nreplicas: 3\n\n---\nimage: registry.example.com/app:v1.0.0\nreplicas: 3\n"
+		if string(manifest) != expected {
+			t.Errorf("unexpected manifest: %s", manifest)
+		}
+	})
+
+	t.Run("Order Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{Addons: []asset.AddonAsset{
+			{Name: "dashboard"},
+			{Name: "app", Manifests: []asset.AddonManifest{{Source: "app.yaml"}}, DependsOn: []string{"ingress-nginx"}},
+			{Name: "ingress-nginx"},
+			{Name: "metrics-server"},
+			{Name: "nfs-csi"},
+		}}
+		conf.Addons[4].SetEnabled(false)
+		addons, err := Order(conf, catalog)
+		if err != nil {
+			t.Fatalf("Order failed: %v", err)
+		}
+		var names []string
+		for _, a := range addons {
+			names = append(names, a.Name)
+		}
+		if strings.Join(names, ",") != "metrics-server,dashboard,ingress-nginx,app" {
+			t.Errorf("unexpected order: %v", names)
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		for _, addons := range [][]asset.AddonAsset{
+			{{Name: "dashboard"}},
+			{{Name: "prometheus"}},
+			{{Name: "metrics-server", Version: "v0.1.0"}},
+			{{Name: "a", Manifests: []asset.AddonManifest{{Source: "a.yaml"}}, DependsOn: []string{"b"}}, {Name: "b", Manifests: []asset.AddonManifest{{Source: "b.yaml"}}, DependsOn: []string{"a"}}},
+		} {
+			if err := Validate(&asset.ClusterAsset{Addons: addons}); err == nil {
+				t.Errorf("expected failure for addons %+v", addons)
+			}
+		}
+		conf := &asset.ClusterAsset{Addons: []asset.AddonAsset{{Name: "dashboard"}, {Name: "metrics-server"}}}
+		conf.Addons[1].SetEnabled(false)
+		if err := Validate(conf); err == nil {
+			t.Error("expected failure for a disabled dependency")
+		}
+	})
+
+	t.Run("Images Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{Addons: []asset.AddonAsset{{Name: "local-path-provisioner"}, {Name: "dashboard"}, {Name: "metrics-server"}}}
+		conf.Addons[1].SetEnabled(false)
+		images, err := Images(conf)
+		if err != nil {
+			t.Fatalf("Images failed: %v", err)
+		}
+		joined := strings.Join(images, ",")
+		for _, expected := range []string{"docker.io/rancher/local-path-provisioner:v0.0.27", "docker.io/library/busybox:1.36.1", "registry.k8s.io/metrics-server/metrics-server:v0.7.1"} {
+			if !strings.Contains(joined, expected) {
+				t.Errorf("images do not contain %s: %v", expected, images)
+			}
+		}
+		if strings.Contains(joined, "dashboard") {
+			t.Errorf("images of a disabled addon are collected: %v", images)
+		}
+	})
+}
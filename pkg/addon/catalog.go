@@ -0,0 +1,91 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/data"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const catalogPath = "addons/catalog.yaml"
+
+// Catalog 内置的附加组件目录
+type Catalog struct {
+	Addons map[string]Addon `yaml:"addons"`
+}
+
+// Addon 内置的附加组件，每个版本的清单由 addons/<名称>/<版本>.yaml.template 渲染得到
+type Addon struct {
+	Description    string            `yaml:"description"`
+	DefaultVersion string            `yaml:"defaultVersion"`
+	ImageRegistry  string            `yaml:"imageRegistry"` // 附加组件镜像默认所在的镜像仓库
+	DependsOn      []string          `yaml:"dependsOn"`
+	Versions       []string          `yaml:"versions"`
+	Values         map[string]string `yaml:"values"` // 可设置的值及其默认值
+}
+
+// LoadCatalog 读取内置的附加组件目录
+func LoadCatalog() (*Catalog, error) {
+	file, err := data.Assets.Open(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read addon catalog")
+	}
+
+	catalog := &Catalog{}
+	if err := yaml.Unmarshal(content, catalog); err != nil {
+		return nil, errors.Wrap(err, "failed to parse addon catalog")
+	}
+	return catalog, nil
+}
+
+// Names 返回目录中所有附加组件的名称
+func (c *Catalog) Names() []string {
+	names := make([]string, 0, len(c.Addons))
+	for name := range c.Addons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup 返回附加组件及其版本，version 为空时使用默认版本
+func (c *Catalog) Lookup(name string, version string) (*Addon, string, error) {
+	addon, ok := c.Addons[name]
+	if !ok {
+		return nil, "", fmt.Errorf("addon %s is not built in, supported addons: %s", name, strings.Join(c.Names(), ", "))
+	}
+	if version == "" {
+		return &addon, addon.DefaultVersion, nil
+	}
+	for _, v := range addon.Versions {
+		if v == version {
+			return &addon, version, nil
+		}
+	}
+	return nil, "", fmt.Errorf("unsupported version %q of addon %s, supported versions: %s", version, name, strings.Join(addon.Versions, ", "))
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package api
 
+import "os"
+
 type OperatingSystem interface {
 	GenerateResourceFiles() error
 }
@@ -28,4 +30,43 @@ type BootConfigAPI interface {
 type Runtime interface {
 	// GetRuntimeCriSocket 返回与运行时相关的 CRI (Container Runtime Interface) 套接字地址
 	GetRuntimeCriSocket() string
+	// GetRuntimeConfigFiles 根据运行时设置生成运行时的完整配置文件或配置片段，以及 crictl 的配置文件
+	GetRuntimeConfigFiles(config *RuntimeConfig) ([]RuntimeFile, error)
+}
+
+// RuntimeConfig 容器运行时的设置
+type RuntimeConfig struct {
+	PauseImage          string                  // 完整的 pause 镜像地址
+	CgroupDriver        string                  // systemd 或 cgroupfs
+	Mirrors             []RegistryMirror        // 镜像仓库的 mirror
+	InsecureRegistries  []string                // 不校验证书访问的镜像仓库
+	PlainHTTPRegistries []string                // 通过 HTTP 访问的镜像仓库
+	CAs                 map[string][]byte       // 校验镜像仓库证书的 CA 证书（PEM 格式）
+	Auths               map[string]RegistryAuth // 镜像仓库的认证信息
+	Handlers            []RuntimeHandler        // 额外注册的运行时
+}
+
+// RuntimeHandler 在容器运行时中额外注册的运行时，Pod 通过同名的 RuntimeClass 使用
+type RuntimeHandler struct {
+	Name        string
+	Type        string // kata 或 gvisor
+	RuntimePath string // 运行时（shim）的可执行文件路径，为空时使用默认路径
+}
+
+// RegistryMirror 镜像仓库及其 mirror 地址，地址包含协议，如 https://mirror.example.com
+type RegistryMirror struct {
+	Registry  string
+	Endpoints []string
+}
+
+type RegistryAuth struct {
+	Username string
+	Password string
+}
+
+// RuntimeFile 写入节点的运行时配置文件
+type RuntimeFile struct {
+	Path     string
+	Mode     os.FileMode
+	Contents []byte
 }
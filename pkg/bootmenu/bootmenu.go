@@ -0,0 +1,375 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootmenu
+
+import (
+	"bytes"
+	"fmt"
+	"nestos-kubernetes-deployer/data"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	// LocalLabel 从本地磁盘启动的菜单项
+	LocalLabel = "local"
+
+	// PXE客户端从TFTP获取的内核与initrd路径
+	KernelFile = "nkd/vmlinuz"
+	InitrdFile = "nkd/initrd.img"
+	// 包含引导CA证书的附加initrd，TFTP与iPXE使用相同的路径
+	CAInitrdFile = "nkd/bootstrap-ca.img"
+
+	// 安装程序initramfs中curl使用的CA证书包，以及 update-ca-trust 读取的CA证书目录中的引导CA证书
+	caBundlePath = "etc/pki/tls/certs/ca-bundle.crt"
+	caAnchorPath = "etc/pki/ca-trust/source/anchors/nkd-bootstrap-ca.crt"
+
+	menuTimeout = 60 // 默认菜单等待选择的秒数
+)
+
+// Entry 引导菜单项
+type Entry struct {
+	Label     string // 菜单项名称，通常为节点主机名
+	Kickstart string // kickstart 文件地址
+	// 地址携带访问令牌，该项不出现在默认菜单中，只出现在对应MAC地址的客户端的菜单中
+	HostOnly bool
+}
+
+// Menu 根据集群配置生成的PXE/iPXE引导菜单
+type Menu struct {
+	KernelURL  string // iPXE通过HTTP获取的内核地址
+	InitrdURL  string
+	BaseURL    string // iPXE获取菜单文件的地址，以 / 结尾
+	Repo       string // 安装源，即HTTP服务提供的操作系统安装树
+	KernelArgs string // 追加的内核参数
+	Entries    []Entry
+	// 以MAC地址为索引的菜单项名称，对应的客户端直接启动该项，不显示菜单
+	Hosts map[string]string
+	// 默认菜单的默认项，为空时从本地磁盘启动
+	Default string
+	// 引导服务的CA证书，通过附加的initrd提供给安装程序，使其能够校验HTTPS的kickstart地址
+	BootstrapCA []byte
+}
+
+type menuData struct {
+	Kernel  string
+	Initrds []initrdData
+	Default string
+	Timeout int    // 秒，0 表示不显示菜单直接启动
+	Chain   string // iPXE按MAC地址跳转的菜单地址
+	Entries []entryData
+	Hosts   []hostData
+}
+
+type initrdData struct {
+	Name string // iPXE中的镜像名称
+	Path string
+}
+
+type entryData struct {
+	Label    string
+	Local    bool
+	HostOnly bool
+	Args     string
+}
+
+type hostData struct {
+	MAC   string // 以短横线分隔的MAC地址
+	Label string
+}
+
+// Deciseconds 返回pxelinux使用的超时时间
+func (d menuData) Deciseconds() int {
+	return d.Timeout * 10
+}
+
+// Milliseconds 返回iPXE使用的超时时间
+func (d menuData) Milliseconds() int {
+	return d.Timeout * 1000
+}
+
+// InitrdPaths 返回以 sep 分隔的initrd路径
+func (d menuData) InitrdPaths(sep string) string {
+	paths := make([]string, 0, len(d.Initrds))
+	for _, initrd := range d.Initrds {
+		paths = append(paths, initrd.Path)
+	}
+	return strings.Join(paths, sep)
+}
+
+// Validate 校验菜单项名称及MAC地址对应的菜单项
+func (m *Menu) Validate() error {
+	labels := map[string]bool{LocalLabel: true}
+	for _, entry := range m.Entries {
+		if entry.Label == "" || strings.ContainsAny(entry.Label, " \t'\"") {
+			return fmt.Errorf("invalid boot menu label %q", entry.Label)
+		}
+		if labels[entry.Label] {
+			return fmt.Errorf("duplicate boot menu label %q", entry.Label)
+		}
+		labels[entry.Label] = true
+	}
+	for mac, label := range m.Hosts {
+		if !labels[label] {
+			return fmt.Errorf("boot menu entry %q of %s does not exist", label, mac)
+		}
+	}
+	if m.Default != "" && !labels[m.Default] {
+		return fmt.Errorf("default boot menu entry %q does not exist", m.Default)
+	}
+	for _, entry := range m.Entries {
+		if entry.HostOnly && entry.Label == m.Default {
+			return fmt.Errorf("boot menu entry %q is only served to its MAC address and can not be the default", entry.Label)
+		}
+	}
+	return nil
+}
+
+// TFTPFiles 生成pxelinux（BIOS）与GRUB（UEFI）的引导菜单，文件名为相对于TFTP根目录的路径：
+//   - pxelinux.cfg/default、grub.cfg：默认菜单，不包含 HostOnly 的菜单项
+//   - pxelinux.cfg/01-<mac>、grub.cfg-01-<mac>：指定MAC地址的客户端的菜单
+//   - nkd/bootstrap-ca.img：设置了 BootstrapCA 时包含引导CA证书的initrd
+func (m *Menu) TFTPFiles() (map[string][]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	for _, t := range []struct {
+		template string
+		name     func(mac string) string
+		kernel   string
+		initrd   string
+		caInitrd string
+	}{
+		{"pxelinux.cfg.template", pxelinuxFile, KernelFile, InitrdFile, CAInitrdFile},
+		// GRUB从TFTP设备的根目录解析绝对路径
+		{"grub.cfg.template", grubFile, "/" + KernelFile, "/" + InitrdFile, "/" + CAInitrdFile},
+	} {
+		if err := m.renderFiles(files, t.template, t.name, m.data(t.kernel, t.initrd, t.caInitrd)); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.BootstrapCA) > 0 {
+		files[CAInitrdFile] = caInitrd(m.BootstrapCA)
+	}
+	return files, nil
+}
+
+// IPXEFiles 生成iPXE脚本，文件名为相对于 BaseURL 的路径：
+//   - ipxe.cfg：默认菜单，按客户端的MAC地址跳转到 ipxe.cfg-01-<mac>，不存在时显示默认菜单
+//   - ipxe.cfg-01-<mac>：指定MAC地址的客户端的菜单
+//   - nkd/bootstrap-ca.img：设置了 BootstrapCA 时包含引导CA证书的initrd
+func (m *Menu) IPXEFiles() (map[string][]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	d := m.data(m.KernelURL, m.InitrdURL, m.BaseURL+CAInitrdFile)
+	if len(d.Hosts) > 0 {
+		d.Chain = m.BaseURL + ipxeFile("${net0/mac:hexhyp}")
+	}
+	if err := m.renderFiles(files, "ipxe.cfg.template", ipxeFile, d); err != nil {
+		return nil, err
+	}
+	if len(m.BootstrapCA) > 0 {
+		files[CAInitrdFile] = caInitrd(m.BootstrapCA)
+	}
+	return files, nil
+}
+
+// renderFiles 生成默认菜单及各MAC地址的菜单，name 返回菜单的文件名，mac 为空时为默认菜单
+func (m *Menu) renderFiles(files map[string][]byte, template string, name func(mac string) string, d menuData) error {
+	content, err := render(template, defaultMenuData(d))
+	if err != nil {
+		return err
+	}
+	files[name("")] = content
+
+	for _, host := range d.Hosts {
+		content, err := render(template, hostMenuData(d, host.Label))
+		if err != nil {
+			return err
+		}
+		files[name(host.MAC)] = content
+	}
+	return nil
+}
+
+// data 返回包含全部菜单项的菜单数据，caInitrd 为引导CA证书initrd的路径
+func (m *Menu) data(kernel, initrd, caInitrd string) menuData {
+	d := menuData{
+		Kernel:  kernel,
+		Initrds: []initrdData{{Name: path.Base(InitrdFile), Path: initrd}},
+		Default: m.Default,
+		Timeout: menuTimeout,
+	}
+	if d.Default == "" {
+		d.Default = LocalLabel
+	}
+	if len(m.BootstrapCA) > 0 {
+		d.Initrds = append(d.Initrds, initrdData{Name: path.Base(CAInitrdFile), Path: caInitrd})
+	}
+
+	for _, entry := range m.Entries {
+		args := []string{"inst.repo=" + m.Repo, "inst.ks=" + entry.Kickstart}
+		if m.KernelArgs != "" {
+			args = append(args, m.KernelArgs)
+		}
+		d.Entries = append(d.Entries, entryData{Label: entry.Label, HostOnly: entry.HostOnly, Args: strings.Join(args, " ")})
+	}
+	d.Entries = append(d.Entries, entryData{Label: LocalLabel, Local: true})
+
+	for mac, label := range m.Hosts {
+		d.Hosts = append(d.Hosts, hostData{MAC: strings.ReplaceAll(strings.ToLower(mac), ":", "-"), Label: label})
+	}
+	sort.Slice(d.Hosts, func(i, j int) bool { return d.Hosts[i].MAC < d.Hosts[j].MAC })
+	return d
+}
+
+// defaultMenuData 返回默认菜单，携带访问令牌的菜单项只出现在对应MAC地址的菜单中
+func defaultMenuData(d menuData) menuData {
+	defaultMenu := d
+	defaultMenu.Entries = nil
+	for _, entry := range d.Entries {
+		if !entry.HostOnly {
+			defaultMenu.Entries = append(defaultMenu.Entries, entry)
+		}
+	}
+	return defaultMenu
+}
+
+// hostMenuData 返回只包含一个菜单项且直接启动的菜单
+func hostMenuData(d menuData, label string) menuData {
+	hostMenu := d
+	hostMenu.Default = label
+	hostMenu.Timeout = 0
+	hostMenu.Chain = ""
+	hostMenu.Hosts = nil
+	hostMenu.Entries = nil
+	for _, entry := range d.Entries {
+		if entry.Label == label {
+			hostMenu.Entries = append(hostMenu.Entries, entry)
+		}
+	}
+	return hostMenu
+}
+
+func pxelinuxFile(mac string) string {
+	if mac == "" {
+		return "pxelinux.cfg/default"
+	}
+	return "pxelinux.cfg/01-" + mac
+}
+
+func grubFile(mac string) string {
+	if mac == "" {
+		return "grub.cfg"
+	}
+	return "grub.cfg-01-" + mac
+}
+
+func ipxeFile(mac string) string {
+	if mac == "" {
+		return "ipxe.cfg"
+	}
+	return "ipxe.cfg-01-" + mac
+}
+
+// systemCABundles 常见发行版的系统CA证书包路径
+var systemCABundles = []string{
+	"/etc/pki/tls/certs/ca-bundle.crt",
+	"/etc/ssl/certs/ca-certificates.crt",
+	"/etc/ssl/ca-bundle.pem",
+	"/etc/pki/ca-trust/extracted/pem/tls-ca-bundle.pem",
+}
+
+// systemCABundle 返回本机的系统CA证书包，均不存在时返回空
+func systemCABundle() []byte {
+	for _, file := range systemCABundles {
+		if content, err := os.ReadFile(file); err == nil && len(content) > 0 {
+			return content
+		}
+	}
+	return nil
+}
+
+/*
+caInitrd 生成包含引导CA证书的 newc 格式 cpio 归档。内核依次解压各initrd，安装程序initramfs中的CA证书包会被覆盖，
+因此归档中的CA证书包由本机的系统CA证书包追加引导CA证书组成，安装程序获取 kickstart 时信任引导CA，且仍信任公共CA。
+引导CA证书同时写入 ca-trust 的 anchors 目录，执行 update-ca-trust 重新生成证书包时不会丢失
+*/
+func caInitrd(caPEM []byte) []byte {
+	buf := &bytes.Buffer{}
+	ino := 1
+	writeEntry := func(name string, mode int, content []byte) {
+		// 依次为 ino、mode、uid、gid、nlink、mtime、filesize、devmajor、devminor、rdevmajor、rdevminor、namesize、check
+		fmt.Fprintf(buf, "070701%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X%08X",
+			ino, mode, 0, 0, 1, 0, len(content), 0, 0, 0, 0, len(name)+1, 0)
+		buf.WriteString(name)
+		buf.WriteByte(0)
+		pad4(buf)
+		buf.Write(content)
+		pad4(buf)
+		ino++
+	}
+
+	dirs := make(map[string]bool)
+	writeFile := func(name string, content []byte) {
+		dir := path.Dir(name)
+		for i := range dir {
+			if (dir[i] == '/' || i == len(dir)-1) && !dirs[dir[:i+1]] {
+				dirs[dir[:i+1]] = true
+				writeEntry(strings.TrimSuffix(dir[:i+1], "/"), 040755, nil)
+			}
+		}
+		writeEntry(name, 0100644, content)
+	}
+
+	bundle := systemCABundle()
+	if len(bundle) > 0 && !bytes.HasSuffix(bundle, []byte("\n")) {
+		bundle = append(bundle, '\n')
+	}
+	writeFile(caBundlePath, append(bundle, caPEM...))
+	writeFile(caAnchorPath, caPEM)
+	writeEntry("TRAILER!!!", 0, nil)
+	return buf.Bytes()
+}
+
+func pad4(buf *bytes.Buffer) {
+	for buf.Len()%4 != 0 {
+		buf.WriteByte(0)
+	}
+}
+
+func render(name string, d menuData) ([]byte, error) {
+	file, err := data.Assets.Open(path.Join("bootmenu", name))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	_, content, err := utils.GetCompleteFile(name, file, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %v", strings.TrimSuffix(name, ".template"), err)
+	}
+	return content, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootmenu
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBootMenu(t *testing.T) {
+	if err := os.Chdir("../../data"); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	newMenu := func() *Menu {
+		return &Menu{
+			KernelURL:  "http://10.0.0.1:9080/dir/images/pxeboot/vmlinuz",
+			InitrdURL:  "http://10.0.0.1:9080/dir/images/pxeboot/initrd.img",
+			Repo:       "http://10.0.0.1:9080/dir/",
+			BaseURL:    "http://10.0.0.1:9080/",
+			KernelArgs: "console=ttyS0",
+			Entries: []Entry{
+				{Label: "k8s-master01", Kickstart: "https://10.0.0.1:9080/nodes/k8s-master01.cfg?token=abc", HostOnly: true},
+				{Label: "k8s-worker01", Kickstart: "http://10.0.0.1:9080/worker.cfg"},
+			},
+			Hosts:       map[string]string{"52:54:00:aa:bb:01": "k8s-master01"},
+			BootstrapCA: []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+		}
+	}
+
+	t.Run("TFTPFiles Success", func(t *testing.T) {
+		files, err := newMenu().TFTPFiles()
+		if err != nil {
+			t.Fatalf("TFTPFiles failed: %v", err)
+		}
+		if len(files) != 5 {
+			t.Fatalf("expected 5 files, got %d", len(files))
+		}
+
+		pxelinux := string(files["pxelinux.cfg/default"])
+		for _, want := range []string{
+			"DEFAULT local\n",
+			"TIMEOUT 600\n",
+			"LABEL k8s-worker01\n  KERNEL nkd/vmlinuz\n  APPEND initrd=nkd/initrd.img,nkd/bootstrap-ca.img inst.repo=http://10.0.0.1:9080/dir/ " +
+				"inst.ks=http://10.0.0.1:9080/worker.cfg console=ttyS0\n",
+			"LABEL local\n  LOCALBOOT 0\n",
+		} {
+			if !strings.Contains(pxelinux, want) {
+				t.Errorf("pxelinux.cfg/default does not contain %q:\n%s", want, pxelinux)
+			}
+		}
+		for name, content := range files {
+			if strings.Contains(string(content), "inst.noverifyssl") {
+				t.Errorf("%s disables TLS verification of the installer", name)
+			}
+			if !strings.Contains(name, "01-") && strings.Contains(string(content), "token=") {
+				t.Errorf("default menu %s contains a tokenized URL:\n%s", name, content)
+			}
+		}
+
+		host := string(files["pxelinux.cfg/01-52-54-00-aa-bb-01"])
+		if !strings.Contains(host, "DEFAULT k8s-master01\nPROMPT 0\n") || strings.Contains(host, "k8s-worker01") ||
+			!strings.Contains(host, "inst.ks=https://10.0.0.1:9080/nodes/k8s-master01.cfg?token=abc") {
+			t.Errorf("unexpected host menu:\n%s", host)
+		}
+
+		grub := string(files["grub.cfg-01-52-54-00-aa-bb-01"])
+		for _, want := range []string{
+			"set default=\"k8s-master01\"\nset timeout=0\n",
+			"  linux /nkd/vmlinuz inst.repo=",
+			"  initrd /nkd/initrd.img /nkd/bootstrap-ca.img\n",
+		} {
+			if !strings.Contains(grub, want) {
+				t.Errorf("grub.cfg does not contain %q:\n%s", want, grub)
+			}
+		}
+		if !strings.Contains(string(files["grub.cfg"]), "menuentry \"local\" --id local {\n  exit\n}") {
+			t.Errorf("unexpected grub.cfg:\n%s", files["grub.cfg"])
+		}
+	})
+
+	t.Run("IPXEFiles Success", func(t *testing.T) {
+		menu := newMenu()
+		menu.Default = "k8s-worker01"
+		files, err := menu.IPXEFiles()
+		if err != nil {
+			t.Fatalf("IPXEFiles failed: %v", err)
+		}
+		if len(files) != 3 || len(files[CAInitrdFile]) == 0 {
+			t.Fatalf("unexpected ipxe files: %d", len(files))
+		}
+
+		script := string(files["ipxe.cfg"])
+		for _, want := range []string{
+			"#!ipxe\n",
+			"chain --autofree http://10.0.0.1:9080/ipxe.cfg-01-${net0/mac:hexhyp} || goto menu\n",
+			"choose --default k8s-worker01 --timeout 60000 target && goto ${target} || goto k8s-worker01\n",
+			":k8s-worker01\nkernel http://10.0.0.1:9080/dir/images/pxeboot/vmlinuz initrd=initrd.img initrd=bootstrap-ca.img inst.repo=",
+			"initrd --name initrd.img http://10.0.0.1:9080/dir/images/pxeboot/initrd.img\n" +
+				"initrd --name bootstrap-ca.img http://10.0.0.1:9080/nkd/bootstrap-ca.img\nboot\n",
+			":local\nexit\n",
+		} {
+			if !strings.Contains(script, want) {
+				t.Errorf("ipxe script does not contain %q:\n%s", want, script)
+			}
+		}
+		if strings.Contains(script, "k8s-master01") {
+			t.Errorf("default ipxe script contains a host only entry:\n%s", script)
+		}
+
+		host := string(files["ipxe.cfg-01-52-54-00-aa-bb-01"])
+		if !strings.Contains(host, "goto k8s-master01\n") || strings.Contains(host, "chain") ||
+			!strings.Contains(host, "inst.ks=https://10.0.0.1:9080/nodes/k8s-master01.cfg?token=abc") {
+			t.Errorf("unexpected host script:\n%s", host)
+		}
+	})
+
+	t.Run("caInitrd Success", func(t *testing.T) {
+		system := []byte("-----BEGIN CERTIFICATE-----\nMIIA\n-----END CERTIFICATE-----")
+		bundleFile := filepath.Join(t.TempDir(), "ca-bundle.crt")
+		if err := os.WriteFile(bundleFile, system, 0644); err != nil {
+			t.Fatal(err)
+		}
+		saved := systemCABundles
+		systemCABundles = []string{filepath.Join(t.TempDir(), "missing.crt"), bundleFile}
+		defer func() { systemCABundles = saved }()
+
+		ca := []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n")
+		archive := caInitrd(ca)
+		if len(archive)%4 != 0 || !bytes.HasPrefix(archive, []byte("070701")) {
+			t.Fatalf("invalid newc archive")
+		}
+		for _, want := range [][]byte{
+			[]byte(caBundlePath + "\x00"),
+			[]byte(caAnchorPath + "\x00"),
+			append(append(system, '\n'), ca...),
+			[]byte("TRAILER!!!\x00"),
+		} {
+			if !bytes.Contains(archive, want) {
+				t.Errorf("archive does not contain %q", want)
+			}
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		for _, menu := range []*Menu{
+			{Entries: []Entry{{Label: "local"}}},
+			{Entries: []Entry{{Label: "node 1"}}},
+			{Entries: []Entry{{Label: "a"}, {Label: "a"}}},
+			{Hosts: map[string]string{"52:54:00:aa:bb:01": "missing"}},
+			{Default: "missing"},
+			{Entries: []Entry{{Label: "a", HostOnly: true}}, Default: "a"},
+		} {
+			if _, err := menu.TFTPFiles(); err == nil {
+				t.Errorf("expected error for %+v", menu)
+			}
+			if _, err := menu.IPXEFiles(); err == nil {
+				t.Errorf("expected error for %+v", menu)
+			}
+		}
+	})
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/pkg/ociregistry"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ManifestFile 为安装包清单的文件名，位于压缩包的第一项
+	ManifestFile  = "manifest.yaml"
+	formatVersion = 1
+
+	// 已加载的安装包位于 PersistDir 的 bundle 目录，provider 位于 TFInit 使用的 providers 目录
+	bundleDir    = "bundle"
+	providersDir = "providers"
+
+	KindImage    = "image"
+	KindPackage  = "package"
+	KindProvider = "provider"
+	KindManifest = "manifest"
+	KindOSImage  = "os-image"
+)
+
+// 安装包内各类内容所在的目录
+var kindDirs = map[string]string{
+	KindImage:    "images",
+	KindPackage:  "packages",
+	KindProvider: providersDir,
+	KindManifest: "manifests",
+	KindOSImage:  "os",
+}
+
+var imageRegexp = regexp.MustCompile(`(?m)^\s*(?:-\s+)?image:\s*["']?([^\s"']+)`)
+
+// Manifest 安装包清单，记录每个文件的来源与校验和
+type Manifest struct {
+	FormatVersion     int       `yaml:"formatVersion"`
+	CreatedAt         time.Time `yaml:"createdAt"`
+	Architecture      string    `yaml:"architecture"`
+	KubernetesVersion string    `yaml:"kubernetesVersion"`
+	Images            []string  `yaml:"images,omitempty"`
+	Files             []File    `yaml:"files"`
+}
+
+// File 安装包中的文件，Path 为压缩包内的相对路径
+type File struct {
+	Path   string `yaml:"path"`
+	Kind   string `yaml:"kind"`
+	Source string `yaml:"source,omitempty"`
+	Size   int64  `yaml:"size"`
+	SHA256 string `yaml:"sha256"`
+}
+
+// Create 按照 plan 收集镜像、软件包、provider、清单及操作系统镜像，打包为 output
+func Create(ctx context.Context, plan *Plan, output string) (*Manifest, error) {
+	stage, err := os.MkdirTemp("", "nkd-bundle-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(stage)
+
+	manifest := &Manifest{
+		FormatVersion:     formatVersion,
+		CreatedAt:         time.Now().UTC(),
+		Architecture:      plan.Architecture,
+		KubernetesVersion: plan.KubernetesVersion,
+	}
+	sources := map[string]string{}
+
+	images := append([]string{}, plan.Images...)
+	for _, source := range plan.Manifests {
+		rel, err := collectFile(ctx, source, stage, KindManifest)
+		if err != nil {
+			return nil, err
+		}
+		sources[rel] = source
+		content, err := os.ReadFile(filepath.Join(stage, rel))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range imageRegexp.FindAllStringSubmatch(string(content), -1) {
+			images = append(images, match[1])
+		}
+	}
+	for _, source := range plan.OSImages {
+		rel, err := collectFile(ctx, source, stage, KindOSImage)
+		if err != nil {
+			return nil, err
+		}
+		sources[rel] = source
+	}
+
+	puller := ociregistry.NewPuller()
+	seen := map[string]bool{}
+	for _, image := range images {
+		if seen[image] {
+			continue
+		}
+		seen[image] = true
+		logrus.Infof("Pulling %s", image)
+		if _, err := puller.Pull(ctx, image, filepath.Join(stage, kindDirs[KindImage]), plan.Architecture); err != nil {
+			return nil, err
+		}
+		manifest.Images = append(manifest.Images, image)
+	}
+
+	if plan.PackageDir != "" {
+		if err := collectPackages(plan.PackageDir, filepath.Join(stage, kindDirs[KindPackage])); err != nil {
+			return nil, err
+		}
+	}
+	for _, provider := range plan.Providers {
+		if err := collectProvider(ctx, plan.ProviderRegistry, provider, plan.ProviderTarget, filepath.Join(stage, kindDirs[KindProvider])); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := filepath.Walk(stage, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(stage, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		sum, err := fileChecksum(p)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, File{
+			Path:   rel,
+			Kind:   kindOf(rel),
+			Source: sources[rel],
+			Size:   info.Size(),
+			SHA256: sum,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(manifest.Files, func(i, j int) bool { return manifest.Files[i].Path < manifest.Files[j].Path })
+
+	if err := writeArchive(manifest, stage, output); err != nil {
+		os.Remove(output)
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Load 校验并解压安装包到 persistDir，替换之前加载的安装包
+func Load(archive string, persistDir string) (*Manifest, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s is not a bundle", archive)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	header, err := tr.Next()
+	if err != nil || header.Name != ManifestFile {
+		return nil, fmt.Errorf("%s is not a bundle: %s is missing", archive, ManifestFile)
+	}
+	content, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := yaml.Unmarshal(content, manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", ManifestFile)
+	}
+	if manifest.FormatVersion != formatVersion {
+		return nil, fmt.Errorf("unsupported bundle format version %d", manifest.FormatVersion)
+	}
+	files := map[string]File{}
+	for _, file := range manifest.Files {
+		// 清单中的路径必须位于安装包的各类目录内
+		if path.Clean(file.Path) != file.Path || path.IsAbs(file.Path) || strings.Contains(file.Path, "..") || kindOf(file.Path) == "" {
+			return nil, fmt.Errorf("invalid path %q in the bundle manifest", file.Path)
+		}
+		files[file.Path] = file
+	}
+
+	target := filepath.Join(persistDir, bundleDir)
+	if err := os.RemoveAll(target); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return nil, err
+	}
+
+	extracted := 0
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", archive)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		file, ok := files[header.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is not listed in the bundle manifest", header.Name)
+		}
+		if err := extractFile(tr, LocalPath(persistDir, file.Path), file); err != nil {
+			return nil, err
+		}
+		extracted++
+	}
+	if extracted != len(files) {
+		return nil, fmt.Errorf("the bundle is incomplete: %d of %d files are present", extracted, len(files))
+	}
+
+	if err := os.WriteFile(filepath.Join(target, ManifestFile), content, 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// LoadManifest 读取已加载的安装包清单，未加载安装包时返回 nil
+func LoadManifest(persistDir string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(persistDir, bundleDir, ManifestFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := yaml.Unmarshal(content, manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", ManifestFile)
+	}
+	return manifest, nil
+}
+
+// LocalPath 返回安装包内的文件加载后在 persistDir 中的路径
+func LocalPath(persistDir string, rel string) string {
+	if strings.HasPrefix(rel, providersDir+"/") {
+		return filepath.Join(persistDir, filepath.FromSlash(rel))
+	}
+	return filepath.Join(persistDir, bundleDir, filepath.FromSlash(rel))
+}
+
+// Lookup 返回来源为 source 的清单或操作系统镜像加载后的路径
+func (m *Manifest) Lookup(persistDir string, source string) (string, bool) {
+	if source == "" {
+		return "", false
+	}
+	for _, file := range m.Files {
+		if file.Source == source {
+			return LocalPath(persistDir, file.Path), true
+		}
+	}
+	return "", false
+}
+
+// Dir 返回某类内容加载后所在的目录，安装包中没有该类内容时返回空
+func (m *Manifest) Dir(persistDir string, kind string) string {
+	for _, file := range m.Files {
+		if file.Kind == kind {
+			return LocalPath(persistDir, kindDirs[kind])
+		}
+	}
+	return ""
+}
+
+func kindOf(rel string) string {
+	for kind, dir := range kindDirs {
+		if strings.HasPrefix(rel, dir+"/") {
+			return kind
+		}
+	}
+	return ""
+}
+
+// collectFile 将本地文件或URL的内容保存到安装包的 kind 目录，返回其相对路径
+func collectFile(ctx context.Context, source string, stage string, kind string) (string, error) {
+	name := path.Base(strings.SplitN(source, "?", 2)[0])
+	rel := kindDirs[kind] + "/" + name
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(stage, rel)); os.IsNotExist(err) {
+			break
+		}
+		rel = fmt.Sprintf("%s/%d-%s", kindDirs[kind], i, name)
+	}
+	dest := filepath.Join(stage, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		logrus.Infof("Downloading %s", source)
+		return rel, download(ctx, source, dest, "")
+	}
+	return rel, copyFile(source, dest)
+}
+
+// collectPackages 复制目录（含子目录）中的RPM软件包
+func collectPackages(dir string, dest string) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(info.Name(), ".rpm") {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		return copyFile(p, target)
+	})
+}
+
+// collectProvider 通过 provider 仓库协议下载 provider，保存为 OpenTofu plugin 目录的压缩包格式：
+// <仓库>/<命名空间>/<类型>/terraform-provider-<类型>_<版本>_<平台>.zip
+func collectProvider(ctx context.Context, registry string, provider Provider, target string, dest string) error {
+	parts := strings.Split(provider.Source, "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid provider source %q", provider.Source)
+	}
+	osArch := strings.SplitN(target, "_", 2)
+	if len(osArch) != 2 {
+		return fmt.Errorf("invalid provider target %q", target)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/providers/%s/%s/%s/download/%s/%s", registry, parts[0], parts[1], provider.Version, osArch[0], osArch[1])
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to find provider %s %s for %s: %s", provider.Source, provider.Version, target, resp.Status)
+	}
+	info := struct {
+		Filename    string `json:"filename"`
+		DownloadURL string `json:"download_url"`
+		Shasum      string `json:"shasum"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return errors.Wrapf(err, "failed to parse the download info of provider %s", provider.Source)
+	}
+
+	dir := filepath.Join(dest, registry, parts[0], parts[1])
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	logrus.Infof("Downloading provider %s %s", provider.Source, provider.Version)
+	return download(ctx, info.DownloadURL, filepath.Join(dir, filepath.Base(info.Filename)), info.Shasum)
+}
+
+// download 下载文件，sha256 非空时校验内容
+func download(ctx context.Context, url string, dest string, sha256sum string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), resp.Body); err != nil {
+		return errors.Wrapf(err, "failed to download %s", url)
+	}
+	if sha256sum != "" && hex.EncodeToString(hash.Sum(nil)) != sha256sum {
+		return fmt.Errorf("checksum of %s does not match", url)
+	}
+	return nil
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+func fileChecksum(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func writeArchive(manifest *Manifest, stage string, output string) error {
+	content, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	if err := tw.WriteHeader(&tar.Header{Name: ManifestFile, Mode: 0644, Size: int64(len(content)), ModTime: manifest.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return err
+	}
+	for _, file := range manifest.Files {
+		if err := addToArchive(tw, filepath.Join(stage, filepath.FromSlash(file.Path)), file, manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func addToArchive(tw *tar.Writer, p string, file File, modTime time.Time) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := tw.WriteHeader(&tar.Header{Name: file.Path, Mode: 0644, Size: file.Size, ModTime: modTime}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// extractFile 解压文件并校验其大小与校验和，校验失败时删除该文件
+func extractFile(r io.Reader, dest string, file File) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract %s", file.Path)
+	}
+	if n != file.Size || hex.EncodeToString(hash.Sum(nil)) != file.SHA256 {
+		os.Remove(dest)
+		return fmt.Errorf("checksum of %s does not match the bundle manifest", file.Path)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"context"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBundle(t *testing.T) {
+	if err := os.Chdir("../../data"); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	catalog, err := LoadCatalog("")
+	if err != nil {
+		t.Fatalf("LoadCatalog failed: %v", err)
+	}
+
+	t.Run("KubeadmImages Success", func(t *testing.T) {
+		images, err := catalog.KubeadmImages("registry.k8s.io", "v1.29.1")
+		if err != nil {
+			t.Fatalf("KubeadmImages failed: %v", err)
+		}
+		if len(images) != 7 || images[0] != "registry.k8s.io/kube-apiserver:v1.29.1" || images[5] != "registry.k8s.io/coredns/coredns:v1.11.1" {
+			t.Errorf("unexpected images: %v", images)
+		}
+		images, _ = catalog.KubeadmImages("hub.oepkgs.net/k8s", "v1.29.1")
+		if images[5] != "hub.oepkgs.net/k8s/coredns:v1.11.1" {
+			t.Errorf("unexpected coredns image: %s", images[5])
+		}
+	})
+
+	t.Run("KubeadmImages Fail", func(t *testing.T) {
+		if _, err := catalog.KubeadmImages("registry.k8s.io", "v1.2.0"); err == nil {
+			t.Error("expected failure for a version that is not in the catalog")
+		}
+	})
+
+	t.Run("NewPlan Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			Architecture:  "x86_64",
+			Platform:      "libvirt",
+			OSImage:       asset.OSImage{Type: "generalos"},
+			InfraPlatform: &infraasset.LibvirtAsset{OSPath: "/opt/openEuler.qcow2"},
+			Kubernetes: asset.Kubernetes{
+				KubernetesVersion: "v1.29.1",
+				ImageRegistry:     "registry.k8s.io",
+				PauseImage:        "pause:3.9",
+				RpmPackagePath:    "/opt/rpms",
+			},
+		}
+		plan, err := NewPlan(conf, catalog)
+		if err != nil {
+			t.Fatalf("NewPlan failed: %v", err)
+		}
+		if plan.Architecture != "amd64" || len(plan.Images) != 7 || plan.PackageDir != "/opt/rpms" ||
+			len(plan.Providers) != 1 || plan.Providers[0].Source != "dmacvicar/libvirt" || len(plan.OSImages) != 1 {
+			t.Errorf("unexpected plan: %+v", plan)
+		}
+	})
+
+	t.Run("NewPlan Network Plugin Manifest Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			Architecture: "aarch64",
+			Platform:     "pxe",
+			Kubernetes: asset.Kubernetes{
+				KubernetesVersion: "v1.29.1",
+				ImageRegistry:     "registry.k8s.io",
+				Network:           asset.Network{PodSubnet: "10.244.0.0/16", Plugin: "https://example.com/kube-flannel.yml"},
+			},
+		}
+		plan, err := NewPlan(conf, catalog)
+		if err != nil {
+			t.Fatalf("NewPlan failed: %v", err)
+		}
+		if len(plan.Manifests) != 1 || plan.Manifests[0] != "https://example.com/kube-flannel.yml" || len(plan.Images) != 7 {
+			t.Errorf("unexpected plan: %+v", plan)
+		}
+	})
+
+	t.Run("NewPlan Addons Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			Architecture: "x86_64",
+			Platform:     "pxe",
+			Kubernetes: asset.Kubernetes{
+				KubernetesVersion: "v1.29.1",
+				ImageRegistry:     "registry.k8s.io",
+				Network:           asset.Network{PodSubnet: "10.244.0.0/16"},
+			},
+			Addons: []asset.AddonAsset{{Name: "metrics-server", ImageRegistry: "hub.oepkgs.net"}},
+		}
+		plan, err := NewPlan(conf, catalog)
+		if err != nil {
+			t.Fatalf("NewPlan failed: %v", err)
+		}
+		if len(plan.Images) != 8 || plan.Images[7] != "hub.oepkgs.net/metrics-server/metrics-server:v0.7.1" {
+			t.Errorf("unexpected plan: %+v", plan)
+		}
+	})
+
+	src := t.TempDir()
+	pluginPath := filepath.Join(src, "calico.yaml")
+	osImagePath := filepath.Join(src, "openEuler.qcow2")
+	files := map[string]string{
+		pluginPath:                            "kind: DaemonSet\n",
+		osImagePath:                           "qcow2",
+		filepath.Join(src, "rpms", "a.rpm"):   "rpm a",
+		filepath.Join(src, "rpms", "x/b.rpm"): "rpm b",
+	}
+	for p, content := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+	archive := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	persistDir := t.TempDir()
+
+	t.Run("Create Success", func(t *testing.T) {
+		plan := &Plan{
+			Architecture:      "amd64",
+			KubernetesVersion: "v1.29.1",
+			Manifests:         []string{pluginPath},
+			PackageDir:        filepath.Join(src, "rpms"),
+			OSImages:          []string{osImagePath},
+		}
+		manifest, err := Create(context.Background(), plan, archive)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if len(manifest.Files) != 4 || manifest.Files[0].Path != "manifests/calico.yaml" || manifest.Files[0].Source != pluginPath {
+			t.Errorf("unexpected bundle files: %+v", manifest.Files)
+		}
+	})
+
+	t.Run("Load Success", func(t *testing.T) {
+		manifest, err := Load(archive, persistDir)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(persistDir, "bundle", "packages", "x", "b.rpm")); err != nil {
+			t.Errorf("package is not extracted: %v", err)
+		}
+		loaded, err := LoadManifest(persistDir)
+		if err != nil || loaded == nil || len(loaded.Files) != len(manifest.Files) {
+			t.Fatalf("LoadManifest failed: %v", err)
+		}
+		if p, ok := loaded.Lookup(persistDir, osImagePath); !ok || p != filepath.Join(persistDir, "bundle", "os", "openEuler.qcow2") {
+			t.Errorf("unexpected path of the OS image: %s", p)
+		}
+		if dir := loaded.Dir(persistDir, KindPackage); dir != filepath.Join(persistDir, "bundle", "packages") {
+			t.Errorf("unexpected package directory: %s", dir)
+		}
+		if dir := loaded.Dir(persistDir, KindImage); dir != "" {
+			t.Errorf("the bundle has no images, got %s", dir)
+		}
+	})
+
+	t.Run("Load Fail", func(t *testing.T) {
+		if _, err := Load(pluginPath, t.TempDir()); err == nil {
+			t.Error("expected failure for a file that is not a bundle")
+		}
+
+		stage := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(stage, "os"), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(stage, "os", "a.qcow2"), []byte("tampered"), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		tampered := filepath.Join(t.TempDir(), "tampered.tar.gz")
+		manifest := &Manifest{FormatVersion: formatVersion, Files: []File{{Path: "os/a.qcow2", Kind: KindOSImage, Size: 8, SHA256: "0"}}}
+		if err := writeArchive(manifest, stage, tampered); err != nil {
+			t.Fatalf("writeArchive failed: %v", err)
+		}
+		if _, err := Load(tampered, t.TempDir()); err == nil {
+			t.Error("expected failure for a file that does not match its checksum")
+		}
+
+		manifest.Files[0].Path = "../os/a.qcow2"
+		if err := writeArchive(manifest, filepath.Join(stage, "inner"), tampered); err != nil {
+			t.Fatalf("writeArchive failed: %v", err)
+		}
+		if _, err := Load(tampered, t.TempDir()); err == nil {
+			t.Error("expected failure for a path outside of the bundle")
+		}
+
+		if manifest, err := LoadManifest(t.TempDir()); err != nil || manifest != nil {
+			t.Errorf("expected no manifest without a loaded bundle, got %v %v", manifest, err)
+		}
+	})
+}
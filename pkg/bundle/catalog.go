@@ -0,0 +1,104 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/data"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultCatalog = "bundle/catalog.yaml"
+
+// Catalog 版本目录，记录各 Kubernetes 版本 kubeadm 所需的镜像版本以及各平台所需的 OpenTofu provider
+type Catalog struct {
+	Kubernetes       map[string]KubeadmImages `yaml:"kubernetes"`
+	ProviderRegistry string                   `yaml:"providerRegistry"`
+	Providers        map[string]Provider      `yaml:"providers"`
+}
+
+// KubeadmImages kube-apiserver 等组件的镜像与 Kubernetes 版本一致，其余镜像版本由目录指定
+type KubeadmImages struct {
+	Etcd    string `yaml:"etcd"`
+	CoreDNS string `yaml:"coredns"`
+	Pause   string `yaml:"pause"`
+}
+
+// Provider OpenTofu provider，Source 形如 dmacvicar/libvirt
+type Provider struct {
+	Source  string `yaml:"source"`
+	Version string `yaml:"version"`
+}
+
+// LoadCatalog 读取版本目录，path 为空时使用内置的版本目录
+func LoadCatalog(path string) (*Catalog, error) {
+	var content []byte
+	var err error
+	if path == "" {
+		var file io.ReadCloser
+		file, err = data.Assets.Open(defaultCatalog)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		content, err = io.ReadAll(file)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read version catalog")
+	}
+
+	catalog := &Catalog{}
+	if err := yaml.Unmarshal(content, catalog); err != nil {
+		return nil, errors.Wrap(err, "failed to parse version catalog")
+	}
+	return catalog, nil
+}
+
+// KubeadmImages 返回 kubeadm 部署 version 版本的集群时从 imageRegistry 拉取的镜像
+func (c *Catalog) KubeadmImages(imageRegistry string, version string) ([]string, error) {
+	minor := version
+	if parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3); len(parts) >= 2 {
+		minor = "v" + parts[0] + "." + parts[1]
+	}
+	images, ok := c.Kubernetes[minor]
+	if !ok {
+		return nil, fmt.Errorf("kubernetes %s is not in the version catalog", version)
+	}
+
+	// kubeadm 仅在使用默认仓库时从 coredns/coredns 拉取 CoreDNS
+	coredns := imageRegistry + "/coredns:" + images.CoreDNS
+	if imageRegistry == "registry.k8s.io" {
+		coredns = imageRegistry + "/coredns/coredns:" + images.CoreDNS
+	}
+
+	return []string{
+		imageRegistry + "/kube-apiserver:" + version,
+		imageRegistry + "/kube-controller-manager:" + version,
+		imageRegistry + "/kube-scheduler:" + version,
+		imageRegistry + "/kube-proxy:" + version,
+		imageRegistry + "/etcd:" + images.Etcd,
+		coredns,
+		imageRegistry + "/pause:" + images.Pause,
+	}, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/addon"
+	"nestos-kubernetes-deployer/pkg/cni"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"nestos-kubernetes-deployer/pkg/osmanager"
+	"runtime"
+	"strings"
+)
+
+// Plan 离线安装包需要包含的内容
+type Plan struct {
+	Architecture      string // 集群节点的架构，如 amd64、arm64
+	KubernetesVersion string
+	Images            []string
+	Manifests         []string // 非内置的网络插件清单的路径或URL，其中引用的镜像在打包时加入
+	PackageDir        string   // 通用OS节点需要安装的RPM软件包目录
+	ProviderRegistry  string
+	Providers         []Provider
+	ProviderTarget    string   // 执行部署的主机的平台，如 linux_amd64
+	OSImages          []string // 操作系统镜像的路径或URL
+}
+
+// NewPlan 根据集群配置和版本目录计算离线安装包的内容
+func NewPlan(conf *asset.ClusterAsset, catalog *Catalog) (*Plan, error) {
+	plan := &Plan{
+		KubernetesVersion: conf.Kubernetes.KubernetesVersion,
+		ProviderRegistry:  catalog.ProviderRegistry,
+		ProviderTarget:    runtime.GOOS + "_" + runtime.GOARCH,
+	}
+	switch conf.Architecture {
+	case "amd64", "x86_64":
+		plan.Architecture = "amd64"
+	case "arm64", "aarch64":
+		plan.Architecture = "arm64"
+	default:
+		return nil, fmt.Errorf("unsupported architecture: %s", conf.Architecture)
+	}
+
+	images, err := catalog.KubeadmImages(conf.Kubernetes.ImageRegistry, conf.Kubernetes.KubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+	plan.addImages(images...)
+	if conf.Kubernetes.PauseImage != "" {
+		plan.addImages(conf.Kubernetes.ImageRegistry + "/" + conf.Kubernetes.PauseImage)
+	}
+	if conf.Housekeeper.DeployHousekeeper {
+		plan.addImages(conf.Housekeeper.OperatorImageURL, conf.Housekeeper.ControllerImageURL)
+	}
+
+	osMgr := osmanager.NewOSManager(conf)
+	if osMgr.IsNestOS() {
+		plan.addImages(conf.Kubernetes.ReleaseImageURL)
+	}
+	if osMgr.IsGeneralOS() {
+		plan.PackageDir = conf.Kubernetes.RpmPackagePath
+	}
+
+	if cni.IsBuiltin(conf.Network.Plugin) {
+		images, err := cni.Images(conf)
+		if err != nil {
+			return nil, err
+		}
+		plan.addImages(images...)
+	} else if conf.Network.Plugin != "" {
+		plan.Manifests = append(plan.Manifests, conf.Network.Plugin)
+	}
+	addonImages, err := addon.Images(conf)
+	if err != nil {
+		return nil, err
+	}
+	plan.addImages(addonImages...)
+
+	platform := strings.ToLower(conf.Platform)
+	if provider, ok := catalog.Providers[platform]; ok {
+		plan.Providers = append(plan.Providers, provider)
+	}
+	if libvirt, ok := conf.InfraPlatform.(*infraasset.LibvirtAsset); ok && libvirt.OSPath != "" {
+		plan.OSImages = append(plan.OSImages, libvirt.OSPath)
+	}
+
+	return plan, nil
+}
+
+func (p *Plan) addImages(images ...string) {
+	for _, image := range images {
+		if image == "" {
+			continue
+		}
+		exists := false
+		for _, i := range p.Images {
+			exists = exists || i == image
+		}
+		if !exists {
+			p.Images = append(p.Images, image)
+		}
+	}
+}
@@ -17,9 +17,9 @@ limitations under the License.
 package cert
 
 import (
-	"crypto/x509"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/utils"
 	"net"
 
@@ -28,6 +28,18 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+/*
+persistCAKey 将CA私钥保存到持久化目录并将配置中的密钥路径改为保存的路径。
+密钥路径为外部引用（env:、file:、exec:）时保留引用，不保存解析得到的密钥，每次加载集群配置时重新解析
+*/
+func persistCAKey(keyPath *string, savePath string, key []byte) error {
+	if secret.IsReference(*keyPath) {
+		return nil
+	}
+	*keyPath = savePath
+	return SaveSecretFileToLocal(savePath, key)
+}
+
 type CertGenerator struct {
 	ClusterID  string
 	CaCertHash string
@@ -75,8 +87,15 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 
 	/* **********生成root CA 证书和密钥********** */
 
-	rootCACert, err := GenerateAllCA(clusterconfig.CertAsset.RootCACertPath,
-		clusterconfig.CertAsset.RootCAKeyPath, "kubernetes", []string{"kubernetes"})
+	externalCA := clusterconfig.CertAsset.ExternalCA
+	policy, err := NewCertPolicy(&clusterconfig.CertAsset)
+	if err != nil {
+		logrus.Errorf("Invalid certificate policy:%v", err)
+		return err
+	}
+
+	rootCACert, err := NewCAIssuer(externalCA, KubernetesCAName, clusterconfig.CertAsset.RootCACertPath,
+		clusterconfig.CertAsset.RootCAKeyPath, clusterconfig.CertAsset.RootCAKey, "kubernetes", []string{"kubernetes"}, policy)
 	if err != nil {
 		logrus.Errorf("Error generating root CA:%v", err)
 		return err
@@ -85,7 +104,6 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 	/*如果用户没有提供自定义路径，则将ca保存在以下目录；
 	  如果用户提供了自定义路径，也保存一份在以下路径，并反存到配置文件中*/
 	clusterconfig.CertAsset.RootCACertPath = globalconfig.PersistDir + "/" + clusterID + "/pki/ca.crt"
-	clusterconfig.CertAsset.RootCAKeyPath = globalconfig.PersistDir + "/" + clusterID + "/pki/ca.key"
 
 	//保存root CA证书和密钥到宿主机
 	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/ca.crt", rootCACert.CertRaw)
@@ -93,24 +111,29 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 		return err
 	}
 
-	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/ca.key", rootCACert.KeyRaw)
-	if err != nil {
-		return err
-	}
-
 	rootCACertContent := utils.StorageContent{
 		Path:    utils.CaCrt,
 		Mode:    int(utils.CertFileMode),
 		Content: rootCACert.CertRaw,
 	}
 
-	rootCAKeyContent := utils.StorageContent{
-		Path:    utils.CaKey,
-		Mode:    int(utils.CertFileMode),
-		Content: rootCACert.KeyRaw,
-	}
+	certs = append(certs, rootCACertContent)
+
+	// 外部CA模式下不保存也不分发CA私钥
+	if len(rootCACert.KeyRaw) > 0 {
+		err = persistCAKey(&clusterconfig.CertAsset.RootCAKeyPath, globalconfig.PersistDir+"/"+clusterID+"/pki/ca.key", rootCACert.KeyRaw)
+		if err != nil {
+			return err
+		}
 
-	certs = append(certs, rootCACertContent, rootCAKeyContent)
+		rootCAKeyContent := utils.StorageContent{
+			Path:    utils.CaKey,
+			Mode:    int(utils.CertFileMode),
+			Content: rootCACert.KeyRaw,
+		}
+
+		certs = append(certs, rootCAKeyContent)
+	}
 
 	cg.CaCertHash, err = GenerateCACertHashes(rootCACert.CertRaw)
 	if err != nil {
@@ -119,8 +142,8 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 
 	/* **********生成etcd CA 证书和密钥********** */
 
-	etcdCACert, err := GenerateAllCA(clusterconfig.CertAsset.EtcdCACertPath,
-		clusterconfig.CertAsset.EtcdCAKeyPath, "etcd-ca", []string{"etcd-ca"})
+	etcdCACert, err := NewCAIssuer(externalCA, EtcdCAName, clusterconfig.CertAsset.EtcdCACertPath,
+		clusterconfig.CertAsset.EtcdCAKeyPath, clusterconfig.CertAsset.EtcdCAKey, "etcd-ca", []string{"etcd-ca"}, policy)
 	if err != nil {
 		logrus.Errorf("Error generating etcd CA:%v", err)
 		return err
@@ -129,7 +152,6 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 	/*如果用户没有提供自定义路径，则将ca保存在以下目录；
 	  如果用户提供了自定义路径，也保存一份在以下路径，并反存到配置文件中*/
 	clusterconfig.CertAsset.EtcdCACertPath = globalconfig.PersistDir + "/" + clusterID + "/pki/etcd/ca.crt"
-	clusterconfig.CertAsset.EtcdCAKeyPath = globalconfig.PersistDir + "/" + clusterID + "/pki/etcd/ca.key"
 
 	//保存etcd-ca和密钥到宿主机
 	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/etcd/ca.crt", etcdCACert.CertRaw)
@@ -137,29 +159,34 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 		return err
 	}
 
-	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/etcd/ca.key", etcdCACert.KeyRaw)
-	if err != nil {
-		return err
-	}
-
 	etcdCACertContent := utils.StorageContent{
 		Path:    utils.EtcdCaCrt,
 		Mode:    int(utils.CertFileMode),
 		Content: etcdCACert.CertRaw,
 	}
 
-	etcdCAKeyContent := utils.StorageContent{
-		Path:    utils.EtcdCaKey,
-		Mode:    int(utils.CertFileMode),
-		Content: etcdCACert.KeyRaw,
-	}
+	certs = append(certs, etcdCACertContent)
+
+	// 外部CA模式下不保存也不分发CA私钥
+	if len(etcdCACert.KeyRaw) > 0 {
+		err = persistCAKey(&clusterconfig.CertAsset.EtcdCAKeyPath, globalconfig.PersistDir+"/"+clusterID+"/pki/etcd/ca.key", etcdCACert.KeyRaw)
+		if err != nil {
+			return err
+		}
 
-	certs = append(certs, etcdCACertContent, etcdCAKeyContent)
+		etcdCAKeyContent := utils.StorageContent{
+			Path:    utils.EtcdCaKey,
+			Mode:    int(utils.CertFileMode),
+			Content: etcdCACert.KeyRaw,
+		}
+
+		certs = append(certs, etcdCAKeyContent)
+	}
 
 	/* **********生成front-proxy CA 证书和密钥********** */
 
-	frontProxyCACert, err := GenerateAllCA(clusterconfig.CertAsset.FrontProxyCACertPath,
-		clusterconfig.CertAsset.FrontProxyCAKeyPath, "front-proxy-ca", []string{"front-proxy-ca"})
+	frontProxyCACert, err := NewCAIssuer(externalCA, FrontProxyCAName, clusterconfig.CertAsset.FrontProxyCACertPath,
+		clusterconfig.CertAsset.FrontProxyCAKeyPath, clusterconfig.CertAsset.FrontProxyCAKey, "front-proxy-ca", []string{"front-proxy-ca"}, policy)
 	if err != nil {
 		logrus.Errorf("Error generating front-proxy CA:%v", err)
 		return err
@@ -168,7 +195,6 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 	/*如果用户没有提供自定义路径，则将ca保存在以下目录；
 	  如果用户提供了自定义路径，也保存一份在以下路径，并反存到配置文件中*/
 	clusterconfig.CertAsset.FrontProxyCACertPath = globalconfig.PersistDir + "/" + clusterID + "/pki/front-proxy-ca.crt"
-	clusterconfig.CertAsset.FrontProxyCAKeyPath = globalconfig.PersistDir + "/" + clusterID + "/pki/front-proxy-ca.key"
 
 	//保存front-proxy-ca和密钥到宿主机
 	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/front-proxy-ca.crt", frontProxyCACert.CertRaw)
@@ -176,28 +202,33 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 		return err
 	}
 
-	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/front-proxy-ca.key", frontProxyCACert.KeyRaw)
-	if err != nil {
-		return err
-	}
-
 	frontProxyCACertContent := utils.StorageContent{
 		Path:    utils.FrontProxyCaCrt,
 		Mode:    int(utils.CertFileMode),
 		Content: frontProxyCACert.CertRaw,
 	}
 
-	frontProxyCAKeyContent := utils.StorageContent{
-		Path:    utils.FrontProxyCaKey,
-		Mode:    int(utils.CertFileMode),
-		Content: frontProxyCACert.KeyRaw,
-	}
+	certs = append(certs, frontProxyCACertContent)
 
-	certs = append(certs, frontProxyCACertContent, frontProxyCAKeyContent)
+	// 外部CA模式下不保存也不分发CA私钥
+	if len(frontProxyCACert.KeyRaw) > 0 {
+		err = persistCAKey(&clusterconfig.CertAsset.FrontProxyCAKeyPath, globalconfig.PersistDir+"/"+clusterID+"/pki/front-proxy-ca.key", frontProxyCACert.KeyRaw)
+		if err != nil {
+			return err
+		}
+
+		frontProxyCAKeyContent := utils.StorageContent{
+			Path:    utils.FrontProxyCaKey,
+			Mode:    int(utils.CertFileMode),
+			Content: frontProxyCACert.KeyRaw,
+		}
+
+		certs = append(certs, frontProxyCAKeyContent)
+	}
 
 	/* **********生成 sa.pub和sa.key********** */
 
-	sakeypair, err := GenerateKeyPair()
+	sakeypair, err := GenerateKeyPair(policy.KeyAlgorithm)
 	if err != nil {
 		logrus.Errorf("Error generating sa keypair:%v", err)
 		return err
@@ -209,7 +240,7 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 	clusterconfig.CertAsset.SAPub = globalconfig.PersistDir + "/pki/sa.pub"
 
 	//保存密钥对到宿主机
-	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/sa.key", sakeypair.PrivateKeyPEM)
+	err = SaveSecretFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/pki/sa.key", sakeypair.PrivateKeyPEM)
 	if err != nil {
 		return err
 	}
@@ -233,317 +264,38 @@ func (cg *CertGenerator) GenerateAllFiles() error {
 
 	certs = append(certs, saKeyContent, saPubContent)
 
-	/* **********生成 /etcd/server.crt********** */
-
-	commonName := hostname
-	dnsNames := []string{hostname, "localhost"}
-	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}
-	ipAddresses := []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1")}
-
-	servercrt, err := GenerateAllSignedCert(commonName,
-		nil, dnsNames, extKeyUsage, ipAddresses, etcdCACert.CertRaw, etcdCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating /etcd/server cert:%v", err)
-		return err
-	}
-
-	serverCertContent := utils.StorageContent{
-		Path:    utils.ServerCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: servercrt.CertRaw,
-	}
-
-	serverKeyContent := utils.StorageContent{
-		Path:    utils.ServerKey,
-		Mode:    int(utils.CertFileMode),
-		Content: servercrt.KeyRaw,
-	}
-
-	certs = append(certs, serverCertContent, serverKeyContent)
-
-	/* **********生成 /etcd/peer.crt********** */
-
-	commonName = hostname
-	dnsNames = []string{hostname, "localhost"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}
-	ipAddresses = []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
-
-	peercrt, err := GenerateAllSignedCert(commonName,
-		nil, dnsNames, extKeyUsage, ipAddresses, etcdCACert.CertRaw, etcdCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating /etcd/peer cert:%v", err)
-		return err
-	}
-
-	peerCertContent := utils.StorageContent{
-		Path:    utils.PeerCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: peercrt.CertRaw,
-	}
-
-	peerKeyContent := utils.StorageContent{
-		Path:    utils.PeerKey,
-		Mode:    int(utils.CertFileMode),
-		Content: peercrt.KeyRaw,
-	}
+	/* **********生成 etcd、kube-apiserver 等组件的证书和 kubeconfig********** */
 
-	certs = append(certs, peerCertContent, peerKeyContent)
-
-	/* **********生成 apiserver.crt********** */
-
-	commonName = "kube-apiserver"
-	dnsNames = []string{hostname, "kubernetes", "kubernetes.default",
-		"kubernetes.default.svc", "kubernetes.default.svc.cluster", "kubernetes.default.svc.cluster.local"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
-	ipAddresses = []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1"), net.ParseIP(internalAPIServerVirtualIP.String())}
-
-	apiservercrt, err := GenerateAllSignedCert(commonName,
-		nil, dnsNames, extKeyUsage, ipAddresses, rootCACert.CertRaw, rootCACert.KeyRaw)
+	issuers := &caIssuers{root: rootCACert, etcd: etcdCACert, frontProxy: frontProxyCACert}
+	nodeCerts, err := issuers.controlPlaneCerts(hostname, ipaddress, apiserverEndpoint, internalAPIServerVirtualIP)
 	if err != nil {
-		logrus.Errorf("Error generating apiserver cert:%v", err)
-		return err
-	}
-
-	apiserverCertContent := utils.StorageContent{
-		Path:    utils.ApiserverCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: apiservercrt.CertRaw,
-	}
-
-	apiserverKeyContent := utils.StorageContent{
-		Path:    utils.ApiserverKey,
-		Mode:    int(utils.CertFileMode),
-		Content: apiservercrt.KeyRaw,
-	}
-
-	certs = append(certs, apiserverCertContent, apiserverKeyContent)
-
-	/* **********生成 front-proxy-client.crt********** */
-
-	commonName = "front-proxy-client"
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	frontProxyClientcrt, err := GenerateAllSignedCert(commonName,
-		nil, nil, extKeyUsage, nil, frontProxyCACert.CertRaw, frontProxyCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating front-proxy-client cert:%v", err)
-		return err
-	}
-
-	frontProxyClientCertContent := utils.StorageContent{
-		Path:    utils.FrontProxyClientCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: frontProxyClientcrt.CertRaw,
-	}
-
-	frontProxyClientKeyContent := utils.StorageContent{
-		Path:    utils.FrontProxyClientKey,
-		Mode:    int(utils.CertFileMode),
-		Content: frontProxyClientcrt.KeyRaw,
-	}
-
-	certs = append(certs, frontProxyClientCertContent, frontProxyClientKeyContent)
-
-	/* **********生成 apiserver-kubelet-client.crt********** */
-
-	commonName = "kube-apiserver-kubelet-client"
-	organization := []string{"system:masters"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	apiserverKubeletClientcrt, err := GenerateAllSignedCert(commonName,
-		organization, nil, extKeyUsage, nil, rootCACert.CertRaw, rootCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating apiserver-kubelet-client cert:%v", err)
-		return err
-	}
-
-	apiserverKubeletClientCertContent := utils.StorageContent{
-		Path:    utils.ApiserverKubeletClientCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: apiserverKubeletClientcrt.CertRaw,
-	}
-
-	apiserverKubeletClientKeyContent := utils.StorageContent{
-		Path:    utils.ApiserverKubeletClientKey,
-		Mode:    int(utils.CertFileMode),
-		Content: apiserverKubeletClientcrt.KeyRaw,
-	}
-
-	certs = append(certs, apiserverKubeletClientCertContent, apiserverKubeletClientKeyContent)
-
-	/* **********生成 apiserver-etcd-client.crt********** */
-
-	commonName = "kube-apiserver-etcd-client"
-	organization = []string{"system:masters"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	apiserverEtcdClient, err := GenerateAllSignedCert(commonName,
-		organization, nil, extKeyUsage, nil, etcdCACert.CertRaw, etcdCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating kube-apiserver-etcd-client cert:%v", err)
-		return err
-	}
-
-	apiserverEtcdClientCertContent := utils.StorageContent{
-		Path:    utils.ApiserverEtcdClientCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: apiserverEtcdClient.CertRaw,
-	}
-
-	apiserverEtcdClientKeyContent := utils.StorageContent{
-		Path:    utils.ApiserverEtcdClientKey,
-		Mode:    int(utils.CertFileMode),
-		Content: apiserverEtcdClient.KeyRaw,
-	}
-
-	certs = append(certs, apiserverEtcdClientCertContent, apiserverEtcdClientKeyContent)
-
-	/* **********生成 healthcheck.crt********** */
-
-	commonName = "kube-etcd-healthcheck-client"
-	organization = []string{"system:masters"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	healthcheckcrt, err := GenerateAllSignedCert(commonName,
-		organization, nil, extKeyUsage, nil, etcdCACert.CertRaw, etcdCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generating healthcheck cert:%v", err)
-		return err
-	}
-
-	healthcheckCertContent := utils.StorageContent{
-		Path:    utils.HealthcheckClientCrt,
-		Mode:    int(utils.CertFileMode),
-		Content: healthcheckcrt.CertRaw,
-	}
-
-	healthcheckKeyContent := utils.StorageContent{
-		Path:    utils.HealthcheckClientKey,
-		Mode:    int(utils.CertFileMode),
-		Content: healthcheckcrt.KeyRaw,
-	}
-
-	certs = append(certs, healthcheckCertContent, healthcheckKeyContent)
-
-	/* **********生成 admin.config********** */
-
-	commonName = "kubernetes-admin"
-	organization = []string{"system:masters"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	admincrt, err := GenerateAllSignedCert(commonName,
-		organization, nil, extKeyUsage, nil, rootCACert.CertRaw, rootCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generate admin cert:%v", err)
-		return err
-	}
-
-	adminKubeconfig, err := generateKubeconfig(rootCACert.CertRaw, admincrt.CertRaw, admincrt.KeyRaw,
-		apiserverEndpoint, "kubernetes-admin", "kubernetes-admin@kubernetes")
-	if err != nil {
-		logrus.Errorf("Error generate admin.config:%v", err)
 		return err
 	}
 
 	clusterconfig.Kubernetes.AdminKubeConfig = globalconfig.PersistDir + "/" + clusterID + "/admin.config"
 
-	//将admin.config文件保存至宿主机
-	err = SaveFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/admin.config", adminKubeconfig)
+	//将admin.config文件保存至宿主机，其中包含集群管理员的私钥，按敏感文件保存
+	err = SaveSecretFileToLocal(globalconfig.PersistDir+"/"+clusterID+"/admin.config", storageContent(nodeCerts, utils.AdminConfig))
 	if err != nil {
 		return err
 	}
 
-	adminKubeconfigContent := utils.StorageContent{
-		Path:    utils.AdminConfig,
-		Mode:    int(utils.CertFileMode),
-		Content: adminKubeconfig,
-	}
-
-	certs = append(certs, adminKubeconfigContent)
-
-	/* **********生成 controller-manager.config********** */
-
-	commonName = "system:kube-controller-manager"
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
-
-	controllerManagercrt, err := GenerateAllSignedCert(commonName,
-		nil, nil, extKeyUsage, nil, rootCACert.CertRaw, rootCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generate controller-manager cert:%v", err)
-		return err
-	}
-
-	controllerManagerKubeconfig, err := generateKubeconfig(rootCACert.CertRaw, controllerManagercrt.CertRaw, controllerManagercrt.KeyRaw,
-		apiserverEndpoint, "system:kube-controller-manager", "system:kube-controller-manager@kubernetes")
-	if err != nil {
-		logrus.Errorf("Error generate controller-manager.config:%v", err)
-		return err
-	}
-
-	controllerManagerKubeconfigContent := utils.StorageContent{
-		Path:    utils.ControllerManager,
-		Mode:    int(utils.CertFileMode),
-		Content: controllerManagerKubeconfig,
-	}
-
-	certs = append(certs, controllerManagerKubeconfigContent)
-
-	/* **********生成 scheduler.config********** */
+	cg.Node.Certs = append(append([]utils.StorageContent{}, certs...), nodeCerts...)
 
-	commonName = "system:kube-scheduler"
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	/* **********外部CA模式下为其余节点签发证书，随节点专属引导配置分发********** */
 
-	schedulercrt, err := GenerateAllSignedCert(commonName,
-		nil, nil, extKeyUsage, nil, rootCACert.CertRaw, rootCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generate scheduler cert:%v", err)
-		return err
-	}
-
-	schedulerKubeconfig, err := generateKubeconfig(rootCACert.CertRaw, schedulercrt.CertRaw, schedulercrt.KeyRaw,
-		apiserverEndpoint, "system:kube-scheduler", "system:kube-scheduler@kubernetes")
-	if err != nil {
-		logrus.Errorf("Error generate scheduler.config:%v", err)
-		return err
+	if externalCA != nil {
+		if err := issuers.signNodeCerts(clusterconfig, cg.Node, certs, apiserverEndpoint, internalAPIServerVirtualIP); err != nil {
+			return err
+		}
 	}
 
-	schedulerKubeconfigContent := utils.StorageContent{
-		Path:    utils.SchedulerConf,
-		Mode:    int(utils.CertFileMode),
-		Content: schedulerKubeconfig,
-	}
-
-	certs = append(certs, schedulerKubeconfigContent)
-
-	/* **********生成 kubelet.config********** */
-
-	commonName = "system:node:" + hostname
-	organization = []string{"system:nodes"}
-	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	/* **********生成引导服务CA证书和密钥，仅保存在宿主机，不分发到节点********** */
 
-	kubeletcrt, err := GenerateAllSignedCert(commonName,
-		organization, nil, extKeyUsage, nil, rootCACert.CertRaw, rootCACert.KeyRaw)
-	if err != nil {
-		logrus.Errorf("Error generate kubelet cert:%v", err)
-		return err
-	}
-
-	kubeletKubeconfig, err := generateKubeconfig(rootCACert.CertRaw, kubeletcrt.CertRaw, kubeletcrt.KeyRaw,
-		apiserverEndpoint, "system:node:"+hostname, "system:node:"+hostname+"@kubernetes")
-	if err != nil {
-		logrus.Errorf("Error generate kubelet.config:%v", err)
+	if err := GenerateBootstrapCA(&clusterconfig.CertAsset, globalconfig.PersistDir+"/"+clusterID+"/pki"); err != nil {
+		logrus.Errorf("Error generating bootstrap CA:%v", err)
 		return err
 	}
 
-	kubeletKubeconfigContent := utils.StorageContent{
-		Path:    utils.KubeletConfig,
-		Mode:    int(utils.CertFileMode),
-		Content: kubeletKubeconfig,
-	}
-
-	certs = append(certs, kubeletKubeconfigContent)
-
-	cg.Node.Certs = certs
-
 	return nil
 }
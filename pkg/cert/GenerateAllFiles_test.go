@@ -16,10 +16,17 @@ limitations under the License.
 package cert
 
 import (
+	"bytes"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func TestOsmanager(t *testing.T) {
@@ -51,7 +58,8 @@ func TestOsmanager(t *testing.T) {
 	}
 
 	configmanager.GlobalConfig = &globalconfig.GlobalConfig{
-		PersistDir: "/",
+		PersistDir:            "/",
+		AllowPlaintextSecrets: true,
 		BootstrapUrl: globalconfig.BootstrapUrl{
 			BootstrapIgnHost: "127.0.0.1",
 			BootstrapIgnPort: "1234",
@@ -89,3 +97,211 @@ func TestOsmanager(t *testing.T) {
 		}
 	})
 }
+
+func TestCAKeyReference(t *testing.T) {
+	persistDir := t.TempDir()
+	configmanager.GlobalConfig = &globalconfig.GlobalConfig{PersistDir: persistDir, AllowPlaintextSecrets: true}
+	defer delete(configmanager.ClusterAsset, "refcluster")
+
+	policy, err := NewCertPolicy(&asset.CertAsset{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := GenerateAllCA("", "", nil, "kubernetes", []string{"kubernetes"}, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCertPath := filepath.Join(t.TempDir(), "ca.crt")
+	if err := os.WriteFile(caCertPath, ca.CertRaw, 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("NKD_TEST_ROOT_CA_KEY", string(ca.KeyRaw))
+
+	const ref = "env:NKD_TEST_ROOT_CA_KEY"
+	load := func(content []byte) *asset.ClusterAsset {
+		clusterAsset := &asset.ClusterAsset{}
+		if err := yaml.Unmarshal(content, clusterAsset); err != nil {
+			t.Fatal(err)
+		}
+		if err := clusterAsset.ResolveSecretRefs(); err != nil {
+			t.Fatalf("ResolveSecretRefs failed: %v", err)
+		}
+		return clusterAsset
+	}
+	clusterAsset := load([]byte(`
+clusterID: refcluster
+master:
+  - hostname: k8s-master01
+    ip: 127.0.0.1
+kubernetes:
+  apiserverEndpoint: 127.0.0.1:6443
+  network:
+    serviceSubnet: 10.96.0.0/16
+certAsset:
+  rootCACertPath: ` + caCertPath + `
+  rootCAKeyPath: ` + ref + `
+`))
+	configmanager.ClusterAsset["refcluster"] = clusterAsset
+
+	t.Run("GenerateAllFiles Reference Success", func(t *testing.T) {
+		if err := NewCertGenerator("refcluster", &clusterAsset.Master[0]).GenerateAllFiles(); err != nil {
+			t.Fatalf("GenerateAllFiles failed: %v", err)
+		}
+		if clusterAsset.CertAsset.RootCAKeyPath != ref {
+			t.Errorf("reference is overwritten: %s", clusterAsset.CertAsset.RootCAKeyPath)
+		}
+		if _, err := os.Stat(filepath.Join(persistDir, "refcluster", "pki", "ca.key")); !os.IsNotExist(err) {
+			t.Errorf("referenced CA key is copied to the persist dir: %v", err)
+		}
+		distributed := false
+		for _, c := range clusterAsset.Master[0].Certs {
+			if c.Path == utils.CaKey && bytes.Equal(c.Content, ca.KeyRaw) {
+				distributed = true
+			}
+		}
+		if !distributed {
+			t.Error("referenced CA key is not distributed to the control plane node")
+		}
+	})
+
+	t.Run("Persist Reference Success", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := clusterAsset.Persist(dir, nil); err != nil {
+			t.Fatalf("Persist failed: %v", err)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, "cluster_config.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(content, []byte("rootCAKeyPath: "+ref)) || bytes.Contains(content, []byte("PRIVATE KEY")) {
+			t.Errorf("reference is not persisted as is:\n%s", content)
+		}
+		if reloaded := load(content); !bytes.Equal(reloaded.CertAsset.RootCAKey, ca.KeyRaw) {
+			t.Error("reference is not resolved after reloading")
+		}
+	})
+}
+
+func TestExternalCANodeCerts(t *testing.T) {
+	persistDir := t.TempDir()
+	configmanager.GlobalConfig = &globalconfig.GlobalConfig{PersistDir: persistDir, AllowPlaintextSecrets: true}
+	defer delete(configmanager.ClusterAsset, "extcluster")
+
+	policy, err := NewCertPolicy(&asset.CertAsset{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	caDir := t.TempDir()
+	writeCA := func(name string) (string, string) {
+		ca, err := GenerateAllCA("", "", nil, name, []string{name}, policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		certPath, keyPath := filepath.Join(caDir, name+".crt"), filepath.Join(caDir, name+".key")
+		if err := os.WriteFile(certPath, ca.CertRaw, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(keyPath, ca.KeyRaw, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return certPath, keyPath
+	}
+	certAsset := asset.CertAsset{ExternalCA: &asset.ExternalCA{Signer: asset.ExternalCASignerFile}}
+	certAsset.RootCACertPath, certAsset.RootCAKeyPath = writeCA("kubernetes")
+	certAsset.EtcdCACertPath, certAsset.EtcdCAKeyPath = writeCA("etcd-ca")
+	certAsset.FrontProxyCACertPath, certAsset.FrontProxyCAKeyPath = writeCA("front-proxy-ca")
+
+	clusterAsset := &asset.ClusterAsset{
+		ClusterID: "extcluster",
+		Master: []asset.NodeAsset{
+			{Hostname: "k8s-master01", IP: "192.168.0.11"},
+			{Hostname: "k8s-master02", IP: "192.168.0.12"},
+		},
+		Worker: []asset.NodeAsset{
+			{Hostname: "k8s-worker01", IP: "192.168.0.21"},
+		},
+		Kubernetes: asset.Kubernetes{
+			ApiServerEndpoint: "192.168.0.11:6443",
+			Network:           asset.Network{ServiceSubnet: "10.96.0.0/16"},
+		},
+		CertAsset: certAsset,
+	}
+	configmanager.ClusterAsset["extcluster"] = clusterAsset
+
+	paths := func(certs []utils.StorageContent) map[string][]byte {
+		m := make(map[string][]byte)
+		for _, c := range certs {
+			m[c.Path] = c.Content
+		}
+		return m
+	}
+	kubeletUser := func(t *testing.T, kubeconfig []byte) string {
+		config, err := clientcmd.Load(kubeconfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, authInfo := range config.AuthInfos {
+			crt, err := PemToCertificate(authInfo.ClientCertificateData)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return crt.Subject.CommonName
+		}
+		return ""
+	}
+
+	t.Run("GenerateAllFiles ExternalCA Success", func(t *testing.T) {
+		if err := NewCertGenerator("extcluster", &clusterAsset.Master[0]).GenerateAllFiles(); err != nil {
+			t.Fatalf("GenerateAllFiles failed: %v", err)
+		}
+
+		master := paths(clusterAsset.Master[1].Certs)
+		for _, path := range []string{utils.CaCrt, utils.SaKey, utils.ApiserverCrt, utils.ServerCrt, utils.PeerCrt,
+			utils.FrontProxyClientCrt, utils.AdminConfig, utils.ControllerManager, utils.SchedulerConf, utils.KubeletConfig} {
+			if len(master[path]) == 0 {
+				t.Errorf("%s is not signed for the joining master", path)
+			}
+		}
+		if _, ok := master[utils.CaKey]; ok {
+			t.Error("CA key is distributed in external CA mode")
+		}
+		if !bytes.Equal(master[utils.SaKey], paths(clusterAsset.Master[0].Certs)[utils.SaKey]) {
+			t.Error("masters do not share the service account key")
+		}
+		apiserver, err := PemToCertificate(master[utils.ApiserverCrt])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := apiserver.VerifyHostname("k8s-master02"); err != nil {
+			t.Errorf("apiserver cert is not signed for the joining master: %v", err)
+		}
+		if user := kubeletUser(t, master[utils.KubeletConfig]); user != "system:node:k8s-master02" {
+			t.Errorf("unexpected kubelet user of the joining master: %s", user)
+		}
+
+		worker := paths(clusterAsset.Worker[0].Certs)
+		if len(worker) != 2 || len(worker[utils.CaCrt]) == 0 {
+			t.Errorf("unexpected certs of the worker: %d files", len(worker))
+		}
+		if user := kubeletUser(t, worker[utils.KubeletConfig]); user != "system:node:k8s-worker01" {
+			t.Errorf("unexpected kubelet user of the worker: %s", user)
+		}
+	})
+
+	t.Run("GenerateWorkerCerts Success", func(t *testing.T) {
+		node := &asset.NodeAsset{Hostname: "k8s-worker02"}
+		if err := GenerateWorkerCerts(clusterAsset, node); err != nil {
+			t.Fatalf("GenerateWorkerCerts failed: %v", err)
+		}
+		if user := kubeletUser(t, paths(node.Certs)[utils.KubeletConfig]); user != "system:node:k8s-worker02" {
+			t.Errorf("unexpected kubelet user of the extended worker: %s", user)
+		}
+
+		clusterAsset.CertAsset.ExternalCA = nil
+		defer func() { clusterAsset.CertAsset.ExternalCA = certAsset.ExternalCA }()
+		node = &asset.NodeAsset{Hostname: "k8s-worker03"}
+		if err := GenerateWorkerCerts(clusterAsset, node); err != nil || len(node.Certs) != 0 {
+			t.Errorf("GenerateWorkerCerts signed certs without external CA: %v", err)
+		}
+	})
+}
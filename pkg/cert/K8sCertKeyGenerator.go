@@ -17,27 +17,28 @@ limitations under the License.
 package cert
 
 import (
-	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"net"
 	"os"
-	"time"
-
-	"github.com/pkg/errors"
 )
 
-// SetUserCA 读取用户提供的各类ca证书和密钥路径中的内容
-func setUserCA(a *SelfSignedCertKey, certPath, keyPath string) error {
+// SetUserCA 读取用户提供的各类ca证书和密钥路径中的内容，密钥以外部引用提供时直接使用解析得到的内容
+func setUserCA(a *SelfSignedCertKey, certPath, keyPath string, key []byte) error {
 	cacert, err := os.ReadFile(certPath)
 	if err != nil {
 		return err
 	}
 
-	cakey, err := os.ReadFile(keyPath)
-	if err != nil {
-		return err
+	cakey := key
+	if len(cakey) == 0 {
+		// 持久化目录中的密钥可能已加密保存
+		cakey, err = secret.ReadFile(keyPath, configmanager.GetSecretSealer())
+		if err != nil {
+			return err
+		}
 	}
 
 	// 存储到结构体中
@@ -53,13 +54,14 @@ GenerateAllCA()用于生成
 /etc/kubernetes/pki/front-proxy-ca.crt
 /etc/kubernetes/pki/etcd/ca.crt   以及所有对应key
 */
-func GenerateAllCA(userCACertPath, userCAKeyPath, commonname string, dnsname []string) (*SelfSignedCertKey, error) {
+func GenerateAllCA(userCACertPath, userCAKeyPath string, userCAKey []byte, commonname string, dnsname []string,
+	policy *CertPolicy) (*SelfSignedCertKey, error) {
 
 	a := SelfSignedCertKey{}
 
 	// 如果用户提供了路径，则读取用户提供的证书和密钥
 	if userCACertPath != "" && userCAKeyPath != "" {
-		err := setUserCA(&a, userCACertPath, userCAKeyPath)
+		err := setUserCA(&a, userCACertPath, userCAKeyPath, userCAKey)
 		if err != nil {
 			return nil, err
 		}
@@ -68,9 +70,10 @@ func GenerateAllCA(userCACertPath, userCAKeyPath, commonname string, dnsname []s
 		cfg := &CertConfig{
 			Subject:   pkix.Name{CommonName: commonname},
 			KeyUsages: x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-			Validity:  time.Hour * 36500 * 24,
-			IsCA:      true,
-			DNSNames:  dnsname,
+			Validity:     policy.CAValidity,
+			IsCA:         true,
+			DNSNames:     dnsname,
+			KeyAlgorithm: policy.KeyAlgorithm,
 		}
 
 		err := a.Generate(cfg)
@@ -84,14 +87,14 @@ func GenerateAllCA(userCACertPath, userCAKeyPath, commonname string, dnsname []s
 
 /* GenerateKeyPair()用于生成/etc/kubernetes/pki/sa.pub和/etc/kubernetes/pki/sa.key ，
 通常创建自定义证书时说生成四组 CA-Key与CA-Cert其中一组就是指这个密钥对*/
-func GenerateKeyPair() (*KeyPairPEM, error) {
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+func GenerateKeyPair(algorithm string) (*KeyPairPEM, error) {
+	privateKey, err := GeneratePrivateKey(algorithm)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to generate RSA private key")
+		return nil, err
 	}
 
 	privateKeyPEM := PrivateKeyToPem(privateKey)
-	publicKeyPEM, err := PublicKeyToPem(&privateKey.PublicKey)
+	publicKeyPEM, err := PublicKeyToPem(privateKey.Public())
 	if err != nil {
 		return nil, err
 	}
@@ -104,22 +107,30 @@ func GenerateKeyPair() (*KeyPairPEM, error) {
 
 //GenerateAllSignedCert()用于生成所有签发的证书
 func GenerateAllSignedCert(commonname string, org, dnsname []string, extkeyusage []x509.ExtKeyUsage,
-	ip []net.IP, cacert, cakey []byte) (*SignedCertKey, error) {
-	a := SignedCertKey{}
-
+	ip []net.IP, issuer *CAIssuer) (*SignedCertKey, error) {
+	policy := issuer.policy()
 	cfg := &CertConfig{
 		Subject:      pkix.Name{CommonName: commonname, Organization: org},
 		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsages: extkeyusage,
-		Validity:     time.Hour * 36500 * 24,
+		Validity:     policy.LeafValidity,
 		IsCA:         false,
 		DNSNames:     dnsname,
 		IPAddresses:  ip,
+		KeyAlgorithm: policy.KeyAlgorithm,
 	}
-	err := a.Generate(cfg, cacert, cakey)
-	if err != nil {
-		return nil, err
-	}
+	return issuer.Issue(cfg)
+}
 
-	return &a, nil
+// GenerateKubeconfigSignedCert 用于生成 kubeconfig 中的客户端证书
+func GenerateKubeconfigSignedCert(commonname string, org []string, issuer *CAIssuer) (*SignedCertKey, error) {
+	policy := issuer.policy()
+	cfg := &CertConfig{
+		Subject:      pkix.Name{CommonName: commonname, Organization: org},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     policy.KubeconfigValidity,
+		KeyAlgorithm: policy.KeyAlgorithm,
+	}
+	return issuer.Issue(cfg)
 }
@@ -0,0 +1,121 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/secret"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	bootstrapCAValidity      = 10 * 365 * 24 * time.Hour
+	bootstrapServingValidity = 30 * 24 * time.Hour
+	bootstrapTokenLength     = 32
+)
+
+// GenerateBootstrapCA 生成引导服务（提供引导配置文件的HTTPS服务）的CA证书、私钥以及派生访问令牌的密钥，已存在时复用
+func GenerateBootstrapCA(certAsset *asset.CertAsset, pkiDir string) error {
+	if certAsset.BootstrapTokenSecret == "" {
+		token := make([]byte, bootstrapTokenLength)
+		if _, err := rand.Read(token); err != nil {
+			return errors.Wrap(err, "failed to generate bootstrap token secret")
+		}
+		certAsset.BootstrapTokenSecret = hex.EncodeToString(token)
+	}
+
+	if certAsset.BootstrapCACertPath != "" && certAsset.BootstrapCAKeyPath != "" {
+		if _, err := os.Stat(certAsset.BootstrapCACertPath); err == nil {
+			return nil
+		}
+	}
+
+	ca := SelfSignedCertKey{}
+	if err := ca.Generate(&CertConfig{
+		Subject:      pkix.Name{CommonName: "nkd-bootstrap-ca"},
+		KeyUsages:    x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		Validity:     bootstrapCAValidity,
+		IsCA:         true,
+		KeyAlgorithm: KeyAlgorithmECDSAP256,
+	}); err != nil {
+		return errors.Wrap(err, "failed to generate bootstrap CA")
+	}
+
+	certPath := filepath.Join(pkiDir, "bootstrap", "ca.crt")
+	keyPath := filepath.Join(pkiDir, "bootstrap", "ca.key")
+	if err := SaveFileToLocal(certPath, ca.CertRaw); err != nil {
+		return err
+	}
+	if err := SaveSecretFileToLocal(keyPath, ca.KeyRaw); err != nil {
+		return err
+	}
+	certAsset.BootstrapCACertPath = certPath
+	certAsset.BootstrapCAKeyPath = keyPath
+
+	return nil
+}
+
+// NewBootstrapServingCert 使用引导CA为引导服务签发服务端证书，hosts 为节点访问引导服务所用的IP或域名
+func NewBootstrapServingCert(certAsset *asset.CertAsset, hosts ...string) (*SignedCertKey, error) {
+	caCert, err := os.ReadFile(certAsset.BootstrapCACertPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read bootstrap CA certificate")
+	}
+	caKey, err := secret.ReadFile(certAsset.BootstrapCAKeyPath, configmanager.GetSecretSealer())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read bootstrap CA key")
+	}
+	signer, err := NewLocalSigner(caCert, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &CertConfig{
+		Subject:      pkix.Name{CommonName: "nkd-bootstrap"},
+		KeyUsages:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		Validity:     bootstrapServingValidity,
+		KeyAlgorithm: KeyAlgorithmECDSAP256,
+	}
+	for _, host := range hosts {
+		if host == "" {
+			continue
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			cfg.IPAddresses = append(cfg.IPAddresses, ip)
+		} else {
+			cfg.DNSNames = append(cfg.DNSNames, host)
+		}
+	}
+	if len(cfg.IPAddresses) == 0 && len(cfg.DNSNames) == 0 {
+		return nil, fmt.Errorf("no address is provided for the bootstrap serving certificate")
+	}
+
+	issuer := &CAIssuer{Name: "bootstrap-ca", CertRaw: caCert, Signer: signer}
+	return issuer.Issue(cfg)
+}
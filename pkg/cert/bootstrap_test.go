@@ -0,0 +1,89 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/x509"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"os"
+	"testing"
+)
+
+func TestBootstrapCA(t *testing.T) {
+	configmanager.GlobalConfig = &globalconfig.GlobalConfig{
+		PersistDir:            t.TempDir(),
+		AllowPlaintextSecrets: true,
+	}
+	pkiDir := t.TempDir()
+	certAsset := &asset.CertAsset{}
+
+	t.Run("GenerateBootstrapCA Success", func(t *testing.T) {
+		if err := GenerateBootstrapCA(certAsset, pkiDir); err != nil {
+			t.Fatalf("GenerateBootstrapCA failed: %v", err)
+		}
+		if certAsset.BootstrapTokenSecret == "" || certAsset.BootstrapCACertPath == "" || certAsset.BootstrapCAKeyPath == "" {
+			t.Fatalf("bootstrap CA is not recorded: %+v", certAsset)
+		}
+
+		// 再次生成时复用已有的CA和令牌密钥
+		caCert, _ := os.ReadFile(certAsset.BootstrapCACertPath)
+		secret := certAsset.BootstrapTokenSecret
+		if err := GenerateBootstrapCA(certAsset, pkiDir); err != nil {
+			t.Fatalf("GenerateBootstrapCA failed: %v", err)
+		}
+		reused, _ := os.ReadFile(certAsset.BootstrapCACertPath)
+		if string(reused) != string(caCert) || certAsset.BootstrapTokenSecret != secret {
+			t.Errorf("bootstrap CA should be reused")
+		}
+	})
+
+	t.Run("NewBootstrapServingCert Success", func(t *testing.T) {
+		servingCert, err := NewBootstrapServingCert(certAsset, "192.168.1.10", "nkd.example.com", "")
+		if err != nil {
+			t.Fatalf("NewBootstrapServingCert failed: %v", err)
+		}
+		crt, err := PemToCertificate(servingCert.CertRaw)
+		if err != nil {
+			t.Fatalf("failed to parse serving certificate: %v", err)
+		}
+		if len(crt.IPAddresses) != 1 || len(crt.DNSNames) != 1 {
+			t.Errorf("unexpected SANs: %v %v", crt.IPAddresses, crt.DNSNames)
+		}
+
+		caCert, _ := os.ReadFile(certAsset.BootstrapCACertPath)
+		roots := x509.NewCertPool()
+		roots.AppendCertsFromPEM(caCert)
+		if _, err := crt.Verify(x509.VerifyOptions{
+			DNSName:   "192.168.1.10",
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			t.Errorf("serving certificate is not valid for the bootstrap CA: %v", err)
+		}
+	})
+
+	t.Run("NewBootstrapServingCert Fail", func(t *testing.T) {
+		if _, err := NewBootstrapServingCert(certAsset); err == nil {
+			t.Errorf("expected error without hosts")
+		}
+		if _, err := NewBootstrapServingCert(&asset.CertAsset{}, "192.168.1.10"); err == nil {
+			t.Errorf("expected error without bootstrap CA")
+		}
+	})
+}
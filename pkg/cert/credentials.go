@@ -0,0 +1,73 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// 记录已签发用户凭证的文件，位于集群持久化目录下
+const issuedCredentialsFile = "issued_credentials.yaml"
+
+// IssuedCredential 记录签发的用户凭证，便于后续审计和吊销
+type IssuedCredential struct {
+	User         string    `yaml:"user"`
+	Groups       []string  `yaml:"groups,omitempty"`
+	SerialNumber string    `yaml:"serialNumber"`
+	Fingerprint  string    `yaml:"fingerprint"` // 证书的 SHA-256 指纹
+	Signer       string    `yaml:"signer"`
+	Binding      string    `yaml:"binding,omitempty"` // 创建的 RoleBinding/ClusterRoleBinding
+	NotBefore    time.Time `yaml:"notBefore"`
+	NotAfter     time.Time `yaml:"notAfter"`
+	IssuedAt     time.Time `yaml:"issuedAt"`
+}
+
+// LoadIssuedCredentials 读取集群已签发的用户凭证列表
+func LoadIssuedCredentials(clusterDir string) ([]IssuedCredential, error) {
+	data, err := os.ReadFile(filepath.Join(clusterDir, issuedCredentialsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var credentials []IssuedCredential
+	if err := yaml.Unmarshal(data, &credentials); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// RecordIssuedCredential 追加一条签发记录
+func RecordIssuedCredential(clusterDir string, credential IssuedCredential) error {
+	credentials, err := LoadIssuedCredentials(clusterDir)
+	if err != nil {
+		return err
+	}
+	credentials = append(credentials, credential)
+
+	data, err := yaml.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+	return SaveFileToLocal(filepath.Join(clusterDir, issuedCredentialsFile), data)
+}
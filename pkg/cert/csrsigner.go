@@ -0,0 +1,103 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	csrPollInterval = 2 * time.Second
+	csrTimeout      = 2 * time.Minute
+)
+
+// csrSigner 通过 CertificateSigningRequest API 由集群签发客户端证书
+type csrSigner struct {
+	client kubernetes.Interface
+	name   string
+}
+
+// NewCSRSigner 创建使用 kubernetes.io/kube-apiserver-client 签发者的 Signer，
+// CSR 由 client 对应的管理员身份自动批准
+func NewCSRSigner(client kubernetes.Interface, name string) Signer {
+	return &csrSigner{client: client, name: name}
+}
+
+func (s *csrSigner) Sign(csr *x509.CertificateRequest, cfg *CertConfig) ([]byte, error) {
+	expirationSeconds := int32(cfg.Validity.Seconds())
+	request := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: s.name},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw}),
+			SignerName:        certificatesv1.KubeAPIServerClientSignerName,
+			ExpirationSeconds: &expirationSeconds,
+			Usages: []certificatesv1.KeyUsage{
+				certificatesv1.UsageDigitalSignature,
+				certificatesv1.UsageClientAuth,
+			},
+		},
+	}
+
+	ctx := context.TODO()
+	csrClient := s.client.CertificatesV1().CertificateSigningRequests()
+	created, err := csrClient.Create(ctx, request, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CertificateSigningRequest")
+	}
+
+	created.Status.Conditions = append(created.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "NKDApprove",
+		Message:        "Approved by nkd kubeconfig create",
+		LastUpdateTime: metav1.Now(),
+	})
+	if _, err := csrClient.UpdateApproval(ctx, created.Name, created, metav1.UpdateOptions{}); err != nil {
+		return nil, errors.Wrap(err, "failed to approve CertificateSigningRequest")
+	}
+
+	var certificate []byte
+	err = wait.PollImmediate(csrPollInterval, csrTimeout, func() (bool, error) {
+		current, err := csrClient.Get(ctx, created.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, condition := range current.Status.Conditions {
+			if condition.Type == certificatesv1.CertificateDenied || condition.Type == certificatesv1.CertificateFailed {
+				return false, fmt.Errorf("CertificateSigningRequest %s: %s", condition.Type, condition.Message)
+			}
+		}
+		certificate = current.Status.Certificate
+		return len(certificate) > 0, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for CertificateSigningRequest %s", created.Name)
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EtcdHealthcheckClientName 访问 etcd 客户端端口检查集群状态时使用的客户端证书名称
+const EtcdHealthcheckClientName = "nkd-etcd-healthcheck-client"
+
+// NewEtcdCAIssuer 使用持久化目录中的 etcd CA 创建签发方，外部CA模式下使用配置的外部签发方
+func NewEtcdCAIssuer(clusterconfig *asset.ClusterAsset) (*CAIssuer, error) {
+	certAsset := &clusterconfig.CertAsset
+	if certAsset.EtcdCACertPath == "" {
+		return nil, fmt.Errorf("etcd CA certificate of cluster %s is not found", clusterconfig.ClusterID)
+	}
+	if certAsset.ExternalCA == nil && certAsset.EtcdCAKeyPath == "" && len(certAsset.EtcdCAKey) == 0 {
+		return nil, fmt.Errorf("etcd CA key of cluster %s is not found", clusterconfig.ClusterID)
+	}
+
+	policy, err := NewCertPolicy(certAsset)
+	if err != nil {
+		return nil, err
+	}
+	return NewCAIssuer(certAsset.ExternalCA, EtcdCAName, certAsset.EtcdCACertPath,
+		certAsset.EtcdCAKeyPath, certAsset.EtcdCAKey, "etcd-ca", []string{"etcd-ca"}, policy)
+}
+
+// EtcdClientTLSConfig 由 etcd CA 签发有效期为 validity 的客户端证书，返回访问 etcd 客户端端口的 TLS 配置
+func EtcdClientTLSConfig(issuer *CAIssuer, validity time.Duration) (*tls.Config, error) {
+	cfg := &CertConfig{
+		Subject:      pkix.Name{CommonName: EtcdHealthcheckClientName},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     validity,
+		KeyAlgorithm: issuer.policy().KeyAlgorithm,
+	}
+	clientCert, err := issuer.Issue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	keyPair, err := tls.X509KeyPair(clientCert.CertRaw, clientCert.KeyRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load etcd client certificate")
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(issuer.CertRaw) {
+		return nil, errors.Errorf("failed to parse certificate of %s", issuer.Name)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      roots,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/x509"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"net"
+
+	"github.com/sirupsen/logrus"
+)
+
+// caIssuers 集群的 kubernetes、etcd 和 front-proxy 三个CA
+type caIssuers struct {
+	root       *CAIssuer
+	etcd       *CAIssuer
+	frontProxy *CAIssuer
+}
+
+// controlPlaneCerts 为控制平面节点签发 etcd、kube-apiserver 等组件的证书，以及 admin、controller-manager、scheduler 和 kubelet 的 kubeconfig
+func (issuers *caIssuers) controlPlaneCerts(hostname, ipaddress, apiserverEndpoint string, internalAPIServerVirtualIP net.IP) ([]utils.StorageContent, error) {
+	var certs []utils.StorageContent
+
+	/* **********生成 /etcd/server.crt********** */
+
+	commonName := hostname
+	dnsNames := []string{hostname, "localhost"}
+	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}
+	ipAddresses := []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1")}
+
+	servercrt, err := GenerateAllSignedCert(commonName,
+		nil, dnsNames, extKeyUsage, ipAddresses, issuers.etcd)
+	if err != nil {
+		logrus.Errorf("Error generating /etcd/server cert:%v", err)
+		return nil, err
+	}
+
+	serverCertContent := utils.StorageContent{
+		Path:    utils.ServerCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: servercrt.CertRaw,
+	}
+
+	serverKeyContent := utils.StorageContent{
+		Path:    utils.ServerKey,
+		Mode:    int(utils.CertFileMode),
+		Content: servercrt.KeyRaw,
+	}
+
+	certs = append(certs, serverCertContent, serverKeyContent)
+
+	/* **********生成 /etcd/peer.crt********** */
+
+	commonName = hostname
+	dnsNames = []string{hostname, "localhost"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}
+	ipAddresses = []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+
+	peercrt, err := GenerateAllSignedCert(commonName,
+		nil, dnsNames, extKeyUsage, ipAddresses, issuers.etcd)
+	if err != nil {
+		logrus.Errorf("Error generating /etcd/peer cert:%v", err)
+		return nil, err
+	}
+
+	peerCertContent := utils.StorageContent{
+		Path:    utils.PeerCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: peercrt.CertRaw,
+	}
+
+	peerKeyContent := utils.StorageContent{
+		Path:    utils.PeerKey,
+		Mode:    int(utils.CertFileMode),
+		Content: peercrt.KeyRaw,
+	}
+
+	certs = append(certs, peerCertContent, peerKeyContent)
+
+	/* **********生成 apiserver.crt********** */
+
+	commonName = "kube-apiserver"
+	dnsNames = []string{hostname, "kubernetes", "kubernetes.default",
+		"kubernetes.default.svc", "kubernetes.default.svc.cluster", "kubernetes.default.svc.cluster.local"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	ipAddresses = []net.IP{net.ParseIP(ipaddress), net.ParseIP("127.0.0.1"), net.ParseIP(internalAPIServerVirtualIP.String())}
+
+	apiservercrt, err := GenerateAllSignedCert(commonName,
+		nil, dnsNames, extKeyUsage, ipAddresses, issuers.root)
+	if err != nil {
+		logrus.Errorf("Error generating apiserver cert:%v", err)
+		return nil, err
+	}
+
+	apiserverCertContent := utils.StorageContent{
+		Path:    utils.ApiserverCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: apiservercrt.CertRaw,
+	}
+
+	apiserverKeyContent := utils.StorageContent{
+		Path:    utils.ApiserverKey,
+		Mode:    int(utils.CertFileMode),
+		Content: apiservercrt.KeyRaw,
+	}
+
+	certs = append(certs, apiserverCertContent, apiserverKeyContent)
+
+	/* **********生成 front-proxy-client.crt********** */
+
+	commonName = "front-proxy-client"
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	frontProxyClientcrt, err := GenerateAllSignedCert(commonName,
+		nil, nil, extKeyUsage, nil, issuers.frontProxy)
+	if err != nil {
+		logrus.Errorf("Error generating front-proxy-client cert:%v", err)
+		return nil, err
+	}
+
+	frontProxyClientCertContent := utils.StorageContent{
+		Path:    utils.FrontProxyClientCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: frontProxyClientcrt.CertRaw,
+	}
+
+	frontProxyClientKeyContent := utils.StorageContent{
+		Path:    utils.FrontProxyClientKey,
+		Mode:    int(utils.CertFileMode),
+		Content: frontProxyClientcrt.KeyRaw,
+	}
+
+	certs = append(certs, frontProxyClientCertContent, frontProxyClientKeyContent)
+
+	/* **********生成 apiserver-kubelet-client.crt********** */
+
+	commonName = "kube-apiserver-kubelet-client"
+	organization := []string{"system:masters"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	apiserverKubeletClientcrt, err := GenerateAllSignedCert(commonName,
+		organization, nil, extKeyUsage, nil, issuers.root)
+	if err != nil {
+		logrus.Errorf("Error generating apiserver-kubelet-client cert:%v", err)
+		return nil, err
+	}
+
+	apiserverKubeletClientCertContent := utils.StorageContent{
+		Path:    utils.ApiserverKubeletClientCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: apiserverKubeletClientcrt.CertRaw,
+	}
+
+	apiserverKubeletClientKeyContent := utils.StorageContent{
+		Path:    utils.ApiserverKubeletClientKey,
+		Mode:    int(utils.CertFileMode),
+		Content: apiserverKubeletClientcrt.KeyRaw,
+	}
+
+	certs = append(certs, apiserverKubeletClientCertContent, apiserverKubeletClientKeyContent)
+
+	/* **********生成 apiserver-etcd-client.crt********** */
+
+	commonName = "kube-apiserver-etcd-client"
+	organization = []string{"system:masters"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	apiserverEtcdClient, err := GenerateAllSignedCert(commonName,
+		organization, nil, extKeyUsage, nil, issuers.etcd)
+	if err != nil {
+		logrus.Errorf("Error generating kube-apiserver-etcd-client cert:%v", err)
+		return nil, err
+	}
+
+	apiserverEtcdClientCertContent := utils.StorageContent{
+		Path:    utils.ApiserverEtcdClientCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: apiserverEtcdClient.CertRaw,
+	}
+
+	apiserverEtcdClientKeyContent := utils.StorageContent{
+		Path:    utils.ApiserverEtcdClientKey,
+		Mode:    int(utils.CertFileMode),
+		Content: apiserverEtcdClient.KeyRaw,
+	}
+
+	certs = append(certs, apiserverEtcdClientCertContent, apiserverEtcdClientKeyContent)
+
+	/* **********生成 healthcheck.crt********** */
+
+	commonName = "kube-etcd-healthcheck-client"
+	organization = []string{"system:masters"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	healthcheckcrt, err := GenerateAllSignedCert(commonName,
+		organization, nil, extKeyUsage, nil, issuers.etcd)
+	if err != nil {
+		logrus.Errorf("Error generating healthcheck cert:%v", err)
+		return nil, err
+	}
+
+	healthcheckCertContent := utils.StorageContent{
+		Path:    utils.HealthcheckClientCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: healthcheckcrt.CertRaw,
+	}
+
+	healthcheckKeyContent := utils.StorageContent{
+		Path:    utils.HealthcheckClientKey,
+		Mode:    int(utils.CertFileMode),
+		Content: healthcheckcrt.KeyRaw,
+	}
+
+	certs = append(certs, healthcheckCertContent, healthcheckKeyContent)
+
+	/* **********生成 admin.config********** */
+
+	commonName = "kubernetes-admin"
+	organization = []string{"system:masters"}
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	admincrt, err := GenerateKubeconfigSignedCert(commonName, organization, issuers.root)
+	if err != nil {
+		logrus.Errorf("Error generate admin cert:%v", err)
+		return nil, err
+	}
+
+	adminKubeconfig, err := generateKubeconfig(issuers.root.CertRaw, admincrt.CertRaw, admincrt.KeyRaw,
+		apiserverEndpoint, "kubernetes-admin", "kubernetes-admin@kubernetes")
+	if err != nil {
+		logrus.Errorf("Error generate admin.config:%v", err)
+		return nil, err
+	}
+
+	adminKubeconfigContent := utils.StorageContent{
+		Path:    utils.AdminConfig,
+		Mode:    int(utils.CertFileMode),
+		Content: adminKubeconfig,
+	}
+
+	certs = append(certs, adminKubeconfigContent)
+
+	/* **********生成 controller-manager.config********** */
+
+	commonName = "system:kube-controller-manager"
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	controllerManagercrt, err := GenerateKubeconfigSignedCert(commonName, nil, issuers.root)
+	if err != nil {
+		logrus.Errorf("Error generate controller-manager cert:%v", err)
+		return nil, err
+	}
+
+	controllerManagerKubeconfig, err := generateKubeconfig(issuers.root.CertRaw, controllerManagercrt.CertRaw, controllerManagercrt.KeyRaw,
+		apiserverEndpoint, "system:kube-controller-manager", "system:kube-controller-manager@kubernetes")
+	if err != nil {
+		logrus.Errorf("Error generate controller-manager.config:%v", err)
+		return nil, err
+	}
+
+	controllerManagerKubeconfigContent := utils.StorageContent{
+		Path:    utils.ControllerManager,
+		Mode:    int(utils.CertFileMode),
+		Content: controllerManagerKubeconfig,
+	}
+
+	certs = append(certs, controllerManagerKubeconfigContent)
+
+	/* **********生成 scheduler.config********** */
+
+	commonName = "system:kube-scheduler"
+	extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	schedulercrt, err := GenerateKubeconfigSignedCert(commonName, nil, issuers.root)
+	if err != nil {
+		logrus.Errorf("Error generate scheduler cert:%v", err)
+		return nil, err
+	}
+
+	schedulerKubeconfig, err := generateKubeconfig(issuers.root.CertRaw, schedulercrt.CertRaw, schedulercrt.KeyRaw,
+		apiserverEndpoint, "system:kube-scheduler", "system:kube-scheduler@kubernetes")
+	if err != nil {
+		logrus.Errorf("Error generate scheduler.config:%v", err)
+		return nil, err
+	}
+
+	schedulerKubeconfigContent := utils.StorageContent{
+		Path:    utils.SchedulerConf,
+		Mode:    int(utils.CertFileMode),
+		Content: schedulerKubeconfig,
+	}
+
+	certs = append(certs, schedulerKubeconfigContent)
+
+	kubeletKubeconfigContent, err := kubeletKubeconfig(issuers.root, hostname, apiserverEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	certs = append(certs, kubeletKubeconfigContent)
+
+	return certs, nil
+}
+
+// kubeletKubeconfig 为节点的 kubelet 签发客户端证书（system:node:<主机名>，system:nodes 组）并生成 kubelet.conf
+func kubeletKubeconfig(issuer *CAIssuer, hostname, apiserverEndpoint string) (utils.StorageContent, error) {
+	commonName := "system:node:" + hostname
+	organization := []string{"system:nodes"}
+
+	kubeletcrt, err := GenerateKubeconfigSignedCert(commonName, organization, issuer)
+	if err != nil {
+		logrus.Errorf("Error generate kubelet cert:%v", err)
+		return utils.StorageContent{}, err
+	}
+
+	kubeletKubeconfig, err := generateKubeconfig(issuer.CertRaw, kubeletcrt.CertRaw, kubeletcrt.KeyRaw,
+		apiserverEndpoint, "system:node:"+hostname, "system:node:"+hostname+"@kubernetes")
+	if err != nil {
+		logrus.Errorf("Error generate kubelet.config:%v", err)
+		return utils.StorageContent{}, err
+	}
+
+	return utils.StorageContent{
+		Path:    utils.KubeletConfig,
+		Mode:    int(utils.CertFileMode),
+		Content: kubeletKubeconfig,
+	}, nil
+}
+
+/*
+signNodeCerts 外部CA模式下集群中没有CA私钥：kube-controller-manager 无法完成 kubelet 的 TLS 引导，
+kubeadm 也无法为加入的控制平面节点生成证书。因此为除 first 以外的 master 节点签发控制平面各组件的证书和 kubeconfig，
+并附加各节点共用的CA证书和 sa 密钥对（shared）；为 worker 节点签发 kubelet 的 kubeconfig。
+签发结果写入 node.Certs，随节点专属引导配置分发
+*/
+func (issuers *caIssuers) signNodeCerts(clusterconfig *asset.ClusterAsset, first *asset.NodeAsset, shared []utils.StorageContent,
+	apiserverEndpoint string, internalAPIServerVirtualIP net.IP) error {
+	for i := range clusterconfig.Master {
+		node := &clusterconfig.Master[i]
+		if node == first {
+			continue
+		}
+		nodeCerts, err := issuers.controlPlaneCerts(node.Hostname, node.IP, apiserverEndpoint, internalAPIServerVirtualIP)
+		if err != nil {
+			logrus.Errorf("Error generating certs of node %s:%v", node.Hostname, err)
+			return err
+		}
+		node.Certs = append(append([]utils.StorageContent{}, shared...), nodeCerts...)
+	}
+
+	for i := range clusterconfig.Worker {
+		node := &clusterconfig.Worker[i]
+		nodeCerts, err := workerCerts(issuers.root, node.Hostname, apiserverEndpoint)
+		if err != nil {
+			logrus.Errorf("Error generating certs of node %s:%v", node.Hostname, err)
+			return err
+		}
+		node.Certs = nodeCerts
+	}
+	return nil
+}
+
+// GenerateWorkerCerts 外部CA模式下为扩容的 worker 节点签发 kubelet 的 kubeconfig，写入 node.Certs。非外部CA模式下不做处理
+func GenerateWorkerCerts(clusterconfig *asset.ClusterAsset, node *asset.NodeAsset) error {
+	if clusterconfig.CertAsset.ExternalCA == nil {
+		return nil
+	}
+	issuer, err := NewClusterCAIssuer(clusterconfig)
+	if err != nil {
+		return err
+	}
+	node.Certs, err = workerCerts(issuer, node.Hostname, "https://"+clusterconfig.Kubernetes.ApiServerEndpoint)
+	return err
+}
+
+// workerCerts 返回 worker 节点的集群CA证书和 kubelet.conf
+func workerCerts(issuer *CAIssuer, hostname, apiserverEndpoint string) ([]utils.StorageContent, error) {
+	kubeletKubeconfigContent, err := kubeletKubeconfig(issuer, hostname, apiserverEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCACertContent := utils.StorageContent{
+		Path:    utils.CaCrt,
+		Mode:    int(utils.CertFileMode),
+		Content: issuer.CertRaw,
+	}
+
+	return []utils.StorageContent{rootCACertContent, kubeletKubeconfigContent}, nil
+}
+
+// storageContent 返回 certs 中路径为 path 的文件内容
+func storageContent(certs []utils.StorageContent, path string) []byte {
+	for _, c := range certs {
+		if c.Path == path {
+			return c.Content
+		}
+	}
+	return nil
+}
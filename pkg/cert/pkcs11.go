@@ -0,0 +1,120 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"io"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pkcs11Tool   = "pkcs11-tool"
+	pkcs11PinEnv = "NKD_PKCS11_PIN"
+)
+
+// RSA PKCS#1 v1.5 签名所需的 DigestInfo 前缀
+var digestInfoPrefix = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// pkcs11Key 通过 pkcs11-tool 使用硬件令牌中的私钥签名，私钥不离开令牌
+type pkcs11Key struct {
+	config *asset.PKCS11Config
+	keyID  string
+	public crypto.PublicKey
+}
+
+func newPKCS11Key(config *asset.PKCS11Config, keyID string, public crypto.PublicKey) (*pkcs11Key, error) {
+	if config.Module == "" || keyID == "" {
+		return nil, errors.New("pkcs11 module and key id must be set")
+	}
+	if _, err := exec.LookPath(pkcs11Tool); err != nil {
+		return nil, errors.Wrapf(err, "%s is required for the pkcs11 signer", pkcs11Tool)
+	}
+	return &pkcs11Key{config: config, keyID: keyID, public: public}, nil
+}
+
+func pkcs11KeyID(config *asset.PKCS11Config, caName string) string {
+	switch caName {
+	case EtcdCAName:
+		return config.EtcdCAKeyID
+	case FrontProxyCAName:
+		return config.FrontProxyCAKeyID
+	default:
+		return config.RootCAKeyID
+	}
+}
+
+func (k *pkcs11Key) Public() crypto.PublicKey {
+	return k.public
+}
+
+func (k *pkcs11Key) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var input []byte
+	args := []string{"--module", k.config.Module, "--login", "--pin", "env:" + pkcs11PinEnv, "--sign", "--id", k.keyID}
+	if k.config.TokenLabel != "" {
+		args = append(args, "--token-label", k.config.TokenLabel)
+	}
+
+	switch k.public.(type) {
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, errors.New("pkcs11 signer does not support RSA-PSS")
+		}
+		prefix, ok := digestInfoPrefix[opts.HashFunc()]
+		if !ok {
+			return nil, errors.Errorf("unsupported hash function: %v", opts.HashFunc())
+		}
+		input = append(append([]byte{}, prefix...), digest...)
+		args = append(args, "--mechanism", "RSA-PKCS")
+	case *ecdsa.PublicKey:
+		input = digest
+		args = append(args, "--mechanism", "ECDSA", "--signature-format", "openssl")
+	default:
+		return nil, errors.Errorf("unsupported pkcs11 key type %T", k.public)
+	}
+
+	dir, err := os.MkdirTemp("", "nkd-pkcs11")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	inputFile := filepath.Join(dir, "digest")
+	outputFile := filepath.Join(dir, "signature")
+	if err := os.WriteFile(inputFile, input, 0600); err != nil {
+		return nil, err
+	}
+	args = append(args, "--input-file", inputFile, "--output-file", outputFile)
+
+	cmd := exec.Command(pkcs11Tool, args...)
+	cmd.Env = append(os.Environ(), pkcs11PinEnv+"="+k.config.Pin)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, errors.Wrapf(err, "pkcs11 sign failed: %s", out)
+	}
+	return os.ReadFile(outputFile)
+}
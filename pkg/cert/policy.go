@@ -0,0 +1,118 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// 证书默认有效期
+const defaultValidity = time.Hour * 36500 * 24
+
+// CertPolicy 集群PKI的密钥算法和证书有效期策略
+type CertPolicy struct {
+	KeyAlgorithm       string
+	CAValidity         time.Duration
+	LeafValidity       time.Duration
+	KubeconfigValidity time.Duration
+}
+
+func DefaultCertPolicy() *CertPolicy {
+	return &CertPolicy{
+		KeyAlgorithm:       KeyAlgorithmRSA2048,
+		CAValidity:         defaultValidity,
+		LeafValidity:       defaultValidity,
+		KubeconfigValidity: defaultValidity,
+	}
+}
+
+// NewCertPolicy 根据集群配置中的 certAsset 生成证书策略
+func NewCertPolicy(certAsset *asset.CertAsset) (*CertPolicy, error) {
+	policy := DefaultCertPolicy()
+
+	algorithm, err := NormalizeKeyAlgorithm(certAsset.KeyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	policy.KeyAlgorithm = algorithm
+
+	validities := []struct {
+		name  string
+		value string
+		field *time.Duration
+	}{
+		{"ca", certAsset.Validity.CA, &policy.CAValidity},
+		{"leaf", certAsset.Validity.Leaf, &policy.LeafValidity},
+		{"kubeconfig", certAsset.Validity.Kubeconfig, &policy.KubeconfigValidity},
+	}
+	for _, v := range validities {
+		if v.value == "" {
+			// 未配置时不超过CA证书有效期
+			if *v.field > policy.CAValidity {
+				*v.field = policy.CAValidity
+			}
+			continue
+		}
+		d, err := time.ParseDuration(v.value)
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid %s certificate validity: %s", v.name, v.value)
+		}
+		if d > policy.CAValidity {
+			return nil, errors.Errorf("%s certificate validity %s exceeds the CA validity", v.name, v.value)
+		}
+		*v.field = d
+	}
+
+	return policy, nil
+}
+
+/*
+kubeadm v1beta3 的 ClusterConfiguration 没有 encryptionAlgorithm，只能通过 PublicKeysECDSA 特性门控生成 ECDSA-P256 密钥，
+Kubernetes v1.31 起该特性门控被 v1beta4 的 encryptionAlgorithm 取代
+*/
+var publicKeysECDSARemoved = version.MustParseGeneric("v1.31.0")
+
+// KubeadmPublicKeysECDSA 判断是否为 kubeadm 开启 PublicKeysECDSA 特性门控，使 kubeadm 自行生成的证书同样使用 ECDSA-P256 密钥
+func KubeadmPublicKeysECDSA(conf *asset.ClusterAsset) bool {
+	algorithm, err := NormalizeKeyAlgorithm(conf.CertAsset.KeyAlgorithm)
+	if err != nil || algorithm != KeyAlgorithmECDSAP256 {
+		return false
+	}
+	v, err := version.ParseGeneric(conf.Kubernetes.KubernetesVersion)
+	return err == nil && v.LessThan(publicKeysECDSARemoved)
+}
+
+/*
+ValidatePolicy 校验集群配置中的证书策略。其他控制平面节点加入集群时由 kubeadm 生成其证书，
+kubeadm 只能使用 RSA-2048 或（通过 PublicKeysECDSA 特性门控）ECDSA-P256，因此多 master 节点的集群只支持这两种算法
+*/
+func ValidatePolicy(conf *asset.ClusterAsset) error {
+	policy, err := NewCertPolicy(&conf.CertAsset)
+	if err != nil {
+		return err
+	}
+	if len(conf.Master) <= 1 || policy.KeyAlgorithm == KeyAlgorithmRSA2048 || KubeadmPublicKeysECDSA(conf) {
+		return nil
+	}
+	return errors.Errorf("key algorithm %s can not be applied by kubeadm %s to joining control plane nodes, "+
+		"clusters with multiple masters support %s, and %s before Kubernetes %s",
+		policy.KeyAlgorithm, conf.Kubernetes.KubernetesVersion, KeyAlgorithmRSA2048, KeyAlgorithmECDSAP256, publicKeysECDSARemoved)
+}
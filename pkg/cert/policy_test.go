@@ -0,0 +1,147 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"testing"
+	"time"
+)
+
+func TestCertPolicy(t *testing.T) {
+	t.Run("NewCertPolicy Default Success", func(t *testing.T) {
+		policy, err := NewCertPolicy(&asset.CertAsset{})
+		if err != nil || policy.KeyAlgorithm != KeyAlgorithmRSA2048 || policy.LeafValidity != defaultValidity {
+			t.Errorf("unexpected default policy: %+v, %v", policy, err)
+		}
+	})
+
+	t.Run("NewCertPolicy Success", func(t *testing.T) {
+		policy, err := NewCertPolicy(&asset.CertAsset{
+			KeyAlgorithm: "ecdsa-p384",
+			Validity:     asset.CertValidity{CA: "87600h", Leaf: "8760h"},
+		})
+		if err != nil {
+			t.Fatalf("NewCertPolicy failed: %v", err)
+		}
+		if policy.KeyAlgorithm != KeyAlgorithmECDSAP384 || policy.LeafValidity != 8760*time.Hour ||
+			policy.KubeconfigValidity != 87600*time.Hour {
+			t.Errorf("unexpected policy: %+v", policy)
+		}
+	})
+
+	t.Run("NewCertPolicy Fail", func(t *testing.T) {
+		if _, err := NewCertPolicy(&asset.CertAsset{KeyAlgorithm: "dsa"}); err == nil {
+			t.Errorf("unsupported key algorithm should fail")
+		}
+		if _, err := NewCertPolicy(&asset.CertAsset{Validity: asset.CertValidity{CA: "24h", Leaf: "48h"}}); err == nil {
+			t.Errorf("leaf validity exceeding the CA validity should fail")
+		}
+	})
+
+	t.Run("NewCertPolicy Ed25519 Fail", func(t *testing.T) {
+		if _, err := NewCertPolicy(&asset.CertAsset{KeyAlgorithm: "ed25519"}); err == nil {
+			t.Errorf("ed25519 keys can not be loaded by kubeadm and should fail")
+		}
+	})
+
+	newClusterAsset := func(algorithm, kubeVersion string, masters int) *asset.ClusterAsset {
+		conf := &asset.ClusterAsset{
+			CertAsset:  asset.CertAsset{KeyAlgorithm: algorithm},
+			Kubernetes: asset.Kubernetes{KubernetesVersion: kubeVersion},
+		}
+		conf.Master = make([]asset.NodeAsset, masters)
+		return conf
+	}
+
+	t.Run("KubeadmPublicKeysECDSA Success", func(t *testing.T) {
+		if !KubeadmPublicKeysECDSA(newClusterAsset("ecdsa-p256", "v1.29.1", 1)) {
+			t.Errorf("ECDSA-P256 before v1.31 should enable the PublicKeysECDSA feature gate")
+		}
+		if KubeadmPublicKeysECDSA(newClusterAsset("ecdsa-p256", "v1.31.0", 1)) ||
+			KubeadmPublicKeysECDSA(newClusterAsset("rsa-2048", "v1.29.1", 1)) {
+			t.Errorf("PublicKeysECDSA feature gate should only be enabled for ECDSA-P256 before v1.31")
+		}
+	})
+
+	t.Run("ValidatePolicy Success", func(t *testing.T) {
+		for _, conf := range []*asset.ClusterAsset{
+			newClusterAsset("rsa-4096", "v1.29.1", 1),
+			newClusterAsset("rsa-2048", "v1.31.0", 3),
+			newClusterAsset("ecdsa-p256", "v1.29.1", 3),
+		} {
+			if err := ValidatePolicy(conf); err != nil {
+				t.Errorf("ValidatePolicy failed: %v", err)
+			}
+		}
+	})
+
+	t.Run("ValidatePolicy Fail", func(t *testing.T) {
+		for _, conf := range []*asset.ClusterAsset{
+			newClusterAsset("rsa-4096", "v1.29.1", 3),
+			newClusterAsset("ecdsa-p384", "v1.29.1", 2),
+			newClusterAsset("ecdsa-p256", "v1.31.0", 2),
+		} {
+			if err := ValidatePolicy(conf); err == nil {
+				t.Errorf("ValidatePolicy should fail for %s on %s with %d masters",
+					conf.CertAsset.KeyAlgorithm, conf.Kubernetes.KubernetesVersion, len(conf.Master))
+			}
+		}
+	})
+}
+
+func TestKeyAlgorithm(t *testing.T) {
+	for _, algorithm := range []string{KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384} {
+		t.Run("Issue "+algorithm+" Success", func(t *testing.T) {
+			policy := DefaultCertPolicy()
+			policy.KeyAlgorithm = algorithm
+
+			issuer, err := NewCAIssuer(nil, KubernetesCAName, "", "", nil, "kubernetes", nil, policy)
+			if err != nil {
+				t.Fatalf("NewCAIssuer failed: %v", err)
+			}
+			leaf, err := GenerateAllSignedCert("kube-apiserver", nil, []string{"kubernetes"},
+				[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, nil, issuer)
+			if err != nil {
+				t.Fatalf("GenerateAllSignedCert failed: %v", err)
+			}
+
+			key, err := PemToPrivateKey(leaf.KeyRaw)
+			if err != nil {
+				t.Fatalf("PemToPrivateKey failed: %v", err)
+			}
+			switch key.(type) {
+			case *ecdsa.PrivateKey:
+			default:
+				t.Errorf("unexpected key type %T", key)
+			}
+
+			crt, err := PemToCertificate(leaf.CertRaw)
+			if err != nil || crt.KeyUsage&x509.KeyUsageKeyEncipherment != 0 {
+				t.Errorf("non-RSA certificate should not have key encipherment usage: %v", err)
+			}
+		})
+	}
+
+	t.Run("GenerateKeyPair ECDSA Success", func(t *testing.T) {
+		if _, err := GenerateKeyPair(KeyAlgorithmECDSAP256); err != nil {
+			t.Errorf("GenerateKeyPair failed: %v", err)
+		}
+	})
+}
@@ -17,8 +17,8 @@ limitations under the License.
 package cert
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"math/big"
 	"time"
@@ -28,7 +28,7 @@ import (
 )
 
 // SelfSignedCertificate 只负责创建自签名的证书，这里只传入cfg和privatekey
-func SelfSignedCertificate(cfg *CertConfig, key *rsa.PrivateKey) (*x509.Certificate, error) {
+func SelfSignedCertificate(cfg *CertConfig, key crypto.Signer) (*x509.Certificate, error) {
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
@@ -38,7 +38,7 @@ func SelfSignedCertificate(cfg *CertConfig, key *rsa.PrivateKey) (*x509.Certific
 	certTemplate := x509.Certificate{
 		BasicConstraintsValid: true,
 		IsCA:                  cfg.IsCA,
-		KeyUsage:              cfg.KeyUsages,
+		KeyUsage:              keyUsagesFor(key.Public(), cfg.KeyUsages),
 		NotAfter:              time.Now().Add(cfg.Validity),
 		NotBefore:             time.Now(),
 		SerialNumber:          serialNumber,
@@ -62,8 +62,8 @@ func SelfSignedCertificate(cfg *CertConfig, key *rsa.PrivateKey) (*x509.Certific
 
 /*GenerateSelfSignedCertificate负责根据cfg生成私钥和证书
   在这一步会调用PrivateKey生成私钥并调用SelfSignedCertificate生成证书，并将结果返回*/
-func GenerateSelfSignedCertificate(cfg *CertConfig) (*rsa.PrivateKey, *x509.Certificate, error) {
-	key, err := PrivateKey()
+func GenerateSelfSignedCertificate(cfg *CertConfig) (crypto.Signer, *x509.Certificate, error) {
+	key, err := GeneratePrivateKey(cfg.KeyAlgorithm)
 	if err != nil {
 		logrus.Debugf("Failed to generate private key: %s", err)
 		return nil, nil, errors.Wrap(err, "Failed to generate private key")
@@ -17,8 +17,8 @@ limitations under the License.
 package cert
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"math/big"
 	"time"
@@ -30,9 +30,20 @@ import (
 func SignedCertificate(
 	cfg *CertConfig,
 	csr *x509.CertificateRequest,
-	key *rsa.PrivateKey,
+	key crypto.Signer,
 	caCert *x509.Certificate,
-	caKey *rsa.PrivateKey,
+	caKey crypto.Signer,
+) (*x509.Certificate, error) {
+	return signCertificate(cfg, csr, key.Public(), caCert, caKey)
+}
+
+// signCertificate 使用任意实现了 crypto.Signer 的CA私钥签发证书
+func signCertificate(
+	cfg *CertConfig,
+	csr *x509.CertificateRequest,
+	pub crypto.PublicKey,
+	caCert *x509.Certificate,
+	caKey crypto.Signer,
 ) (*x509.Certificate, error) {
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
@@ -46,22 +57,22 @@ func SignedCertificate(
 		DNSNames:              csr.DNSNames,
 		ExtKeyUsage:           cfg.ExtKeyUsages,
 		IPAddresses:           csr.IPAddresses,
-		KeyUsage:              cfg.KeyUsages,
+		KeyUsage:              keyUsagesFor(pub, cfg.KeyUsages),
 		NotAfter:              time.Now().Add(cfg.Validity),
 		NotBefore:             caCert.NotBefore,
 		SerialNumber:          serialNumber,
 		Subject:               csr.Subject,
 	}
-	certBytes, err := x509.CreateCertificate(rand.Reader, &certTemplate, caCert, key.Public(), caKey)
+	certBytes, err := x509.CreateCertificate(rand.Reader, &certTemplate, caCert, pub, caKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create x509 certificate")
 	}
 	return x509.ParseCertificate(certBytes)
 }
 
-func GenerateSignedCertificate(caKey *rsa.PrivateKey, caCert *x509.Certificate,
-	cfg *CertConfig) (*rsa.PrivateKey, *x509.Certificate, error) {
-	key, err := PrivateKey()
+func GenerateSignedCertificate(caKey crypto.Signer, caCert *x509.Certificate,
+	cfg *CertConfig) (crypto.Signer, *x509.Certificate, error) {
+	key, err := GeneratePrivateKey(cfg.KeyAlgorithm)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to generate private key")
 	}
@@ -95,14 +106,14 @@ func (c *SignedCertKey) Generate(
 	cfg *CertConfig,
 	cacert, cakey []byte,
 ) error {
-	var key *rsa.PrivateKey
+	var key crypto.Signer
 	var crt *x509.Certificate
 	var err error
 
 	cakeypem, err := PemToPrivateKey(cakey)
 	if err != nil {
-		logrus.Debugf("Failed to parse RSA private key: %s", err)
-		return errors.Wrap(err, "failed to parse rsa private key")
+		logrus.Debugf("Failed to parse private key: %s", err)
+		return errors.Wrap(err, "failed to parse private key")
 	}
 
 	cacertpem, err := PemToCertificate(cacert)
@@ -0,0 +1,264 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 传递给外部签发方的CA名称
+const (
+	KubernetesCAName = "kubernetes-ca"
+	EtcdCAName       = "etcd-ca"
+	FrontProxyCAName = "front-proxy-ca"
+)
+
+// Signer 负责签发叶子证书
+type Signer interface {
+	// Sign 根据cfg签发csr，返回PEM格式的证书链，叶子证书在前
+	Sign(csr *x509.CertificateRequest, cfg *CertConfig) ([]byte, error)
+}
+
+// CAIssuer 描述一个CA及其签发方式
+type CAIssuer struct {
+	Name    string // CA名称
+	CertRaw []byte // CA证书，可以是包含中间CA和根CA的证书包
+	KeyRaw  []byte // CA私钥，外部CA模式下为空，不保存也不分发到节点
+	Signer  Signer
+	Policy  *CertPolicy // 签发证书使用的密钥算法和有效期，为空时使用默认策略
+}
+
+func (issuer *CAIssuer) policy() *CertPolicy {
+	if issuer.Policy == nil {
+		return DefaultCertPolicy()
+	}
+	return issuer.Policy
+}
+
+// localSigner 使用CA证书和私钥在本地签发证书
+type localSigner struct {
+	caCert *x509.Certificate
+	caKey  crypto.Signer
+	chain  []byte // 附加在叶子证书之后的中间CA证书
+}
+
+// NewLocalSigner 使用PEM格式的CA证书（包）和私钥创建本地签发方，证书包中的第一个证书为签发CA
+func NewLocalSigner(caCertRaw, caKeyRaw []byte) (Signer, error) {
+	certs, err := PemToCertificates(caCertRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse x509 certificate")
+	}
+	key, err := PemToPrivateKey(caKeyRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+	return newLocalSigner(certs, key), nil
+}
+
+func newLocalSigner(certs []*x509.Certificate, key crypto.Signer) *localSigner {
+	return &localSigner{
+		caCert: certs[0],
+		caKey:  key,
+		chain:  intermediateChain(certs),
+	}
+}
+
+func (s *localSigner) Sign(csr *x509.CertificateRequest, cfg *CertConfig) ([]byte, error) {
+	crt, err := signCertificate(cfg, csr, csr.PublicKey, s.caCert, s.caKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(CertToPem(crt), s.chain...), nil
+}
+
+// commandSigner 调用本地命令签发证书：标准输入为PEM格式的CSR，标准输出为PEM格式的证书链
+type commandSigner struct {
+	command string
+	caName  string
+}
+
+func (s *commandSigner) Sign(csr *x509.CertificateRequest, cfg *CertConfig) ([]byte, error) {
+	cmd := exec.Command("sh", "-c", s.command)
+	cmd.Stdin = bytes.NewReader(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw}))
+	cmd.Env = append(os.Environ(),
+		"NKD_CA_NAME="+s.caName,
+		"NKD_CERT_COMMON_NAME="+csr.Subject.CommonName,
+		"NKD_CERT_USAGES="+strings.Join(extKeyUsageNames(cfg.ExtKeyUsages), ","),
+		fmt.Sprintf("NKD_CERT_VALIDITY_HOURS=%d", int64(cfg.Validity.Hours())),
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "signer command failed: %s", strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func extKeyUsageNames(usages []x509.ExtKeyUsage) []string {
+	var names []string
+	for _, usage := range usages {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			names = append(names, "server")
+		case x509.ExtKeyUsageClientAuth:
+			names = append(names, "client")
+		}
+	}
+	return names
+}
+
+// intermediateChain 返回证书包中除自签名根证书外的证书
+func intermediateChain(certs []*x509.Certificate) []byte {
+	var chain []byte
+	for _, crt := range certs {
+		if bytes.Equal(crt.RawIssuer, crt.RawSubject) && crt.CheckSignatureFrom(crt) == nil {
+			continue
+		}
+		chain = append(chain, CertToPem(crt)...)
+	}
+	return chain
+}
+
+// Issue 生成私钥和CSR，交由签发方签发，并校验返回的证书链
+func (issuer *CAIssuer) Issue(cfg *CertConfig) (*SignedCertKey, error) {
+	key, err := GeneratePrivateKey(cfg.KeyAlgorithm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate private key")
+	}
+
+	csrTmpl := x509.CertificateRequest{Subject: cfg.Subject, DNSNames: cfg.DNSNames, IPAddresses: cfg.IPAddresses}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTmpl, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate request")
+	}
+	csr, err := x509.ParseCertificateRequest(csrBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing x509 certificate request")
+	}
+
+	chain, err := issuer.Signer.Sign(csr, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign certificate %s by %s", cfg.Subject.CommonName, issuer.Name)
+	}
+	if err := issuer.verifyChain(chain, key.Public()); err != nil {
+		return nil, errors.Wrapf(err, "invalid certificate %s signed by %s", cfg.Subject.CommonName, issuer.Name)
+	}
+
+	return &SignedCertKey{CertKey{CertRaw: chain, KeyRaw: PrivateKeyToPem(key)}}, nil
+}
+
+// verifyChain 校验签发的叶子证书与私钥匹配，且可通过证书链验证到CA
+func (issuer *CAIssuer) verifyChain(chain []byte, pub crypto.PublicKey) error {
+	certs, err := PemToCertificates(chain)
+	if err != nil {
+		return err
+	}
+	leaf := certs[0]
+	if key, ok := leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !key.Equal(pub) {
+		return errors.New("certificate does not match the private key")
+	}
+
+	caCerts, err := PemToCertificates(issuer.CertRaw)
+	if err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	for _, crt := range caCerts {
+		roots.AddCert(crt)
+	}
+	intermediates := x509.NewCertPool()
+	for _, crt := range certs[1:] {
+		intermediates.AddCert(crt)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+// NewCAIssuer 生成或读取CA。外部CA模式下只读取CA证书，叶子证书由配置的外部签发方签发
+func NewCAIssuer(externalCA *asset.ExternalCA, name, certPath, keyPath string, key []byte,
+	commonname string, dnsname []string, policy *CertPolicy) (*CAIssuer, error) {
+	if externalCA == nil {
+		ca, err := GenerateAllCA(certPath, keyPath, key, commonname, dnsname, policy)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := NewLocalSigner(ca.CertRaw, ca.KeyRaw)
+		if err != nil {
+			return nil, err
+		}
+		return &CAIssuer{Name: name, CertRaw: ca.CertRaw, KeyRaw: ca.KeyRaw, Signer: signer, Policy: policy}, nil
+	}
+
+	if certPath == "" {
+		return nil, fmt.Errorf("external CA mode requires the certificate of %s", name)
+	}
+	certRaw, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := PemToCertificates(certRaw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse certificate of %s", name)
+	}
+
+	issuer := &CAIssuer{Name: name, CertRaw: certRaw, Policy: policy}
+	switch externalCA.Signer {
+	case asset.ExternalCASignerFile:
+		a := SelfSignedCertKey{}
+		if err := setUserCA(&a, certPath, keyPath, key); err != nil {
+			return nil, errors.Wrapf(err, "failed to read the key of %s", name)
+		}
+		issuer.Signer, err = NewLocalSigner(a.CertRaw, a.KeyRaw)
+		if err != nil {
+			return nil, err
+		}
+	case asset.ExternalCASignerCommand:
+		if externalCA.Command == "" {
+			return nil, errors.New("external CA signer command is not set")
+		}
+		issuer.Signer = &commandSigner{command: externalCA.Command, caName: name}
+	case asset.ExternalCASignerPKCS11:
+		if externalCA.PKCS11 == nil {
+			return nil, errors.New("external CA pkcs11 config is not set")
+		}
+		caKey, err := newPKCS11Key(externalCA.PKCS11, pkcs11KeyID(externalCA.PKCS11, name), certs[0].PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to use pkcs11 key of %s", name)
+		}
+		issuer.Signer = newLocalSigner(certs, caKey)
+	default:
+		return nil, fmt.Errorf("unsupported external CA signer: %s", externalCA.Signer)
+	}
+
+	return issuer, nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestIntermediateCA 生成根CA和由其签发的中间CA，返回中间CA证书包（中间CA在前）和私钥
+func newTestIntermediateCA(t *testing.T) ([]byte, []byte) {
+	root := SelfSignedCertKey{}
+	if err := root.Generate(&CertConfig{
+		Subject:   pkix.Name{CommonName: "test-root"},
+		KeyUsages: x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		Validity:  time.Hour,
+		IsCA:      true,
+	}); err != nil {
+		t.Fatalf("failed to generate root CA: %v", err)
+	}
+
+	rootSigner, err := NewLocalSigner(root.CertRaw, root.KeyRaw)
+	if err != nil {
+		t.Fatalf("NewLocalSigner failed: %v", err)
+	}
+	issuer := &CAIssuer{Name: "test-root", CertRaw: root.CertRaw, Signer: rootSigner}
+	intermediate, err := issuer.Issue(&CertConfig{
+		Subject:   pkix.Name{CommonName: "test-intermediate"},
+		KeyUsages: x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		Validity:  time.Hour,
+		IsCA:      true,
+	})
+	if err != nil {
+		t.Fatalf("failed to issue intermediate CA: %v", err)
+	}
+
+	return append(intermediate.CertRaw, root.CertRaw...), intermediate.KeyRaw
+}
+
+func TestSigner(t *testing.T) {
+	bundle, key := newTestIntermediateCA(t)
+	leafConfig := &CertConfig{
+		Subject:      pkix.Name{CommonName: "kube-apiserver"},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		Validity:     time.Hour,
+		DNSNames:     []string{"kubernetes"},
+	}
+
+	t.Run("Issue Intermediate Chain Success", func(t *testing.T) {
+		signer, err := NewLocalSigner(bundle, key)
+		if err != nil {
+			t.Fatalf("NewLocalSigner failed: %v", err)
+		}
+		issuer := &CAIssuer{Name: KubernetesCAName, CertRaw: bundle, Signer: signer}
+		leaf, err := issuer.Issue(leafConfig)
+		if err != nil {
+			t.Fatalf("Issue failed: %v", err)
+		}
+		chain, err := PemToCertificates(leaf.CertRaw)
+		if err != nil || len(chain) != 2 {
+			t.Errorf("expected leaf certificate followed by the intermediate CA, got %d certificates", len(chain))
+		}
+	})
+
+	t.Run("NewCAIssuer External File Success", func(t *testing.T) {
+		dir := t.TempDir()
+		certPath := filepath.Join(dir, "ca.crt")
+		keyPath := filepath.Join(dir, "ca.key")
+		if err := os.WriteFile(certPath, bundle, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(keyPath, key, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		externalCA := &asset.ExternalCA{Signer: asset.ExternalCASignerFile}
+		issuer, err := NewCAIssuer(externalCA, KubernetesCAName, certPath, keyPath, nil, "kubernetes", nil, nil)
+		if err != nil {
+			t.Fatalf("NewCAIssuer failed: %v", err)
+		}
+		if len(issuer.KeyRaw) != 0 {
+			t.Errorf("external CA issuer should not expose the CA key")
+		}
+		if _, err := issuer.Issue(leafConfig); err != nil {
+			t.Errorf("Issue failed: %v", err)
+		}
+	})
+
+	t.Run("NewCAIssuer External Command Fail", func(t *testing.T) {
+		certPath := filepath.Join(t.TempDir(), "ca.crt")
+		if err := os.WriteFile(certPath, bundle, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		externalCA := &asset.ExternalCA{Signer: asset.ExternalCASignerCommand, Command: "cat"}
+		issuer, err := NewCAIssuer(externalCA, KubernetesCAName, certPath, "", nil, "kubernetes", nil, nil)
+		if err != nil {
+			t.Fatalf("NewCAIssuer failed: %v", err)
+		}
+		// 命令原样返回CSR，不是有效的证书链
+		if _, err := issuer.Issue(leafConfig); err == nil {
+			t.Errorf("Issue should fail when the signer returns no certificate")
+		}
+	})
+
+	t.Run("NewCAIssuer External Without Cert Fail", func(t *testing.T) {
+		externalCA := &asset.ExternalCA{Signer: asset.ExternalCASignerCommand, Command: "cat"}
+		if _, err := NewCAIssuer(externalCA, KubernetesCAName, "", "", nil, "kubernetes", nil, nil); err == nil {
+			t.Errorf("NewCAIssuer should fail without the CA certificate")
+		}
+	})
+
+	t.Run("EtcdClientTLSConfig Success", func(t *testing.T) {
+		dir := t.TempDir()
+		certPath := filepath.Join(dir, "ca.crt")
+		if err := os.WriteFile(certPath, bundle, 0600); err != nil {
+			t.Fatal(err)
+		}
+		conf := &asset.ClusterAsset{CertAsset: asset.CertAsset{
+			EtcdCACertPath: certPath,
+			EtcdCAKey:      key,
+			ExternalCA:     &asset.ExternalCA{Signer: asset.ExternalCASignerFile},
+		}}
+		issuer, err := NewEtcdCAIssuer(conf)
+		if err != nil {
+			t.Fatalf("NewEtcdCAIssuer failed: %v", err)
+		}
+		tlsConfig, err := EtcdClientTLSConfig(issuer, time.Hour)
+		if err != nil {
+			t.Fatalf("EtcdClientTLSConfig failed: %v", err)
+		}
+		leaf, err := x509.ParseCertificate(tlsConfig.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if leaf.Subject.CommonName != EtcdHealthcheckClientName || len(leaf.ExtKeyUsage) != 1 || leaf.ExtKeyUsage[0] != x509.ExtKeyUsageClientAuth {
+			t.Errorf("unexpected etcd client certificate: %v %v", leaf.Subject, leaf.ExtKeyUsage)
+		}
+	})
+
+	t.Run("NewEtcdCAIssuer Fail", func(t *testing.T) {
+		if _, err := NewEtcdCAIssuer(&asset.ClusterAsset{ClusterID: "cluster"}); err == nil {
+			t.Errorf("NewEtcdCAIssuer should fail without the etcd CA")
+		}
+	})
+}
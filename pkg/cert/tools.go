@@ -17,6 +17,9 @@ limitations under the License.
 package cert
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -24,9 +27,12 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -42,42 +48,130 @@ func PrivateKey() (*rsa.PrivateKey, error) {
 	return rsaKey, nil
 }
 
-// PrivateKeyToPem 返回私钥的PEM格式字节切片
-func PrivateKeyToPem(key *rsa.PrivateKey) []byte {
-	keyInBytes := x509.MarshalPKCS1PrivateKey(key)
-	keyinPem := pem.EncodeToMemory(
-		&pem.Block{
-			Type:  "RSA PRIVATE KEY",
-			Bytes: keyInBytes,
-		},
-	)
-	return keyinPem
+// 证书密钥算法，取值与 kubeadm 的 encryptionAlgorithm 保持一致。kubeadm 只能加载 RSA 和 ECDSA 密钥，因此不支持 Ed25519
+const (
+	KeyAlgorithmRSA2048   = "RSA-2048"
+	KeyAlgorithmRSA3072   = "RSA-3072"
+	KeyAlgorithmRSA4096   = "RSA-4096"
+	KeyAlgorithmECDSAP256 = "ECDSA-P256"
+	KeyAlgorithmECDSAP384 = "ECDSA-P384"
+)
+
+var keyAlgorithms = []string{KeyAlgorithmRSA2048, KeyAlgorithmRSA3072, KeyAlgorithmRSA4096,
+	KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384}
+
+// NormalizeKeyAlgorithm 校验密钥算法并返回规范名称，不区分大小写，为空时返回 RSA-2048
+func NormalizeKeyAlgorithm(algorithm string) (string, error) {
+	if algorithm == "" {
+		return KeyAlgorithmRSA2048, nil
+	}
+	for _, a := range keyAlgorithms {
+		if strings.EqualFold(a, algorithm) {
+			return a, nil
+		}
+	}
+	return "", errors.Errorf("unsupported key algorithm %s, supported: %s", algorithm, strings.Join(keyAlgorithms, ", "))
 }
 
-func PemToPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+// GeneratePrivateKey 按指定算法生成私钥，算法为空时生成 RSA-2048 私钥
+func GeneratePrivateKey(algorithm string) (crypto.Signer, error) {
+	algorithm, err := NormalizeKeyAlgorithm(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	var key crypto.Signer
+	switch algorithm {
+	case KeyAlgorithmRSA3072:
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+	case KeyAlgorithmRSA4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case KeyAlgorithmECDSAP256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return PrivateKey()
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to generate %s private key", algorithm)
+	}
+	return key, nil
+}
+
+// PrivateKeyToPem 返回私钥的PEM格式字节切片，RSA私钥使用PKCS#1，ECDSA私钥使用SEC 1，其他私钥使用PKCS#8格式
+func PrivateKeyToPem(key crypto.Signer) []byte {
+	var block *pem.Block
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case *ecdsa.PrivateKey:
+		keyInBytes, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyInBytes}
+	default:
+		keyInBytes, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: keyInBytes}
+	}
+	return pem.EncodeToMemory(block)
+}
+
+func PemToPrivateKey(data []byte) (crypto.Signer, error) {
 	block, _ := pem.Decode(data)
 	if block == nil {
 		return nil, errors.Errorf("could not find a PEM block in the private key")
 	}
-	return x509.ParsePKCS1PrivateKey(block.Bytes)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
 }
 
 // PublicKeyToPem 返回公钥的PEM格式字节切片
-func PublicKeyToPem(key *rsa.PublicKey) ([]byte, error) {
+func PublicKeyToPem(key crypto.PublicKey) ([]byte, error) {
 	keyInBytes, err := x509.MarshalPKIXPublicKey(key)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to marshal public key")
 	}
 
+	blockType := "PUBLIC KEY"
+	if _, ok := key.(*rsa.PublicKey); ok {
+		blockType = "RSA PUBLIC KEY"
+	}
 	keyinPem := pem.EncodeToMemory(
 		&pem.Block{
-			Type:  "RSA PUBLIC KEY",
+			Type:  blockType,
 			Bytes: keyInBytes,
 		},
 	)
 	return keyinPem, nil
 }
 
+// keyUsagesFor 非RSA密钥不支持密钥加密用途
+func keyUsagesFor(pub crypto.PublicKey, usages x509.KeyUsage) x509.KeyUsage {
+	if _, ok := pub.(*rsa.PublicKey); !ok {
+		usages &^= x509.KeyUsageKeyEncipherment
+	}
+	return usages
+}
+
 // CACertPEM 返回证书的PEM格式字节切片
 func CertToPem(cert *x509.Certificate) []byte {
 	certInPem := pem.EncodeToMemory(
@@ -97,6 +191,30 @@ func PemToCertificate(data []byte) (*x509.Certificate, error) {
 	return x509.ParseCertificate(block.Bytes)
 }
 
+// PemToCertificates 解析PEM格式的证书链或证书包中的所有证书
+func PemToCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		crt, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, crt)
+	}
+	if len(certs) == 0 {
+		return nil, errors.Errorf("could not find a PEM block in the certificate")
+	}
+	return certs, nil
+}
+
 // SaveFileToLocal 将文件保存到本地
 func SaveFileToLocal(savepath string, file []byte) error {
 	err := os.MkdirAll(filepath.Dir(savepath), 0755)
@@ -116,6 +234,28 @@ func SaveFileToLocal(savepath string, file []byte) error {
 	return nil
 }
 
+// SaveSecretFileToLocal 将私钥等敏感文件保存到本地，提供了密钥材料时加密保存
+func SaveSecretFileToLocal(savepath string, file []byte) error {
+	if err := configmanager.CheckSecretPolicy(); err != nil {
+		logrus.Errorf("Failed to save %s: %v", savepath, err)
+		return err
+	}
+
+	err := os.MkdirAll(filepath.Dir(savepath), 0755)
+	if err != nil {
+		logrus.Errorf("Failed to create directory: %v", err)
+		return err
+	}
+
+	err = secret.WriteFile(savepath, file, configmanager.GetSecretSealer())
+	if err != nil {
+		logrus.Errorf("Faile to save %s: %v", savepath, err)
+		return err
+	}
+
+	return nil
+}
+
 // GenerateCACertHashes: specifies a set of public key pins to verify when token-based discovery is used.
 func GenerateCACertHashes(certData []byte) (string, error) {
 	block, _ := pem.Decode(certData)
@@ -43,4 +43,5 @@ type CertConfig struct {
 	Subject      pkix.Name
 	Validity     time.Duration
 	IsCA         bool
+	KeyAlgorithm string // 密钥算法，为空时使用 RSA-2048
 }
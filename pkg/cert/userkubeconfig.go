@@ -0,0 +1,111 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 用户 kubeconfig 的签发方式
+const (
+	UserSignerLocal = "local"
+	UserSignerCSR   = "csr"
+)
+
+// UserKubeconfigRequest 描述需要签发的用户 kubeconfig
+type UserKubeconfigRequest struct {
+	User   string
+	Groups []string
+	TTL    time.Duration
+}
+
+// NewClusterCAIssuer 使用持久化目录中的集群根CA创建签发方，外部CA模式下使用配置的外部签发方
+func NewClusterCAIssuer(clusterconfig *asset.ClusterAsset) (*CAIssuer, error) {
+	certAsset := &clusterconfig.CertAsset
+	if certAsset.RootCACertPath == "" {
+		return nil, fmt.Errorf("root CA certificate of cluster %s is not found", clusterconfig.ClusterID)
+	}
+	if certAsset.ExternalCA == nil && certAsset.RootCAKeyPath == "" && len(certAsset.RootCAKey) == 0 {
+		return nil, fmt.Errorf("root CA key of cluster %s is not found", clusterconfig.ClusterID)
+	}
+
+	policy, err := NewCertPolicy(certAsset)
+	if err != nil {
+		return nil, err
+	}
+	return NewCAIssuer(certAsset.ExternalCA, KubernetesCAName, certAsset.RootCACertPath,
+		certAsset.RootCAKeyPath, certAsset.RootCAKey, "kubernetes", []string{"kubernetes"}, policy)
+}
+
+// GenerateUserKubeconfig 为用户签发客户端证书并生成 kubeconfig，返回 kubeconfig 内容和签发记录
+func GenerateUserKubeconfig(issuer *CAIssuer, req *UserKubeconfigRequest, apiserverEndpoint, clusterName string) ([]byte, *IssuedCredential, error) {
+	if req.User == "" {
+		return nil, nil, errors.New("user name is required")
+	}
+	if req.TTL <= 0 {
+		return nil, nil, errors.New("ttl must be positive")
+	}
+
+	cfg := &CertConfig{
+		Subject:      pkix.Name{CommonName: req.User, Organization: req.Groups},
+		KeyUsages:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     req.TTL,
+		KeyAlgorithm: issuer.policy().KeyAlgorithm,
+	}
+	clientCert, err := issuer.Issue(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubeconfig, err := generateKubeconfig(issuer.CertRaw, clientCert.CertRaw, clientCert.KeyRaw,
+		apiserverEndpoint, req.User, req.User+"@"+clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	crt, err := PemToCertificate(clientCert.CertRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	fingerprint := sha256.Sum256(crt.Raw)
+	credential := &IssuedCredential{
+		User:         req.User,
+		Groups:       req.Groups,
+		SerialNumber: crt.SerialNumber.Text(16),
+		Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		Signer:       issuer.Name,
+		NotBefore:    crt.NotBefore,
+		NotAfter:     crt.NotAfter,
+		IssuedAt:     time.Now(),
+	}
+	return kubeconfig, credential, nil
+}
+
+// SaveUserKubeconfig 保存用户 kubeconfig，文件中包含私钥，仅所有者可读写
+func SaveUserKubeconfig(path string, kubeconfig []byte) error {
+	return os.WriteFile(path, kubeconfig, 0600)
+}
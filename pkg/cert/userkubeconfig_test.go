@@ -0,0 +1,110 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cert
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestUserKubeconfig(t *testing.T) {
+	root := SelfSignedCertKey{}
+	if err := root.Generate(&CertConfig{
+		Subject:   pkix.Name{CommonName: "kubernetes"},
+		KeyUsages: x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		Validity:  24 * time.Hour,
+		IsCA:      true,
+	}); err != nil {
+		t.Fatalf("failed to generate root CA: %v", err)
+	}
+	signer, err := NewLocalSigner(root.CertRaw, root.KeyRaw)
+	if err != nil {
+		t.Fatalf("NewLocalSigner failed: %v", err)
+	}
+	issuer := &CAIssuer{Name: UserSignerLocal, CertRaw: root.CertRaw, Signer: signer}
+
+	t.Run("GenerateUserKubeconfig Success", func(t *testing.T) {
+		req := &UserKubeconfigRequest{User: "alice", Groups: []string{"dev"}, TTL: time.Hour}
+		data, credential, err := GenerateUserKubeconfig(issuer, req, "https://127.0.0.1:6443", "cluster")
+		if err != nil {
+			t.Fatalf("GenerateUserKubeconfig failed: %v", err)
+		}
+
+		config, err := clientcmd.Load(data)
+		if err != nil {
+			t.Fatalf("failed to load kubeconfig: %v", err)
+		}
+		if config.CurrentContext != "alice@cluster" {
+			t.Errorf("unexpected current context: %s", config.CurrentContext)
+		}
+		authInfo, ok := config.AuthInfos["alice"]
+		if !ok {
+			t.Fatalf("user alice not found in kubeconfig")
+		}
+		crt, err := PemToCertificate(authInfo.ClientCertificateData)
+		if err != nil {
+			t.Fatalf("failed to parse client certificate: %v", err)
+		}
+		if crt.Subject.CommonName != "alice" || len(crt.Subject.Organization) != 1 || crt.Subject.Organization[0] != "dev" {
+			t.Errorf("unexpected subject: %v", crt.Subject)
+		}
+		if crt.NotAfter.After(time.Now().Add(time.Hour + time.Minute)) {
+			t.Errorf("certificate outlives the requested ttl: %v", crt.NotAfter)
+		}
+		if credential.User != "alice" || credential.Signer != UserSignerLocal || credential.SerialNumber != crt.SerialNumber.Text(16) {
+			t.Errorf("unexpected credential record: %+v", credential)
+		}
+	})
+
+	t.Run("GenerateUserKubeconfig Fail", func(t *testing.T) {
+		if _, _, err := GenerateUserKubeconfig(issuer, &UserKubeconfigRequest{TTL: time.Hour}, "https://127.0.0.1:6443", "cluster"); err == nil {
+			t.Errorf("expected error for empty user")
+		}
+		if _, _, err := GenerateUserKubeconfig(issuer, &UserKubeconfigRequest{User: "alice"}, "https://127.0.0.1:6443", "cluster"); err == nil {
+			t.Errorf("expected error for zero ttl")
+		}
+	})
+
+	t.Run("RecordIssuedCredential Success", func(t *testing.T) {
+		dir := t.TempDir()
+		credentials, err := LoadIssuedCredentials(dir)
+		if err != nil || len(credentials) != 0 {
+			t.Fatalf("expected no credentials, got %v, %v", credentials, err)
+		}
+		for _, user := range []string{"alice", "bob"} {
+			if err := RecordIssuedCredential(dir, IssuedCredential{User: user, Signer: UserSignerLocal}); err != nil {
+				t.Fatalf("RecordIssuedCredential failed: %v", err)
+			}
+		}
+		credentials, err = LoadIssuedCredentials(dir)
+		if err != nil {
+			t.Fatalf("LoadIssuedCredentials failed: %v", err)
+		}
+		var users []string
+		for _, c := range credentials {
+			users = append(users, c.User)
+		}
+		if strings.Join(users, ",") != "alice,bob" {
+			t.Errorf("unexpected credentials: %v", users)
+		}
+	})
+}
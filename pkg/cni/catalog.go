@@ -0,0 +1,134 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cni
+
+import (
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/data"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const catalogPath = "cni/catalog.yaml"
+
+var sha256Regexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Catalog 内置的网络插件目录，记录 nkd 支持的网络插件及其版本
+type Catalog struct {
+	Plugins map[string]Plugin `yaml:"plugins"`
+}
+
+// Plugin 网络插件，每个版本的清单为内置的上游清单 cni/<插件名>/<版本>.yaml
+type Plugin struct {
+	DefaultVersion string   `yaml:"defaultVersion"`
+	ImageRegistry  string   `yaml:"imageRegistry"` // 插件镜像默认所在的镜像仓库
+	SupportsMTU    bool     `yaml:"supportsMTU"`
+	Versions       []string `yaml:"versions"`
+	// 以版本为索引的清单来源
+	Sources map[string]Source `yaml:"sources"`
+}
+
+// Source 内置清单的上游来源
+type Source struct {
+	URL          string        `yaml:"url"`
+	SHA256       string        `yaml:"sha256"` // 上游清单的 sha256 校验和
+	Replacements []Replacement `yaml:"replacements"`
+}
+
+// Replacement 对上游清单的修改，将 Old 替换为使用模板参数渲染 New 的结果
+type Replacement struct {
+	Old string `yaml:"old"`
+	New string `yaml:"new"`
+}
+
+// LoadCatalog 读取内置的网络插件目录
+func LoadCatalog() (*Catalog, error) {
+	file, err := data.Assets.Open(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read network plugin catalog")
+	}
+
+	catalog := &Catalog{}
+	if err := yaml.Unmarshal(content, catalog); err != nil {
+		return nil, errors.Wrap(err, "failed to parse network plugin catalog")
+	}
+	if err := catalog.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid network plugin catalog")
+	}
+	return catalog, nil
+}
+
+// validate 校验目录中每个版本都记录了上游清单的地址和 sha256 校验和
+func (c *Catalog) validate() error {
+	for name, plugin := range c.Plugins {
+		if !contains(plugin.Versions, plugin.DefaultVersion) {
+			return fmt.Errorf("default version %q of network plugin %s is not in its versions", plugin.DefaultVersion, name)
+		}
+		for _, version := range plugin.Versions {
+			source, ok := plugin.Sources[version]
+			if !ok || source.URL == "" {
+				return fmt.Errorf("upstream source of network plugin %s %s is not recorded", name, version)
+			}
+			if !sha256Regexp.MatchString(source.SHA256) {
+				return fmt.Errorf("invalid sha256 %q of network plugin %s %s", source.SHA256, name, version)
+			}
+		}
+	}
+	return nil
+}
+
+// Names 返回目录中所有网络插件的名称
+func (c *Catalog) Names() []string {
+	names := make([]string, 0, len(c.Plugins))
+	for name := range c.Plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup 返回网络插件及其版本，version 为空时使用插件的默认版本
+func (c *Catalog) Lookup(name string, version string) (*Plugin, string, error) {
+	plugin, ok := c.Plugins[name]
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported network plugin %q, supported plugins: %s", name, strings.Join(c.Names(), ", "))
+	}
+	if version == "" {
+		return &plugin, plugin.DefaultVersion, nil
+	}
+	for _, v := range plugin.Versions {
+		if v == version {
+			return &plugin, version, nil
+		}
+	}
+	return nil, "", fmt.Errorf("unsupported version %q of network plugin %s, supported versions: %s", version, name, strings.Join(plugin.Versions, ", "))
+}
+
+// IsBuiltin 判断集群配置中的网络插件是否为目录中的插件，否则为网络插件清单的路径或URL
+func IsBuiltin(plugin string) bool {
+	return plugin != "" && !strings.Contains(plugin, "/") && !strings.HasSuffix(plugin, ".yaml") && !strings.HasSuffix(plugin, ".yml")
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cni
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestCluster(plugin string, podSubnet string) *asset.ClusterAsset {
+	conf := &asset.ClusterAsset{
+		Master: []asset.NodeAsset{{Hostname: "k8s-master01", IP: "192.168.1.10"}, {Hostname: "k8s-master02", IP: "192.168.1.11"}},
+	}
+	conf.Network = asset.Network{ServiceSubnet: "10.96.0.0/16", PodSubnet: podSubnet, Plugin: plugin}
+	return conf
+}
+
+func TestCNI(t *testing.T) {
+	if err := os.Chdir("../../data"); err != nil {
+		t.Fatalf("failed to change directory: %v", err)
+	}
+
+	catalog, err := LoadCatalog()
+	if err != nil {
+		t.Fatalf("LoadCatalog failed: %v", err)
+	}
+
+	t.Run("Catalog Sources Success", func(t *testing.T) {
+		listed := map[string]bool{catalogPath: true}
+		for _, name := range catalog.Names() {
+			plugin := catalog.Plugins[name]
+			for _, version := range plugin.Versions {
+				file := "cni/" + name + "/" + version + ".yaml"
+				listed[file] = true
+				content, err := os.ReadFile(filepath.Join("data", file))
+				if err != nil {
+					t.Errorf("upstream manifest of %s %s: %v", name, version, err)
+					continue
+				}
+				sum := sha256.Sum256(content)
+				if actual := hex.EncodeToString(sum[:]); actual != plugin.Sources[version].SHA256 {
+					t.Errorf("sha256 of %s is %s, but %s is recorded in the catalog", file, actual, plugin.Sources[version].SHA256)
+				}
+			}
+		}
+		err := filepath.Walk("data/cni", func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if file, _ := filepath.Rel("data", path); !listed[filepath.ToSlash(file)] {
+				t.Errorf("%s is not listed in the catalog", file)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed to walk data/cni: %v", err)
+		}
+	})
+
+	t.Run("Catalog Validate Fail", func(t *testing.T) {
+		source := Source{URL: "https://example.com/plugin.yaml", SHA256: strings.Repeat("0", 64)}
+		for _, plugin := range []Plugin{
+			{DefaultVersion: "v1.1.0", Versions: []string{"v1.0.0"}, Sources: map[string]Source{"v1.0.0": source}},
+			{DefaultVersion: "v1.0.0", Versions: []string{"v1.0.0"}},
+			{DefaultVersion: "v1.0.0", Versions: []string{"v1.0.0"}, Sources: map[string]Source{"v1.0.0": {URL: source.URL}}},
+		} {
+			c := &Catalog{Plugins: map[string]Plugin{"example": plugin}}
+			if err := c.validate(); err == nil {
+				t.Errorf("expected failure for %+v", plugin)
+			}
+		}
+	})
+
+	t.Run("Lookup Success", func(t *testing.T) {
+		c := &Catalog{Plugins: map[string]Plugin{"example": {DefaultVersion: "v1.1.0", Versions: []string{"v1.0.0", "v1.1.0"}}}}
+		if _, version, err := c.Lookup("example", ""); err != nil || version != "v1.1.0" {
+			t.Errorf("unexpected default version %s: %v", version, err)
+		}
+		if _, version, err := c.Lookup("example", "v1.0.0"); err != nil || version != "v1.0.0" {
+			t.Errorf("unexpected version %s: %v", version, err)
+		}
+	})
+
+	t.Run("Lookup Fail", func(t *testing.T) {
+		c := &Catalog{Plugins: map[string]Plugin{"example": {DefaultVersion: "v1.1.0", Versions: []string{"v1.1.0"}}}}
+		if _, _, err := c.Lookup("weave", ""); err == nil {
+			t.Error("expected failure for an unsupported plugin")
+		}
+		if _, _, err := c.Lookup("example", "v1.0.0"); err == nil {
+			t.Error("expected failure for an unsupported version")
+		}
+		if _, err := Manifest(newTestCluster("weave", "10.244.0.0/16")); err == nil {
+			t.Error("expected failure for a plugin that is not in the catalog")
+		}
+	})
+
+	t.Run("IsBuiltin Success", func(t *testing.T) {
+		for plugin, expected := range map[string]bool{
+			"calico":                           true,
+			"kube-ovn":                         true,
+			"":                                 false,
+			"calico.yaml":                      false,
+			"/opt/cni/calico.yaml":             false,
+			"https://example.com/calico.yaml":  false,
+			"https://example.com/calico/v3.27": false,
+		} {
+			if IsBuiltin(plugin) != expected {
+				t.Errorf("IsBuiltin(%q) != %v", plugin, expected)
+			}
+		}
+	})
+
+	upstream := []byte(`apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: example-node
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      containers:
+      - image: docker.io/example/node:v1.0.0
+        env:
+        - name: POOL_CIDR
+          value: "192.168.0.0/16"
+        - name: MTU
+          value: "0"
+`)
+	sum := sha256.Sum256(upstream)
+	source := Source{
+		URL:    "https://example.com/v1.0.0/example.yaml",
+		SHA256: hex.EncodeToString(sum[:]),
+		Replacements: []Replacement{
+			{Old: "docker.io/example/", New: "{{.ImageRegistry}}/example/"},
+			{Old: `"192.168.0.0/16"`, New: `"{{required "IPv4 pod subnet" .PodSubnetV4}}"`},
+			{Old: `value: "0"`, New: `value: "{{.MTU}}"`},
+		},
+	}
+
+	t.Run("Apply Success", func(t *testing.T) {
+		params := &Params{ImageRegistry: "hub.oepkgs.net", IPv4: true, PodSubnetV4: "10.244.0.0/16", MTU: 1450}
+		content, err := source.Apply("example", upstream, params)
+		if err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		for _, expected := range []string{"image: hub.oepkgs.net/example/node:v1.0.0", `value: "10.244.0.0/16"`, `value: "1450"`} {
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("manifest does not contain %s:\n%s", expected, content)
+			}
+		}
+		if _, err := kubeclient.DecodeManifest(content); err != nil {
+			t.Errorf("invalid manifest: %v", err)
+		}
+	})
+
+	t.Run("Apply Fail", func(t *testing.T) {
+		params := &Params{ImageRegistry: "hub.oepkgs.net", IPv4: true, PodSubnetV4: "10.244.0.0/16"}
+		modified := append([]byte("# modified\n"), upstream...)
+		if _, err := source.Apply("example", modified, params); err == nil {
+			t.Error("expected failure for a manifest that differs from upstream")
+		}
+
+		missing := source
+		missing.Replacements = append([]Replacement{{Old: "quay.io/example/", New: "{{.ImageRegistry}}/example/"}}, source.Replacements...)
+		if _, err := missing.Apply("example", upstream, params); err == nil {
+			t.Error("expected failure for a replacement that is not in the upstream manifest")
+		}
+
+		if _, err := source.Apply("example", upstream, &Params{ImageRegistry: "hub.oepkgs.net", IPv6: true, PodSubnetV6: "fd00:10:244::/56"}); err == nil {
+			t.Error("expected failure for a plugin that requires an IPv4 pod subnet")
+		}
+	})
+
+	t.Run("Custom Manifest Success", func(t *testing.T) {
+		content := []byte("hostPath: /usr/libexec/kubernetes/kubelet-plugins/volume/exec\n")
+		path := filepath.Join(t.TempDir(), "plugin.yaml")
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			t.Fatalf("failed to write manifest: %v", err)
+		}
+		sum := sha256.Sum256(content)
+		conf := newTestCluster(path, "10.244.0.0/16")
+		conf.Network.PluginSHA256 = hex.EncodeToString(sum[:])
+		conf.IsNestOS = true
+		if err := Validate(conf); err != nil {
+			t.Fatalf("Validate failed: %v", err)
+		}
+		manifest, err := Manifest(conf)
+		if err != nil {
+			t.Fatalf("Manifest failed: %v", err)
+		}
+		if string(manifest) != "hostPath: /opt/libexec/kubernetes/kubelet-plugins/volume/exec\n" {
+			t.Errorf("unexpected manifest: %s", manifest)
+		}
+	})
+
+	t.Run("Custom Manifest Fail", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "plugin.yaml")
+		if err := os.WriteFile(path, []byte("kind: DaemonSet\n"), 0644); err != nil {
+			t.Fatalf("failed to write manifest: %v", err)
+		}
+		conf := newTestCluster(path, "10.244.0.0/16")
+		conf.Network.PluginSHA256 = strings.Repeat("0", 64)
+		if _, err := Manifest(conf); err == nil {
+			t.Error("expected failure for a checksum mismatch")
+		}
+		conf.Network.MTU = 1450
+		if err := Validate(conf); err == nil {
+			t.Error("expected failure for the mtu of a custom manifest")
+		}
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cni
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/data"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"net"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// ApplySet 网络插件的对象所属的 apply set，对象带有 kubeclient.ApplySetLabel 标签
+const ApplySet = "network-plugin"
+
+const (
+	flexVolumeDir = "/usr/libexec/kubernetes/kubelet-plugins"
+	// NestOS 等不可变操作系统中 /usr 为只读目录，而 FlexVolume 的目录必须可写，因此使用 /opt 下的目录
+	nestosFlexVolumeDir = "/opt/libexec/kubernetes/kubelet-plugins"
+)
+
+var imageRegexp = regexp.MustCompile(`(?m)^\s*(?:-\s+)?image:\s*["']?([^\s"']+)`)
+
+// Params 渲染网络插件清单的修改时使用的参数
+type Params struct {
+	Version       string
+	ImageRegistry string
+	IPv4          bool
+	IPv6          bool
+	PodSubnet     string // 所有地址族的容器子网，以逗号分隔
+	PodSubnetV4   string
+	PodSubnetV6   string
+	ServiceSubnet string
+	MTU           int // 为 0 时由网络插件自动检测
+}
+
+// NewParams 根据集群配置计算内置网络插件的模板参数
+func NewParams(conf *asset.ClusterAsset) (*Params, error) {
+	catalog, err := LoadCatalog()
+	if err != nil {
+		return nil, err
+	}
+	network := conf.Kubernetes.Network
+	plugin, version, err := catalog.Lookup(network.Plugin, network.PluginVersion)
+	if err != nil {
+		return nil, err
+	}
+	if network.MTU < 0 {
+		return nil, fmt.Errorf("invalid mtu %d of network plugin", network.MTU)
+	}
+	if network.MTU > 0 && !plugin.SupportsMTU {
+		return nil, fmt.Errorf("network plugin %s does not support setting the mtu", network.Plugin)
+	}
+
+	params := &Params{
+		Version:       version,
+		ImageRegistry: plugin.ImageRegistry,
+		PodSubnet:     strings.ReplaceAll(network.PodSubnet, " ", ""),
+		ServiceSubnet: strings.ReplaceAll(network.ServiceSubnet, " ", ""),
+		MTU:           network.MTU,
+	}
+	if network.PluginImageRegistry != "" {
+		params.ImageRegistry = strings.TrimSuffix(network.PluginImageRegistry, "/")
+	}
+
+	for _, subnet := range strings.Split(params.PodSubnet, ",") {
+		ip, _, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pod subnet %q", network.PodSubnet)
+		}
+		if ip.To4() != nil && !params.IPv4 {
+			params.IPv4, params.PodSubnetV4 = true, subnet
+		} else if ip.To4() == nil && !params.IPv6 {
+			params.IPv6, params.PodSubnetV6 = true, subnet
+		} else {
+			return nil, fmt.Errorf("invalid pod subnet %q, at most one subnet of each IP family is allowed", network.PodSubnet)
+		}
+	}
+	return params, nil
+}
+
+// Render 读取内置网络插件的上游清单，并使用参数应用目录中记录的修改
+func Render(name string, params *Params) ([]byte, error) {
+	catalog, err := LoadCatalog()
+	if err != nil {
+		return nil, err
+	}
+	plugin, version, err := catalog.Lookup(name, params.Version)
+	if err != nil {
+		return nil, err
+	}
+	file, err := data.Assets.Open(path.Join("cni", name, version+".yaml"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open manifest of network plugin %s %s", name, version)
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	source := plugin.Sources[version]
+	return source.Apply(name, content, params)
+}
+
+// Apply 校验上游清单的 sha256 校验和，再依次将清单中的 Old 替换为使用参数渲染 New 的结果
+func (s *Source) Apply(name string, content []byte, params *Params) ([]byte, error) {
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); actual != s.SHA256 {
+		return nil, fmt.Errorf("manifest of network plugin %s does not match the upstream manifest %s: sha256 %s, expected %s",
+			name, s.URL, actual, s.SHA256)
+	}
+
+	funcs := template.FuncMap{
+		"required": func(desc string, value string) (string, error) {
+			if value == "" {
+				return "", fmt.Errorf("%s are required by network plugin %s", desc, name)
+			}
+			return value, nil
+		},
+	}
+	for _, r := range s.Replacements {
+		if !bytes.Contains(content, []byte(r.Old)) {
+			return nil, fmt.Errorf("%q is not found in the manifest of network plugin %s", r.Old, name)
+		}
+		tmpl, err := template.New(name).Funcs(funcs).Parse(r.New)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse replacement of network plugin %s", name)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, params); err != nil {
+			return nil, errors.Wrapf(err, "failed to render manifest of network plugin %s", name)
+		}
+		content = bytes.ReplaceAll(content, []byte(r.Old), buf.Bytes())
+	}
+	return content, nil
+}
+
+// Validate 校验集群配置中的网络插件设置
+func Validate(conf *asset.ClusterAsset) error {
+	network := conf.Kubernetes.Network
+	if network.Plugin == "" {
+		return errors.New("network plugin is not set")
+	}
+	if IsBuiltin(network.Plugin) {
+		_, err := NewParams(conf)
+		return err
+	}
+	if network.PluginVersion != "" || network.PluginImageRegistry != "" || network.MTU != 0 {
+		return fmt.Errorf("pluginVersion, pluginImageRegistry and mtu are only used by the built-in network plugins, set them in the manifest %s instead", network.Plugin)
+	}
+	return nil
+}
+
+/*
+Manifest 返回集群使用的网络插件清单：
+  - 内置的网络插件使用内置的上游清单，并根据集群的网络设置修改
+  - 其余情况下从路径或URL读取清单，设置了 pluginSHA256 时校验清单的 sha256 校验和
+*/
+func Manifest(conf *asset.ClusterAsset) ([]byte, error) {
+	network := conf.Kubernetes.Network
+	var content []byte
+	if IsBuiltin(network.Plugin) {
+		params, err := NewParams(conf)
+		if err != nil {
+			return nil, err
+		}
+		content, err = Render(network.Plugin, params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		content, err = utils.FetchManifest(network.Plugin, network.PluginSHA256)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if conf.IsNestOS {
+		content = bytes.ReplaceAll(content, []byte(flexVolumeDir), []byte(nestosFlexVolumeDir))
+	}
+	return content, nil
+}
+
+// Images 返回内置网络插件清单中引用的镜像
+func Images(conf *asset.ClusterAsset) ([]string, error) {
+	params, err := NewParams(conf)
+	if err != nil {
+		return nil, err
+	}
+	content, err := Render(conf.Kubernetes.Network.Plugin, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []string
+	for _, match := range imageRegexp.FindAllStringSubmatch(string(content), -1) {
+		if !contains(images, match[1]) {
+			images = append(images, match[1])
+		}
+	}
+	return images, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
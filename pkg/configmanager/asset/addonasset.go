@@ -0,0 +1,109 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// 附加组件的安装状态
+const (
+	AddonInstalled = "Installed"
+	AddonFailed    = "Failed"
+)
+
+// AddonAsset 集群部署后安装的附加组件，内置的附加组件（见 data/addons/catalog.yaml）仅需设置名称，其余附加组件需设置清单
+type AddonAsset struct {
+	Name          string            `yaml:"name"`
+	Enabled       *bool             `yaml:"enabled,omitempty"`       // 是否安装，默认为 true
+	Version       string            `yaml:"version,omitempty"`       // 附加组件的版本，内置附加组件默认为目录中的默认版本
+	ImageRegistry string            `yaml:"imageRegistry,omitempty"` // 附加组件镜像所在的镜像仓库，替换内置附加组件的默认镜像仓库
+	Manifests     []AddonManifest   `yaml:"manifests,omitempty"`     // 非内置附加组件的清单，按顺序应用
+	DependsOn     []string          `yaml:"dependsOn,omitempty"`     // 依赖的附加组件，在其就绪后安装
+	Values        map[string]string `yaml:"values,omitempty"`        // 渲染清单模板使用的值，通过 {{.Values.<key>}} 引用
+	Status        AddonStatus       `yaml:"status,omitempty"`        // 由 nkd 记录的安装状态
+}
+
+// AddonManifest 附加组件清单的路径或URL，以及可选的 sha256 校验和
+type AddonManifest struct {
+	Source string `yaml:"source"`
+	SHA256 string `yaml:"sha256,omitempty"`
+}
+
+// AddonStatus 附加组件最近一次安装的结果
+type AddonStatus struct {
+	Phase     string `yaml:"phase,omitempty"`     // Installed 或 Failed
+	Version   string `yaml:"version,omitempty"`   // 已安装的版本
+	Message   string `yaml:"message,omitempty"`   // 安装失败的原因
+	UpdatedAt string `yaml:"updatedAt,omitempty"` // RFC 3339 格式的时间
+}
+
+// IsEnabled 返回是否安装该附加组件
+func (a *AddonAsset) IsEnabled() bool {
+	return a.Enabled == nil || *a.Enabled
+}
+
+// SetEnabled 设置是否安装该附加组件
+func (a *AddonAsset) SetEnabled(enabled bool) {
+	a.Enabled = &enabled
+}
+
+// Validate 校验附加组件的名称和清单，附加组件是否存在于目录中由 addon 包校验
+func (a *AddonAsset) Validate() error {
+	if errs := validation.IsDNS1123Label(a.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid addon name %q: %s", a.Name, strings.Join(errs, "; "))
+	}
+	for _, manifest := range a.Manifests {
+		if manifest.Source == "" {
+			return fmt.Errorf("source of the manifests of addon %s is required", a.Name)
+		}
+	}
+	for _, dep := range a.DependsOn {
+		if dep == a.Name {
+			return fmt.Errorf("addon %s depends on itself", a.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateAddons 校验所有附加组件，名称不能重复
+func ValidateAddons(addons []AddonAsset) error {
+	names := make(map[string]struct{}, len(addons))
+	for i := range addons {
+		if err := addons[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[addons[i].Name]; ok {
+			return fmt.Errorf("addon %s is configured more than once", addons[i].Name)
+		}
+		names[addons[i].Name] = struct{}{}
+	}
+	return nil
+}
+
+// Addon 返回名称为 name 的附加组件，不存在时返回 nil
+func (clusterAsset *ClusterAsset) Addon(name string) *AddonAsset {
+	for i := range clusterAsset.Addons {
+		if clusterAsset.Addons[i].Name == name {
+			return &clusterAsset.Addons[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"testing"
+)
+
+func TestAddons(t *testing.T) {
+	t.Run("ValidateAddons Success", func(t *testing.T) {
+		addons := []AddonAsset{
+			{Name: "metrics-server"},
+			{Name: "my-app", DependsOn: []string{"metrics-server"}, Manifests: []AddonManifest{{Source: "https://example.com/app.yaml"}},
+				Values: map[string]string{"replicas": "2"}},
+		}
+		if err := ValidateAddons(addons); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ValidateAddons Fail", func(t *testing.T) {
+		invalid := [][]AddonAsset{
+			{{}},
+			{{Name: "Metrics_Server"}},
+			{{Name: "my-app", Manifests: []AddonManifest{{SHA256: "abc"}}}},
+			{{Name: "my-app", DependsOn: []string{"my-app"}}},
+			{{Name: "dashboard"}, {Name: "dashboard"}},
+		}
+		for _, addons := range invalid {
+			if err := ValidateAddons(addons); err == nil {
+				t.Errorf("expected error of %+v", addons)
+			}
+		}
+	})
+
+	t.Run("IsEnabled Success", func(t *testing.T) {
+		addon := AddonAsset{Name: "dashboard"}
+		if !addon.IsEnabled() {
+			t.Error("addon should be enabled by default")
+		}
+		addon.SetEnabled(false)
+		if addon.IsEnabled() {
+			t.Error("addon should be disabled")
+		}
+		conf := &ClusterAsset{Addons: []AddonAsset{addon}}
+		if conf.Addon("dashboard") == nil || conf.Addon("metrics-server") != nil {
+			t.Error("unexpected result of Addon")
+		}
+	})
+}
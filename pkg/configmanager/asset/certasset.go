@@ -16,14 +16,93 @@ limitations under the License.
 
 package asset
 
-//接受用户自定义的各类ca证书路径
+import (
+	"nestos-kubernetes-deployer/pkg/secret"
+)
+
+// 接受用户自定义的各类ca证书路径
 type CertAsset struct {
-	RootCACertPath       string `yaml:"rootCACertPath"`
-	RootCAKeyPath        string `yaml:"rootCAKeyPath"`
-	EtcdCACertPath       string `yaml:"etcdCACertPath"`
-	EtcdCAKeyPath        string `yaml:"etcdCAKeyPath"`
-	FrontProxyCACertPath string `yaml:"frontProxyCACertPath"`
-	FrontProxyCAKeyPath  string `yaml:"frontProxyCAKeyPath"`
-	SAPub                string `yaml:"saPub"`
-	SAKey                string `yaml:"saKey"`
+	RootCACertPath       string      `yaml:"rootCACertPath"`
+	RootCAKeyPath        string      `yaml:"rootCAKeyPath"`
+	EtcdCACertPath       string      `yaml:"etcdCACertPath"`
+	EtcdCAKeyPath        string      `yaml:"etcdCAKeyPath"`
+	FrontProxyCACertPath string      `yaml:"frontProxyCACertPath"`
+	FrontProxyCAKeyPath  string      `yaml:"frontProxyCAKeyPath"`
+	SAPub                string      `yaml:"saPub"`
+	SAKey                string      `yaml:"saKey"`
+	ExternalCA           *ExternalCA `yaml:"externalCA,omitempty"`
+
+	// 证书密钥算法：RSA-2048（默认）、RSA-3072、RSA-4096、ECDSA-P256、ECDSA-P384
+	KeyAlgorithm string       `yaml:"keyAlgorithm,omitempty"`
+	Validity     CertValidity `yaml:"validity,omitempty"`
+
+	// 引导服务的CA证书和私钥，以及派生引导文件访问令牌的密钥，均由nkd自动生成
+	BootstrapCACertPath  string `yaml:"bootstrapCACertPath,omitempty"`
+	BootstrapCAKeyPath   string `yaml:"bootstrapCAKeyPath,omitempty"`
+	BootstrapTokenSecret string `yaml:"bootstrapTokenSecret,omitempty"`
+
+	// 密钥路径为外部引用（env:、file:、exec:）时解析得到的密钥内容，仅保存在内存中
+	RootCAKey       []byte `yaml:"-"`
+	EtcdCAKey       []byte `yaml:"-"`
+	FrontProxyCAKey []byte `yaml:"-"`
+}
+
+// CertValidity 各类证书的有效期，格式如 8760h，为空时使用默认值
+type CertValidity struct {
+	CA         string `yaml:"ca,omitempty"`         // CA证书
+	Leaf       string `yaml:"leaf,omitempty"`       // 组件服务端和客户端证书
+	Kubeconfig string `yaml:"kubeconfig,omitempty"` // kubeconfig 中的客户端证书
+}
+
+// 外部CA签发方式
+const (
+	ExternalCASignerFile    = "file"
+	ExternalCASignerCommand = "command"
+	ExternalCASignerPKCS11  = "pkcs11"
+)
+
+/*
+ExternalCA 外部CA模式配置：nkd不持有也不分发CA私钥，叶子证书的CSR交由外部签发方签发。
+各CA证书路径（可包含到根CA的证书链）必须提供：
+file     使用 rootCAKeyPath 等路径中的中间CA私钥在本地签发，私钥不会分发到节点
+command  执行本地命令，标准输入为PEM格式的CSR，标准输出为PEM格式的证书链（叶子证书在前）
+pkcs11   通过 pkcs11-tool 使用硬件令牌中的CA私钥签名
+集群中没有CA私钥，各节点的证书和 kubeconfig 由nkd在部署和扩容时预先签发，随节点专属引导配置分发
+*/
+type ExternalCA struct {
+	Signer  string        `yaml:"signer"`
+	Command string        `yaml:"command,omitempty"`
+	PKCS11  *PKCS11Config `yaml:"pkcs11,omitempty"`
+}
+
+type PKCS11Config struct {
+	Module            string `yaml:"module"`               // PKCS#11 模块路径
+	TokenLabel        string `yaml:"tokenLabel,omitempty"` // 令牌标签，为空时使用第一个可用令牌
+	Pin               string `yaml:"pin"`                  // 令牌PIN，支持 env:/file:/exec: 引用
+	RootCAKeyID       string `yaml:"rootCAKeyID"`
+	EtcdCAKeyID       string `yaml:"etcdCAKeyID"`
+	FrontProxyCAKeyID string `yaml:"frontProxyCAKeyID"`
+}
+
+// resolveCAKeyRefs 解析以外部引用形式提供的CA密钥
+func (ca *CertAsset) resolveCAKeyRefs() error {
+	keys := []struct {
+		path    string
+		content *[]byte
+	}{
+		{ca.RootCAKeyPath, &ca.RootCAKey},
+		{ca.EtcdCAKeyPath, &ca.EtcdCAKey},
+		{ca.FrontProxyCAKeyPath, &ca.FrontProxyCAKey},
+	}
+	for _, key := range keys {
+		if !secret.IsReference(key.path) {
+			continue
+		}
+		value, err := secret.ResolveReference(key.path)
+		if err != nil {
+			return err
+		}
+		*key.content = []byte(value + "\n")
+	}
+	return nil
 }
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	mrand "math/rand"
 	"nestos-kubernetes-deployer/cmd/command/opts"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
 	"strings"
@@ -150,30 +152,41 @@ func setWorkerHostname(wc []NodeAsset, opts *opts.WorkerConfig) []NodeAsset {
 // ========== Structure method ==========
 
 type ClusterAsset struct {
-	ClusterID     string `yaml:"clusterID"`
-	Architecture  string
-	Platform      string
-	InfraPlatform interface{} `yaml:"infraPlatform"`
-	OSImage       `yaml:"osImage"`
-	UserName      string `yaml:"username"`
-	Password      string
-	SSHKey        string      `yaml:"sshKey"`
-	Master        []NodeAsset `yaml:"master,omitempty"`
-	Worker        []NodeAsset `yaml:"worker,omitempty"`
-	BootConfig    NodeType    `yaml:"bootConfig,omitempty"`
-	Runtime       string      `yaml:"runtime,omitempty"` //后续考虑增加os层面的配置管理，并将runtime放入OS层面的配置中
+	ClusterID       string `yaml:"clusterID"`
+	Architecture    string
+	Platform        string
+	InfraPlatform   interface{} `yaml:"infraPlatform"`
+	OSImage         `yaml:"osImage"`
+	UserName        string `yaml:"username"`
+	Password        string
+	SSHKey          string                `yaml:"sshKey"`
+	Master          []NodeAsset           `yaml:"master,omitempty"`
+	Worker          []NodeAsset           `yaml:"worker,omitempty"`
+	BootConfig      NodeType              `yaml:"bootConfig,omitempty"`
+	Runtime         string                `yaml:"runtime,omitempty"`             //后续考虑增加os层面的配置管理，并将runtime放入OS层面的配置中
+	RuntimeHandlers []RuntimeHandlerAsset `yaml:"runtimeHandlers,omitempty"`     // 在容器运行时中额外注册的运行时，如 kata-containers、gVisor
+	Kickstart       KickstartAsset        `yaml:"kickstart,omitempty"`           // pxe/ipxe 平台上通用操作系统的安装参数
+	Fragments       BootConfigFragments   `yaml:"bootConfigFragments,omitempty"` // 合并到生成的引导配置中的用户配置片段
+	Addons          []AddonAsset          `yaml:"addons,omitempty"`              // 集群部署后安装的附加组件，如 metrics-server、ingress-nginx
+	Readiness       ReadinessAsset        `yaml:"readiness,omitempty"`           // 集群部署完成后的就绪检查
 	Kubernetes
 	Housekeeper `json:"housekeeper" yaml:"-"` //不对housekeeper字段配置
 	CertAsset   `yaml:"certAsset,omitempty"`
 	HookConf    `yaml:"hooks,omitempty"`
+
+	secretRefs map[*string]secretRef
+}
+
+// secretRef records an external secret reference and its resolved value.
+type secretRef struct {
+	ref   string
+	value string
 }
 
 type NodeType struct {
 	Controlplane BootFile `yaml:"controlplane,omitempty"`
 	Master       BootFile `yaml:"master,omitempty"`
 	Worker       BootFile `yaml:"worker,omitempty"`
-
-	KickstartMaster []BootFile `yaml:"-"`
 }
 
 type BootFile struct {
@@ -195,32 +208,48 @@ type ShellFile struct {
 }
 
 type OSImage struct {
-	Type        string
-	IsNestOS    bool `json:"isNestOS" yaml:"-"`
-	IsGeneralOS bool `json:"isGeneralOS" yaml:"-"`
+	Type            string
+	IgnitionVersion string `yaml:"ignitionVersion,omitempty"` // NestOS 引导配置使用的 Ignition 规范版本，默认为支持的最新版本
+	IsNestOS        bool   `json:"isNestOS" yaml:"-"`
+	IsGeneralOS     bool   `json:"isGeneralOS" yaml:"-"`
 }
 
 type Kubernetes struct {
-	KubernetesVersion    string   `yaml:"kubernetesVersion"`
-	KubernetesAPIVersion string   `yaml:"kubernetesApiVersion"`
-	ApiServerEndpoint    string   `yaml:"apiserverEndpoint"`
-	ImageRegistry        string   `yaml:"imageRegistry"`
-	RegistryMirror       string   `json:"registryMirror" yaml:"registryMirror,omitempty"`
-	PauseImage           string   `yaml:"pauseImage"`
-	ReleaseImageURL      string   `json:"releaseImageURL" yaml:"releaseImageURL,omitempty"`
-	Token                string   `json:"token" yaml:"token,omitempty"`
-	AdminKubeConfig      string   `yaml:"adminKubeconfig"`
-	CertificateKey       string   `yaml:"certificateKey"`
-	CaCertHash           string   `json:"-" yaml:"-"`
-	PackageList          []string `json:"packageList" yaml:"packageList,omitempty"`
-	RpmPackagePath       string   `json:"rpmPackagePath" yaml:"rpmPackagePath,omitempty"`
+	KubernetesVersion    string          `yaml:"kubernetesVersion"`
+	KubernetesAPIVersion string          `yaml:"kubernetesApiVersion"`
+	ApiServerEndpoint    string          `yaml:"apiserverEndpoint"`
+	ImageRegistry        string          `yaml:"imageRegistry"`
+	RegistryMirror       string          `json:"registryMirror" yaml:"registryMirror,omitempty"`
+	PauseImage           string          `yaml:"pauseImage"`
+	CgroupDriver         string          `json:"cgroupDriver" yaml:"cgroupDriver,omitempty"` // kubelet 和容器运行时使用的 cgroup 驱动：systemd（默认）或 cgroupfs
+	ReleaseImageURL      string          `json:"releaseImageURL" yaml:"releaseImageURL,omitempty"`
+	Token                string          `json:"token" yaml:"token,omitempty"`
+	AdminKubeConfig      string          `yaml:"adminKubeconfig"`
+	CertificateKey       string          `yaml:"certificateKey"`
+	CaCertHash           string          `json:"-" yaml:"-"`
+	PackageList          []string        `json:"packageList" yaml:"packageList,omitempty"`
+	RpmPackagePath       string          `json:"rpmPackagePath" yaml:"rpmPackagePath,omitempty"`
+	RpmGPGKeyPath        string          `json:"rpmGpgKeyPath" yaml:"rpmGpgKeyPath,omitempty"`     // 软件包签名公钥，设置后节点通过dnf校验软件包签名
+	ImageLayoutPath      string          `json:"imageLayoutPath" yaml:"imageLayoutPath,omitempty"` // OCI镜像布局目录，设置后由内置的只读镜像仓库提供其中的镜像
+	Registries           []RegistryAsset `json:"registries" yaml:"registries,omitempty"`           // 镜像仓库的 mirror、访问方式及认证信息
 	Network
 }
 
 type Network struct {
-	ServiceSubnet string `yaml:"serviceSubnet"`
-	PodSubnet     string `yaml:"podSubnet"`
-	Plugin        string `yaml:"plugin"`
+	ServiceSubnet       string `yaml:"serviceSubnet"`
+	PodSubnet           string `yaml:"podSubnet"`
+	Plugin              string `yaml:"plugin"`                        // 内置网络插件目录中的网络插件，或网络插件清单的路径或URL
+	PluginVersion       string `yaml:"pluginVersion,omitempty"`       // 内置网络插件的版本，默认为目录中的默认版本
+	PluginSHA256        string `yaml:"pluginSHA256,omitempty"`        // 网络插件清单的 sha256 校验和
+	PluginImageRegistry string `yaml:"pluginImageRegistry,omitempty"` // 内置网络插件镜像所在的镜像仓库
+	MTU                 int    `yaml:"mtu,omitempty"`                 // 容器网络的 MTU，默认由网络插件自动检测
+}
+
+// ReadinessAsset 集群部署完成后的就绪检查设置，检查项见 pkg/readiness
+type ReadinessAsset struct {
+	Timeouts     map[string]string `yaml:"timeouts,omitempty"`     // 检查项的超时时间，如 nodes: 30m，未设置的检查项使用默认超时时间
+	Skip         []string          `yaml:"skip,omitempty"`         // 跳过的检查项
+	ReportFormat string            `yaml:"reportFormat,omitempty"` // 健康报告的输出格式：text（默认）或 json
 }
 
 type Housekeeper struct {
@@ -337,6 +366,50 @@ func (clusterAsset *ClusterAsset) InitClusterAsset(opts *opts.OptionsList) (*Clu
 		clusterAsset.Housekeeper.EvictPodForce = opts.Housekeeper.EvictPodForce
 	}
 
+	if err := clusterAsset.Kickstart.Validate(); err != nil {
+		return nil, err
+	}
+	if err := clusterAsset.Fragments.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ValidateRegistries(clusterAsset.Kubernetes.Registries); err != nil {
+		return nil, err
+	}
+	if err := ValidateRuntimeHandlers(clusterAsset.RuntimeHandlers); err != nil {
+		return nil, err
+	}
+	if len(clusterAsset.RuntimeHandlers) > 0 && clusterAsset.Runtime == constants.Docker {
+		return nil, errors.New("runtime handlers are not supported by docker")
+	}
+	if err := ValidateAddons(clusterAsset.Addons); err != nil {
+		return nil, err
+	}
+
+	macs := make(map[string]string)
+	for _, nodes := range [][]NodeAsset{clusterAsset.Master, clusterAsset.Worker} {
+		for i := range nodes {
+			if err := nodes[i].ValidateLabels(); err != nil {
+				return nil, err
+			}
+			if err := nodes[i].ValidateMAC(); err != nil {
+				return nil, err
+			}
+			if err := nodes[i].ValidateNetwork(); err != nil {
+				return nil, err
+			}
+			if err := nodes[i].Kickstart.Validate(); err != nil {
+				return nil, fmt.Errorf("node %s: %v", nodes[i].Hostname, err)
+			}
+			if nodes[i].MAC == "" {
+				continue
+			}
+			if other, ok := macs[nodes[i].MAC]; ok {
+				return nil, fmt.Errorf("mac address %s is used by both node %s and %s", nodes[i].MAC, other, nodes[i].Hostname)
+			}
+			macs[nodes[i].MAC] = nodes[i].Hostname
+		}
+	}
+
 	if err := GetCmdHooks(&clusterAsset.HookConf); err != nil {
 		logrus.Errorf("error in initializing cluster hooks config: %v", err)
 		return nil, err
@@ -352,14 +425,107 @@ func (clusterAsset *ClusterAsset) Delete(dir string) error {
 	return nil
 }
 
-func (clusterAsset *ClusterAsset) Persist(dir string) error {
+// SecretHolder is implemented by assets carrying sensitive fields.
+type SecretHolder interface {
+	SecretFields() []*string
+}
+
+// SecretFields returns the sensitive fields of the cluster asset, including those of the infra platform.
+func (clusterAsset *ClusterAsset) SecretFields() []*string {
+	fields := []*string{
+		&clusterAsset.Password,
+		&clusterAsset.Kubernetes.Token,
+		&clusterAsset.Kubernetes.CertificateKey,
+		&clusterAsset.CertAsset.BootstrapTokenSecret,
+	}
+	for i := range clusterAsset.Kubernetes.Registries {
+		fields = append(fields, &clusterAsset.Kubernetes.Registries[i].Password)
+	}
+	if externalCA := clusterAsset.CertAsset.ExternalCA; externalCA != nil && externalCA.PKCS11 != nil {
+		fields = append(fields, &externalCA.PKCS11.Pin)
+	}
+	if holder, ok := clusterAsset.InfraPlatform.(SecretHolder); ok {
+		fields = append(fields, holder.SecretFields()...)
+	}
+	return fields
+}
+
+// OpenSecrets decrypts the sensitive fields read from the persist directory.
+func (clusterAsset *ClusterAsset) OpenSecrets(sealer *secret.Sealer) error {
+	for _, field := range clusterAsset.SecretFields() {
+		if !secret.IsSealedString(*field) {
+			continue
+		}
+		if sealer == nil {
+			return fmt.Errorf("cluster %s contains encrypted secrets but no secret key material is provided (set %s or %s)",
+				clusterAsset.ClusterID, secret.PassphraseEnv, secret.KeyFileEnv)
+		}
+		value, err := sealer.OpenString(*field)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decrypt secrets of cluster %s", clusterAsset.ClusterID)
+		}
+		*field = value
+	}
+	return nil
+}
+
+// ResolveSecretRefs resolves the external secret references (env:, file:, exec:) of the cluster asset.
+// The references are kept so that Persist never writes the resolved values back.
+func (clusterAsset *ClusterAsset) ResolveSecretRefs() error {
+	clusterAsset.secretRefs = map[*string]secretRef{}
+	for _, field := range clusterAsset.SecretFields() {
+		if !secret.IsReference(*field) {
+			continue
+		}
+		value, err := secret.ResolveReference(*field)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve secret reference of cluster %s", clusterAsset.ClusterID)
+		}
+		clusterAsset.secretRefs[field] = secretRef{ref: *field, value: value}
+		*field = value
+	}
+
+	if err := clusterAsset.CertAsset.resolveCAKeyRefs(); err != nil {
+		return errors.Wrapf(err, "failed to resolve CA key reference of cluster %s", clusterAsset.ClusterID)
+	}
+	return nil
+}
+
+func (clusterAsset *ClusterAsset) Persist(dir string, sealer *secret.Sealer) error {
+	// Write back the references or seal the sensitive fields for serialization, and restore them afterwards.
+	fields := clusterAsset.SecretFields()
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = *field
+	}
+	defer func() {
+		for i, field := range fields {
+			*field = values[i]
+		}
+	}()
+
+	for _, field := range fields {
+		if ref, ok := clusterAsset.secretRefs[field]; ok && ref.value == *field {
+			*field = ref.ref
+			continue
+		}
+		if sealer == nil {
+			continue
+		}
+		sealed, err := sealer.SealString(*field)
+		if err != nil {
+			return err
+		}
+		*field = sealed
+	}
+
 	// Serialize the cluster asset to yaml.
 	clusterData, err := yaml.Marshal(clusterAsset)
 	if err != nil {
 		return err
 	}
 
-	if err := os.WriteFile(dir+"/cluster_config.yaml", clusterData, 0644); err != nil {
+	if err := os.WriteFile(dir+"/cluster_config.yaml", clusterData, secret.FileMode); err != nil {
 		return err
 	}
 
@@ -17,6 +17,8 @@ package asset
 
 import (
 	"nestos-kubernetes-deployer/cmd/command/opts"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -101,6 +103,14 @@ func TestClusterasset(t *testing.T) {
 		}
 	})
 
+	t.Run("InitClusterAsset ExternalCA Success", func(t *testing.T) {
+		cc.CertAsset.ExternalCA = &ExternalCA{Signer: ExternalCASignerCommand, Command: "/usr/local/bin/sign-csr"}
+		defer func() { cc.CertAsset.ExternalCA = nil }()
+		if _, err := cc.InitClusterAsset(opts); err != nil {
+			t.Errorf("InitClusterAsset failed in external CA mode with worker nodes: %v", err)
+		}
+	})
+
 	t.Run("InitClusterAsset Master", func(t *testing.T) {
 		cc.Master = nil
 		clusterConfig, err := cc.InitClusterAsset(opts)
@@ -125,12 +135,32 @@ func TestClusterasset(t *testing.T) {
 	})
 
 	t.Run("Persist Success", func(t *testing.T) {
-		err := cc.Persist("/tmp")
+		err := cc.Persist("/tmp", nil)
 		if err != nil {
 			t.Errorf("Persist failed: %v", err)
 		}
 	})
 
+	t.Run("Persist SecretRef Success", func(t *testing.T) {
+		t.Setenv("NKD_TEST_TOKEN", "abcdef.0123456789abcdef")
+		cc.Kubernetes.Token = "env:NKD_TEST_TOKEN"
+		if err := cc.ResolveSecretRefs(); err != nil {
+			t.Fatalf("ResolveSecretRefs failed: %v", err)
+		}
+		if cc.Kubernetes.Token != "abcdef.0123456789abcdef" {
+			t.Errorf("unexpected resolved token: %s", cc.Kubernetes.Token)
+		}
+
+		dir := t.TempDir()
+		if err := cc.Persist(dir, nil); err != nil {
+			t.Fatalf("Persist failed: %v", err)
+		}
+		data, err := os.ReadFile(dir + "/cluster_config.yaml")
+		if err != nil || !strings.Contains(string(data), "env:NKD_TEST_TOKEN") || strings.Contains(string(data), "0123456789abcdef") {
+			t.Errorf("Persist should write back the secret reference")
+		}
+	})
+
 	t.Run("InitClusterAsset KubernetesAPIVersion Fail", func(t *testing.T) {
 		opts.KubernetesAPIVersion = 21
 		clusterConfig, err := cc.InitClusterAsset(opts)
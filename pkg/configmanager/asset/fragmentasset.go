@@ -0,0 +1,70 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"fmt"
+	"os"
+)
+
+/*
+BootConfigFragments 用户提供的引导配置片段，按角色合并到生成的引导配置中：
+NestOS 使用 Ignition JSON（.ign、.json）或 Butane YAML，通用操作系统使用 cloud-init user-data（#cloud-config）。
+片段依次按 all、master、controlplane 或 worker 及列出的顺序合并，后合并的片段优先
+*/
+type BootConfigFragments struct {
+	All          []string `yaml:"all,omitempty"`
+	Master       []string `yaml:"master,omitempty"`       // 所有 master 节点
+	Controlplane []string `yaml:"controlplane,omitempty"` // 仅初始化集群的第一个 master 节点
+	Worker       []string `yaml:"worker,omitempty"`
+}
+
+// ForRole 按合并顺序返回角色（controlplane、master、worker）使用的片段
+func (f *BootConfigFragments) ForRole(role string) []string {
+	fragments := append([]string{}, f.All...)
+	switch role {
+	case "controlplane":
+		fragments = append(fragments, f.Master...)
+		fragments = append(fragments, f.Controlplane...)
+	case "master":
+		fragments = append(fragments, f.Master...)
+	case "worker":
+		fragments = append(fragments, f.Worker...)
+	}
+	return fragments
+}
+
+// Empty 返回是否未设置任何片段
+func (f *BootConfigFragments) Empty() bool {
+	return len(f.All)+len(f.Master)+len(f.Controlplane)+len(f.Worker) == 0
+}
+
+// Validate 校验片段文件是否存在
+func (f *BootConfigFragments) Validate() error {
+	for _, paths := range [][]string{f.All, f.Master, f.Controlplane, f.Worker} {
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				return fmt.Errorf("invalid boot config fragment: %v", err)
+			}
+			if info.IsDir() {
+				return fmt.Errorf("boot config fragment %s is a directory", path)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBootConfigFragments(t *testing.T) {
+	fragments := &BootConfigFragments{
+		All:          []string{"all.bu"},
+		Master:       []string{"master.bu"},
+		Controlplane: []string{"controlplane.bu"},
+		Worker:       []string{"worker.bu"},
+	}
+
+	t.Run("ForRole Success", func(t *testing.T) {
+		for role, expected := range map[string][]string{
+			"controlplane": {"all.bu", "master.bu", "controlplane.bu"},
+			"master":       {"all.bu", "master.bu"},
+			"worker":       {"all.bu", "worker.bu"},
+		} {
+			if got := fragments.ForRole(role); !reflect.DeepEqual(got, expected) {
+				t.Errorf("unexpected fragments of %s: %v", role, got)
+			}
+		}
+		if !(&BootConfigFragments{}).Empty() || fragments.Empty() {
+			t.Errorf("unexpected result of Empty")
+		}
+	})
+
+	t.Run("Validate Success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "all.bu")
+		if err := os.WriteFile(path, []byte("variant: fcos\nversion: 1.3.0\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := (&BootConfigFragments{All: []string{path}}).Validate(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		if err := fragments.Validate(); err == nil {
+			t.Errorf("expected error for missing fragments")
+		}
+		if err := (&BootConfigFragments{Worker: []string{t.TempDir()}}).Validate(); err == nil {
+			t.Errorf("expected error for a directory")
+		}
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infraasset
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DHCPModeDHCP  = "dhcp"
+	DHCPModeProxy = "proxy"
+)
+
+// DHCPAsset 内置DHCP服务的配置，Mode 为空时不启动，需使用已有的DHCP服务
+type DHCPAsset struct {
+	Mode       string        `yaml:"mode,omitempty"`       // dhcp 分配地址并提供引导信息，proxy 与已有的DHCP服务共存，仅提供引导信息
+	RangeStart string        `yaml:"rangeStart,omitempty"` // dhcp 模式下地址池的起止地址
+	RangeEnd   string        `yaml:"rangeEnd,omitempty"`
+	SubnetMask string        `yaml:"subnetMask,omitempty"` // 默认使用ip所在网段的掩码
+	Router     string        `yaml:"router,omitempty"`
+	DNSServers []string      `yaml:"dnsServers,omitempty"`
+	LeaseTime  string        `yaml:"leaseTime,omitempty"`
+	NextServer string        `yaml:"nextServer,omitempty"` // 提供引导文件的TFTP服务器，默认为ip
+	BootFiles  DHCPBootFiles `yaml:"bootFiles,omitempty"`
+}
+
+// DHCPBootFiles 各架构PXE客户端的引导文件
+type DHCPBootFiles struct {
+	BIOS      string `yaml:"bios,omitempty"`
+	UEFI      string `yaml:"uefi,omitempty"`
+	UEFIArm64 string `yaml:"uefiArm64,omitempty"`
+}
+
+// initDHCPAsset 解析平台配置中的 dhcp 字段，mode 为命令行参数指定的模式
+func initDHCPAsset(value interface{}, mode string, defaults DHCPBootFiles) (DHCPAsset, error) {
+	da := DHCPAsset{}
+	if value != nil {
+		content, err := yaml.Marshal(value)
+		if err != nil {
+			return da, err
+		}
+		if err := yaml.Unmarshal(content, &da); err != nil {
+			return da, fmt.Errorf("failed to parse dhcp config: %v", err)
+		}
+	}
+	if mode != "" {
+		da.Mode = mode
+	}
+
+	switch da.Mode {
+	case "":
+		return da, nil
+	case DHCPModeDHCP:
+		if da.RangeStart == "" || da.RangeEnd == "" {
+			return da, fmt.Errorf("rangeStart and rangeEnd are required in %s mode", DHCPModeDHCP)
+		}
+	case DHCPModeProxy:
+	default:
+		return da, fmt.Errorf("unsupported dhcp mode: %s", da.Mode)
+	}
+
+	for _, ip := range append([]string{da.RangeStart, da.RangeEnd, da.Router, da.NextServer}, da.DNSServers...) {
+		if ip != "" && net.ParseIP(ip).To4() == nil {
+			return da, fmt.Errorf("invalid IPv4 address in dhcp config: %s", ip)
+		}
+	}
+	if da.SubnetMask != "" && net.ParseIP(da.SubnetMask).To4() == nil {
+		return da, fmt.Errorf("invalid subnet mask in dhcp config: %s", da.SubnetMask)
+	}
+	if da.LeaseTime != "" {
+		if _, err := time.ParseDuration(da.LeaseTime); err != nil {
+			return da, fmt.Errorf("invalid lease time in dhcp config: %v", err)
+		}
+	}
+
+	if da.BootFiles.BIOS == "" {
+		da.BootFiles.BIOS = defaults.BIOS
+	}
+	if da.BootFiles.UEFI == "" {
+		da.BootFiles.UEFI = defaults.UEFI
+	}
+	if da.BootFiles.UEFIArm64 == "" {
+		da.BootFiles.UEFIArm64 = defaults.UEFIArm64
+	}
+	return da, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package infraasset
 
 import (
+	"errors"
 	"nestos-kubernetes-deployer/cmd/command/opts"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 )
@@ -24,21 +25,42 @@ import (
 type IPXEAsset struct {
 	IP                string
 	Port              string
-	FilePath          string `yaml:"filePath"`
-	OSInstallTreePath string `yaml:"osInstallTreePath"`
+	FilePath          string    `yaml:"filePath,omitempty"`
+	OSInstallTreePath string    `yaml:"osInstallTreePath"`
+	KernelPath        string    `yaml:"kernelPath"`
+	InitrdPath        string    `yaml:"initrdPath"`
+	KernelArgs        string    `yaml:"kernelArgs,omitempty"`
+	DHCP              DHCPAsset `yaml:"dhcp,omitempty"`
 }
 
 func (ia *IPXEAsset) InitAsset(ipxeMap map[string]interface{}, opts *opts.OptionsList, args ...interface{}) (InfraAsset, error) {
+	updateFieldFromMap("ip", &ia.IP, ipxeMap)
+	asset.SetStringValue(&ia.IP, opts.InfraPlatform.IPXE.IP, "")
+
 	updateFieldFromMap("port", &ia.Port, ipxeMap)
 	asset.SetStringValue(&ia.Port, opts.InfraPlatform.IPXE.Port, "9080")
 
+	// 未指定引导脚本时，根据集群配置生成引导菜单
 	updateFieldFromMap("filePath", &ia.FilePath, ipxeMap)
-	if err := asset.CheckStringValue(&ia.FilePath, opts.InfraPlatform.IPXE.FilePath, "ipxe-osInstallTreePath"); err != nil {
-		return nil, err
+	asset.SetStringValue(&ia.FilePath, opts.InfraPlatform.IPXE.FilePath, "")
+	if ia.FilePath == "" && ia.IP == "" {
+		return nil, errors.New("ip is required to generate the ipxe boot script, or specify filePath")
 	}
 
 	updateFieldFromMap("osInstallTreePath", &ia.OSInstallTreePath, ipxeMap)
 	asset.SetStringValue(&ia.OSInstallTreePath, opts.InfraPlatform.IPXE.OSInstallTreePath, "/var/www/html/")
 
+	initBootMenuFields(ipxeMap, &ia.KernelPath, &ia.InitrdPath, &ia.KernelArgs)
+
+	// iPXE客户端直接获取引导脚本，其余客户端的引导文件（如iPXE程序）需由用户指定
+	dhcp, err := initDHCPAsset(ipxeMap["dhcp"], opts.InfraPlatform.IPXE.DHCPMode, DHCPBootFiles{})
+	if err != nil {
+		return nil, err
+	}
+	if dhcp.Mode != "" && ia.IP == "" {
+		return nil, errors.New("ip is required by the built-in dhcp service")
+	}
+	ia.DHCP = dhcp
+
 	return ia, nil
 }
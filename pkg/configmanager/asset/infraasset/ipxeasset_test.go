@@ -42,9 +42,23 @@ func TestIPXE(t *testing.T) {
 		}
 	})
 
+	t.Run("InitAsset Generated Script Success", func(t *testing.T) {
+		ia := IPXEAsset{}
+		opts.InfraPlatform.IPXE.FilePath = ""
+		_, err := ia.InitAsset(map[string]interface{}{"kernelArgs": "console=ttyS0"}, opts, nil)
+		if err != nil {
+			t.Errorf("InitAsset failed: %v", err)
+		}
+		if ia.FilePath != "" || ia.KernelPath != "images/pxeboot/vmlinuz" || ia.KernelArgs != "console=ttyS0" {
+			t.Errorf("unexpected ipxe asset: %+v", ia)
+		}
+	})
+
 	t.Run("InitAsset Fail", func(t *testing.T) {
 		la.FilePath = ""
+		la.IP = ""
 		opts.InfraPlatform.IPXE.FilePath = ""
+		opts.InfraPlatform.IPXE.IP = ""
 		_, err := la.InitAsset(nil, opts, nil)
 		if err == nil {
 			t.Log("Expected error, got nil")
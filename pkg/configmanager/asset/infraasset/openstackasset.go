@@ -33,6 +33,10 @@ type OpenStackAsset struct {
 	AvailabilityZone string `yaml:"availabilityZone"`
 }
 
+func (oa *OpenStackAsset) SecretFields() []*string {
+	return []*string{&oa.Password}
+}
+
 func (oa *OpenStackAsset) InitAsset(openstackMap map[string]interface{}, opts *opts.OptionsList, args ...interface{}) (InfraAsset, error) {
 	updateFieldFromMap("username", &oa.UserName, openstackMap)
 	asset.SetStringValue(&oa.UserName, opts.InfraPlatform.OpenStack.UserName, "admin")
@@ -23,10 +23,15 @@ import (
 
 type PXEAsset struct {
 	IP             string
-	HTTPServerPort string `yaml:"httpServerPort"`
-	HTTPRootDir    string `yaml:"httpRootDir"`
-	TFTPServerPort string `yaml:"tftpServerPort"`
-	TFTPRootDir    string `yaml:"tftpRootDir"`
+	HTTPServerPort string    `yaml:"httpServerPort"`
+	HTTPRootDir    string    `yaml:"httpRootDir"`
+	TFTPServerPort string    `yaml:"tftpServerPort"`
+	TFTPRootDir    string    `yaml:"tftpRootDir"`
+	TFTPWritable   bool      `yaml:"tftpWritable,omitempty"`
+	KernelPath     string    `yaml:"kernelPath"`
+	InitrdPath     string    `yaml:"initrdPath"`
+	KernelArgs     string    `yaml:"kernelArgs,omitempty"`
+	DHCP           DHCPAsset `yaml:"dhcp,omitempty"`
 }
 
 func (pa *PXEAsset) InitAsset(pxeMap map[string]interface{}, opts *opts.OptionsList, args ...interface{}) (InfraAsset, error) {
@@ -47,5 +52,33 @@ func (pa *PXEAsset) InitAsset(pxeMap map[string]interface{}, opts *opts.OptionsL
 	updateFieldFromMap("tftpRootDir", &pa.TFTPRootDir, pxeMap)
 	asset.SetStringValue(&pa.TFTPRootDir, opts.InfraPlatform.PXE.TFTPRootDir, "/var/lib/tftpboot/")
 
+	// TFTP服务默认只读
+	if writable, ok := pxeMap["tftpWritable"].(bool); ok {
+		pa.TFTPWritable = writable
+	}
+
+	initBootMenuFields(pxeMap, &pa.KernelPath, &pa.InitrdPath, &pa.KernelArgs)
+
+	dhcp, err := initDHCPAsset(pxeMap["dhcp"], opts.InfraPlatform.PXE.DHCPMode, DHCPBootFiles{
+		BIOS:      "pxelinux.0",
+		UEFI:      "grubx64.efi",
+		UEFIArm64: "grubaa64.efi",
+	})
+	if err != nil {
+		return nil, err
+	}
+	pa.DHCP = dhcp
+
 	return pa, nil
 }
+
+// initBootMenuFields 设置引导菜单使用的内核、initrd（相对于操作系统安装树的路径）及追加的内核参数
+func initBootMenuFields(m map[string]interface{}, kernelPath, initrdPath, kernelArgs *string) {
+	updateFieldFromMap("kernelPath", kernelPath, m)
+	asset.SetStringValue(kernelPath, "", "images/pxeboot/vmlinuz")
+
+	updateFieldFromMap("initrdPath", initrdPath, m)
+	asset.SetStringValue(initrdPath, "", "images/pxeboot/initrd.img")
+
+	updateFieldFromMap("kernelArgs", kernelArgs, m)
+}
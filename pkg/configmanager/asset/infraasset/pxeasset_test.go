@@ -49,4 +49,46 @@ func TestPXE(t *testing.T) {
 			t.Logf("InitAsset failed: %v", err)
 		}
 	})
+
+	t.Run("InitAsset TFTPWritable Success", func(t *testing.T) {
+		writable := PXEAsset{}
+		if _, err := writable.InitAsset(map[string]interface{}{"tftpWritable": true}, opts, nil); err != nil {
+			t.Fatalf("InitAsset failed: %v", err)
+		}
+		if !writable.TFTPWritable || pa.TFTPWritable {
+			t.Errorf("the tftp service should be read-only unless tftpWritable is set")
+		}
+	})
+
+	t.Run("InitAsset DHCP Success", func(t *testing.T) {
+		pxeMap := map[string]interface{}{
+			"dhcp": map[interface{}]interface{}{
+				"mode":       "dhcp",
+				"rangeStart": "192.168.1.100",
+				"rangeEnd":   "192.168.1.200",
+				"bootFiles":  map[interface{}]interface{}{"uefi": "shimx64.efi"},
+			},
+		}
+		infraAsset, err := (&PXEAsset{}).InitAsset(pxeMap, opts, nil)
+		if err != nil {
+			t.Fatalf("InitAsset failed: %v", err)
+		}
+		dhcp := infraAsset.(*PXEAsset).DHCP
+		if dhcp.Mode != DHCPModeDHCP || dhcp.BootFiles.UEFI != "shimx64.efi" || dhcp.BootFiles.BIOS != "pxelinux.0" {
+			t.Errorf("unexpected dhcp config: %+v", dhcp)
+		}
+	})
+
+	t.Run("InitAsset DHCP Fail", func(t *testing.T) {
+		for _, dhcp := range []map[interface{}]interface{}{
+			{"mode": "unknown"},
+			{"mode": "dhcp"},
+			{"mode": "proxy", "router": "not-an-ip"},
+			{"mode": "proxy", "leaseTime": "1 day"},
+		} {
+			if _, err := (&PXEAsset{}).InitAsset(map[string]interface{}{"dhcp": dhcp}, opts, nil); err == nil {
+				t.Errorf("expected error for dhcp config %v", dhcp)
+			}
+		}
+	})
 }
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KickstartAsset 通用操作系统在 pxe/ipxe 平台上的安装参数，节点的设置优先于集群的设置
+type KickstartAsset struct {
+	Disk           string   `yaml:"disk,omitempty"`           // 安装目标磁盘，如 sda、nvme0n1 或 /dev/disk/by-id/ 下的路径
+	AutopartType   string   `yaml:"autopartType,omitempty"`   // 自动分区的类型：lvm、btrfs、plain、thinp
+	Partitions     []string `yaml:"partitions,omitempty"`     // 分区命令（part、volgroup、logvol等），设置后不再自动分区
+	BootloaderArgs string   `yaml:"bootloaderArgs,omitempty"` // 追加到已安装系统的内核参数
+	Keyboard       string   `yaml:"keyboard,omitempty"`
+	Lang           string   `yaml:"lang,omitempty"`
+	Timezone       string   `yaml:"timezone,omitempty"`
+	NTPServers     []string `yaml:"ntpServers,omitempty"`
+	Packages       []string `yaml:"packages,omitempty"` // 包组（以@开头）及软件包
+	Pre            string   `yaml:"pre,omitempty"`      // 追加的 %pre 脚本
+	Post           string   `yaml:"post,omitempty"`     // 追加到 %post 末尾的脚本
+}
+
+// defaultKickstart 返回与早期版本kickstart文件相同的默认安装参数
+func defaultKickstart() KickstartAsset {
+	return KickstartAsset{
+		Disk:         "sda",
+		AutopartType: "lvm",
+		Keyboard:     "cn",
+		Lang:         "zh_CN.UTF-8",
+		Timezone:     "Asia/Shanghai",
+		Packages:     []string{"@^minimal-environment"},
+	}
+}
+
+// NodeKickstart 返回节点的安装参数：节点的设置优先于集群的设置，均未设置的参数使用默认值
+func (clusterAsset *ClusterAsset) NodeKickstart(node *NodeAsset) KickstartAsset {
+	ks := defaultKickstart()
+	ks.override(&clusterAsset.Kickstart)
+	if node != nil {
+		ks.override(node.Kickstart)
+	}
+	return ks
+}
+
+// override 使用 other 中已设置的参数覆盖当前参数
+func (ks *KickstartAsset) override(other *KickstartAsset) {
+	if other == nil {
+		return
+	}
+	for _, field := range []struct {
+		target *string
+		value  string
+	}{
+		{&ks.Disk, other.Disk},
+		{&ks.AutopartType, other.AutopartType},
+		{&ks.BootloaderArgs, other.BootloaderArgs},
+		{&ks.Keyboard, other.Keyboard},
+		{&ks.Lang, other.Lang},
+		{&ks.Timezone, other.Timezone},
+		{&ks.Pre, other.Pre},
+		{&ks.Post, other.Post},
+	} {
+		if field.value != "" {
+			*field.target = field.value
+		}
+	}
+	if len(other.Partitions) > 0 {
+		ks.Partitions = other.Partitions
+	}
+	if len(other.NTPServers) > 0 {
+		ks.NTPServers = other.NTPServers
+	}
+	if len(other.Packages) > 0 {
+		ks.Packages = other.Packages
+	}
+}
+
+// Validate 校验安装参数
+func (ks *KickstartAsset) Validate() error {
+	if ks == nil {
+		return nil
+	}
+	if strings.ContainsAny(ks.Disk, " \t\n,") {
+		return fmt.Errorf("invalid kickstart disk %q", ks.Disk)
+	}
+	switch ks.AutopartType {
+	case "", "lvm", "btrfs", "plain", "thinp":
+	default:
+		return fmt.Errorf("invalid kickstart autopartType %q", ks.AutopartType)
+	}
+	for _, part := range ks.Partitions {
+		if part == "" || strings.Contains(part, "\n") {
+			return fmt.Errorf("kickstart partition command %q should be a single line", part)
+		}
+	}
+	for name, value := range map[string]string{"keyboard": ks.Keyboard, "lang": ks.Lang, "timezone": ks.Timezone} {
+		if strings.ContainsAny(value, " \t\n'\"") {
+			return fmt.Errorf("invalid kickstart %s %q", name, value)
+		}
+	}
+	if strings.ContainsAny(ks.BootloaderArgs, "\n\"") {
+		return fmt.Errorf("invalid kickstart bootloaderArgs %q", ks.BootloaderArgs)
+	}
+	for _, server := range ks.NTPServers {
+		if server == "" || strings.ContainsAny(server, " \t\n,") {
+			return fmt.Errorf("invalid kickstart ntp server %q", server)
+		}
+	}
+	for _, pkg := range ks.Packages {
+		if pkg == "" || strings.ContainsAny(pkg, " \t\n") {
+			return fmt.Errorf("invalid kickstart package %q", pkg)
+		}
+	}
+	// 脚本中以 % 开头的行（如 %end）会提前结束所在的段
+	for name, script := range map[string]string{"pre": ks.Pre, "post": ks.Post} {
+		for _, line := range strings.Split(script, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "%") {
+				return fmt.Errorf("kickstart %s script should not contain the section line %q", name, line)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKickstartAsset(t *testing.T) {
+	t.Run("NodeKickstart Success", func(t *testing.T) {
+		clusterAsset := &ClusterAsset{
+			Kickstart: KickstartAsset{Disk: "nvme0n1", Timezone: "Europe/Berlin", NTPServers: []string{"ntp.example.com"}},
+		}
+
+		ks := clusterAsset.NodeKickstart(nil)
+		if ks.Disk != "nvme0n1" || ks.Timezone != "Europe/Berlin" || ks.AutopartType != "lvm" || ks.Lang != "zh_CN.UTF-8" {
+			t.Errorf("unexpected cluster kickstart settings: %+v", ks)
+		}
+		if !reflect.DeepEqual(ks.Packages, []string{"@^minimal-environment"}) {
+			t.Errorf("unexpected default packages: %v", ks.Packages)
+		}
+
+		node := &NodeAsset{Hostname: "k8s-worker01", Kickstart: &KickstartAsset{Disk: "sdb", Packages: []string{"@core"}}}
+		ks = clusterAsset.NodeKickstart(node)
+		if ks.Disk != "sdb" || ks.Timezone != "Europe/Berlin" || !reflect.DeepEqual(ks.Packages, []string{"@core"}) {
+			t.Errorf("node settings should override the cluster settings: %+v", ks)
+		}
+		if clusterAsset.Kickstart.Disk != "nvme0n1" || len(clusterAsset.Kickstart.Packages) != 0 {
+			t.Errorf("cluster settings should not be modified: %+v", clusterAsset.Kickstart)
+		}
+	})
+
+	t.Run("Validate Success", func(t *testing.T) {
+		var ks *KickstartAsset
+		if err := ks.Validate(); err != nil {
+			t.Errorf("unexpected error for empty settings: %v", err)
+		}
+		ks = &KickstartAsset{
+			Disk:       "/dev/disk/by-id/nvme-example",
+			Partitions: []string{"part /boot --fstype=xfs --size=1024", "part / --fstype=xfs --grow"},
+			Post:       "echo done > /root/nkd\nsync",
+		}
+		if err := ks.Validate(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		for _, ks := range []*KickstartAsset{
+			{Disk: "sda,sdb"},
+			{AutopartType: "zfs"},
+			{Partitions: []string{"part /boot\npart /"}},
+			{Timezone: "Asia/Shanghai --utc"},
+			{BootloaderArgs: "console=\"ttyS0\""},
+			{NTPServers: []string{"a,b"}},
+			{Packages: []string{"@core vim"}},
+			{Post: "echo done\n%end\nreboot"},
+		} {
+			if err := ks.Validate(); err == nil {
+				t.Errorf("expected error for %+v", ks)
+			}
+		}
+	})
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// 网络接口的类型
+const (
+	InterfaceEthernet = "ethernet"
+	InterfaceBond     = "bond"
+	InterfaceVLAN     = "vlan"
+	InterfaceBridge   = "bridge"
+)
+
+// bootInterface 为节点引导网卡设置了 MAC 地址而未设置名称时，由其生成的网络接口的名称
+const bootInterface = "boot"
+
+// NodeNetwork 节点的网络配置，由引导配置生成 NetworkManager 配置、cloud-init network-config 或 kickstart network 命令
+type NodeNetwork struct {
+	Interfaces []NetworkInterface `yaml:"interfaces"`
+	DNS        []string           `yaml:"dns,omitempty"`
+	Search     []string           `yaml:"search,omitempty"` // DNS 搜索域
+}
+
+// NetworkInterface 网络接口，未设置地址时使用 DHCP 获取地址（vlan 的父接口除外）
+type NetworkInterface struct {
+	Name      string         `yaml:"name"`
+	Type      string         `yaml:"type,omitempty"`      // ethernet（默认）、bond、vlan、bridge
+	MAC       string         `yaml:"mac,omitempty"`       // 按 MAC 地址匹配物理网卡，仅用于 ethernet
+	Addresses []string       `yaml:"addresses,omitempty"` // CIDR 格式的地址，如 192.168.10.11/24
+	Gateway   string         `yaml:"gateway,omitempty"`
+	Routes    []NetworkRoute `yaml:"routes,omitempty"`
+	MTU       int            `yaml:"mtu,omitempty"`
+	Members   []string       `yaml:"members,omitempty"`  // bond 或 bridge 的成员网卡
+	BondMode  string         `yaml:"bondMode,omitempty"` // 默认为 active-backup
+	VLANID    int            `yaml:"vlanID,omitempty"`
+	Parent    string         `yaml:"parent,omitempty"` // vlan 所在的网卡
+}
+
+type NetworkRoute struct {
+	To     string `yaml:"to"` // CIDR 格式的目的网段
+	Via    string `yaml:"via"`
+	Metric int    `yaml:"metric,omitempty"`
+}
+
+var bondModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+// Validate 校验网络配置，并将接口类型、MAC 地址和 bond 模式转换为规范格式
+func (network *NodeNetwork) Validate() error {
+	if network == nil {
+		return nil
+	}
+	if len(network.Interfaces) == 0 {
+		return fmt.Errorf("network interfaces are required")
+	}
+	for _, server := range network.DNS {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("invalid dns server %q", server)
+		}
+	}
+
+	names := make(map[string]*NetworkInterface)
+	for i := range network.Interfaces {
+		iface := &network.Interfaces[i]
+		if iface.Name == "" || len(iface.Name) > 15 || strings.ContainsAny(iface.Name, " \t/:") {
+			return fmt.Errorf("invalid interface name %q", iface.Name)
+		}
+		if _, ok := names[iface.Name]; ok {
+			return fmt.Errorf("duplicate interface %s", iface.Name)
+		}
+		names[iface.Name] = iface
+		if err := iface.validate(); err != nil {
+			return fmt.Errorf("interface %s: %v", iface.Name, err)
+		}
+	}
+
+	// 成员网卡只能属于一个 bond 或 bridge，且由其所属的接口配置地址；bridge 的成员可以是 bond 或 vlan
+	owners := make(map[string]string)
+	for _, iface := range network.Interfaces {
+		for _, member := range iface.Members {
+			if owner, ok := owners[member]; ok {
+				return fmt.Errorf("interface %s is a member of both %s and %s", member, owner, iface.Name)
+			}
+			owners[member] = iface.Name
+			m, ok := names[member]
+			if !ok {
+				continue
+			}
+			if iface.Type == InterfaceBond && m.Type != InterfaceEthernet {
+				return fmt.Errorf("member %s of bond %s must be an ethernet interface", member, iface.Name)
+			}
+			if m.Type == InterfaceBridge {
+				return fmt.Errorf("member %s of %s can not be a bridge", member, iface.Name)
+			}
+			if len(m.Addresses) > 0 || m.Gateway != "" || len(m.Routes) > 0 {
+				return fmt.Errorf("member %s of %s can not have addresses, gateway or routes", member, iface.Name)
+			}
+		}
+		if iface.Type == InterfaceVLAN {
+			if p, ok := names[iface.Parent]; ok && p.Type == InterfaceVLAN {
+				return fmt.Errorf("parent %s of vlan %s can not be a vlan", iface.Parent, iface.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (iface *NetworkInterface) validate() error {
+	if iface.Type == "" {
+		iface.Type = InterfaceEthernet
+	}
+	iface.Type = strings.ToLower(iface.Type)
+
+	switch iface.Type {
+	case InterfaceEthernet:
+		if iface.MAC != "" {
+			hw, err := net.ParseMAC(iface.MAC)
+			if err != nil || len(hw) != 6 {
+				return fmt.Errorf("invalid mac address %q", iface.MAC)
+			}
+			iface.MAC = hw.String()
+		}
+	case InterfaceBond:
+		if len(iface.Members) == 0 {
+			return fmt.Errorf("members are required by a bond")
+		}
+		if iface.BondMode == "" {
+			iface.BondMode = "active-backup"
+		}
+		if !contains(bondModes, iface.BondMode) {
+			return fmt.Errorf("unsupported bond mode %q, supported modes: %s", iface.BondMode, strings.Join(bondModes, ", "))
+		}
+	case InterfaceVLAN:
+		if iface.VLANID < 1 || iface.VLANID > 4094 {
+			return fmt.Errorf("invalid vlan id %d", iface.VLANID)
+		}
+		if iface.Parent == "" {
+			return fmt.Errorf("parent is required by a vlan")
+		}
+	case InterfaceBridge:
+	default:
+		return fmt.Errorf("unsupported interface type %q", iface.Type)
+	}
+	if iface.Type != InterfaceEthernet && iface.MAC != "" {
+		return fmt.Errorf("mac address is only supported by ethernet interfaces")
+	}
+	if iface.Type != InterfaceBond && iface.BondMode != "" {
+		return fmt.Errorf("bond mode is only supported by bond interfaces")
+	}
+	if iface.Type != InterfaceBond && iface.Type != InterfaceBridge && len(iface.Members) > 0 {
+		return fmt.Errorf("members are only supported by bond and bridge interfaces")
+	}
+	if iface.Type != InterfaceVLAN && (iface.VLANID != 0 || iface.Parent != "") {
+		return fmt.Errorf("vlan id and parent are only supported by vlan interfaces")
+	}
+	if iface.MTU < 0 || (iface.MTU > 0 && iface.MTU < 576) || iface.MTU > 65535 {
+		return fmt.Errorf("invalid mtu %d", iface.MTU)
+	}
+
+	for _, address := range iface.Addresses {
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			return fmt.Errorf("invalid address %q, CIDR notation is required", address)
+		}
+	}
+	if iface.Gateway != "" {
+		gateway := net.ParseIP(iface.Gateway)
+		if gateway == nil {
+			return fmt.Errorf("invalid gateway %q", iface.Gateway)
+		}
+		if !iface.HasStaticAddress(gateway.To4() != nil) {
+			return fmt.Errorf("gateway %s requires a static address of the same family", iface.Gateway)
+		}
+	}
+	for _, route := range iface.Routes {
+		if _, _, err := net.ParseCIDR(route.To); err != nil {
+			return fmt.Errorf("invalid route destination %q, CIDR notation is required", route.To)
+		}
+		if net.ParseIP(route.Via) == nil {
+			return fmt.Errorf("invalid route gateway %q", route.Via)
+		}
+		if route.Metric < 0 {
+			return fmt.Errorf("invalid route metric %d", route.Metric)
+		}
+	}
+	return nil
+}
+
+// HasStaticAddress 返回接口是否设置了指定地址族（IPv4 或 IPv6）的静态地址
+func (iface *NetworkInterface) HasStaticAddress(ipv4 bool) bool {
+	for _, address := range iface.Addresses {
+		if ip, _, err := net.ParseCIDR(address); err == nil && (ip.To4() != nil) == ipv4 {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectiveNetwork 返回节点的网络配置：未设置 network 时由引导网卡的静态网络设置（interface/mac、netmask、gateway、dns）生成，
+// 引导网卡既无名称也无 MAC 地址或未使用静态地址时返回 nil
+func (node *NodeAsset) EffectiveNetwork() *NodeNetwork {
+	if node.Network != nil {
+		return node.Network
+	}
+	if !node.StaticNetwork() || (node.Interface == "" && node.MAC == "") {
+		return nil
+	}
+
+	prefix := node.Netmask
+	if mask := net.ParseIP(node.Netmask).To4(); mask != nil {
+		ones, _ := net.IPMask(mask).Size()
+		prefix = fmt.Sprint(ones)
+	}
+	iface := NetworkInterface{
+		Name:      node.Interface,
+		Type:      InterfaceEthernet,
+		Addresses: []string{node.IP + "/" + prefix},
+		Gateway:   node.Gateway,
+	}
+	if iface.Name == "" {
+		iface.Name = bootInterface
+		iface.MAC = node.MAC
+	}
+	return &NodeNetwork{
+		Interfaces: []NetworkInterface{iface},
+		DNS:        node.DNS,
+	}
+}
+
+// Static 返回是否有接口使用静态地址，此时节点不再通过 DHCP 获取地址
+func (network *NodeNetwork) Static() bool {
+	for _, iface := range network.Interfaces {
+		if len(iface.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberOf 返回以 name 为成员的 bond 或 bridge
+func (network *NodeNetwork) MemberOf(name string) *NetworkInterface {
+	for i, iface := range network.Interfaces {
+		if contains(iface.Members, name) {
+			return &network.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+// Unaddressed 返回接口是否不配置地址：未设置静态地址的 vlan 父接口仅承载 vlan，不通过 DHCP 获取地址
+func (network *NodeNetwork) Unaddressed(iface *NetworkInterface) bool {
+	if len(iface.Addresses) > 0 {
+		return false
+	}
+	for _, vlan := range network.Interfaces {
+		if vlan.Type == InterfaceVLAN && vlan.Parent == iface.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Interface 返回名称为 name 的网络接口
+func (network *NodeNetwork) Interface(name string) *NetworkInterface {
+	for i, iface := range network.Interfaces {
+		if iface.Name == name {
+			return &network.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+// UndeclaredMembers 返回未在 interfaces 中声明的 bond 或 bridge 成员网卡，按名称匹配
+func (network *NodeNetwork) UndeclaredMembers() []string {
+	var members []string
+	for _, iface := range network.Interfaces {
+		for _, member := range iface.Members {
+			if network.Interface(member) == nil {
+				members = append(members, member)
+			}
+		}
+	}
+	return members
+}
+
+// PrimaryInterface 返回承载 DNS 设置的接口：优先选择设置了网关的接口，否则为第一个非成员接口
+func (network *NodeNetwork) PrimaryInterface() string {
+	first := ""
+	for _, iface := range network.Interfaces {
+		if network.MemberOf(iface.Name) != nil {
+			continue
+		}
+		if iface.Gateway != "" {
+			return iface.Name
+		}
+		if first == "" {
+			first = iface.Name
+		}
+	}
+	return first
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import "testing"
+
+func TestNodeNetwork(t *testing.T) {
+	t.Run("Validate Success", func(t *testing.T) {
+		node := &NodeAsset{
+			Hostname: "k8s-worker01",
+			IP:       "192.168.1.11",
+			Network: &NodeNetwork{
+				Interfaces: []NetworkInterface{
+					{Name: "eno1", MAC: "52-54-00-AA-BB-01"},
+					{Name: "bond0", Type: "Bond", Members: []string{"eno1", "eno2"}},
+					{Name: "bond0.100", Type: "vlan", VLANID: 100, Parent: "bond0", Addresses: []string{"192.168.1.11/24", "fd00::11/64"},
+						Gateway: "192.168.1.1", Routes: []NetworkRoute{{To: "10.0.0.0/8", Via: "192.168.1.254", Metric: 100}}},
+				},
+				DNS:    []string{"192.168.1.2"},
+				Search: []string{"example.com"},
+			},
+		}
+		if err := node.ValidateNetwork(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		network := node.EffectiveNetwork()
+		if network.Interfaces[0].Type != InterfaceEthernet || network.Interfaces[0].MAC != "52:54:00:aa:bb:01" {
+			t.Errorf("ethernet interface is not normalized: %+v", network.Interfaces[0])
+		}
+		if network.Interfaces[1].Type != InterfaceBond || network.Interfaces[1].BondMode != "active-backup" {
+			t.Errorf("bond interface is not normalized: %+v", network.Interfaces[1])
+		}
+		if network.MemberOf("eno2").Name != "bond0" || network.PrimaryInterface() != "bond0.100" || !network.Static() {
+			t.Errorf("unexpected network topology: %+v", network)
+		}
+		if members := network.UndeclaredMembers(); len(members) != 1 || members[0] != "eno2" {
+			t.Errorf("unexpected undeclared members: %v", members)
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		for _, node := range []*NodeAsset{
+			{Hostname: "n", Network: &NodeNetwork{}},
+			{Hostname: "n", Netmask: "24", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "eth0"}}}},
+			{Hostname: "n", IP: "192.168.1.12", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "eth0", Addresses: []string{"192.168.1.11/24"}}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "eth0", Addresses: []string{"192.168.1.11"}}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "eth0", Gateway: "192.168.1.1"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "eth0"}, {Name: "eth0"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "bond0", Type: "bond"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "bond0", Type: "bond", Members: []string{"eth0"}, BondMode: "fastest"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "vlan5000", Type: "vlan", VLANID: 5000, Parent: "eth0"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{{Name: "br0", Type: "team"}}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{
+				{Name: "eth0", Addresses: []string{"192.168.1.11/24"}},
+				{Name: "bond0", Type: "bond", Members: []string{"eth0"}},
+			}}},
+			{Hostname: "n", Network: &NodeNetwork{Interfaces: []NetworkInterface{
+				{Name: "bond0", Type: "bond", Members: []string{"eth0"}},
+				{Name: "br0", Type: "bridge", Members: []string{"eth0"}},
+			}}},
+		} {
+			if err := node.ValidateNetwork(); err == nil {
+				t.Errorf("expected error for network %+v", node.Network)
+			}
+		}
+	})
+
+	t.Run("EffectiveNetwork Success", func(t *testing.T) {
+		node := &NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11", MAC: "52:54:00:aa:bb:01", Netmask: "255.255.255.0", Gateway: "192.168.1.1"}
+		network := node.EffectiveNetwork()
+		if network == nil || len(network.Interfaces) != 1 {
+			t.Fatalf("expected a network generated from the static settings, got %+v", network)
+		}
+		iface := network.Interfaces[0]
+		if iface.Name != bootInterface || iface.MAC != node.MAC || iface.Addresses[0] != "192.168.1.11/24" || iface.Gateway != node.Gateway {
+			t.Errorf("unexpected interface: %+v", iface)
+		}
+
+		if network := (&NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "24"}).EffectiveNetwork(); network != nil {
+			t.Errorf("expected no network without interface and mac, got %+v", network)
+		}
+	})
+}
@@ -16,13 +16,31 @@ limitations under the License.
 
 package asset
 
-import "nestos-kubernetes-deployer/pkg/utils"
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
 
 type NodeAsset struct {
-	Hostname     string
-	IP           string
-	HardwareInfo `yaml:"hardwareInfo,omitempty"`
-	Certs        []utils.StorageContent `json:"-" yaml:"-"` // Certificates content (not printed in JSON and YAML)
+	Hostname      string
+	IP            string
+	MAC           string            `yaml:"mac,omitempty"`       // 引导网卡的MAC地址，内置DHCP服务据此保留IP地址
+	Interface     string            `yaml:"interface,omitempty"` // 引导网卡的名称，为空时按MAC地址选择网卡
+	Netmask       string            `yaml:"netmask,omitempty"`   // 子网掩码或前缀长度，设置后节点使用静态IP地址
+	Gateway       string            `yaml:"gateway,omitempty"`
+	DNS           []string          `yaml:"dns,omitempty"`
+	Network       *NodeNetwork      `yaml:"network,omitempty"`   // 节点的网络接口配置，设置后不能再设置 netmask、gateway 和 dns
+	Labels        map[string]string `yaml:"labels,omitempty"`    // 节点注册时由kubelet设置的标签
+	Kickstart     *KickstartAsset   `yaml:"kickstart,omitempty"` // 覆盖集群的安装参数
+	HardwareInfo  `yaml:"hardwareInfo,omitempty"`
+	Certs         []utils.StorageContent `json:"-" yaml:"-"`              // Certificates content (not printed in JSON and YAML)
+	BootConfig    BootFile               `yaml:"bootConfig,omitempty"`    // 节点专属引导配置
+	NetworkConfig BootFile               `yaml:"networkConfig,omitempty"` // 节点的 cloud-init network-config
 }
 
 type HardwareInfo struct {
@@ -30,3 +48,134 @@ type HardwareInfo struct {
 	RAM  uint
 	Disk uint
 }
+
+// ValidateLabels 校验节点标签。kubelet 仅允许在注册时设置 kubernetes.io 和 k8s.io 命名空间中
+// kubelet.kubernetes.io 与 node.kubernetes.io 前缀的标签，其余标签（如 node-role.kubernetes.io）需在节点加入集群后设置
+func (node *NodeAsset) ValidateLabels() error {
+	for key, value := range node.Labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q of node %s: %s", key, node.Hostname, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %q of node %s: %s", value, node.Hostname, strings.Join(errs, "; "))
+		}
+		if !kubeletAllowedLabel(key) {
+			return fmt.Errorf("label %q of node %s can not be set by kubelet", key, node.Hostname)
+		}
+	}
+	return nil
+}
+
+// ValidateMAC 校验节点的MAC地址，并转换为小写冒号分隔的格式
+func (node *NodeAsset) ValidateMAC() error {
+	if node.MAC == "" {
+		return nil
+	}
+	hw, err := net.ParseMAC(node.MAC)
+	if err != nil || len(hw) != 6 {
+		return fmt.Errorf("invalid mac address %q of node %s", node.MAC, node.Hostname)
+	}
+	node.MAC = hw.String()
+	return nil
+}
+
+// ValidateNetwork 校验节点引导网卡的静态网络配置和 network 配置，IPv4 的前缀长度转换为子网掩码
+func (node *NodeAsset) ValidateNetwork() error {
+	if node.Network != nil {
+		return node.validateNetworkInterfaces()
+	}
+	if node.Interface != "" && strings.ContainsAny(node.Interface, " \t/") {
+		return fmt.Errorf("invalid interface name %q of node %s", node.Interface, node.Hostname)
+	}
+	if node.Gateway != "" && net.ParseIP(node.Gateway) == nil {
+		return fmt.Errorf("invalid gateway %q of node %s", node.Gateway, node.Hostname)
+	}
+	for _, server := range node.DNS {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("invalid dns server %q of node %s", server, node.Hostname)
+		}
+	}
+	if node.Netmask == "" {
+		if node.Gateway != "" || len(node.DNS) > 0 {
+			return fmt.Errorf("netmask of node %s is required by the static network settings", node.Hostname)
+		}
+		return nil
+	}
+
+	ip := net.ParseIP(node.IP)
+	if ip == nil {
+		return fmt.Errorf("ip of node %s is required by the static network settings", node.Hostname)
+	}
+	bits := net.IPv6len * 8
+	if ip.To4() != nil {
+		bits = net.IPv4len * 8
+	}
+	if ones, err := strconv.Atoi(node.Netmask); err == nil {
+		if ones < 0 || ones > bits {
+			return fmt.Errorf("invalid netmask %q of node %s", node.Netmask, node.Hostname)
+		}
+		if ip.To4() != nil {
+			node.Netmask = net.IP(net.CIDRMask(ones, bits)).String()
+		}
+		return nil
+	}
+	mask := net.ParseIP(node.Netmask).To4()
+	if ip.To4() == nil || mask == nil {
+		return fmt.Errorf("invalid netmask %q of node %s", node.Netmask, node.Hostname)
+	}
+	if ones, _ := net.IPMask(mask).Size(); ones == 0 && !mask.Equal(net.IPv4zero) {
+		return fmt.Errorf("invalid netmask %q of node %s", node.Netmask, node.Hostname)
+	}
+	return nil
+}
+
+func (node *NodeAsset) validateNetworkInterfaces() error {
+	if node.Netmask != "" || node.Gateway != "" || len(node.DNS) > 0 {
+		return fmt.Errorf("netmask, gateway and dns of node %s can not be used together with network", node.Hostname)
+	}
+	if err := node.Network.Validate(); err != nil {
+		return fmt.Errorf("invalid network of node %s: %v", node.Hostname, err)
+	}
+
+	// 节点IP用于注册节点和访问节点，设置了静态地址时须为其中之一
+	ip := net.ParseIP(node.IP)
+	if ip == nil {
+		return nil
+	}
+	static := false
+	for _, iface := range node.Network.Interfaces {
+		for _, address := range iface.Addresses {
+			static = true
+			if addr, _, _ := net.ParseCIDR(address); addr.Equal(ip) {
+				return nil
+			}
+		}
+	}
+	if static {
+		return fmt.Errorf("ip %s of node %s is not one of the addresses in its network", node.IP, node.Hostname)
+	}
+	return nil
+}
+
+// StaticNetwork 返回节点是否使用静态IP地址
+func (node *NodeAsset) StaticNetwork() bool {
+	return node.IP != "" && node.Netmask != ""
+}
+
+func kubeletAllowedLabel(key string) bool {
+	i := strings.Index(key, "/")
+	if i < 0 {
+		return true
+	}
+	namespace := key[:i]
+	if namespace == "kubelet.kubernetes.io" || strings.HasSuffix(namespace, ".kubelet.kubernetes.io") ||
+		namespace == "node.kubernetes.io" || strings.HasSuffix(namespace, ".node.kubernetes.io") {
+		return true
+	}
+	for _, restricted := range []string{"kubernetes.io", "k8s.io"} {
+		if namespace == restricted || strings.HasSuffix(namespace, "."+restricted) {
+			return false
+		}
+	}
+	return true
+}
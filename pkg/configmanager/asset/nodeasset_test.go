@@ -0,0 +1,110 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import "testing"
+
+func TestValidateLabels(t *testing.T) {
+	t.Run("ValidateLabels Success", func(t *testing.T) {
+		node := &NodeAsset{
+			Hostname: "k8s-worker01",
+			Labels: map[string]string{
+				"zone":                          "a",
+				"example.com/rack":              "r1",
+				"node.kubernetes.io/pool":       "gpu",
+				"kubelet.kubernetes.io/profile": "",
+			},
+		}
+		if err := node.ValidateLabels(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ValidateLabels Fail", func(t *testing.T) {
+		for _, labels := range []map[string]string{
+			{"node-role.kubernetes.io/worker": ""},
+			{"example.k8s.io/key": "v"},
+			{"bad key": "v"},
+			{"zone": "bad value!"},
+		} {
+			node := &NodeAsset{Hostname: "k8s-worker01", Labels: labels}
+			if err := node.ValidateLabels(); err == nil {
+				t.Errorf("expected error for labels %v", labels)
+			}
+		}
+	})
+}
+
+func TestValidateMAC(t *testing.T) {
+	t.Run("ValidateMAC Success", func(t *testing.T) {
+		node := &NodeAsset{Hostname: "k8s-worker01", MAC: "52-54-00-AB-CD-EF"}
+		if err := node.ValidateMAC(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if node.MAC != "52:54:00:ab:cd:ef" {
+			t.Errorf("mac address is not normalized: %s", node.MAC)
+		}
+	})
+
+	t.Run("ValidateMAC Fail", func(t *testing.T) {
+		for _, mac := range []string{"52:54:00", "not-a-mac", "00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01"} {
+			node := &NodeAsset{Hostname: "k8s-worker01", MAC: mac}
+			if err := node.ValidateMAC(); err == nil {
+				t.Errorf("expected error for mac %s", mac)
+			}
+		}
+	})
+}
+
+func TestValidateNetwork(t *testing.T) {
+	t.Run("ValidateNetwork Success", func(t *testing.T) {
+		node := &NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "24", Gateway: "192.168.1.1", DNS: []string{"192.168.1.2"}}
+		if err := node.ValidateNetwork(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if node.Netmask != "255.255.255.0" || !node.StaticNetwork() {
+			t.Errorf("prefix length is not converted to a netmask: %s", node.Netmask)
+		}
+
+		node = &NodeAsset{Hostname: "k8s-worker01", IP: "fd00::11", Netmask: "64"}
+		if err := node.ValidateNetwork(); err != nil || node.Netmask != "64" {
+			t.Errorf("unexpected result for an ipv6 address: %v %s", err, node.Netmask)
+		}
+
+		node = &NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11", Interface: "eno1"}
+		if err := node.ValidateNetwork(); err != nil || node.StaticNetwork() {
+			t.Errorf("node without netmask should use dhcp: %v", err)
+		}
+	})
+
+	t.Run("ValidateNetwork Fail", func(t *testing.T) {
+		for _, node := range []*NodeAsset{
+			{Hostname: "k8s-worker01", Netmask: "24"},
+			{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "33"},
+			{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "255.0.255.0"},
+			{Hostname: "k8s-worker01", IP: "fd00::11", Netmask: "255.255.255.0"},
+			{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "24", Gateway: "gateway"},
+			{Hostname: "k8s-worker01", IP: "192.168.1.11", Netmask: "24", DNS: []string{"dns"}},
+			{Hostname: "k8s-worker01", IP: "192.168.1.11", Gateway: "192.168.1.1"},
+			{Hostname: "k8s-worker01", Interface: "eno 1"},
+		} {
+			if err := node.ValidateNetwork(); err == nil {
+				t.Errorf("expected error for %+v", node)
+			}
+		}
+	})
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// RegistryAsset 镜像仓库的 mirror、访问方式以及拉取镜像使用的认证信息
+type RegistryAsset struct {
+	Name      string   `yaml:"name"`                // 镜像仓库地址，如 docker.io、registry.example.com:5000
+	Mirrors   []string `yaml:"mirrors,omitempty"`   // mirror 地址，须包含协议，如 https://mirror.example.com，http:// 表示通过 HTTP 访问
+	Insecure  bool     `yaml:"insecure,omitempty"`  // 不校验镜像仓库的 TLS 证书
+	PlainHTTP bool     `yaml:"plainHTTP,omitempty"` // 通过 HTTP 访问镜像仓库
+	CAFile    string   `yaml:"caFile,omitempty"`    // 校验镜像仓库证书的 CA 证书文件（PEM 格式）
+	Username  string   `yaml:"username,omitempty"`
+	Password  string   `yaml:"password,omitempty"`
+}
+
+// HasAuth 返回是否设置了镜像仓库的认证信息
+func (r *RegistryAsset) HasAuth() bool {
+	return r.Username != "" || r.Password != ""
+}
+
+// Validate 校验镜像仓库的设置
+func (r *RegistryAsset) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("registry name is required")
+	}
+	if strings.Contains(r.Name, "://") || strings.Contains(r.Name, "/") {
+		return fmt.Errorf("registry name %s must be a host without scheme or path", r.Name)
+	}
+	for _, mirror := range r.Mirrors {
+		u, err := url.Parse(mirror)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("invalid mirror %q of registry %s, an http or https URL is required", mirror, r.Name)
+		}
+	}
+	if r.HasAuth() && (r.Username == "" || r.Password == "") {
+		return fmt.Errorf("both username and password of registry %s are required", r.Name)
+	}
+	if r.CAFile != "" {
+		content, err := os.ReadFile(r.CAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read CA file of registry %s: %v", r.Name, err)
+		}
+		if block, _ := pem.Decode(content); block == nil || block.Type != "CERTIFICATE" {
+			return fmt.Errorf("CA file %s of registry %s is not a PEM certificate", r.CAFile, r.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateRegistries 校验镜像仓库列表，每个镜像仓库只能配置一次
+func ValidateRegistries(registries []RegistryAsset) error {
+	names := make(map[string]struct{}, len(registries))
+	for i := range registries {
+		if err := registries[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[registries[i].Name]; ok {
+			return fmt.Errorf("registry %s is configured more than once", registries[i].Name)
+		}
+		names[registries[i].Name] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegistries(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	ca := "-----BEGIN CERTIFICATE-----\nAAECAwQF\n-----END CERTIFICATE-----\n"
+	if err := os.WriteFile(caFile, []byte(ca), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ValidateRegistries Success", func(t *testing.T) {
+		registries := []RegistryAsset{
+			{Name: "docker.io", Mirrors: []string{"https://mirror.example.com", "http://192.168.1.1:5000"}},
+			{Name: "registry.example.com:5000", CAFile: caFile, Username: "user", Password: "env:REGISTRY_PASSWORD"},
+			{Name: "registry.local", PlainHTTP: true},
+		}
+		if err := ValidateRegistries(registries); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !registries[1].HasAuth() || registries[0].HasAuth() {
+			t.Errorf("unexpected result of HasAuth")
+		}
+	})
+
+	t.Run("ValidateRegistries Fail", func(t *testing.T) {
+		invalid := [][]RegistryAsset{
+			{{Mirrors: []string{"https://mirror.example.com"}}},
+			{{Name: "https://registry.example.com"}},
+			{{Name: "docker.io", Mirrors: []string{"mirror.example.com"}}},
+			{{Name: "registry.example.com", Username: "user"}},
+			{{Name: "registry.example.com", CAFile: filepath.Join(t.TempDir(), "missing.crt")}},
+			{{Name: "registry.example.com", CAFile: "registryasset_test.go"}},
+			{{Name: "docker.io"}, {Name: "docker.io"}},
+		}
+		for _, registries := range invalid {
+			if err := ValidateRegistries(registries); err == nil {
+				t.Errorf("expected error of %+v", registries)
+			}
+		}
+	})
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// RuntimeHandlerAsset 在容器运行时中额外注册的运行时（如 kata-containers、gVisor），nkd 为其创建同名的 RuntimeClass
+type RuntimeHandlerAsset struct {
+	Name         string            `yaml:"name"`                   // 运行时 handler 及 RuntimeClass 的名称
+	Type         string            `yaml:"type"`                   // 运行时类型：kata 或 gvisor
+	RuntimePath  string            `yaml:"runtimePath,omitempty"`  // 运行时（shim）的可执行文件路径，未设置时使用该类型的默认路径
+	Roles        []string          `yaml:"roles,omitempty"`        // 注册该运行时的节点角色：master、worker，未设置时注册到所有节点
+	NodeSelector map[string]string `yaml:"nodeSelector,omitempty"` // RuntimeClass 的节点选择器，使用该运行时的 Pod 仅调度到标签匹配的节点
+}
+
+// OnRole 返回角色（controlplane、master、worker）的节点是否注册该运行时，controlplane 节点属于 master 角色
+func (h *RuntimeHandlerAsset) OnRole(role string) bool {
+	if len(h.Roles) == 0 {
+		return true
+	}
+	if role == constants.Controlplane {
+		role = constants.Master
+	}
+	for _, r := range h.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 校验运行时 handler 的设置，名称须为 DNS-1123 label，且不能与容器运行时默认的 runc 重名
+func (h *RuntimeHandlerAsset) Validate() error {
+	if errs := validation.IsDNS1123Label(h.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid runtime handler name %q: %s", h.Name, strings.Join(errs, "; "))
+	}
+	if h.Name == "runc" {
+		return fmt.Errorf("runtime handler name runc is reserved for the default runtime")
+	}
+	switch h.Type {
+	case constants.RuntimeHandlerKata, constants.RuntimeHandlerGVisor:
+	default:
+		return fmt.Errorf("unsupported type %q of runtime handler %s, supported types: %s, %s", h.Type, h.Name, constants.RuntimeHandlerKata, constants.RuntimeHandlerGVisor)
+	}
+	for _, role := range h.Roles {
+		if role != constants.Master && role != constants.Worker {
+			return fmt.Errorf("invalid role %q of runtime handler %s, supported roles: %s, %s", role, h.Name, constants.Master, constants.Worker)
+		}
+	}
+	for key, value := range h.NodeSelector {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector key %q of runtime handler %s: %s", key, h.Name, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector value %q of runtime handler %s: %s", value, h.Name, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// ValidateRuntimeHandlers 校验运行时 handler 列表，每个名称只能配置一次
+func ValidateRuntimeHandlers(handlers []RuntimeHandlerAsset) error {
+	names := make(map[string]struct{}, len(handlers))
+	for i := range handlers {
+		if err := handlers[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[handlers[i].Name]; ok {
+			return fmt.Errorf("runtime handler %s is configured more than once", handlers[i].Name)
+		}
+		names[handlers[i].Name] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asset
+
+import (
+	"testing"
+)
+
+func TestRuntimeHandlers(t *testing.T) {
+	t.Run("ValidateRuntimeHandlers Success", func(t *testing.T) {
+		handlers := []RuntimeHandlerAsset{
+			{Name: "kata", Type: "kata"},
+			{Name: "gvisor", Type: "gvisor", RuntimePath: "/usr/local/bin/containerd-shim-runsc-v1", Roles: []string{"worker"},
+				NodeSelector: map[string]string{"example.com/sandbox": "gvisor"}},
+		}
+		if err := ValidateRuntimeHandlers(handlers); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ValidateRuntimeHandlers Fail", func(t *testing.T) {
+		invalid := [][]RuntimeHandlerAsset{
+			{{Type: "kata"}},
+			{{Name: "Kata", Type: "kata"}},
+			{{Name: "runc", Type: "kata"}},
+			{{Name: "kata", Type: "firecracker"}},
+			{{Name: "kata", Type: "kata", Roles: []string{"controlplane"}}},
+			{{Name: "kata", Type: "kata", NodeSelector: map[string]string{"sandbox": "kata runtime"}}},
+			{{Name: "kata", Type: "kata"}, {Name: "kata", Type: "gvisor"}},
+		}
+		for _, handlers := range invalid {
+			if err := ValidateRuntimeHandlers(handlers); err == nil {
+				t.Errorf("expected error of %+v", handlers)
+			}
+		}
+	})
+
+	t.Run("OnRole Success", func(t *testing.T) {
+		all := RuntimeHandlerAsset{Name: "kata", Type: "kata"}
+		master := RuntimeHandlerAsset{Name: "kata", Type: "kata", Roles: []string{"master"}}
+		worker := RuntimeHandlerAsset{Name: "kata", Type: "kata", Roles: []string{"worker"}}
+		if !all.OnRole("controlplane") || !all.OnRole("worker") {
+			t.Errorf("handler without roles should be registered on all nodes")
+		}
+		if !master.OnRole("controlplane") || !master.OnRole("master") || master.OnRole("worker") {
+			t.Errorf("unexpected result of OnRole for master handler")
+		}
+		if worker.OnRole("controlplane") || !worker.OnRole("worker") {
+			t.Errorf("unexpected result of OnRole for worker handler")
+		}
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -55,6 +56,12 @@ func InitGlobalConfig(opts *opts.OptionsList) (*GlobalConfig, error) {
 	if opts.NKD.LogLevel != "" {
 		globalAsset.LogLevel = opts.NKD.LogLevel
 	}
+	if opts.NKD.SecretKeyFile != "" {
+		globalAsset.SecretKeyFile = opts.NKD.SecretKeyFile
+	}
+	if opts.NKD.AllowPlaintextSecrets {
+		globalAsset.AllowPlaintextSecrets = true
+	}
 	if opts.NKD.BootstrapIgnHost != "" {
 		globalAsset.BootstrapIgnHost = opts.NKD.BootstrapIgnHost
 	}
@@ -67,6 +74,12 @@ func InitGlobalConfig(opts *opts.OptionsList) (*GlobalConfig, error) {
 		return nil, fmt.Errorf(errMsg)
 	}
 
+	if globalAsset.BootstrapFileTTL != "" {
+		if _, err := time.ParseDuration(globalAsset.BootstrapFileTTL); err != nil {
+			return nil, fmt.Errorf("invalid bootstrapFileTTL %q: %v", globalAsset.BootstrapFileTTL, err)
+		}
+	}
+
 	if globalAsset.BootstrapIgnHost == "" {
 		if ip, err := utils.GetLocalIP(); err != nil {
 			logrus.Errorf("failed to get local IP: %v", err)
@@ -90,15 +103,18 @@ func InitGlobalConfig(opts *opts.OptionsList) (*GlobalConfig, error) {
 // ========== Structure method ==========
 
 type GlobalConfig struct {
-	LogLevel          string `yaml:"logLevel"`
-	ClusterConfigPath string `yaml:"clusterConfigPath"`
-	PersistDir        string // default: /etc/nkd
+	LogLevel              string `yaml:"logLevel"`
+	ClusterConfigPath     string `yaml:"clusterConfigPath"`
+	PersistDir            string // default: /etc/nkd
+	SecretKeyFile         string `yaml:"secretKeyFile,omitempty"`         // 加密持久化敏感数据所用的密钥材料文件
+	AllowPlaintextSecrets bool   `yaml:"allowPlaintextSecrets,omitempty"` // 未提供密钥材料时是否允许明文持久化敏感数据
 	BootstrapUrl
 }
 
 type BootstrapUrl struct {
 	BootstrapIgnHost string `yaml:"bootstrapIgnHost"`
 	BootstrapIgnPort string `yaml:"bootstrapIgnPort"`
+	BootstrapFileTTL string `yaml:"bootstrapFileTTL,omitempty"` // 受保护引导文件的有效期，如 2h
 }
 
 // Delete deletes the global asset.
@@ -18,12 +18,15 @@ package configmanager
 
 import (
 	"errors"
+	"fmt"
 	"nestos-kubernetes-deployer/cmd/command/opts"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -32,10 +35,17 @@ import (
 var GlobalConfig *globalconfig.GlobalConfig
 var ClusterAsset = map[string]*asset.ClusterAsset{}
 
+// Sealer encrypts secrets in the persist directory, nil if no secret key material is provided
+var Sealer *secret.Sealer
+
 // var InfraAsset = map[string]*asset.InfraAsset{}
 
 const clusterConfigFile string = "cluster_config.yaml"
 
+/*
+Initial 初始化全局配置，并加载 --cluster-id 选择的集群的持久化配置及 --config 指定的集群配置。
+其他集群的配置不被加载，其中的敏感信息不会被解密，外部引用（env:、file:、exec:）也不会被解析
+*/
 func Initial(opts *opts.OptionsList) error {
 	// Init global asset
 	globalConfig, err := globalconfig.InitGlobalConfig(opts)
@@ -44,10 +54,21 @@ func Initial(opts *opts.OptionsList) error {
 	}
 	GlobalConfig = globalConfig
 
-	files, err := filepath.Glob(filepath.Join(globalConfig.PersistDir, "*", clusterConfigFile))
+	sealer, err := secret.LoadSealer(globalConfig.SecretKeyFile)
 	if err != nil {
 		return err
 	}
+	Sealer = sealer
+
+	var files []string
+	if opts.ClusterID != "" {
+		file := filepath.Join(globalConfig.PersistDir, opts.ClusterID, clusterConfigFile)
+		if _, err := os.Stat(file); err == nil {
+			files = append(files, file)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
 
 	if opts.ClusterConfigFile != "" {
 		files = append(files, opts.ClusterConfigFile)
@@ -90,6 +111,13 @@ func initializeClusterAsset(fileData *asset.ClusterAsset, opts *opts.OptionsList
 	}
 	clusterAsset.InfraPlatform = infraAsset
 
+	if err := clusterAsset.OpenSecrets(Sealer); err != nil {
+		return err
+	}
+	if err := clusterAsset.ResolveSecretRefs(); err != nil {
+		return err
+	}
+
 	ClusterAsset[fileData.ClusterID] = clusterAsset
 	return nil
 }
@@ -114,6 +142,18 @@ func GetBootstrapIgnHostPort() string {
 	return GetBootstrapIgnHost() + ":" + GetBootstrapIgnPort()
 }
 
+// GetBootstrapFileTTL returns the lifetime of protected bootstrap files, zero means the default
+func GetBootstrapFileTTL() time.Duration {
+	if GlobalConfig == nil {
+		return 0
+	}
+	ttl, err := time.ParseDuration(GlobalConfig.BootstrapFileTTL)
+	if err != nil {
+		return 0
+	}
+	return ttl
+}
+
 func GetClusterConfig(clusterID string) (*asset.ClusterAsset, error) {
 	clusterConfig, ok := ClusterAsset[clusterID]
 	if !ok {
@@ -123,7 +163,24 @@ func GetClusterConfig(clusterID string) (*asset.ClusterAsset, error) {
 	return clusterConfig, nil
 }
 
+func GetSecretSealer() *secret.Sealer {
+	return Sealer
+}
+
+// CheckSecretPolicy refuses to persist secrets in plaintext unless explicitly allowed.
+func CheckSecretPolicy() error {
+	if Sealer != nil || GlobalConfig.AllowPlaintextSecrets {
+		return nil
+	}
+	return fmt.Errorf("refusing to persist secrets in plaintext: provide secret key material via %s, %s or --secret-key-file, or pass --allow-plaintext-secrets",
+		secret.PassphraseEnv, secret.KeyFileEnv)
+}
+
 func Persist() error {
+	if err := CheckSecretPolicy(); err != nil {
+		return err
+	}
+
 	// Get persist dir
 	persistDir := GetPersistDir()
 
@@ -134,7 +191,7 @@ func Persist() error {
 			return err
 		}
 
-		if err := clusterAsset.Persist(clusterDir); err != nil {
+		if err := clusterAsset.Persist(clusterDir, Sealer); err != nil {
 			return err
 		}
 	}
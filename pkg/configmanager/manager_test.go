@@ -20,6 +20,7 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -194,6 +195,46 @@ func TestConfigmanager(t *testing.T) {
 	})
 }
 
+func TestInitialSelectedCluster(t *testing.T) {
+	persistDir := t.TempDir()
+	p := gomonkey.ApplyFunc(globalconfig.InitGlobalConfig, func(*opts.OptionsList) (*globalconfig.GlobalConfig, error) {
+		return &globalconfig.GlobalConfig{PersistDir: persistDir}, nil
+	})
+	defer p.Reset()
+
+	// 未选择的集群中的外部引用无法解析，加载该集群时 Initial 失败
+	for clusterID, password := range map[string]string{"selected": "123", "other": "env:NKD_TEST_UNSET_PASSWORD"} {
+		if err := os.MkdirAll(filepath.Join(persistDir, clusterID), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config := "clusterID: " + clusterID + "\narchitecture: amd64\nplatform: libvirt\npassword: " + password + "\n" +
+			"infraPlatform:\n  uri: qemu:///system\n  osPath: /etc/qcow2.qcow2\n  cidr: 127.0.0.1/24\n  gateway: 127.0.0.1\n"
+		if err := os.WriteFile(filepath.Join(persistDir, clusterID, clusterConfigFile), []byte(config), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	os.Unsetenv("NKD_TEST_UNSET_PASSWORD")
+	ClusterAsset = map[string]*asset.ClusterAsset{}
+
+	t.Run("Initial Selected Success", func(t *testing.T) {
+		if err := Initial(&opts.OptionsList{ClusterID: "selected"}); err != nil {
+			t.Fatalf("Initial failed: %v", err)
+		}
+		if _, err := GetClusterConfig("other"); err == nil {
+			t.Error("unselected cluster is loaded")
+		}
+		if config, err := GetClusterConfig("selected"); err != nil || config.Password != "123" {
+			t.Errorf("selected cluster is not loaded: %v", err)
+		}
+	})
+
+	t.Run("Initial Selected Fail", func(t *testing.T) {
+		if err := Initial(&opts.OptionsList{ClusterID: "other"}); err == nil {
+			t.Error("expected failure resolving the secret reference of the selected cluster")
+		}
+	})
+}
+
 func writeToFile(path string, content string) error {
 	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
@@ -0,0 +1,271 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// 运行时使用的 cgroup 驱动
+const (
+	CgroupDriverSystemd  = "systemd"
+	CgroupDriverCgroupfs = "cgroupfs"
+)
+
+// ValidateConfig 校验运行时设置
+func ValidateConfig(config *api.RuntimeConfig) error {
+	switch config.CgroupDriver {
+	case "", CgroupDriverSystemd, CgroupDriverCgroupfs:
+	default:
+		return fmt.Errorf("unsupported cgroup driver %q, supported drivers: %s, %s", config.CgroupDriver, CgroupDriverSystemd, CgroupDriverCgroupfs)
+	}
+	for _, mirror := range config.Mirrors {
+		for _, endpoint := range mirror.Endpoints {
+			if _, _, err := endpointHost(endpoint); err != nil {
+				return fmt.Errorf("invalid mirror of %s: %v", mirror.Registry, err)
+			}
+		}
+	}
+	for registry, auth := range config.Auths {
+		if auth.Username == "" || auth.Password == "" {
+			return fmt.Errorf("username and password of registry %s are required", registry)
+		}
+	}
+	for registry, ca := range config.CAs {
+		if len(ca) == 0 {
+			return fmt.Errorf("CA certificate of registry %s is empty", registry)
+		}
+	}
+	names := make(map[string]struct{}, len(config.Handlers))
+	for _, handler := range config.Handlers {
+		if handler.Name == "" || handler.Name == "runc" {
+			return fmt.Errorf("invalid runtime handler name %q", handler.Name)
+		}
+		if _, ok := names[handler.Name]; ok {
+			return fmt.Errorf("runtime handler %s is configured more than once", handler.Name)
+		}
+		names[handler.Name] = struct{}{}
+		switch handler.Type {
+		case constants.RuntimeHandlerKata, constants.RuntimeHandlerGVisor:
+		default:
+			return fmt.Errorf("unsupported type %q of runtime handler %s", handler.Type, handler.Name)
+		}
+	}
+	return nil
+}
+
+// cgroupDriver 返回运行时使用的 cgroup 驱动，默认为 systemd，与 kubelet 保持一致
+func cgroupDriver(config *api.RuntimeConfig) string {
+	if config.CgroupDriver == "" {
+		return CgroupDriverSystemd
+	}
+	return config.CgroupDriver
+}
+
+// endpointHost 返回 mirror 地址中的主机（及路径）部分，以及是否通过 HTTP 访问
+func endpointHost(endpoint string) (string, bool, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", false, fmt.Errorf("invalid endpoint %q, an http or https URL is required", endpoint)
+	}
+	return u.Host + strings.TrimSuffix(u.Path, "/"), u.Scheme == "http", nil
+}
+
+// crictlConfig 生成 crictl 的配置文件，使 crictl 默认连接节点的运行时
+func crictlConfig(socket string) api.RuntimeFile {
+	if !strings.Contains(socket, "://") {
+		socket = "unix://" + socket
+	}
+	content := fmt.Sprintf("runtime-endpoint: %s\nimage-endpoint: %s\ntimeout: 10\ndebug: false\n", socket, socket)
+	return api.RuntimeFile{Path: constants.CrictlConfig, Mode: constants.BootConfigFileMode, Contents: []byte(content)}
+}
+
+// DockerConfigJSON 生成 docker config.json 格式的认证信息，containers-auth.json、kubelet 以及 kubernetes.io/dockerconfigjson 类型的 Secret 使用相同的格式
+func DockerConfigJSON(auths map[string]api.RegistryAuth) ([]byte, error) {
+	type authEntry struct {
+		Auth string `json:"auth"`
+	}
+	entries := make(map[string]authEntry, len(auths))
+	for registry, auth := range auths {
+		entries[registry] = authEntry{Auth: base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + auth.Password))}
+	}
+	content, err := json.MarshalIndent(map[string]interface{}{"auths": entries}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// authFile 生成仅 root 可读的认证文件
+func authFile(path string, auths map[string]api.RegistryAuth) (api.RuntimeFile, error) {
+	content, err := DockerConfigJSON(auths)
+	if err != nil {
+		return api.RuntimeFile{}, err
+	}
+	return api.RuntimeFile{Path: path, Mode: constants.RegistryAuthMode, Contents: content}, nil
+}
+
+// caFiles 生成 certs.d 目录中各镜像仓库的 CA 证书（<dir>/<镜像仓库>/ca.crt），docker、iSulad、containers 和 containerd 使用相同的目录结构
+func caFiles(dir string, config *api.RuntimeConfig) []api.RuntimeFile {
+	registries := make([]string, 0, len(config.CAs))
+	for registry := range config.CAs {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+
+	files := make([]api.RuntimeFile, 0, len(registries))
+	for _, registry := range registries {
+		files = append(files, api.RuntimeFile{Path: caPath(dir, registry), Mode: constants.BootConfigFileMode, Contents: config.CAs[registry]})
+	}
+	return files
+}
+
+func caPath(dir string, registry string) string {
+	return path.Join(dir, registry, "ca.crt")
+}
+
+// insecureRegistries 返回不校验证书或通过 HTTP 访问的镜像仓库，docker、iSulad 和 containers 不区分这两种访问方式
+func insecureRegistries(config *api.RuntimeConfig) []string {
+	var registries []string
+	for _, registry := range append(append([]string{}, config.InsecureRegistries...), config.PlainHTTPRegistries...) {
+		if !contains(registries, registry) {
+			registries = append(registries, registry)
+		}
+	}
+	return registries
+}
+
+func marshalJSON(path string, v interface{}) (api.RuntimeFile, error) {
+	content, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return api.RuntimeFile{}, err
+	}
+	return api.RuntimeFile{Path: path, Mode: constants.BootConfigFileMode, Contents: append(content, '\n')}, nil
+}
+
+func marshalTOML(path string, v interface{}) (api.RuntimeFile, error) {
+	var buf bytes.Buffer
+	buf.WriteString("# Generated by nkd\n")
+	if err := toml.NewEncoder(&buf).Encode(v); err != nil {
+		return api.RuntimeFile{}, err
+	}
+	return api.RuntimeFile{Path: path, Mode: constants.BootConfigFileMode, Contents: buf.Bytes()}, nil
+}
+
+// dockerHubMirrors 返回 docker.io 的 mirror 地址；docker 和 iSulad 仅支持 docker.io 的 mirror
+func dockerHubMirrors(config *api.RuntimeConfig) (endpoints []string, ignored []string) {
+	for _, mirror := range config.Mirrors {
+		if mirror.Registry == "docker.io" {
+			endpoints = append(endpoints, mirror.Endpoints...)
+		} else {
+			ignored = append(ignored, mirror.Registry)
+		}
+	}
+	return endpoints, ignored
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+type containersRegistries struct {
+	Registries []containersRegistry `toml:"registry"`
+}
+
+type containersRegistry struct {
+	Prefix   string             `toml:"prefix"`
+	Location string             `toml:"location"`
+	Insecure bool               `toml:"insecure"`
+	Mirrors  []containersMirror `toml:"mirror,omitempty"`
+}
+
+type containersMirror struct {
+	Location string `toml:"location"`
+	Insecure bool   `toml:"insecure"`
+}
+
+/*
+RegistriesConf 生成 containers-registries.conf 配置片段（/etc/containers/registries.conf.d/nkd-registry.conf），
+CRI-O、podman 以及 NestOS 通过 rpm-ostree 获取发布镜像时使用其中的 mirror 和 insecure 设置。未设置 mirror 和 insecure 镜像仓库时返回 nil
+*/
+func RegistriesConf(config *api.RuntimeConfig) (*api.RuntimeFile, error) {
+	var conf containersRegistries
+	index := make(map[string]int)
+	registry := func(name string) *containersRegistry {
+		if i, ok := index[name]; ok {
+			return &conf.Registries[i]
+		}
+		index[name] = len(conf.Registries)
+		conf.Registries = append(conf.Registries, containersRegistry{Prefix: name, Location: name})
+		return &conf.Registries[len(conf.Registries)-1]
+	}
+
+	for _, mirror := range config.Mirrors {
+		r := registry(mirror.Registry)
+		for _, endpoint := range mirror.Endpoints {
+			host, insecure, err := endpointHost(endpoint)
+			if err != nil {
+				return nil, err
+			}
+			r.Mirrors = append(r.Mirrors, containersMirror{Location: host, Insecure: insecure})
+		}
+	}
+	for _, name := range insecureRegistries(config) {
+		registry(name).Insecure = true
+	}
+	if len(conf.Registries) == 0 {
+		return nil, nil
+	}
+
+	file, err := marshalTOML(constants.RegistriesConf, conf)
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+/*
+ContainersFiles 返回 containers/image（CRI-O、podman 以及 NestOS 的 rpm-ostree）使用的镜像仓库配置：
+containers-registries.conf 配置片段（见 RegistriesConf）以及 /etc/containers/certs.d 中的 CA 证书
+*/
+func ContainersFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	files := caFiles(constants.ContainersCertsDir, config)
+	registriesConf, err := RegistriesConf(config)
+	if err != nil {
+		return nil, err
+	}
+	if registriesConf != nil {
+		files = append([]api.RuntimeFile{*registriesConf}, files...)
+	}
+	return files, nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Run("ValidateConfig Success", func(t *testing.T) {
+		if err := ValidateConfig(testRuntimeConfig()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := ValidateConfig(&api.RuntimeConfig{}); err != nil {
+			t.Errorf("unexpected error of the default config: %v", err)
+		}
+		if err := ValidateConfig(&api.RuntimeConfig{Handlers: testHandlers()}); err != nil {
+			t.Errorf("unexpected error of runtime handlers: %v", err)
+		}
+	})
+
+	t.Run("ValidateConfig Fail", func(t *testing.T) {
+		invalid := []*api.RuntimeConfig{
+			{CgroupDriver: "cgroup"},
+			{Mirrors: []api.RegistryMirror{{Registry: "docker.io", Endpoints: []string{"mirror.example.com"}}}},
+			{Auths: map[string]api.RegistryAuth{"registry.example.com": {Username: "user"}}},
+			{Handlers: []api.RuntimeHandler{{Name: "runc", Type: "kata"}}},
+			{Handlers: []api.RuntimeHandler{{Name: "kata", Type: "firecracker"}}},
+			{Handlers: []api.RuntimeHandler{{Name: "kata", Type: "kata"}, {Name: "kata", Type: "gvisor"}}},
+		}
+		for _, config := range invalid {
+			if err := ValidateConfig(config); err == nil {
+				t.Errorf("expected error of %+v", config)
+			}
+		}
+	})
+}
+
+func TestEndpointHost(t *testing.T) {
+	host, insecure, err := endpointHost("http://192.168.1.1:9080/")
+	if err != nil || host != "192.168.1.1:9080" || !insecure {
+		t.Errorf("unexpected host %s, insecure %v: %v", host, insecure, err)
+	}
+	host, insecure, err = endpointHost("https://mirror.example.com/v2/docker")
+	if err != nil || host != "mirror.example.com/v2/docker" || insecure {
+		t.Errorf("unexpected host %s, insecure %v: %v", host, insecure, err)
+	}
+}
+
+func TestRegistriesConf(t *testing.T) {
+	t.Run("RegistriesConf Success", func(t *testing.T) {
+		file, err := RegistriesConf(testRuntimeConfig())
+		if err != nil || file == nil {
+			t.Fatalf("RegistriesConf failed: %v", err)
+		}
+		if file.Path != constants.RegistriesConf {
+			t.Errorf("unexpected path: %s", file.Path)
+		}
+
+		var conf containersRegistries
+		if _, err := toml.Decode(string(file.Contents), &conf); err != nil {
+			t.Fatalf("invalid registries.conf: %v\n%s", err, file.Contents)
+		}
+		if len(conf.Registries) != 3 {
+			t.Fatalf("unexpected registries:\n%s", file.Contents)
+		}
+		docker := conf.Registries[0]
+		if docker.Prefix != "docker.io" || len(docker.Mirrors) != 2 || !docker.Mirrors[0].Insecure || docker.Mirrors[1].Insecure ||
+			docker.Mirrors[1].Location != "mirror.example.com" {
+			t.Errorf("unexpected registry docker.io: %+v", docker)
+		}
+		if embedded := conf.Registries[2]; embedded.Location != "192.168.1.1:9080" || !embedded.Insecure {
+			t.Errorf("unexpected insecure registry: %+v", embedded)
+		}
+		if !strings.Contains(string(file.Contents), `prefix = "registry.k8s.io"`) {
+			t.Errorf("unexpected registries.conf:\n%s", file.Contents)
+		}
+	})
+
+	t.Run("RegistriesConf Empty", func(t *testing.T) {
+		file, err := RegistriesConf(&api.RuntimeConfig{PauseImage: "registry.k8s.io/pause:3.9"})
+		if err != nil || file != nil {
+			t.Errorf("expected no registries.conf, got %v: %v", file, err)
+		}
+	})
+}
+
+func TestRegistryCAsAndPlainHTTP(t *testing.T) {
+	config := &api.RuntimeConfig{
+		PlainHTTPRegistries: []string{"registry.local:5000"},
+		CAs:                 map[string][]byte{"registry.example.com": []byte("-----BEGIN CERTIFICATE-----\n")},
+	}
+	caFiles := map[api.Runtime]string{
+		&containerdRuntime{}: "/etc/containerd/certs.d/registry.example.com/ca.crt",
+		&dockerRuntime{}:     "/etc/docker/certs.d/registry.example.com/ca.crt",
+		&isuladRuntime{}:     "/etc/isulad/certs.d/registry.example.com/ca.crt",
+	}
+	for rt, path := range caFiles {
+		files, err := rt.GetRuntimeConfigFiles(config)
+		if err != nil {
+			t.Fatalf("%T: GetRuntimeConfigFiles failed: %v", rt, err)
+		}
+		if ca := findFile(t, files, path); string(ca.Contents) != "-----BEGIN CERTIFICATE-----\n" {
+			t.Errorf("%T: unexpected CA file: %s", rt, ca.Contents)
+		}
+		if !strings.Contains(string(files[0].Contents), "registry.local:5000") {
+			t.Errorf("%T: plain HTTP registry is not configured:\n%s", rt, files[0].Contents)
+		}
+	}
+
+	files, err := (&containerdRuntime{}).GetRuntimeConfigFiles(config)
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	content := string(findFile(t, files, constants.ContainerdConfig).Contents)
+	if !strings.Contains(content, `endpoint = ["http://registry.local:5000"]`) ||
+		!strings.Contains(content, `ca_file = "/etc/containerd/certs.d/registry.example.com/ca.crt"`) {
+		t.Errorf("unexpected containerd config:\n%s", content)
+	}
+
+	files, err = ContainersFiles(config)
+	if err != nil {
+		t.Fatalf("ContainersFiles failed: %v", err)
+	}
+	if len(files) != 2 || files[0].Path != constants.RegistriesConf || files[1].Path != "/etc/containers/certs.d/registry.example.com/ca.crt" ||
+		!strings.Contains(string(files[0].Contents), "insecure = true") {
+		t.Errorf("unexpected containers files: %v", files)
+	}
+}
@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -17,15 +17,109 @@ package runtime
 
 import (
 	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
 )
 
 type containerdRuntime struct {
 }
 
+// containerdRuntimeTypes 额外注册的运行时使用的 shim v2 运行时
+var containerdRuntimeTypes = map[string]string{
+	constants.RuntimeHandlerKata:   "io.containerd.kata.v2",
+	constants.RuntimeHandlerGVisor: "io.containerd.runsc.v1",
+}
+
 func (ir *containerdRuntime) GetRuntimeCriSocket() string {
 	return "unix:///var/run/containerd/containerd.sock"
 }
 
+/*
+GetRuntimeConfigFiles 生成完整的 containerd 配置文件 /etc/containerd/config.toml（version 2），未设置的配置项使用 containerd 的默认值：
+  - mirror 配置在 registry.mirrors 中，insecure 镜像仓库依次通过 HTTPS（不校验证书）和 HTTP 访问，plain HTTP 镜像仓库仅通过 HTTP 访问
+  - 认证信息和 CA 证书配置在 registry.configs 中，CA 证书保存在 /etc/containerd/certs.d/<镜像仓库>/ca.crt
+  - 额外的运行时注册在 containerd.runtimes 中，kata 和 gvisor 分别使用 shim v2 运行时 io.containerd.kata.v2 和 io.containerd.runsc.v1
+*/
+func (ir *containerdRuntime) GetRuntimeConfigFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	mirrors := make(map[string]interface{})
+	for _, mirror := range config.Mirrors {
+		mirrors[mirror.Registry] = map[string]interface{}{"endpoint": mirror.Endpoints}
+	}
+	configs := make(map[string]map[string]interface{})
+	registryConfig := func(registry string) map[string]interface{} {
+		if _, ok := configs[registry]; !ok {
+			configs[registry] = make(map[string]interface{})
+		}
+		return configs[registry]
+	}
+	tls := func(registry string) map[string]interface{} {
+		if _, ok := registryConfig(registry)["tls"]; !ok {
+			configs[registry]["tls"] = make(map[string]interface{})
+		}
+		return configs[registry]["tls"].(map[string]interface{})
+	}
+	for _, registry := range config.PlainHTTPRegistries {
+		if _, ok := mirrors[registry]; !ok {
+			mirrors[registry] = map[string]interface{}{"endpoint": []string{"http://" + registry}}
+		}
+	}
+	for _, registry := range config.InsecureRegistries {
+		tls(registry)["insecure_skip_verify"] = true
+		if _, ok := mirrors[registry]; !ok {
+			mirrors[registry] = map[string]interface{}{"endpoint": []string{"https://" + registry, "http://" + registry}}
+		}
+	}
+	for registry := range config.CAs {
+		tls(registry)["ca_file"] = caPath(constants.ContainerdCertsDir, registry)
+	}
+	for registry, auth := range config.Auths {
+		registryConfig(registry)["auth"] = map[string]interface{}{"username": auth.Username, "password": auth.Password}
+	}
+
+	runtimes := map[string]interface{}{
+		"runc": map[string]interface{}{
+			"runtime_type": "io.containerd.runc.v2",
+			"options":      map[string]interface{}{"SystemdCgroup": cgroupDriver(config) == CgroupDriverSystemd},
+		},
+	}
+	for _, handler := range config.Handlers {
+		runtime := map[string]interface{}{"runtime_type": containerdRuntimeTypes[handler.Type]}
+		if handler.RuntimePath != "" {
+			runtime["runtime_path"] = handler.RuntimePath
+		}
+		runtimes[handler.Name] = runtime
+	}
+	cri := map[string]interface{}{
+		"containerd": map[string]interface{}{"runtimes": runtimes},
+	}
+	if config.PauseImage != "" {
+		cri["sandbox_image"] = config.PauseImage
+	}
+	registry := make(map[string]interface{})
+	if len(mirrors) > 0 {
+		registry["mirrors"] = mirrors
+	}
+	if len(configs) > 0 {
+		registry["configs"] = configs
+	}
+	if len(registry) > 0 {
+		cri["registry"] = registry
+	}
+
+	containerdConfig, err := marshalTOML(constants.ContainerdConfig, map[string]interface{}{
+		"version": 2,
+		"plugins": map[string]interface{}{"io.containerd.grpc.v1.cri": cri},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 配置文件中包含认证信息时仅 root 可读
+	if len(config.Auths) > 0 {
+		containerdConfig.Mode = constants.RegistryAuthMode
+	}
+	files := []api.RuntimeFile{containerdConfig, crictlConfig(ir.GetRuntimeCriSocket())}
+	return append(files, caFiles(constants.ContainerdCertsDir, config)...), nil
+}
+
 func IsContainerd(rt api.Runtime) bool {
 	_, ok := rt.(*containerdRuntime)
 	return ok
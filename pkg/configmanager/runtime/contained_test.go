@@ -17,7 +17,12 @@ limitations under the License.
 package runtime
 
 import (
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
 	"testing"
+
+	"github.com/BurntSushi/toml"
 )
 
 // Define mockRuntime for testing purposes
@@ -27,6 +32,43 @@ func (mr *mockRuntime) GetRuntimeCriSocket() string {
 	return "/var/run/mock.sock"
 }
 
+func (mr *mockRuntime) GetRuntimeConfigFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	return nil, nil
+}
+
+// testRuntimeConfig 各运行时测试使用的设置
+func testRuntimeConfig() *api.RuntimeConfig {
+	return &api.RuntimeConfig{
+		PauseImage:   "registry.k8s.io/pause:3.9",
+		CgroupDriver: CgroupDriverCgroupfs,
+		Mirrors: []api.RegistryMirror{
+			{Registry: "docker.io", Endpoints: []string{"http://192.168.1.1:9080", "https://mirror.example.com"}},
+			{Registry: "registry.k8s.io", Endpoints: []string{"http://192.168.1.1:9080"}},
+		},
+		InsecureRegistries: []string{"192.168.1.1:9080"},
+		Auths:              map[string]api.RegistryAuth{"registry.example.com": {Username: "user", Password: "pass"}},
+	}
+}
+
+// testHandlers 各运行时测试使用的额外运行时
+func testHandlers() []api.RuntimeHandler {
+	return []api.RuntimeHandler{
+		{Name: "kata", Type: constants.RuntimeHandlerKata},
+		{Name: "gvisor", Type: constants.RuntimeHandlerGVisor, RuntimePath: "/usr/local/bin/runsc"},
+	}
+}
+
+// findFile 返回指定路径的配置文件
+func findFile(t *testing.T, files []api.RuntimeFile, path string) api.RuntimeFile {
+	for _, f := range files {
+		if f.Path == path {
+			return f
+		}
+	}
+	t.Fatalf("file %s not found in %v", path, files)
+	return api.RuntimeFile{}
+}
+
 func TestContainerdRuntimeGetRuntimeCriSocket(t *testing.T) {
 	cr := &containerdRuntime{}
 	expectedSocket := "unix:///var/run/containerd/containerd.sock"
@@ -48,3 +90,105 @@ func TestIsContainerd(t *testing.T) {
 		t.Errorf("Expected IsContainerd to return false for mockRuntime, but got true")
 	}
 }
+
+func TestContainerdRuntimeGetRuntimeConfigFiles(t *testing.T) {
+	cr := &containerdRuntime{}
+	files, err := cr.GetRuntimeConfigFiles(testRuntimeConfig())
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	configFile := findFile(t, files, constants.ContainerdConfig)
+	if configFile.Mode != constants.RegistryAuthMode {
+		t.Errorf("expected mode %o of the config with auths, got %o", constants.RegistryAuthMode, configFile.Mode)
+	}
+
+	var config struct {
+		Version int
+		Plugins struct {
+			CRI struct {
+				SandboxImage string `toml:"sandbox_image"`
+				Containerd   struct {
+					Runtimes struct {
+						Runc struct {
+							RuntimeType string `toml:"runtime_type"`
+							Options     struct {
+								SystemdCgroup bool
+							}
+						}
+					}
+				}
+				Registry struct {
+					Mirrors map[string]struct{ Endpoint []string }
+					Configs map[string]struct {
+						TLS struct {
+							InsecureSkipVerify bool `toml:"insecure_skip_verify"`
+						}
+						Auth struct{ Username, Password string }
+					}
+				}
+			} `toml:"io.containerd.grpc.v1.cri"`
+		}
+	}
+	if _, err := toml.Decode(string(configFile.Contents), &config); err != nil {
+		t.Fatalf("invalid config.toml: %v\n%s", err, configFile.Contents)
+	}
+	cri := config.Plugins.CRI
+	if config.Version != 2 || cri.SandboxImage != "registry.k8s.io/pause:3.9" || cri.Containerd.Runtimes.Runc.Options.SystemdCgroup ||
+		cri.Containerd.Runtimes.Runc.RuntimeType != "io.containerd.runc.v2" {
+		t.Errorf("unexpected config.toml:\n%s", configFile.Contents)
+	}
+	if endpoints := cri.Registry.Mirrors["docker.io"].Endpoint; len(endpoints) != 2 || endpoints[1] != "https://mirror.example.com" {
+		t.Errorf("unexpected mirrors of docker.io: %v", endpoints)
+	}
+	if endpoints := cri.Registry.Mirrors["192.168.1.1:9080"].Endpoint; len(endpoints) != 2 || endpoints[1] != "http://192.168.1.1:9080" {
+		t.Errorf("unexpected endpoints of the insecure registry: %v", endpoints)
+	}
+	if !cri.Registry.Configs["192.168.1.1:9080"].TLS.InsecureSkipVerify || cri.Registry.Configs["registry.example.com"].Auth.Username != "user" {
+		t.Errorf("unexpected registry configs: %v", cri.Registry.Configs)
+	}
+
+	crictl := findFile(t, files, constants.CrictlConfig)
+	if !strings.Contains(string(crictl.Contents), "runtime-endpoint: unix:///var/run/containerd/containerd.sock") {
+		t.Errorf("unexpected crictl.yaml: %s", crictl.Contents)
+	}
+
+	files, err = cr.GetRuntimeConfigFiles(&api.RuntimeConfig{})
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	configFile = findFile(t, files, constants.ContainerdConfig)
+	if configFile.Mode != constants.BootConfigFileMode || !strings.Contains(string(configFile.Contents), "SystemdCgroup = true") ||
+		strings.Contains(string(configFile.Contents), "registry") {
+		t.Errorf("unexpected default config.toml:\n%s", configFile.Contents)
+	}
+}
+
+func TestContainerdRuntimeHandlers(t *testing.T) {
+	cr := &containerdRuntime{}
+	files, err := cr.GetRuntimeConfigFiles(&api.RuntimeConfig{Handlers: testHandlers()})
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var config struct {
+		Plugins struct {
+			CRI struct {
+				Containerd struct {
+					Runtimes map[string]struct {
+						RuntimeType string `toml:"runtime_type"`
+						RuntimePath string `toml:"runtime_path"`
+					}
+				}
+			} `toml:"io.containerd.grpc.v1.cri"`
+		}
+	}
+	configFile := findFile(t, files, constants.ContainerdConfig)
+	if _, err := toml.Decode(string(configFile.Contents), &config); err != nil {
+		t.Fatalf("invalid config.toml: %v\n%s", err, configFile.Contents)
+	}
+	runtimes := config.Plugins.CRI.Containerd.Runtimes
+	if runtimes["runc"].RuntimeType != "io.containerd.runc.v2" || runtimes["kata"].RuntimeType != "io.containerd.kata.v2" ||
+		runtimes["kata"].RuntimePath != "" || runtimes["gvisor"].RuntimeType != "io.containerd.runsc.v1" ||
+		runtimes["gvisor"].RuntimePath != "/usr/local/bin/runsc" {
+		t.Errorf("unexpected runtimes in config.toml:\n%s", configFile.Contents)
+	}
+}
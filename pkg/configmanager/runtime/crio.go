@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -15,14 +15,85 @@ limitations under the License.
 */
 package runtime
 
-import "nestos-kubernetes-deployer/pkg/api"
+import (
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+)
 
 type crioRuntime struct{}
 
+// crioRuntimeDefaults 额外注册的运行时在 CRI-O 中的默认设置：kata 使用 shim v2（runtime_type 为 vm），gvisor 使用 OCI 运行时 runsc
+var crioRuntimeDefaults = map[string]map[string]interface{}{
+	constants.RuntimeHandlerKata: {
+		"runtime_path":                    "/usr/bin/containerd-shim-kata-v2",
+		"runtime_type":                    "vm",
+		"runtime_root":                    "/run/vc",
+		"privileged_without_host_devices": true,
+	},
+	constants.RuntimeHandlerGVisor: {
+		"runtime_path": "/usr/bin/runsc",
+		"runtime_type": "oci",
+		"runtime_root": "/run/runsc",
+	},
+}
+
 func (cr *crioRuntime) GetRuntimeCriSocket() string {
 	return "unix:///var/run/crio/crio.sock"
 }
 
+/*
+GetRuntimeConfigFiles 生成 CRI-O 的配置片段 /etc/crio/crio.conf.d/10-nkd.conf，覆盖 crio.conf 中的同名配置项。
+CRI-O 通过 containers-registries.conf 和 /etc/containers/certs.d 获取 mirror、insecure 设置以及 CA 证书（见 ContainersFiles），认证信息保存在 /etc/crio/auth.json 中。
+额外的运行时注册在 crio.runtime.runtimes 中，与默认的 runc 并存
+*/
+func (cr *crioRuntime) GetRuntimeConfigFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	image := map[string]interface{}{}
+	if config.PauseImage != "" {
+		image["pause_image"] = config.PauseImage
+	}
+
+	var files []api.RuntimeFile
+	if len(config.Auths) > 0 {
+		auth, err := authFile(constants.CrioAuthFile, config.Auths)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, auth)
+		image["global_auth_file"] = constants.CrioAuthFile
+	}
+
+	runtime := map[string]interface{}{
+		"cgroup_manager": cgroupDriver(config),
+		// 使用 cgroupfs 时 conmon 必须位于 pod 的 cgroup 中
+		"conmon_cgroup": "pod",
+	}
+	if len(config.Handlers) > 0 {
+		runtimes := make(map[string]interface{}, len(config.Handlers))
+		for _, handler := range config.Handlers {
+			table := make(map[string]interface{})
+			for key, value := range crioRuntimeDefaults[handler.Type] {
+				table[key] = value
+			}
+			if handler.RuntimePath != "" {
+				table["runtime_path"] = handler.RuntimePath
+			}
+			runtimes[handler.Name] = table
+		}
+		runtime["runtimes"] = runtimes
+	}
+
+	crioConfig, err := marshalTOML(constants.CrioConfig, map[string]interface{}{
+		"crio": map[string]interface{}{
+			"runtime": runtime,
+			"image":   image,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]api.RuntimeFile{crioConfig, crictlConfig(cr.GetRuntimeCriSocket())}, files...), nil
+}
+
 func IsCrio(rt api.Runtime) bool {
 	_, ok := rt.(*crioRuntime)
 	return ok
@@ -17,7 +17,12 @@ limitations under the License.
 package runtime
 
 import (
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
 	"testing"
+
+	"github.com/BurntSushi/toml"
 )
 
 func TestCrioRuntimeGetRuntimeCriSocket(t *testing.T) {
@@ -41,3 +46,72 @@ func TestIsCrio(t *testing.T) {
 		t.Errorf("Expected IsCrio to return false for mockRuntime, but got true")
 	}
 }
+
+func TestCrioRuntimeGetRuntimeConfigFiles(t *testing.T) {
+	cr := &crioRuntime{}
+	files, err := cr.GetRuntimeConfigFiles(testRuntimeConfig())
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var config struct {
+		Crio struct {
+			Runtime struct {
+				CgroupManager string `toml:"cgroup_manager"`
+				ConmonCgroup  string `toml:"conmon_cgroup"`
+			}
+			Image struct {
+				PauseImage     string `toml:"pause_image"`
+				GlobalAuthFile string `toml:"global_auth_file"`
+			}
+		}
+	}
+	configFile := findFile(t, files, constants.CrioConfig)
+	if _, err := toml.Decode(string(configFile.Contents), &config); err != nil {
+		t.Fatalf("invalid crio config: %v\n%s", err, configFile.Contents)
+	}
+	if config.Crio.Runtime.CgroupManager != CgroupDriverCgroupfs || config.Crio.Runtime.ConmonCgroup != "pod" ||
+		config.Crio.Image.PauseImage != "registry.k8s.io/pause:3.9" || config.Crio.Image.GlobalAuthFile != constants.CrioAuthFile {
+		t.Errorf("unexpected crio config:\n%s", configFile.Contents)
+	}
+	if auth := findFile(t, files, constants.CrioAuthFile); auth.Mode != constants.RegistryAuthMode ||
+		!strings.Contains(string(auth.Contents), `"registry.example.com"`) {
+		t.Errorf("unexpected auth file %o: %s", auth.Mode, auth.Contents)
+	}
+
+	files, err = cr.GetRuntimeConfigFiles(&api.RuntimeConfig{})
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	if len(files) != 2 || !strings.Contains(string(files[0].Contents), `cgroup_manager = "systemd"`) {
+		t.Errorf("unexpected default crio config: %v", files)
+	}
+}
+
+func TestCrioRuntimeHandlers(t *testing.T) {
+	cr := &crioRuntime{}
+	files, err := cr.GetRuntimeConfigFiles(&api.RuntimeConfig{Handlers: testHandlers()})
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var config struct {
+		Crio struct {
+			Runtime struct {
+				Runtimes map[string]struct {
+					RuntimePath string `toml:"runtime_path"`
+					RuntimeType string `toml:"runtime_type"`
+					RuntimeRoot string `toml:"runtime_root"`
+				}
+			}
+		}
+	}
+	configFile := findFile(t, files, constants.CrioConfig)
+	if _, err := toml.Decode(string(configFile.Contents), &config); err != nil {
+		t.Fatalf("invalid crio config: %v\n%s", err, configFile.Contents)
+	}
+	runtimes := config.Crio.Runtime.Runtimes
+	if runtimes["kata"].RuntimeType != "vm" || runtimes["kata"].RuntimePath != "/usr/bin/containerd-shim-kata-v2" ||
+		runtimes["gvisor"].RuntimeType != "oci" || runtimes["gvisor"].RuntimePath != "/usr/local/bin/runsc" ||
+		runtimes["gvisor"].RuntimeRoot != "/run/runsc" {
+		t.Errorf("unexpected runtimes in crio config:\n%s", configFile.Contents)
+	}
+}
@@ -15,7 +15,14 @@ limitations under the License.
 */
 package runtime
 
-import "nestos-kubernetes-deployer/pkg/api"
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
 
 type dockerRuntime struct{}
 
@@ -23,6 +30,48 @@ func (dr *dockerRuntime) GetRuntimeCriSocket() string {
 	return "/var/run/dockershim.sock"
 }
 
+/*
+GetRuntimeConfigFiles 生成 docker 的配置文件 /etc/docker/daemon.json：
+  - docker 仅支持 docker.io 的 mirror，其他镜像仓库的 mirror 被忽略
+  - 不校验证书和通过 HTTP 访问的镜像仓库均设置为 insecure-registries，CA 证书保存在 /etc/docker/certs.d/<镜像仓库>/ca.crt
+  - pause 镜像由 kubelet 设置，认证信息保存在 kubelet 的 /var/lib/kubelet/config.json 中，由 kubelet 拉取镜像时使用
+*/
+func (dr *dockerRuntime) GetRuntimeConfigFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	// dockershim 仅支持默认的运行时
+	if len(config.Handlers) > 0 {
+		return nil, fmt.Errorf("docker does not support runtime handlers")
+	}
+	mirrors, ignored := dockerHubMirrors(config)
+	if len(ignored) > 0 {
+		logrus.Warnf("docker only supports mirrors of docker.io, mirrors of %s are ignored", strings.Join(ignored, ", "))
+	}
+
+	daemon := map[string]interface{}{
+		"exec-opts": []string{"native.cgroupdriver=" + cgroupDriver(config)},
+	}
+	if len(mirrors) > 0 {
+		daemon["registry-mirrors"] = mirrors
+	}
+	if insecure := insecureRegistries(config); len(insecure) > 0 {
+		daemon["insecure-registries"] = insecure
+	}
+	daemonConfig, err := marshalJSON(constants.DockerConfig, daemon)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []api.RuntimeFile{daemonConfig, crictlConfig(dr.GetRuntimeCriSocket())}
+	files = append(files, caFiles(constants.DockerCertsDir, config)...)
+	if len(config.Auths) > 0 {
+		auth, err := authFile(constants.KubeletAuthFile, config.Auths)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, auth)
+	}
+	return files, nil
+}
+
 func IsDocker(rt api.Runtime) bool {
 	_, ok := rt.(*dockerRuntime)
 	return ok
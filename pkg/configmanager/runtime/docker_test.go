@@ -17,6 +17,9 @@ limitations under the License.
 package runtime
 
 import (
+	"encoding/json"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
 	"testing"
 )
 
@@ -41,3 +44,40 @@ func TestIsDocker(t *testing.T) {
 		t.Errorf("Expected IsDocker to return false for mockRuntime, but got true")
 	}
 }
+
+func TestDockerRuntimeGetRuntimeConfigFiles(t *testing.T) {
+	dr := &dockerRuntime{}
+	files, err := dr.GetRuntimeConfigFiles(testRuntimeConfig())
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var daemon struct {
+		ExecOpts           []string `json:"exec-opts"`
+		RegistryMirrors    []string `json:"registry-mirrors"`
+		InsecureRegistries []string `json:"insecure-registries"`
+	}
+	configFile := findFile(t, files, constants.DockerConfig)
+	if err := json.Unmarshal(configFile.Contents, &daemon); err != nil {
+		t.Fatalf("invalid daemon.json: %v", err)
+	}
+	if len(daemon.ExecOpts) != 1 || daemon.ExecOpts[0] != "native.cgroupdriver=cgroupfs" {
+		t.Errorf("unexpected exec-opts: %v", daemon.ExecOpts)
+	}
+	// docker 仅支持 docker.io 的 mirror
+	if len(daemon.RegistryMirrors) != 2 || daemon.RegistryMirrors[0] != "http://192.168.1.1:9080" {
+		t.Errorf("unexpected registry-mirrors: %v", daemon.RegistryMirrors)
+	}
+	if len(daemon.InsecureRegistries) != 1 || daemon.InsecureRegistries[0] != "192.168.1.1:9080" {
+		t.Errorf("unexpected insecure-registries: %v", daemon.InsecureRegistries)
+	}
+	if auth := findFile(t, files, constants.KubeletAuthFile); auth.Mode != constants.RegistryAuthMode {
+		t.Errorf("unexpected mode of the auth file: %o", auth.Mode)
+	}
+}
+
+func TestDockerRuntimeHandlers(t *testing.T) {
+	dr := &dockerRuntime{}
+	if _, err := dr.GetRuntimeConfigFiles(&api.RuntimeConfig{Handlers: testHandlers()}); err == nil {
+		t.Errorf("expected error of runtime handlers on docker")
+	}
+}
@@ -17,6 +17,10 @@ package runtime
 
 import (
 	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
+
+	"github.com/sirupsen/logrus"
 )
 
 type isuladRuntime struct {
@@ -26,6 +30,106 @@ func (ir *isuladRuntime) GetRuntimeCriSocket() string {
 	return "/var/run/isulad.sock"
 }
 
+/*
+GetRuntimeConfigFiles 生成完整的 iSulad 配置文件 /etc/isulad/daemon.json：
+  - iSulad 仅支持 docker.io 的 mirror，其他镜像仓库的 mirror 被忽略，通过 HTTP 访问的 mirror 同时设置为 insecure 镜像仓库
+  - 不校验证书和通过 HTTP 访问的镜像仓库均设置为 insecure-registries，CA 证书保存在 /etc/isulad/certs.d/<镜像仓库>/ca.crt
+  - 认证信息保存在 kubelet 的 /var/lib/kubelet/config.json 中，由 kubelet 拉取镜像时使用
+  - 额外的运行时注册在 cri-runtimes 中：kata 使用 shim v2 运行时 io.containerd.kata.v2，gvisor 使用 runtimes 中注册的 OCI 运行时 runsc
+*/
+func (ir *isuladRuntime) GetRuntimeConfigFiles(config *api.RuntimeConfig) ([]api.RuntimeFile, error) {
+	endpoints, ignored := dockerHubMirrors(config)
+	if len(ignored) > 0 {
+		logrus.Warnf("iSulad only supports mirrors of docker.io, mirrors of %s are ignored", strings.Join(ignored, ", "))
+	}
+	mirrors := []string{}
+	insecure := append([]string{}, insecureRegistries(config)...)
+	for _, endpoint := range endpoints {
+		host, http, err := endpointHost(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, host)
+		if http && !contains(insecure, host) {
+			insecure = append(insecure, host)
+		}
+	}
+	mirrors = append(mirrors, "docker.io")
+
+	criRuntimes := map[string]string{"kata": "io.containerd.kata.v2"}
+	ociRuntimes := make(map[string]interface{})
+	for _, handler := range config.Handlers {
+		switch handler.Type {
+		case constants.RuntimeHandlerKata:
+			if handler.RuntimePath != "" {
+				logrus.Warnf("iSulad does not support the runtime path of shim v2 runtimes, runtimePath of %s is ignored", handler.Name)
+			}
+			criRuntimes[handler.Name] = "io.containerd.kata.v2"
+		case constants.RuntimeHandlerGVisor:
+			path := handler.RuntimePath
+			if path == "" {
+				path = "/usr/bin/runsc"
+			}
+			criRuntimes[handler.Name] = handler.Name
+			ociRuntimes[handler.Name] = map[string]interface{}{"path": path, "runtime-args": []string{}}
+		}
+	}
+
+	daemon := map[string]interface{}{
+		"group":           "isula",
+		"default-runtime": "lcr",
+		"graph":           "/var/lib/isulad",
+		"state":           "/var/run/isulad",
+		"engine":          "lcr",
+		"log-level":       "ERROR",
+		"pidfile":         "/var/run/isulad.pid",
+		"log-opts": map[string]string{
+			"log-file-mode": "0600",
+			"log-path":      "/var/lib/isulad",
+			"max-file":      "1",
+			"max-size":      "30KB",
+		},
+		"log-driver":                   "stdout",
+		"container-log":                map[string]string{"driver": "json-file"},
+		"hook-spec":                    "/etc/default/isulad/hooks/default.json",
+		"start-timeout":                "2m",
+		"storage-driver":               "overlay2",
+		"storage-opts":                 []string{"overlay2.override_kernel_check=true"},
+		"registry-mirrors":             mirrors,
+		"insecure-registries":          insecure,
+		"systemd-cgroup":               cgroupDriver(config) == CgroupDriverSystemd,
+		"native.umask":                 "secure",
+		"network-plugin":               "cni",
+		"cni-bin-dir":                  "/opt/cni/bin",
+		"cni-conf-dir":                 "/etc/cni/net.d",
+		"image-layer-check":            false,
+		"use-decrypted-key":            true,
+		"insecure-skip-verify-enforce": false,
+		"cri-runtimes":                 criRuntimes,
+	}
+	if len(ociRuntimes) > 0 {
+		daemon["runtimes"] = ociRuntimes
+	}
+	if config.PauseImage != "" {
+		daemon["pod-sandbox-image"] = config.PauseImage
+	}
+	daemonConfig, err := marshalJSON(constants.IsuladConfig, daemon)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []api.RuntimeFile{daemonConfig, crictlConfig(ir.GetRuntimeCriSocket())}
+	files = append(files, caFiles(constants.IsuladCertsDir, config)...)
+	if len(config.Auths) > 0 {
+		auth, err := authFile(constants.KubeletAuthFile, config.Auths)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, auth)
+	}
+	return files, nil
+}
+
 func IsIsulad(rt api.Runtime) bool {
 	_, ok := rt.(*isuladRuntime)
 	return ok
@@ -17,6 +17,9 @@ limitations under the License.
 package runtime
 
 import (
+	"encoding/json"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/constants"
 	"testing"
 )
 
@@ -41,3 +44,62 @@ func TestIsIsulad(t *testing.T) {
 		t.Errorf("Expected IsIsulad to return false for mockRuntime, but got true")
 	}
 }
+
+func TestIsuladRuntimeGetRuntimeConfigFiles(t *testing.T) {
+	ir := &isuladRuntime{}
+	files, err := ir.GetRuntimeConfigFiles(testRuntimeConfig())
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var daemon struct {
+		PodSandboxImage    string   `json:"pod-sandbox-image"`
+		SystemdCgroup      bool     `json:"systemd-cgroup"`
+		RegistryMirrors    []string `json:"registry-mirrors"`
+		InsecureRegistries []string `json:"insecure-registries"`
+		NetworkPlugin      string   `json:"network-plugin"`
+	}
+	configFile := findFile(t, files, constants.IsuladConfig)
+	if err := json.Unmarshal(configFile.Contents, &daemon); err != nil {
+		t.Fatalf("invalid daemon.json: %v", err)
+	}
+	if daemon.PodSandboxImage != "registry.k8s.io/pause:3.9" || daemon.SystemdCgroup || daemon.NetworkPlugin != "cni" {
+		t.Errorf("unexpected daemon.json:\n%s", configFile.Contents)
+	}
+	// iSulad 的 mirror 不包含协议，通过 HTTP 访问的 mirror 设置为 insecure 镜像仓库
+	expectedMirrors := []string{"192.168.1.1:9080", "mirror.example.com", "docker.io"}
+	if len(daemon.RegistryMirrors) != len(expectedMirrors) {
+		t.Fatalf("unexpected registry-mirrors: %v", daemon.RegistryMirrors)
+	}
+	for i := range expectedMirrors {
+		if daemon.RegistryMirrors[i] != expectedMirrors[i] {
+			t.Errorf("unexpected registry-mirrors: %v", daemon.RegistryMirrors)
+		}
+	}
+	if len(daemon.InsecureRegistries) != 1 || daemon.InsecureRegistries[0] != "192.168.1.1:9080" {
+		t.Errorf("unexpected insecure-registries: %v", daemon.InsecureRegistries)
+	}
+	findFile(t, files, constants.KubeletAuthFile)
+	findFile(t, files, constants.CrictlConfig)
+}
+
+func TestIsuladRuntimeHandlers(t *testing.T) {
+	ir := &isuladRuntime{}
+	files, err := ir.GetRuntimeConfigFiles(&api.RuntimeConfig{Handlers: testHandlers()})
+	if err != nil {
+		t.Fatalf("GetRuntimeConfigFiles failed: %v", err)
+	}
+	var daemon struct {
+		CRIRuntimes map[string]string `json:"cri-runtimes"`
+		Runtimes    map[string]struct {
+			Path string `json:"path"`
+		} `json:"runtimes"`
+	}
+	configFile := findFile(t, files, constants.IsuladConfig)
+	if err := json.Unmarshal(configFile.Contents, &daemon); err != nil {
+		t.Fatalf("invalid daemon.json: %v", err)
+	}
+	if daemon.CRIRuntimes["kata"] != "io.containerd.kata.v2" || daemon.CRIRuntimes["gvisor"] != "gvisor" ||
+		daemon.Runtimes["gvisor"].Path != "/usr/local/bin/runsc" {
+		t.Errorf("unexpected runtimes in daemon.json:\n%s", configFile.Contents)
+	}
+}
@@ -46,3 +46,11 @@ func GetRuntime(runtime string) (api.Runtime, error) {
 
 	return rt, nil
 }
+
+// SupportsMirror 返回运行时是否支持为镜像仓库配置 mirror，docker 和 iSulad 仅支持 docker.io 的 mirror
+func SupportsMirror(rt api.Runtime, registry string) bool {
+	if IsDocker(rt) || IsIsulad(rt) {
+		return registry == "docker.io"
+	}
+	return true
+}
@@ -81,3 +81,16 @@ func TestGetRuntime(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportsMirror(t *testing.T) {
+	for _, rt := range []api.Runtime{&dockerRuntime{}, &isuladRuntime{}} {
+		if !SupportsMirror(rt, "docker.io") || SupportsMirror(rt, "registry.k8s.io") {
+			t.Errorf("%T only supports mirrors of docker.io", rt)
+		}
+	}
+	for _, rt := range []api.Runtime{&containerdRuntime{}, &crioRuntime{}} {
+		if !SupportsMirror(rt, "registry.k8s.io") {
+			t.Errorf("%T supports mirrors of any registry", rt)
+		}
+	}
+}
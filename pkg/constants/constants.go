@@ -29,6 +29,10 @@ const (
 	Crio       = "crio"
 	Containerd = "containerd"
 
+	// 额外注册的运行时 handler 类型
+	RuntimeHandlerKata   = "kata"
+	RuntimeHandlerGVisor = "gvisor"
+
 	// 操作系统引导文件存储文件夹
 	BootConfigSaveDir = "bootconfig"
 	// 操作系统引导阶段可能启用的服务
@@ -41,14 +45,30 @@ const (
 	BootConfigSystemdPath    = "bootconfig/systemd"
 	// 操作系统引导阶段可能写入的文件
 	InitClusterYaml       = "/etc/nkdfiles/init-config.yaml.template"
-	IsuladConfig          = "/etc/isulad/daemon.json.template"
-	DockerConfig          = "/etc/docker/daemon.json.template"
 	ReleaseImagePivotFile = "/etc/nkdfiles/node-pivot.sh.template"
 	SetKernelParaConf     = "/etc/sysctl.d/kubernetes.conf"
 	KubeletServiceConf    = "/etc/systemd/system/kubelet.service.d/10-kubeadm.conf.template"
 	Hosts                 = "/etc/hosts.template"
+	RpmRepoFile           = "/etc/yum.repos.d/nkd-packages.repo.template"
+	RpmGPGKeyFile         = "/etc/pki/rpm-gpg/RPM-GPG-KEY-nkd-packages.template"
 	HookFilesPath         = "/etc/nkdfiles/hookfiles/"
+	HostnameFile          = "/etc/hostname"
+	KubeletSysconfig      = "/etc/sysconfig/kubelet"
+	NMConnectionsPath     = "/etc/NetworkManager/system-connections/"
 	BootConfigFilesPath   = "bootconfig/files"
+	// 由容器运行时生成的配置文件
+	ContainerdConfig   = "/etc/containerd/config.toml"
+	CrioConfig         = "/etc/crio/crio.conf.d/10-nkd.conf"
+	CrioAuthFile       = "/etc/crio/auth.json"
+	IsuladConfig       = "/etc/isulad/daemon.json"
+	DockerConfig       = "/etc/docker/daemon.json"
+	CrictlConfig       = "/etc/crictl.yaml"
+	KubeletAuthFile    = "/var/lib/kubelet/config.json"
+	ContainerdCertsDir = "/etc/containerd/certs.d"
+	ContainersCertsDir = "/etc/containers/certs.d"
+	DockerCertsDir     = "/etc/docker/certs.d"
+	IsuladCertsDir     = "/etc/isulad/certs.d"
+	RegistriesConf     = "/etc/containers/registries.conf.d/nkd-registry.conf"
 	// 引导配置文件名称
 	ControlplaneIgn      = "controlplane.ign"
 	ControlplaneMergeIgn = "controlplane-merge.ign"
@@ -57,15 +77,25 @@ const (
 	WorkerIgn            = "worker.ign"
 	WorkerMergeIgn       = "worker-merge.ign"
 	KickstartSuffix      = ".cfg"
+	CloudinitSuffix      = ".cfg"
 	IPXECfg              = "ipxe.cfg"
+	MergeIgnSuffix       = "-merge.ign"
+	IgnSuffix            = ".ign"
+	NetworkConfigSuffix  = "-network-config.yaml"
+	// 节点专属引导配置所在目录，在引导服务中以 /nodes/<主机名>.ign 或 /nodes/<主机名>.cfg 提供
+	NodeBootConfigDir = "nodes"
 
 	CertsFiles     = "certs.json"
 	RpmPackageList = "/packagelist/"
 
 	SetHostname = "set-hostname.service"
+	// 包含所有镜像仓库认证信息的镜像拉取 Secret
+	ImagePullSecretName = "nkd-registry-credentials"
 
 	SystemdServiceMode os.FileMode = 0644
 	StorageFilesMode   os.FileMode = 0755
 	SaveFileDirMode    os.FileMode = 0750
 	BootConfigFileMode os.FileMode = 0644
+	NMConnectionMode   os.FileMode = 0600
+	RegistryAuthMode   os.FileMode = 0600
 )
@@ -0,0 +1,490 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpserver
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ModeDHCP 分配IP地址并提供引导信息
+	ModeDHCP = "dhcp"
+	// ModeProxy 仅向PXE客户端提供引导信息，与现有的DHCP服务共存
+	ModeProxy = "proxy"
+
+	serverPort = 67
+	clientPort = 68
+	proxyPort  = 4011 // PXE客户端向proxyDHCP请求引导信息的端口
+
+	defaultLeaseTime = time.Hour
+	offerTimeout     = time.Minute
+)
+
+// 客户端系统架构（RFC 4578）
+const (
+	archBIOS     = 0
+	archEFIIA32  = 6
+	archEFIBC    = 7
+	archEFIX8664 = 9
+	archEFIARM64 = 11
+)
+
+// BootFiles 各类客户端的引导文件，为空时不向该类客户端提供引导信息
+type BootFiles struct {
+	BIOS      string // x86 BIOS
+	UEFI      string // x86_64 UEFI
+	UEFIArm64 string // aarch64 UEFI
+	IPXE      string // iPXE客户端，通常为引导脚本的HTTP地址
+}
+
+// Reservation 为节点保留的IP地址
+type Reservation struct {
+	IP       net.IP
+	Hostname string
+}
+
+type Config struct {
+	Mode       string
+	IP         net.IP // 服务绑定的地址
+	Interface  string // 为空时使用IP所在的网卡
+	NextServer net.IP // 提供引导文件的TFTP服务器，为空时使用IP
+	RangeStart net.IP
+	RangeEnd   net.IP
+	SubnetMask net.IPMask // 为空时使用IP所在网段的掩码
+	Router     net.IP
+	DNSServers []net.IP
+	LeaseTime  time.Duration
+	BootFiles  BootFiles
+	// 以MAC地址为索引的保留地址
+	Reservations map[string]Reservation
+}
+
+type lease struct {
+	ip     net.IP
+	expiry time.Time
+	bound  bool
+}
+
+type DHCPService struct {
+	Config
+	mutex    sync.Mutex
+	leases   map[string]*lease    // 以MAC地址为索引
+	declined map[string]time.Time // 客户端发现冲突的地址
+	conns    []net.PacketConn
+	stopped  bool
+}
+
+// NewDHCPService 校验配置并补全网卡、掩码等默认值
+func NewDHCPService(config Config) (*DHCPService, error) {
+	if config.Mode != ModeDHCP && config.Mode != ModeProxy {
+		return nil, fmt.Errorf("unsupported dhcp mode: %s", config.Mode)
+	}
+	if config.IP.To4() == nil {
+		return nil, fmt.Errorf("invalid IPv4 address of the dhcp service: %s", config.IP)
+	}
+	config.IP = config.IP.To4()
+
+	iface, ipNet, err := interfaceByIP(config.IP)
+	if err != nil {
+		return nil, err
+	}
+	if config.Interface == "" {
+		config.Interface = iface.Name
+	}
+	if config.SubnetMask == nil {
+		config.SubnetMask = ipNet.Mask
+	}
+	if config.NextServer == nil {
+		config.NextServer = config.IP
+	}
+	if config.LeaseTime == 0 {
+		config.LeaseTime = defaultLeaseTime
+	}
+
+	if config.Mode == ModeDHCP {
+		subnet := &net.IPNet{IP: config.IP.Mask(config.SubnetMask), Mask: config.SubnetMask}
+		if config.RangeStart.To4() == nil || config.RangeEnd.To4() == nil {
+			return nil, errors.New("the address range of the dhcp service is not set")
+		}
+		if !subnet.Contains(config.RangeStart) || !subnet.Contains(config.RangeEnd) {
+			return nil, fmt.Errorf("the address range %s-%s is not in the subnet %s", config.RangeStart, config.RangeEnd, subnet)
+		}
+		if ipToUint(config.RangeStart) > ipToUint(config.RangeEnd) {
+			return nil, fmt.Errorf("invalid address range %s-%s", config.RangeStart, config.RangeEnd)
+		}
+		for mac, r := range config.Reservations {
+			if !subnet.Contains(r.IP) {
+				return nil, fmt.Errorf("the address %s reserved for %s is not in the subnet %s", r.IP, mac, subnet)
+			}
+		}
+	}
+
+	return &DHCPService{
+		Config:   config,
+		leases:   make(map[string]*lease),
+		declined: make(map[string]time.Time),
+	}, nil
+}
+
+// interfaceByIP 查找地址所在的网卡
+func interfaceByIP(ip net.IP) (*net.Interface, *net.IPNet, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range ifaces {
+		addrs, err := ifaces[i].Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.Equal(ip) {
+				return &ifaces[i], ipNet, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("no network interface has the address %s", ip)
+}
+
+// Start 监听DHCP端口并在后台处理请求
+func (d *DHCPService) Start() error {
+	ports := []int{serverPort}
+	if d.Mode == ModeProxy {
+		ports = append(ports, proxyPort)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, port := range ports {
+		conn, err := listen(d.Interface, port)
+		if err != nil {
+			for _, c := range d.conns {
+				c.Close()
+			}
+			d.conns = nil
+			return errors.Wrapf(err, "failed to listen on port %d", port)
+		}
+		d.conns = append(d.conns, conn)
+		go d.serve(conn, port)
+	}
+	logrus.Infof("DHCP server (%s mode) is listening on %s (%s)", d.Mode, d.Interface, d.IP)
+	return nil
+}
+
+func (d *DHCPService) serve(conn net.PacketConn, port int) {
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			d.mutex.Lock()
+			stopped := d.stopped
+			d.mutex.Unlock()
+			if !stopped {
+				logrus.Errorf("DHCP server failed to read from port %d: %v", port, err)
+			}
+			return
+		}
+
+		req, err := parseMessage(buf[:n])
+		if err != nil {
+			logrus.Debugf("Ignored invalid dhcp message from %s: %v", addr, err)
+			continue
+		}
+		resp := d.handle(req, port)
+		if resp == nil {
+			continue
+		}
+		if _, err := conn.WriteTo(resp.marshal(), replyAddr(req, resp, addr, port)); err != nil {
+			logrus.Warnf("Failed to send dhcp reply to %s: %v", req.chaddr, err)
+		}
+	}
+}
+
+// replyAddr 返回应答的目的地址
+func replyAddr(req *message, resp *message, addr net.Addr, port int) net.Addr {
+	switch {
+	case port == proxyPort:
+		return addr
+	case !req.giaddr.Equal(net.IPv4zero):
+		return &net.UDPAddr{IP: req.giaddr, Port: serverPort}
+	case !req.ciaddr.Equal(net.IPv4zero) && resp.messageType() != msgNak:
+		return &net.UDPAddr{IP: req.ciaddr, Port: clientPort}
+	default:
+		return &net.UDPAddr{IP: net.IPv4bcast, Port: clientPort}
+	}
+}
+
+// Stop 停止服务，服务未启用时为 nil
+func (d *DHCPService) Stop() error {
+	if d == nil {
+		return nil
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.stopped = true
+	for _, conn := range d.conns {
+		conn.Close()
+	}
+	d.conns = nil
+	return nil
+}
+
+// handle 处理请求并返回应答，无需应答时返回 nil
+func (d *DHCPService) handle(req *message, port int) *message {
+	if req.op != bootRequest || req.htype != htypeEthernet || len(req.chaddr) != 6 {
+		return nil
+	}
+	if d.Mode == ModeProxy {
+		return d.handleProxy(req, port)
+	}
+	if port != serverPort {
+		return nil
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	mac := req.chaddr.String()
+	switch req.messageType() {
+	case msgDiscover:
+		ip := d.allocate(mac, req.optionIP(optRequestedIP))
+		if ip == nil {
+			logrus.Warnf("DHCP: no free address for %s", mac)
+			return nil
+		}
+		if _, ok := d.leases[mac]; !ok || !d.leases[mac].ip.Equal(ip) {
+			d.leases[mac] = &lease{ip: ip}
+		}
+		if !d.leases[mac].bound {
+			d.leases[mac].expiry = time.Now().Add(offerTimeout)
+		}
+		logrus.Debugf("DHCP: offering %s to %s", ip, mac)
+		return d.reply(req, msgOffer, ip)
+	case msgRequest:
+		if serverID := req.optionIP(optServerID); serverID != nil && !serverID.Equal(d.IP) {
+			// 客户端选择了其他DHCP服务器
+			if l, ok := d.leases[mac]; ok && !l.bound {
+				delete(d.leases, mac)
+			}
+			return nil
+		}
+		requested := req.optionIP(optRequestedIP)
+		if requested == nil {
+			requested = req.ciaddr
+		}
+		ip := d.allocate(mac, requested)
+		if ip == nil || !ip.Equal(requested) {
+			logrus.Infof("DHCP: declined request of %s for %s", mac, requested)
+			return d.reply(req, msgNak, nil)
+		}
+		d.leases[mac] = &lease{ip: ip, expiry: time.Now().Add(d.LeaseTime), bound: true}
+		resp := d.reply(req, msgAck, ip)
+		logrus.Infof("DHCP: leased %s to %s%s", ip, mac, bootFileInfo(resp))
+		return resp
+	case msgDecline:
+		if ip := req.optionIP(optRequestedIP); ip != nil {
+			logrus.Warnf("DHCP: %s reported that %s is already in use", mac, ip)
+			d.declined[ip.String()] = time.Now().Add(d.LeaseTime)
+		}
+		delete(d.leases, mac)
+		return nil
+	case msgRelease:
+		if l, ok := d.leases[mac]; ok && l.ip.Equal(req.ciaddr) {
+			delete(d.leases, mac)
+		}
+		return nil
+	case msgInform:
+		return d.reply(req, msgAck, nil)
+	default:
+		return nil
+	}
+}
+
+// handleProxy 以proxyDHCP方式应答PXE客户端，不分配地址
+func (d *DHCPService) handleProxy(req *message, port int) *message {
+	if !req.isPXEClient() || d.bootFile(req) == "" {
+		return nil
+	}
+
+	var resp *message
+	switch {
+	case port == serverPort && req.messageType() == msgDiscover:
+		resp = d.reply(req, msgOffer, nil)
+	case port == proxyPort && req.messageType() == msgRequest:
+		resp = d.reply(req, msgAck, nil)
+	default:
+		return nil
+	}
+	// PXE_DISCOVERY_CONTROL：直接下载应答中的引导文件，不再进行启动服务器发现
+	resp.options[optVendorSpecific] = []byte{6, 1, 8, optEnd}
+	logrus.Infof("DHCP: sent boot file to %s%s", req.chaddr, bootFileInfo(resp))
+	return resp
+}
+
+func (d *DHCPService) reply(req *message, msgType byte, yiaddr net.IP) *message {
+	resp := &message{
+		op:      bootReply,
+		htype:   req.htype,
+		hlen:    req.hlen,
+		xid:     req.xid,
+		flags:   req.flags,
+		ciaddr:  net.IPv4zero,
+		yiaddr:  net.IPv4zero,
+		siaddr:  net.IPv4zero,
+		giaddr:  req.giaddr,
+		chaddr:  req.chaddr,
+		options: map[byte][]byte{optMessageType: {msgType}, optServerID: d.IP.To4()},
+	}
+	if msgType == msgNak {
+		resp.flags |= flagBroadcast
+		return resp
+	}
+	if yiaddr != nil {
+		resp.yiaddr = yiaddr
+	}
+	if msgType == msgInform || req.messageType() == msgInform {
+		resp.ciaddr = req.ciaddr
+	}
+
+	if d.Mode == ModeDHCP {
+		resp.options[optSubnetMask] = []byte(d.SubnetMask)
+		if d.Router != nil {
+			resp.options[optRouter] = d.Router.To4()
+		}
+		if len(d.DNSServers) > 0 {
+			var dns []byte
+			for _, server := range d.DNSServers {
+				dns = append(dns, server.To4()...)
+			}
+			resp.options[optDNSServers] = dns
+		}
+		if req.messageType() != msgInform {
+			resp.options[optLeaseTime] = make([]byte, 4)
+			binary.BigEndian.PutUint32(resp.options[optLeaseTime], uint32(d.LeaseTime/time.Second))
+		}
+		if r, ok := d.Reservations[req.chaddr.String()]; ok && r.Hostname != "" {
+			resp.options[optHostname] = []byte(r.Hostname)
+		}
+	}
+
+	if file := d.bootFile(req); file != "" {
+		resp.siaddr = d.NextServer.To4()
+		resp.sname = d.NextServer.String()
+		resp.file = file
+		resp.options[optTFTPServer] = []byte(d.NextServer.String())
+		resp.options[optBootFile] = []byte(file)
+		resp.options[optVendorClass] = []byte("PXEClient")
+		if guid, ok := req.options[optClientGUID]; ok {
+			resp.options[optClientGUID] = guid
+		}
+	}
+	return resp
+}
+
+// bootFile 根据客户端类型与架构选择引导文件
+func (d *DHCPService) bootFile(req *message) string {
+	if !req.isPXEClient() && !req.isIPXE() {
+		return ""
+	}
+	if req.isIPXE() && d.BootFiles.IPXE != "" {
+		return d.BootFiles.IPXE
+	}
+	switch req.clientArch() {
+	case archBIOS:
+		return d.BootFiles.BIOS
+	case archEFIIA32, archEFIBC, archEFIX8664:
+		return d.BootFiles.UEFI
+	case archEFIARM64:
+		return d.BootFiles.UEFIArm64
+	default:
+		logrus.Debugf("DHCP: unsupported client architecture %d of %s", req.clientArch(), req.chaddr)
+		return ""
+	}
+}
+
+// allocate 依次选择保留地址、已分配的地址、客户端请求的地址及地址池中的空闲地址
+func (d *DHCPService) allocate(mac string, requested net.IP) net.IP {
+	if r, ok := d.Reservations[mac]; ok {
+		return r.IP.To4()
+	}
+	if l, ok := d.leases[mac]; ok && d.available(l.ip, mac) {
+		return l.ip
+	}
+	if requested != nil && d.inRange(requested) && d.available(requested, mac) {
+		return requested.To4()
+	}
+	for ip := ipToUint(d.RangeStart); ip <= ipToUint(d.RangeEnd); ip++ {
+		if candidate := uintToIP(ip); d.available(candidate, mac) {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// available 判断地址能否分配给 mac
+func (d *DHCPService) available(ip net.IP, mac string) bool {
+	if ip.Equal(d.IP) {
+		return false
+	}
+	if expiry, ok := d.declined[ip.String()]; ok && time.Now().Before(expiry) {
+		return false
+	}
+	for m, r := range d.Reservations {
+		if m != mac && r.IP.Equal(ip) {
+			return false
+		}
+	}
+	now := time.Now()
+	for m, l := range d.leases {
+		if m != mac && l.ip.Equal(ip) && now.Before(l.expiry) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *DHCPService) inRange(ip net.IP) bool {
+	if ip.To4() == nil {
+		return false
+	}
+	n := ipToUint(ip)
+	return n >= ipToUint(d.RangeStart) && n <= ipToUint(d.RangeEnd)
+}
+
+func bootFileInfo(resp *message) string {
+	if resp.file == "" {
+		return ""
+	}
+	return ", boot file " + resp.file
+}
+
+func ipToUint(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func uintToIP(n uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, n)
+	return ip
+}
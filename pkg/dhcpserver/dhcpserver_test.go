@@ -0,0 +1,252 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpserver
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+)
+
+func newRequest(msgType byte, mac string, options map[byte][]byte) *message {
+	hw, _ := net.ParseMAC(mac)
+	req := &message{
+		op:      bootRequest,
+		htype:   htypeEthernet,
+		hlen:    6,
+		xid:     0x12345678,
+		ciaddr:  net.IPv4zero,
+		yiaddr:  net.IPv4zero,
+		siaddr:  net.IPv4zero,
+		giaddr:  net.IPv4zero,
+		chaddr:  hw,
+		options: map[byte][]byte{optMessageType: {msgType}},
+	}
+	for code, value := range options {
+		req.options[code] = value
+	}
+	// 经过编解码，确保与实际收到的报文一致
+	parsed, err := parseMessage(req.marshal())
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func pxeOptions(arch uint16) map[byte][]byte {
+	archOpt := make([]byte, 2)
+	binary.BigEndian.PutUint16(archOpt, arch)
+	return map[byte][]byte{
+		optVendorClass: []byte("PXEClient:Arch:00000:UNDI:002001"),
+		optClientArch:  archOpt,
+		optClientGUID:  {0, 1, 2, 3},
+	}
+}
+
+func newTestService(mode string) *DHCPService {
+	return &DHCPService{
+		Config: Config{
+			Mode:       mode,
+			IP:         net.ParseIP("192.168.1.1").To4(),
+			NextServer: net.ParseIP("192.168.1.1").To4(),
+			RangeStart: net.ParseIP("192.168.1.100").To4(),
+			RangeEnd:   net.ParseIP("192.168.1.101").To4(),
+			SubnetMask: net.CIDRMask(24, 32),
+			Router:     net.ParseIP("192.168.1.254"),
+			DNSServers: []net.IP{net.ParseIP("192.168.1.53")},
+			LeaseTime:  time.Hour,
+			BootFiles: BootFiles{
+				BIOS:      "pxelinux.0",
+				UEFI:      "grubx64.efi",
+				UEFIArm64: "grubaa64.efi",
+				IPXE:      "http://192.168.1.1:9080/ipxe.cfg",
+			},
+			Reservations: map[string]Reservation{
+				"52:54:00:00:00:01": {IP: net.ParseIP("192.168.1.11"), Hostname: "k8s-master01"},
+			},
+		},
+		leases:   make(map[string]*lease),
+		declined: make(map[string]time.Time),
+	}
+}
+
+func TestDHCPServer(t *testing.T) {
+	t.Run("Message Success", func(t *testing.T) {
+		req := newRequest(msgDiscover, "52:54:00:00:00:01", map[byte][]byte{optHostname: make([]byte, 300)})
+		if req.messageType() != msgDiscover || req.chaddr.String() != "52:54:00:00:00:01" {
+			t.Errorf("unexpected message: %+v", req)
+		}
+		if len(req.options[optHostname]) != 300 {
+			t.Errorf("long option is not split and joined, got %d bytes", len(req.options[optHostname]))
+		}
+	})
+
+	t.Run("Message Fail", func(t *testing.T) {
+		if _, err := parseMessage(make([]byte, 100)); err == nil {
+			t.Errorf("expected error for a short message")
+		}
+		b := newRequest(msgDiscover, "52:54:00:00:00:01", nil).marshal()
+		b[headerLength] = 0
+		if _, err := parseMessage(b); err == nil {
+			t.Errorf("expected error for an invalid magic cookie")
+		}
+	})
+
+	t.Run("Reservation Success", func(t *testing.T) {
+		d := newTestService(ModeDHCP)
+		offer := d.handle(newRequest(msgDiscover, "52:54:00:00:00:01", pxeOptions(archBIOS)), serverPort)
+		if offer == nil || offer.messageType() != msgOffer {
+			t.Fatalf("expected an offer, got %+v", offer)
+		}
+		if offer.yiaddr.String() != "192.168.1.11" || string(offer.options[optHostname]) != "k8s-master01" {
+			t.Errorf("reservation is not used: %s %s", offer.yiaddr, offer.options[optHostname])
+		}
+		if offer.file != "pxelinux.0" || offer.siaddr.String() != "192.168.1.1" {
+			t.Errorf("unexpected boot info: %s %s", offer.file, offer.siaddr)
+		}
+
+		ack := d.handle(newRequest(msgRequest, "52:54:00:00:00:01", map[byte][]byte{
+			optRequestedIP: net.ParseIP("192.168.1.11").To4(),
+			optServerID:    d.IP,
+		}), serverPort)
+		if ack == nil || ack.messageType() != msgAck || ack.yiaddr.String() != "192.168.1.11" {
+			t.Errorf("expected an ack, got %+v", ack)
+		}
+	})
+
+	t.Run("Pool Success", func(t *testing.T) {
+		d := newTestService(ModeDHCP)
+		first := d.handle(newRequest(msgDiscover, "52:54:00:00:00:02", nil), serverPort)
+		second := d.handle(newRequest(msgDiscover, "52:54:00:00:00:03", nil), serverPort)
+		if first == nil || second == nil || first.yiaddr.Equal(second.yiaddr) {
+			t.Fatalf("expected different addresses for different clients")
+		}
+		if first.file != "" {
+			t.Errorf("non PXE client should not get a boot file")
+		}
+		if third := d.handle(newRequest(msgDiscover, "52:54:00:00:00:04", nil), serverPort); third != nil {
+			t.Errorf("expected no offer when the pool is exhausted")
+		}
+
+		ack := d.handle(newRequest(msgRequest, "52:54:00:00:00:02", map[byte][]byte{
+			optRequestedIP: first.yiaddr,
+		}), serverPort)
+		if ack == nil || ack.messageType() != msgAck {
+			t.Errorf("expected an ack, got %+v", ack)
+		}
+	})
+
+	t.Run("Request Fail", func(t *testing.T) {
+		d := newTestService(ModeDHCP)
+		nak := d.handle(newRequest(msgRequest, "52:54:00:00:00:01", map[byte][]byte{
+			optRequestedIP: net.ParseIP("192.168.1.100").To4(),
+		}), serverPort)
+		if nak == nil || nak.messageType() != msgNak {
+			t.Errorf("expected a nak for an address other than the reservation, got %+v", nak)
+		}
+
+		other := d.handle(newRequest(msgRequest, "52:54:00:00:00:02", map[byte][]byte{
+			optRequestedIP: net.ParseIP("192.168.1.100").To4(),
+			optServerID:    net.ParseIP("192.168.1.2").To4(),
+		}), serverPort)
+		if other != nil {
+			t.Errorf("expected no reply to a request for another server")
+		}
+	})
+
+	t.Run("BootFile Success", func(t *testing.T) {
+		d := newTestService(ModeDHCP)
+		cases := map[uint16]string{
+			archBIOS:     "pxelinux.0",
+			archEFIBC:    "grubx64.efi",
+			archEFIX8664: "grubx64.efi",
+			archEFIARM64: "grubaa64.efi",
+			16:           "",
+		}
+		for arch, expected := range cases {
+			if file := d.bootFile(newRequest(msgDiscover, "52:54:00:00:00:02", pxeOptions(arch))); file != expected {
+				t.Errorf("arch %d: expected %q, got %q", arch, expected, file)
+			}
+		}
+
+		options := pxeOptions(archEFIX8664)
+		options[optUserClass] = []byte("iPXE")
+		if file := d.bootFile(newRequest(msgDiscover, "52:54:00:00:00:02", options)); file != d.BootFiles.IPXE {
+			t.Errorf("expected the iPXE script for iPXE clients, got %q", file)
+		}
+	})
+
+	t.Run("Proxy Success", func(t *testing.T) {
+		d := newTestService(ModeProxy)
+		offer := d.handle(newRequest(msgDiscover, "52:54:00:00:00:02", pxeOptions(archEFIARM64)), serverPort)
+		if offer == nil || offer.messageType() != msgOffer {
+			t.Fatalf("expected a proxy offer, got %+v", offer)
+		}
+		if !offer.yiaddr.Equal(net.IPv4zero) {
+			t.Errorf("proxy offer should not assign an address, got %s", offer.yiaddr)
+		}
+		if offer.file != "grubaa64.efi" || string(offer.options[optVendorClass]) != "PXEClient" {
+			t.Errorf("unexpected boot info: %s", offer.file)
+		}
+		if _, ok := offer.options[optLeaseTime]; ok {
+			t.Errorf("proxy offer should not carry a lease time")
+		}
+
+		ack := d.handle(newRequest(msgRequest, "52:54:00:00:00:02", pxeOptions(archEFIARM64)), proxyPort)
+		if ack == nil || ack.messageType() != msgAck || ack.file != "grubaa64.efi" {
+			t.Errorf("expected a proxy ack, got %+v", ack)
+		}
+	})
+
+	t.Run("Proxy Fail", func(t *testing.T) {
+		d := newTestService(ModeProxy)
+		if resp := d.handle(newRequest(msgDiscover, "52:54:00:00:00:02", nil), serverPort); resp != nil {
+			t.Errorf("proxy should ignore non PXE clients")
+		}
+		if resp := d.handle(newRequest(msgRequest, "52:54:00:00:00:02", pxeOptions(archBIOS)), serverPort); resp != nil {
+			t.Errorf("proxy should not answer requests to the DHCP server")
+		}
+	})
+
+	t.Run("NewDHCPService Fail", func(t *testing.T) {
+		if _, err := NewDHCPService(Config{Mode: "unknown", IP: net.ParseIP("127.0.0.1")}); err == nil {
+			t.Errorf("expected error for an unsupported mode")
+		}
+		if _, err := NewDHCPService(Config{Mode: ModeDHCP, IP: net.ParseIP("192.0.2.1")}); err == nil {
+			t.Errorf("expected error for an address not on this host")
+		}
+		if _, err := NewDHCPService(Config{Mode: ModeDHCP, IP: net.ParseIP("127.0.0.1")}); err == nil {
+			t.Errorf("expected error without an address range")
+		}
+	})
+
+	t.Run("NewDHCPService Success", func(t *testing.T) {
+		d, err := NewDHCPService(Config{
+			Mode:       ModeDHCP,
+			IP:         net.ParseIP("127.0.0.1"),
+			RangeStart: net.ParseIP("127.0.0.100"),
+			RangeEnd:   net.ParseIP("127.0.0.200"),
+		})
+		if err != nil {
+			t.Fatalf("NewDHCPService failed: %v", err)
+		}
+		if d.Interface == "" || d.SubnetMask == nil || d.LeaseTime != defaultLeaseTime {
+			t.Errorf("defaults are not set: %+v", d.Config)
+		}
+	})
+}
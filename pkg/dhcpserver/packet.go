@@ -0,0 +1,243 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+)
+
+const (
+	bootRequest = 1
+	bootReply   = 2
+
+	htypeEthernet = 1
+	headerLength  = 236
+	magicCookie   = 0x63825363
+	minLength     = 300 // BOOTP报文的最小长度
+	flagBroadcast = 0x8000
+)
+
+// DHCP消息类型（RFC 2132）
+const (
+	msgDiscover = 1
+	msgOffer    = 2
+	msgRequest  = 3
+	msgDecline  = 4
+	msgAck      = 5
+	msgNak      = 6
+	msgRelease  = 7
+	msgInform   = 8
+)
+
+// DHCP选项
+const (
+	optPad            = 0
+	optSubnetMask     = 1
+	optRouter         = 3
+	optDNSServers     = 6
+	optHostname       = 12
+	optVendorSpecific = 43
+	optRequestedIP    = 50
+	optLeaseTime      = 51
+	optMessageType    = 53
+	optServerID       = 54
+	optVendorClass    = 60
+	optTFTPServer     = 66
+	optBootFile       = 67
+	optUserClass      = 77
+	optClientArch     = 93
+	optClientGUID     = 97
+	optEnd            = 255
+)
+
+// message DHCP报文
+type message struct {
+	op     byte
+	htype  byte
+	hlen   byte
+	hops   byte
+	xid    uint32
+	secs   uint16
+	flags  uint16
+	ciaddr net.IP
+	yiaddr net.IP
+	siaddr net.IP
+	giaddr net.IP
+	chaddr net.HardwareAddr
+	sname  string
+	file   string
+	// 同一选项出现多次时按RFC 3396拼接
+	options map[byte][]byte
+}
+
+func parseMessage(b []byte) (*message, error) {
+	if len(b) < headerLength+4 {
+		return nil, errors.New("message is too short")
+	}
+	if binary.BigEndian.Uint32(b[headerLength:headerLength+4]) != magicCookie {
+		return nil, errors.New("invalid magic cookie")
+	}
+
+	m := &message{
+		op:      b[0],
+		htype:   b[1],
+		hlen:    b[2],
+		hops:    b[3],
+		xid:     binary.BigEndian.Uint32(b[4:8]),
+		secs:    binary.BigEndian.Uint16(b[8:10]),
+		flags:   binary.BigEndian.Uint16(b[10:12]),
+		ciaddr:  copyIP(b[12:16]),
+		yiaddr:  copyIP(b[16:20]),
+		siaddr:  copyIP(b[20:24]),
+		giaddr:  copyIP(b[24:28]),
+		sname:   cString(b[44:108]),
+		file:    cString(b[108:236]),
+		options: make(map[byte][]byte),
+	}
+	if m.hlen > 16 {
+		return nil, errors.New("invalid hardware address length")
+	}
+	m.chaddr = net.HardwareAddr(append([]byte(nil), b[28:28+m.hlen]...))
+
+	for i := headerLength + 4; i < len(b); {
+		code := b[i]
+		if code == optPad {
+			i++
+			continue
+		}
+		if code == optEnd {
+			break
+		}
+		if i+1 >= len(b) || i+2+int(b[i+1]) > len(b) {
+			return nil, errors.New("truncated option")
+		}
+		length := int(b[i+1])
+		m.options[code] = append(m.options[code], b[i+2:i+2+length]...)
+		i += 2 + length
+	}
+	return m, nil
+}
+
+func (m *message) marshal() []byte {
+	b := make([]byte, headerLength+4, 576)
+	b[0] = m.op
+	b[1] = m.htype
+	b[2] = m.hlen
+	b[3] = m.hops
+	binary.BigEndian.PutUint32(b[4:8], m.xid)
+	binary.BigEndian.PutUint16(b[8:10], m.secs)
+	binary.BigEndian.PutUint16(b[10:12], m.flags)
+	putIP(b[12:16], m.ciaddr)
+	putIP(b[16:20], m.yiaddr)
+	putIP(b[20:24], m.siaddr)
+	putIP(b[24:28], m.giaddr)
+	copy(b[28:44], m.chaddr)
+	copy(b[44:107], m.sname)
+	copy(b[108:235], m.file)
+	binary.BigEndian.PutUint32(b[headerLength:headerLength+4], magicCookie)
+
+	// 消息类型放在首位，其余选项按编号排序
+	codes := make([]int, 0, len(m.options))
+	for code := range m.options {
+		if code != optMessageType {
+			codes = append(codes, int(code))
+		}
+	}
+	sort.Ints(codes)
+	if value, ok := m.options[optMessageType]; ok {
+		b = appendOption(b, optMessageType, value)
+	}
+	for _, code := range codes {
+		b = appendOption(b, byte(code), m.options[byte(code)])
+	}
+	b = append(b, optEnd)
+
+	for len(b) < minLength {
+		b = append(b, optPad)
+	}
+	return b
+}
+
+// appendOption 写入选项，超过255字节的选项拆分为多个
+func appendOption(b []byte, code byte, value []byte) []byte {
+	for {
+		n := len(value)
+		if n > 255 {
+			n = 255
+		}
+		b = append(b, code, byte(n))
+		b = append(b, value[:n]...)
+		value = value[n:]
+		if len(value) == 0 {
+			return b
+		}
+	}
+}
+
+func (m *message) messageType() byte {
+	if value := m.options[optMessageType]; len(value) == 1 {
+		return value[0]
+	}
+	return 0
+}
+
+// optionIP 返回IPv4地址类型的选项
+func (m *message) optionIP(code byte) net.IP {
+	if value := m.options[code]; len(value) == net.IPv4len {
+		return copyIP(value)
+	}
+	return nil
+}
+
+// clientArch 返回客户端系统架构（RFC 4578），未携带时视为BIOS
+func (m *message) clientArch() uint16 {
+	if value := m.options[optClientArch]; len(value) >= 2 {
+		return binary.BigEndian.Uint16(value[:2])
+	}
+	return archBIOS
+}
+
+// isPXEClient 判断是否为PXE固件或iPXE发出的请求
+func (m *message) isPXEClient() bool {
+	return strings.HasPrefix(string(m.options[optVendorClass]), "PXEClient")
+}
+
+// isIPXE 判断请求是否由iPXE发出，iPXE会在用户类中携带 "iPXE"
+func (m *message) isIPXE() bool {
+	return strings.Contains(string(m.options[optUserClass]), "iPXE")
+}
+
+func copyIP(b []byte) net.IP {
+	return net.IPv4(b[0], b[1], b[2], b[3]).To4()
+}
+
+func putIP(dst []byte, ip net.IP) {
+	if ip4 := ip.To4(); ip4 != nil {
+		copy(dst, ip4)
+	}
+}
+
+func cString(b []byte) string {
+	if i := strings.IndexByte(string(b), 0); i >= 0 {
+		return string(b[:i])
+	}
+	return string(b)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// TokenParam is the URL query parameter carrying the access token
+	TokenParam = "token"
+	// DefaultFileTTL is the default lifetime of protected files
+	DefaultFileTTL = 2 * time.Hour
+
+	tlsHandshakeRecord = 0x16
+)
+
+// AccessPolicy restricts the access to a protected file
+type AccessPolicy struct {
+	Token      string    // token required in the URL query or the Authorization: Bearer header
+	MaxFetches int       // the file is removed after being fetched MaxFetches times, 0 means unlimited
+	ExpiresAt  time.Time // the file is removed after ExpiresAt, zero means never
+	Node       string    // hostname of the node the file is dedicated to, empty for files shared by nodes
+	SourceIP   string    // address the file must be fetched from, such as the DHCP lease of the node, empty for any
+
+	fetches int
+}
+
+// AccessToken derives the access token of a file from the cluster token secret,
+// so that the same token can be computed when the boot configs are generated and served
+func AccessToken(secret, fileName string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.TrimPrefix(fileName, "/")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewAccessPolicy returns the policy of a file fetched by at most maxFetches nodes within ttl
+func NewAccessPolicy(secret, fileName string, maxFetches int, ttl time.Duration) AccessPolicy {
+	if ttl <= 0 {
+		ttl = DefaultFileTTL
+	}
+	return AccessPolicy{
+		Token:      AccessToken(secret, fileName),
+		MaxFetches: maxFetches,
+		ExpiresAt:  time.Now().Add(ttl),
+	}
+}
+
+func (p *AccessPolicy) authorize(r *http.Request) bool {
+	token := r.URL.Query().Get(TokenParam)
+	if auth := r.Header.Get("Authorization"); token == "" && strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(p.Token)) == 1
+}
+
+func (p *AccessPolicy) fromSource(r *http.Request) bool {
+	if p.SourceIP == "" {
+		return true
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	remote, source := net.ParseIP(host), net.ParseIP(p.SourceIP)
+	return remote != nil && source != nil && remote.Equal(source)
+}
+
+func (p *AccessPolicy) expired() bool {
+	return !p.ExpiresAt.IsZero() && time.Now().After(p.ExpiresAt)
+}
+
+type connContextKey struct{}
+
+func saveConnInContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, c)
+}
+
+func isTLSRequest(r *http.Request) bool {
+	if r.TLS != nil {
+		return true
+	}
+	conn, ok := r.Context().Value(connContextKey{}).(*sniffConn)
+	return ok && conn.isTLS
+}
+
+// sniffListener accepts both TLS and plain HTTP connections on the same port,
+// so that PXE/iPXE firmware can still fetch unprotected files over plain HTTP
+type sniffListener struct {
+	net.Listener
+	config *tls.Config
+}
+
+func (l *sniffListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &sniffConn{Conn: conn, config: l.config}, nil
+}
+
+// sniffConn decides on the first read whether the client starts a TLS handshake
+type sniffConn struct {
+	net.Conn
+	config *tls.Config
+	once   sync.Once
+	conn   net.Conn
+	isTLS  bool
+}
+
+func (c *sniffConn) detect() {
+	reader := bufio.NewReader(c.Conn)
+	peeked := &bufferedConn{Conn: c.Conn, reader: reader}
+	if b, err := reader.Peek(1); err == nil && b[0] == tlsHandshakeRecord {
+		c.conn = tls.Server(peeked, c.config)
+		c.isTLS = true
+		return
+	}
+	c.conn = peeked
+}
+
+func (c *sniffConn) Read(b []byte) (int, error) {
+	c.once.Do(c.detect)
+	return c.conn.Read(b)
+}
+
+func (c *sniffConn) Write(b []byte) (int, error) {
+	c.once.Do(c.detect)
+	return c.conn.Write(b)
+}
+
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newTestServingCert 生成 127.0.0.1 的自签名服务端证书
+func newTestServingCert(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func freePort(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer l.Close()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	return port
+}
+
+func get(t *testing.T, client *http.Client, url string, header http.Header) (int, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header = header
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestProtectedFiles(t *testing.T) {
+	certPEM, keyPEM := newTestServingCert(t)
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(certPEM)
+	tlsClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	plainClient := &http.Client{}
+
+	const secret = "test-secret"
+	hs := NewHTTPService(freePort(t))
+	if err := hs.EnableTLS(certPEM, keyPEM); err != nil {
+		t.Fatalf("EnableTLS failed: %v", err)
+	}
+	if err := hs.AddFileToCache("public.cfg", []byte("public")); err != nil {
+		t.Fatalf("AddFileToCache failed: %v", err)
+	}
+	if err := hs.AddProtectedFile("certs.json", []byte("secret"), NewAccessPolicy(secret, "certs.json", 1, time.Hour)); err != nil {
+		t.Fatalf("AddProtectedFile failed: %v", err)
+	}
+	if err := hs.AddProtectedFile("worker.ign", []byte("worker"), NewAccessPolicy(secret, "worker.ign", 0, time.Hour)); err != nil {
+		t.Fatalf("AddProtectedFile failed: %v", err)
+	}
+	for fileName, sourceIP := range map[string]string{"bound.cfg": "127.0.0.1", "other.cfg": "192.0.2.10"} {
+		policy := NewAccessPolicy(secret, fileName, 1, time.Hour)
+		policy.SourceIP = sourceIP
+		if err := hs.AddProtectedFile(fileName, []byte(fileName), policy); err != nil {
+			t.Fatalf("AddProtectedFile failed: %v", err)
+		}
+	}
+	expired := NewAccessPolicy(secret, "expired.ign", 0, time.Hour)
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	if err := hs.AddProtectedFile("expired.ign", []byte("expired"), expired); err != nil {
+		t.Fatalf("AddProtectedFile failed: %v", err)
+	}
+	StartHTTPService(hs)
+	defer hs.Stop()
+
+	base := "127.0.0.1:" + hs.Port
+	token := "?" + TokenParam + "=" + AccessToken(secret, "certs.json")
+	for i := 0; i < 50; i++ {
+		if conn, err := net.Dial("tcp", base); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Run("Public File Success", func(t *testing.T) {
+		if status, body := get(t, plainClient, "http://"+base+"/public.cfg", nil); status != http.StatusOK || body != "public" {
+			t.Errorf("plain HTTP: got %d %q", status, body)
+		}
+		if status, body := get(t, tlsClient, "https://"+base+"/public.cfg", nil); status != http.StatusOK || body != "public" {
+			t.Errorf("TLS: got %d %q", status, body)
+		}
+	})
+
+	t.Run("Protected File Fail", func(t *testing.T) {
+		if status, _ := get(t, plainClient, "http://"+base+"/certs.json"+token, nil); status != http.StatusForbidden {
+			t.Errorf("plain HTTP request should be forbidden, got %d", status)
+		}
+		if status, _ := get(t, tlsClient, "https://"+base+"/certs.json", nil); status != http.StatusUnauthorized {
+			t.Errorf("request without token should be unauthorized, got %d", status)
+		}
+		if status, _ := get(t, tlsClient, "https://"+base+"/certs.json?token=wrong", nil); status != http.StatusUnauthorized {
+			t.Errorf("request with wrong token should be unauthorized, got %d", status)
+		}
+		header := http.Header{"Authorization": []string{"Bearer " + AccessToken(secret, "expired.ign")}}
+		if status, _ := get(t, tlsClient, "https://"+base+"/expired.ign", header); status != http.StatusNotFound {
+			t.Errorf("expired file should not be served, got %d", status)
+		}
+		// other.cfg 绑定了其他地址，拒绝的请求不计入获取次数
+		header = http.Header{"Authorization": []string{"Bearer " + AccessToken(secret, "other.cfg")}}
+		for i := 0; i < 2; i++ {
+			if status, _ := get(t, tlsClient, "https://"+base+"/other.cfg", header); status != http.StatusForbidden {
+				t.Errorf("request from another address should be forbidden, got %d", status)
+			}
+		}
+	})
+
+	t.Run("Protected File Success", func(t *testing.T) {
+		if status, body := get(t, tlsClient, "https://"+base+"/certs.json"+token, nil); status != http.StatusOK || body != "secret" {
+			t.Errorf("got %d %q", status, body)
+		}
+		// certs.json 只允许获取一次
+		if status, _ := get(t, tlsClient, "https://"+base+"/certs.json"+token, nil); status != http.StatusNotFound {
+			t.Errorf("one-shot file should be removed after the first fetch, got %d", status)
+		}
+
+		header := http.Header{"Authorization": []string{"Bearer " + AccessToken(secret, "bound.cfg")}}
+		if status, body := get(t, tlsClient, "https://"+base+"/bound.cfg", header); status != http.StatusOK || body != "bound.cfg" {
+			t.Errorf("got %d %q", status, body)
+		}
+
+		header = http.Header{"Authorization": []string{"Bearer " + AccessToken(secret, "worker.ign")}}
+		for i := 0; i < 2; i++ {
+			if status, body := get(t, tlsClient, "https://"+base+"/worker.ign", header); status != http.StatusOK || body != "worker" {
+				t.Errorf("got %d %q", status, body)
+			}
+		}
+	})
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package httpserver
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"nestos-kubernetes-deployer/pkg/constants"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -38,18 +41,30 @@ type HTTPService struct {
 	Port                string
 	DirPath             string
 	PackageDir          string
+	packageRepodata     map[string][]byte // yum repository metadata of PackageDir, served from memory
 	FileCache           map[string][]byte
+	policies            map[string]*AccessPolicy
+	nodeFiles           map[string]map[string]bool // dedicated files of each expected node and whether they were fetched
+	fetchRecords        []FetchRecord
+	handlers            map[string]http.Handler
+	tlsConfig           *tls.Config
 	running             bool
 	server              *http.Server
 	mutex               sync.RWMutex
 	HttpLastRequestTime int64 `json:"http_last_request_time"`
 	Ch                  chan struct{}
+	// KeepServing keeps the service running until Stop is called instead of stopping it
+	// once the nodes are bootstrapped or idle, e.g. while the nodes pull images from it
+	KeepServing bool
 }
 
 func NewHTTPService(port string) *HTTPService {
 	return &HTTPService{
 		Port:                port,
 		FileCache:           make(map[string][]byte),
+		policies:            make(map[string]*AccessPolicy),
+		nodeFiles:           make(map[string]map[string]bool),
+		handlers:            make(map[string]http.Handler),
 		HttpLastRequestTime: time.Now().Unix(),
 		Ch:                  make(chan struct{}, 1),
 	}
@@ -71,6 +86,79 @@ func (hs *HTTPService) AddFileToCache(fileName string, content []byte) error {
 	return nil
 }
 
+// AddProtectedFile adds file content to the file cache, the file is only served
+// over TLS to requests that carry the token of the policy
+func (hs *HTTPService) AddProtectedFile(fileName string, content []byte, policy AccessPolicy) error {
+	if policy.Token == "" {
+		return fmt.Errorf("failed to add file '%s' to cache: access token is empty", fileName)
+	}
+	if err := hs.AddFileToCache(fileName, content); err != nil {
+		return err
+	}
+	hs.mutex.Lock()
+	defer hs.mutex.Unlock()
+
+	if !strings.HasPrefix(fileName, "/") {
+		fileName = "/" + fileName
+	}
+	if hs.policies == nil {
+		hs.policies = make(map[string]*AccessPolicy)
+	}
+	hs.policies[fileName] = &policy
+
+	if policy.Node != "" {
+		if hs.nodeFiles == nil {
+			hs.nodeFiles = make(map[string]map[string]bool)
+		}
+		if hs.nodeFiles[policy.Node] == nil {
+			hs.nodeFiles[policy.Node] = make(map[string]bool)
+		}
+		hs.nodeFiles[policy.Node][fileName] = false
+	}
+
+	return nil
+}
+
+// SetPackageRepository serves dir under constants.RpmPackageList as a yum repository
+// with the given repository metadata
+func (hs *HTTPService) SetPackageRepository(dir string, repodata map[string][]byte) {
+	hs.mutex.Lock()
+	defer hs.mutex.Unlock()
+
+	hs.PackageDir = dir
+	hs.packageRepodata = repodata
+}
+
+func (hs *HTTPService) repodataFile(rel string) ([]byte, bool) {
+	hs.mutex.RLock()
+	defer hs.mutex.RUnlock()
+
+	content, ok := hs.packageRepodata[strings.TrimPrefix(rel, "/")]
+	return content, ok
+}
+
+// Handle registers an additional handler for pattern, it must be called before Start
+func (hs *HTTPService) Handle(pattern string, handler http.Handler) {
+	hs.mutex.Lock()
+	defer hs.mutex.Unlock()
+
+	hs.handlers[pattern] = handler
+}
+
+// EnableTLS serves the HTTP service with the given certificate. Plain HTTP requests
+// are still accepted on the same port for unprotected files.
+func (hs *HTTPService) EnableTLS(certPEM, keyPEM []byte) error {
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to load serving certificate: %v", err)
+	}
+	hs.tlsConfig = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return nil
+}
+
 func (hs *HTTPService) Start() error {
 	// Check if the http server is already running
 	if hs.running {
@@ -86,8 +174,8 @@ func (hs *HTTPService) Start() error {
 		}
 	}
 
+	// 仅在初始化期间持有锁，请求处理时需要加锁访问文件缓存
 	hs.mutex.Lock()
-	defer hs.mutex.Unlock()
 
 	smux := http.NewServeMux()
 	// 处理目录请求
@@ -105,10 +193,15 @@ func (hs *HTTPService) Start() error {
 		http.ServeFile(w, r, rpath)
 	})
 
-	// 处理rpm软件包请求
+	// 处理rpm软件包请求，仓库元数据由内存提供
 	smux.HandleFunc(constants.RpmPackageList, func(w http.ResponseWriter, r *http.Request) {
 		hs.HttpLastRequestTime = time.Now().Unix()
-		rpath := filepath.Join(hs.PackageDir, r.URL.Path[len(constants.RpmPackageList):])
+		rel := r.URL.Path[len(constants.RpmPackageList):]
+		if content, ok := hs.repodataFile(rel); ok {
+			http.ServeContent(w, r, rel, time.Time{}, bytes.NewReader(content))
+			return
+		}
+		rpath := filepath.Join(hs.PackageDir, rel)
 		_, err := os.Stat(rpath)
 		if err != nil {
 			http.FileServer(http.Dir(rpath)).ServeHTTP(w, r)
@@ -117,42 +210,37 @@ func (hs *HTTPService) Start() error {
 		http.ServeFile(w, r, rpath)
 	})
 
+	for pattern, handler := range hs.handlers {
+		handler := handler
+		smux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			hs.HttpLastRequestTime = time.Now().Unix()
+			handler.ServeHTTP(w, r)
+		})
+	}
+
 	// 处理文件请求
 	smux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		hs.HttpLastRequestTime = time.Now().Unix()
-		rpath := r.URL.Path
-		fileContent, ok := hs.FileCache[rpath]
-		if !ok {
-			http.NotFound(w, r)
+		fileContent, status := hs.fetchFile(r)
+		if status != http.StatusOK {
+			http.Error(w, http.StatusText(status), status)
 			return
 		}
 		fmt.Fprintf(w, "%s", fileContent)
 	})
 
 	hs.server = &http.Server{
-		Addr:    ":" + hs.Port,
-		Handler: smux,
+		Addr:        ":" + hs.Port,
+		Handler:     smux,
+		ConnContext: saveConnInContext,
 	}
+	hs.mutex.Unlock()
 
 	logrus.Infof("HTTP server is listening on port %s...\n", hs.Port)
-	go func() {
-		for {
-			val := time.Now().Unix() - hs.HttpLastRequestTime
-			if val < TimeOut {
-				time.Sleep(30 * time.Second)
-				continue
-			}
-			hs.server.Close()
-			hs.running = false
-			hs.server = nil
-			hs.Ch <- struct{}{}
-			return
-		}
-	}()
-
 	hs.running = true
+	go hs.stopWhenIdle()
 
-	if err := hs.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if err := hs.serve(); err != nil && err != http.ErrServerClosed {
 		logrus.Errorf("ListenAndServe(): %v", err)
 		hs.running = false
 		hs.Ch <- struct{}{}
@@ -164,6 +252,67 @@ func (hs *HTTPService) Start() error {
 	return nil
 }
 
+func (hs *HTTPService) serve() error {
+	if hs.tlsConfig == nil {
+		return hs.server.ListenAndServe()
+	}
+
+	listener, err := net.Listen("tcp", hs.server.Addr)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("HTTP server accepts TLS connections on port %s", hs.Port)
+	return hs.server.Serve(&sniffListener{Listener: listener, config: hs.tlsConfig})
+}
+
+// fetchFile returns the cached file requested by r, checking the access policy of protected files
+func (hs *HTTPService) fetchFile(r *http.Request) ([]byte, int) {
+	hs.mutex.Lock()
+	defer hs.mutex.Unlock()
+
+	rpath := r.URL.Path
+	fileContent, ok := hs.FileCache[rpath]
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+	policy, ok := hs.policies[rpath]
+	if !ok {
+		return fileContent, http.StatusOK
+	}
+
+	if !isTLSRequest(r) {
+		logrus.Warnf("Rejected plain HTTP request for protected file %s from %s", rpath, r.RemoteAddr)
+		return nil, http.StatusForbidden
+	}
+	if !policy.authorize(r) {
+		logrus.Warnf("Rejected unauthorized request for %s from %s", rpath, r.RemoteAddr)
+		return nil, http.StatusUnauthorized
+	}
+	// a request from another address does not count as a fetch, the node can still fetch the file
+	if !policy.fromSource(r) {
+		logrus.Warnf("Rejected request for %s from %s, the file is bound to %s", rpath, r.RemoteAddr, policy.SourceIP)
+		return nil, http.StatusForbidden
+	}
+	if policy.expired() {
+		logrus.Warnf("Access to %s has expired, removing it from the cache", rpath)
+		hs.removeFile(rpath)
+		return nil, http.StatusNotFound
+	}
+
+	policy.fetches++
+	hs.recordFetch(rpath, policy, r.RemoteAddr)
+	if policy.MaxFetches > 0 && policy.fetches >= policy.MaxFetches {
+		logrus.Infof("%s has been fetched %d time(s), removing it from the cache", rpath, policy.fetches)
+		hs.removeFile(rpath)
+	}
+	return fileContent, http.StatusOK
+}
+
+func (hs *HTTPService) removeFile(rpath string) {
+	delete(hs.FileCache, rpath)
+	delete(hs.policies, rpath)
+}
+
 func (hs *HTTPService) Stop() error {
 	if !hs.running {
 		logrus.Warn("HTTP server is not running.")
@@ -184,9 +333,40 @@ func (hs *HTTPService) Stop() error {
 		return err
 	}
 	hs.server = nil
+	hs.running = false
 	return nil
 }
 
+// Finish stops the service unless KeepServing is set, and notifies the services started along with it (e.g. TFTP) through Ch
+func (hs *HTTPService) Finish() {
+	if hs.KeepServing {
+		logrus.Info("Bootstrap completed, the http server keeps serving until the deployment completes")
+	} else if err := hs.Stop(); err != nil {
+		logrus.Errorf("Failed to stop the http server: %v", err)
+	}
+	select {
+	case hs.Ch <- struct{}{}:
+	default:
+	}
+}
+
+// stopWhenIdle finishes the service after TimeOut seconds without requests.
+// While nodes are expected to fetch their boot configs, WaitForNodes decides when to finish the service.
+func (hs *HTTPService) stopWhenIdle() {
+	for {
+		time.Sleep(30 * time.Second)
+		if !hs.running {
+			return
+		}
+		if hs.KeepServing || hs.expectsNodes() || time.Now().Unix()-hs.HttpLastRequestTime < TimeOut {
+			continue
+		}
+		logrus.Infof("No requests in %d seconds, stopping the http server", TimeOut)
+		hs.Finish()
+		return
+	}
+}
+
 func StartHTTPService(httpService *HTTPService) {
 	go func() {
 		if err := httpService.Start(); err != nil {
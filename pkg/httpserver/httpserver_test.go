@@ -18,9 +18,11 @@ package httpserver
 
 import (
 	"nestos-kubernetes-deployer/pkg/constants"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -137,3 +139,74 @@ func TestHTTPServer(t *testing.T) {
 	})
 
 }
+
+func TestPackageRepository(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "foo-1.0-1.x86_64.rpm"), []byte("rpm"), 0644); err != nil {
+		t.Fatalf("failed to write package: %v", err)
+	}
+	hs := NewHTTPService(freePort(t))
+	hs.SetPackageRepository(dir, map[string][]byte{"repodata/repomd.xml": []byte("<repomd/>")})
+	StartHTTPService(hs)
+	defer hs.Stop()
+
+	base := "http://127.0.0.1:" + hs.Port + constants.RpmPackageList
+	for i := 0; i < 50; i++ {
+		if conn, err := net.Dial("tcp", "127.0.0.1:"+hs.Port); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Run("Repodata Success", func(t *testing.T) {
+		if status, body := get(t, http.DefaultClient, base+"repodata/repomd.xml", nil); status != http.StatusOK || body != "<repomd/>" {
+			t.Errorf("got %d %q", status, body)
+		}
+		if status, body := get(t, http.DefaultClient, base+"foo-1.0-1.x86_64.rpm", nil); status != http.StatusOK || body != "rpm" {
+			t.Errorf("got %d %q", status, body)
+		}
+	})
+
+	t.Run("Repodata Fail", func(t *testing.T) {
+		if status, _ := get(t, http.DefaultClient, base+"repodata/primary.xml.gz", nil); status != http.StatusNotFound {
+			t.Errorf("unknown repodata file should not be served, got %d", status)
+		}
+	})
+}
+
+func TestHandle(t *testing.T) {
+	hs := NewHTTPService(freePort(t))
+	hs.Handle("/v2/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("registry"))
+	}))
+	hs.KeepServing = true
+	StartHTTPService(hs)
+	defer hs.Stop()
+
+	for i := 0; i < 50; i++ {
+		if conn, err := net.Dial("tcp", "127.0.0.1:"+hs.Port); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Run("Handle Success", func(t *testing.T) {
+		if status, body := get(t, http.DefaultClient, "http://127.0.0.1:"+hs.Port+"/v2/", nil); status != http.StatusOK || body != "registry" {
+			t.Errorf("got %d %q", status, body)
+		}
+	})
+
+	t.Run("KeepServing Success", func(t *testing.T) {
+		hs.Finish()
+		if !hs.running {
+			t.Error("the service should keep running")
+		}
+		select {
+		case <-hs.Ch:
+		default:
+			t.Error("the services started along should be notified")
+		}
+	})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FetchRecord records a protected file served by the bootstrap service
+type FetchRecord struct {
+	Node       string // hostname of the node the file is dedicated to, empty for shared files
+	File       string
+	RemoteAddr string
+	Time       time.Time
+}
+
+// Progress reports the bootstrap progress of the nodes expected to fetch their boot configs
+type Progress struct {
+	Expected []string // nodes that have dedicated files on the service
+	Fetched  []string // nodes that fetched all of their dedicated files
+	Joined   []string // expected nodes that joined the cluster
+}
+
+// Done reports whether every expected node fetched its files and joined the cluster
+func (p Progress) Done() bool {
+	return len(p.Expected) > 0 && len(p.Fetched) == len(p.Expected) && len(p.Joined) == len(p.Expected)
+}
+
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d nodes fetched their boot configs, %d/%d nodes joined the cluster",
+		len(p.Fetched), len(p.Expected), len(p.Joined), len(p.Expected))
+}
+
+// JoinedFunc returns the nodes that have joined the cluster
+type JoinedFunc func(ctx context.Context) (map[string]bool, error)
+
+// FetchRecords returns the protected files served so far
+func (hs *HTTPService) FetchRecords() []FetchRecord {
+	hs.mutex.RLock()
+	defer hs.mutex.RUnlock()
+
+	return append([]FetchRecord{}, hs.fetchRecords...)
+}
+
+// Progress returns the bootstrap progress of the expected nodes, joined may be nil
+func (hs *HTTPService) Progress(joined map[string]bool) Progress {
+	hs.mutex.RLock()
+	defer hs.mutex.RUnlock()
+
+	progress := Progress{}
+	for node, files := range hs.nodeFiles {
+		progress.Expected = append(progress.Expected, node)
+		fetched := true
+		for _, ok := range files {
+			fetched = fetched && ok
+		}
+		if fetched {
+			progress.Fetched = append(progress.Fetched, node)
+		}
+		if joined[node] {
+			progress.Joined = append(progress.Joined, node)
+		}
+	}
+	sort.Strings(progress.Expected)
+	sort.Strings(progress.Fetched)
+	sort.Strings(progress.Joined)
+	return progress
+}
+
+/*
+WaitForNodes reports the bootstrap progress every interval and finishes the service once every
+expected node fetched its dedicated files and joined the cluster. Services without expected nodes
+are left to the idle timeout.
+*/
+func (hs *HTTPService) WaitForNodes(ctx context.Context, interval time.Duration, joined JoinedFunc) error {
+	if !hs.expectsNodes() {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		nodes, err := joined(ctx)
+		if err != nil {
+			logrus.Debugf("Failed to get the nodes joined the cluster: %v", err)
+		}
+		progress := hs.Progress(nodes)
+		if status := progress.String(); status != last {
+			logrus.Infof("Bootstrap progress: %s", status)
+			last = status
+		}
+		if progress.Done() {
+			logrus.Info("All expected nodes fetched their boot configs and joined the cluster")
+			hs.Finish()
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (hs *HTTPService) expectsNodes() bool {
+	hs.mutex.RLock()
+	defer hs.mutex.RUnlock()
+
+	return len(hs.nodeFiles) > 0
+}
+
+// recordFetch records a protected file served to r, the caller must hold the mutex
+func (hs *HTTPService) recordFetch(rpath string, policy *AccessPolicy, remoteAddr string) {
+	record := FetchRecord{
+		Node:       policy.Node,
+		File:       rpath,
+		RemoteAddr: remoteAddr,
+		Time:       time.Now(),
+	}
+	hs.fetchRecords = append(hs.fetchRecords, record)
+
+	if policy.Node == "" {
+		logrus.Infof("Served %s to %s", rpath, remoteAddr)
+		return
+	}
+	if files, ok := hs.nodeFiles[policy.Node]; ok {
+		files[rpath] = true
+	}
+	logrus.Infof("Node %s fetched %s from %s at %s", policy.Node, rpath, remoteAddr, record.Time.Format(time.RFC3339))
+}
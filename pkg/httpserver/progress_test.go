@@ -0,0 +1,126 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBootstrapProgress(t *testing.T) {
+	certPEM, keyPEM := newTestServingCert(t)
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(certPEM)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+
+	const secret = "test-secret"
+	hs := NewHTTPService(freePort(t))
+	if err := hs.EnableTLS(certPEM, keyPEM); err != nil {
+		t.Fatalf("EnableTLS failed: %v", err)
+	}
+	files := map[string]string{
+		"nodes/k8s-master01.cfg": "k8s-master01",
+		"certs.json":             "k8s-master01",
+		"nodes/k8s-worker01.cfg": "k8s-worker01",
+	}
+	for name, node := range files {
+		policy := NewAccessPolicy(secret, name, 1, time.Hour)
+		policy.Node = node
+		if err := hs.AddProtectedFile(name, []byte(name), policy); err != nil {
+			t.Fatalf("AddProtectedFile failed: %v", err)
+		}
+	}
+	StartHTTPService(hs)
+	defer hs.Stop()
+
+	base := "127.0.0.1:" + hs.Port
+	for i := 0; i < 50; i++ {
+		if conn, err := net.Dial("tcp", base); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	fetch := func(name string) {
+		url := "https://" + base + "/" + name + "?" + TokenParam + "=" + AccessToken(secret, name)
+		if status, _ := get(t, client, url, nil); status != http.StatusOK {
+			t.Fatalf("failed to fetch %s: %d", name, status)
+		}
+	}
+
+	t.Run("Progress Success", func(t *testing.T) {
+		fetch("nodes/k8s-master01.cfg")
+		progress := hs.Progress(nil)
+		if len(progress.Expected) != 2 || len(progress.Fetched) != 0 {
+			t.Errorf("node with unfetched files should not be reported as fetched: %v", progress)
+		}
+
+		fetch("certs.json")
+		progress = hs.Progress(map[string]bool{"k8s-master01": true})
+		if len(progress.Fetched) != 1 || progress.Fetched[0] != "k8s-master01" || len(progress.Joined) != 1 || progress.Done() {
+			t.Errorf("unexpected progress: %v", progress)
+		}
+
+		records := hs.FetchRecords()
+		if len(records) != 2 || records[1].Node != "k8s-master01" || records[1].File != "/certs.json" || records[1].Time.IsZero() {
+			t.Errorf("unexpected fetch records: %+v", records)
+		}
+	})
+
+	t.Run("WaitForNodes Success", func(t *testing.T) {
+		fetch("nodes/k8s-worker01.cfg")
+		joined := func(ctx context.Context) (map[string]bool, error) {
+			return map[string]bool{"k8s-master01": true, "k8s-worker01": true}, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := hs.WaitForNodes(ctx, 10*time.Millisecond, joined); err != nil {
+			t.Fatalf("WaitForNodes failed: %v", err)
+		}
+
+		select {
+		case <-hs.Ch:
+		default:
+			t.Errorf("finishing the service should notify Ch")
+		}
+		if _, err := net.Dial("tcp", base); err == nil {
+			t.Errorf("the service should be stopped once all nodes joined")
+		}
+	})
+
+	t.Run("WaitForNodes Fail", func(t *testing.T) {
+		hs := NewHTTPService(freePort(t))
+		policy := NewAccessPolicy(secret, "nodes/k8s-worker02.ign", 1, time.Hour)
+		policy.Node = "k8s-worker02"
+		if err := hs.AddProtectedFile("nodes/k8s-worker02.ign", []byte("worker"), policy); err != nil {
+			t.Fatalf("AddProtectedFile failed: %v", err)
+		}
+		joined := func(ctx context.Context) (map[string]bool, error) {
+			return map[string]bool{"k8s-worker02": true}, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := hs.WaitForNodes(ctx, 10*time.Millisecond, joined); err != context.DeadlineExceeded {
+			t.Errorf("expected the deadline to be exceeded while the node has not fetched its file, got %v", err)
+		}
+	})
+}
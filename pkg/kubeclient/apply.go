@@ -0,0 +1,610 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// FieldManager is the field manager of the objects applied by nkd
+const FieldManager = "nkd"
+
+// ApplySetLabel labels the objects applied as a set, see ApplyOptions.
+const ApplySetLabel = "nkd.openeuler.org/apply-set"
+
+// crdEstablishedTimeout is how long ApplyManifest waits for the CustomResourceDefinitions of a manifest to be established.
+const crdEstablishedTimeout = time.Minute
+
+// defaultPruneKinds are the kinds pruned in addition to the kinds of the manifest.
+var defaultPruneKinds = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "ConfigMap"},
+	{Version: "v1", Kind: "Secret"},
+	{Version: "v1", Kind: "Service"},
+	{Version: "v1", Kind: "ServiceAccount"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "IngressClass"},
+	{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"},
+	{Group: "storage.k8s.io", Version: "v1", Kind: "CSIDriver"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"},
+	{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIService"},
+	{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+}
+
+// ApplyOptions configures how ApplyManifest applies a manifest.
+type ApplyOptions struct {
+	// Namespace is the namespace of namespaced objects without one, "default" if empty.
+	Namespace string
+	// ApplySet labels every applied object with ApplySetLabel=ApplySet.
+	ApplySet string
+	// Prune deletes the objects of the ApplySet that are no longer in the manifest after it is applied.
+	// The kinds of the manifest and defaultPruneKinds are pruned. CustomResourceDefinitions are never pruned,
+	// deleting them would delete their custom resources.
+	Prune bool
+	// ImagePullSecret is created in every namespace the manifest is applied into once its Namespaces are applied,
+	// and added to the default service account there and to the service accounts of the manifest.
+	ImagePullSecret *ImagePullSecret
+}
+
+// ImagePullSecret is a kubernetes.io/dockerconfigjson secret holding registry credentials.
+type ImagePullSecret struct {
+	Name             string
+	DockerConfigJSON []byte
+}
+
+// DecodeManifest decodes a multi-document YAML manifest into objects, empty documents are skipped.
+func DecodeManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifest: %v", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" {
+			return nil, fmt.Errorf("object %q of the manifest has no apiVersion or kind", obj.GetName())
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// orderObjects sorts the objects so that Namespaces come first, then CustomResourceDefinitions,
+// then the other objects in the order of the manifest.
+func orderObjects(objects []*unstructured.Unstructured) []*unstructured.Unstructured {
+	rank := func(obj *unstructured.Unstructured) int {
+		switch {
+		case isNamespace(obj):
+			return 0
+		case isCRD(obj):
+			return 1
+		}
+		return 2
+	}
+	ordered := append([]*unstructured.Unstructured{}, objects...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return rank(ordered[i]) < rank(ordered[j])
+	})
+	return ordered
+}
+
+func isNamespace(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Namespace"
+}
+
+func isServiceAccount(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "ServiceAccount"
+}
+
+// addPullSecret adds the image pull secret to the service accounts among the objects.
+func addPullSecret(objects []*unstructured.Unstructured, name string) error {
+	for _, obj := range objects {
+		if !isServiceAccount(obj) {
+			continue
+		}
+		refs, _, err := unstructured.NestedSlice(obj.Object, "imagePullSecrets")
+		if err != nil {
+			return fmt.Errorf("invalid imagePullSecrets of ServiceAccount %s: %v", obj.GetName(), err)
+		}
+		found := false
+		for _, ref := range refs {
+			if r, ok := ref.(map[string]interface{}); ok && r["name"] == name {
+				found = true
+			}
+		}
+		if !found {
+			refs = append(refs, map[string]interface{}{"name": name})
+			if err := unstructured.SetNestedSlice(obj.Object, refs, "imagePullSecrets"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isCRD(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition"
+}
+
+// objectKey identifies an object regardless of the version it is served at.
+func objectKey(groupKind schema.GroupKind, namespace, name string) string {
+	return groupKind.String() + "/" + namespace + "/" + name
+}
+
+// manifestClient maps the objects of manifests to their resources through the discovery API.
+type manifestClient struct {
+	client    dynamic.Interface
+	mapper    meta.RESTMapper
+	namespace string
+}
+
+func newManifestClient(kubeconfig string) (*manifestClient, error) {
+	config, err := loadConfig(kubeconfig)
+	if err != nil {
+		logrus.Errorf("Error loading kubeconfig: %v", err)
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		logrus.Errorf("Error creating discovery client: %v", err)
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		logrus.Errorf("Error creating Dynamic client: %v", err)
+		return nil, err
+	}
+	return &manifestClient{
+		client:    dynamicClient,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		namespace: metav1.NamespaceDefault,
+	}, nil
+}
+
+// mapping returns the resource of the kind.
+func (c *manifestClient) mapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// The kind may be defined by a CRD applied just before
+		meta.MaybeResetRESTMapper(c.mapper)
+		mapping, err = c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	return mapping, err
+}
+
+// resourceFor returns the resource of the object. Namespaced objects without a namespace are put into the namespace of the client.
+func (c *manifestClient) resourceFor(obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	mapping, err := c.mapping(obj.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return c.client.Resource(mapping.Resource), nil
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(c.namespace)
+	}
+	return c.client.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
+
+// namespaces returns the sorted namespaces the objects are applied into: the Namespaces of the manifest and the
+// namespaces of namespaced objects. Objects of kinds that are not served yet, such as custom resources, are skipped.
+func (c *manifestClient) namespaces(objects []*unstructured.Unstructured) []string {
+	set := make(map[string]bool)
+	for _, obj := range objects {
+		switch {
+		case isNamespace(obj):
+			set[obj.GetName()] = true
+		case obj.GetNamespace() != "":
+			set[obj.GetNamespace()] = true
+		default:
+			mapping, err := c.mapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+			if err == nil && mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+				set[c.namespace] = true
+			}
+		}
+	}
+	namespaces := make([]string, 0, len(set))
+	for namespace := range set {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// ApplyManifest applies all objects of a multi-document YAML manifest with server-side apply.
+// Namespaces and CustomResourceDefinitions are applied first, and the other objects once the
+// CustomResourceDefinitions are established. The image pull secret of the options is created after the
+// Namespaces, before any workload is applied. The applied objects are returned in the order they are applied.
+func ApplyManifest(kubeconfig string, manifest []byte, options ApplyOptions) ([]*unstructured.Unstructured, error) {
+	if options.Prune && options.ApplySet == "" {
+		return nil, fmt.Errorf("prune requires an apply set")
+	}
+	objects, err := DecodeManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	c, err := newManifestClient(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if options.Namespace != "" {
+		c.namespace = options.Namespace
+	}
+
+	objects = orderObjects(objects)
+	pullSecret := options.ImagePullSecret
+	if pullSecret != nil {
+		if err := addPullSecret(objects, pullSecret.Name); err != nil {
+			return nil, err
+		}
+	}
+	var crds []*unstructured.Unstructured
+	for _, obj := range objects {
+		if pullSecret != nil && !isNamespace(obj) {
+			if err := CreateImagePullSecret(kubeconfig, pullSecret.Name, pullSecret.DockerConfigJSON, c.namespaces(objects)); err != nil {
+				return nil, err
+			}
+			pullSecret = nil
+		}
+		if len(crds) > 0 && !isCRD(obj) && !isNamespace(obj) {
+			if err := c.waitForCRDs(crds, crdEstablishedTimeout); err != nil {
+				return nil, err
+			}
+			crds = nil
+		}
+		if options.ApplySet != "" {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[ApplySetLabel] = options.ApplySet
+			obj.SetLabels(labels)
+		}
+		if err := c.apply(obj); err != nil {
+			return nil, err
+		}
+		if isCRD(obj) {
+			crds = append(crds, obj)
+		}
+	}
+	if err := c.waitForCRDs(crds, crdEstablishedTimeout); err != nil {
+		return nil, err
+	}
+
+	if options.Prune {
+		if err := c.prune(options.ApplySet, objects); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+func (c *manifestClient) apply(obj *unstructured.Unstructured) error {
+	resource, err := c.resourceFor(obj)
+	if err != nil {
+		logrus.Errorf("Error mapping %s %s: %v", obj.GetKind(), obj.GetName(), err)
+		return err
+	}
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	force := true
+	if _, err := resource.Patch(context.TODO(), obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        &force,
+	}); err != nil {
+		logrus.Errorf("Error applying %s %s: %v", obj.GetKind(), obj.GetName(), err)
+		return err
+	}
+	logrus.Debugf("%s %s is applied", obj.GetKind(), obj.GetName())
+	return nil
+}
+
+// waitForCRDs waits until the CustomResourceDefinitions are established, so that their kinds can be applied.
+func (c *manifestClient) waitForCRDs(crds []*unstructured.Unstructured, timeout time.Duration) error {
+	if len(crds) == 0 {
+		return nil
+	}
+	for _, crd := range crds {
+		resource, err := c.resourceFor(crd)
+		if err != nil {
+			return err
+		}
+		err = wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+			current, err := resource.Get(context.TODO(), crd.GetName(), metav1.GetOptions{})
+			if err != nil {
+				logrus.Debugf("Still waiting for CustomResourceDefinition %s: %v", crd.GetName(), err)
+				return false, nil
+			}
+			return crdEstablished(current), nil
+		})
+		if err != nil {
+			return fmt.Errorf("timed out waiting for CustomResourceDefinition %s to be established", crd.GetName())
+		}
+	}
+	meta.MaybeResetRESTMapper(c.mapper)
+	return nil
+}
+
+func crdEstablished(crd *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Established" && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+// prune deletes the objects labeled with the apply set that are not among the applied objects.
+func (c *manifestClient) prune(applySet string, applied []*unstructured.Unstructured) error {
+	keep := make(map[string]bool, len(applied))
+	kinds := append([]schema.GroupVersionKind{}, defaultPruneKinds...)
+	for _, obj := range applied {
+		gvk := obj.GroupVersionKind()
+		keep[objectKey(gvk.GroupKind(), obj.GetNamespace(), obj.GetName())] = true
+		if !containsKind(kinds, gvk) {
+			kinds = append(kinds, gvk)
+		}
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	selector := ApplySetLabel + "=" + applySet
+	for _, gvk := range kinds {
+		if gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition" {
+			continue
+		}
+		mapping, err := c.mapping(gvk)
+		if meta.IsNoMatchError(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		list, err := c.client.Resource(mapping.Resource).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			logrus.Errorf("Error listing %s of apply set %s: %v", gvk.Kind, applySet, err)
+			return err
+		}
+		for _, obj := range list.Items {
+			if keep[objectKey(gvk.GroupKind(), obj.GetNamespace(), obj.GetName())] || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			var resource dynamic.ResourceInterface = c.client.Resource(mapping.Resource)
+			if obj.GetNamespace() != "" {
+				resource = c.client.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+			}
+			err := resource.Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+			if err != nil && !apierrors.IsNotFound(err) {
+				logrus.Errorf("Error pruning %s %s: %v", gvk.Kind, obj.GetName(), err)
+				return err
+			}
+			logrus.Infof("%s %s is pruned", gvk.Kind, obj.GetName())
+		}
+	}
+	return nil
+}
+
+func containsKind(kinds []schema.GroupVersionKind, gvk schema.GroupVersionKind) bool {
+	for _, kind := range kinds {
+		if kind.GroupKind() == gvk.GroupKind() {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteManifest deletes the objects of a multi-document YAML manifest in the reverse order they are applied.
+// Objects that do not exist, or whose kind is no longer served, are skipped.
+func DeleteManifest(kubeconfig string, manifest []byte) error {
+	objects, err := DecodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	c, err := newManifestClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	objects = orderObjects(objects)
+	propagation := metav1.DeletePropagationBackground
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		resource, err := c.resourceFor(obj)
+		if meta.IsNoMatchError(err) {
+			continue
+		} else if err != nil {
+			logrus.Errorf("Error mapping %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			return err
+		}
+		err = resource.Delete(context.TODO(), obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil && !apierrors.IsNotFound(err) {
+			logrus.Errorf("Error deleting %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			return err
+		}
+		logrus.Debugf("%s %s is deleted", obj.GetKind(), obj.GetName())
+	}
+	return nil
+}
+
+// WaitForWorkloads waits until the Deployments, DaemonSets and StatefulSets among the objects are ready.
+func WaitForWorkloads(kubeconfig string, objects []*unstructured.Unstructured, timeout time.Duration) error {
+	for _, obj := range objects {
+		var err error
+		switch obj.GetKind() {
+		case "Deployment":
+			err = WaitForDeployment(kubeconfig, obj.GetNamespace(), obj.GetName(), timeout)
+		case "DaemonSet":
+			err = WaitForDaemonSet(kubeconfig, obj.GetNamespace(), obj.GetName(), timeout)
+		case "StatefulSet":
+			err = WaitForStatefulSet(kubeconfig, obj.GetNamespace(), obj.GetName(), timeout)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForDaemonSet waits until all pods of the DaemonSet are updated and ready.
+func WaitForDaemonSet(kubeconfig, namespace, name string, timeout time.Duration) error {
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var ds *appsv1.DaemonSet
+	err = wait.PollImmediate(5*time.Second, timeout, func() (bool, error) {
+		ds, err = clientset.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			logrus.Debugf("Still waiting for DaemonSet %s/%s: %v", namespace, name, err)
+			return false, nil
+		}
+		return DaemonSetReady(ds), nil
+	})
+	if err != nil {
+		if ds != nil {
+			logrus.Errorf("DaemonSet %s/%s is not ready: %d of %d pods are ready", namespace, name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+		}
+		return fmt.Errorf("timed out waiting for DaemonSet %s/%s to be ready", namespace, name)
+	}
+	return nil
+}
+
+// WaitForDeployment waits until all replicas of the Deployment are updated and available.
+func WaitForDeployment(kubeconfig, namespace, name string, timeout time.Duration) error {
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var deploy *appsv1.Deployment
+	err = wait.PollImmediate(5*time.Second, timeout, func() (bool, error) {
+		deploy, err = clientset.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			logrus.Debugf("Still waiting for Deployment %s/%s: %v", namespace, name, err)
+			return false, nil
+		}
+		return DeploymentReady(deploy), nil
+	})
+	if err != nil {
+		if deploy != nil {
+			logrus.Errorf("Deployment %s/%s is not ready: %d of %d replicas are available", namespace, name, deploy.Status.AvailableReplicas, deploy.Status.Replicas)
+		}
+		return fmt.Errorf("timed out waiting for Deployment %s/%s to be ready", namespace, name)
+	}
+	return nil
+}
+
+// WaitForStatefulSet waits until all replicas of the StatefulSet are updated and ready.
+func WaitForStatefulSet(kubeconfig, namespace, name string, timeout time.Duration) error {
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var sts *appsv1.StatefulSet
+	err = wait.PollImmediate(5*time.Second, timeout, func() (bool, error) {
+		sts, err = clientset.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			logrus.Debugf("Still waiting for StatefulSet %s/%s: %v", namespace, name, err)
+			return false, nil
+		}
+		return StatefulSetReady(sts), nil
+	})
+	if err != nil {
+		if sts != nil {
+			logrus.Errorf("StatefulSet %s/%s is not ready: %d of %d replicas are ready", namespace, name, sts.Status.ReadyReplicas, sts.Status.Replicas)
+		}
+		return fmt.Errorf("timed out waiting for StatefulSet %s/%s to be ready", namespace, name)
+	}
+	return nil
+}
+
+// DaemonSetReady reports whether all pods of the DaemonSet are updated and ready.
+func DaemonSetReady(ds *appsv1.DaemonSet) bool {
+	status := ds.Status
+	return status.ObservedGeneration >= ds.Generation &&
+		status.DesiredNumberScheduled > 0 &&
+		status.NumberReady == status.DesiredNumberScheduled &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled
+}
+
+// DeploymentReady reports whether all replicas of the Deployment are updated and available.
+func DeploymentReady(deploy *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	status := deploy.Status
+	return status.ObservedGeneration >= deploy.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.AvailableReplicas == replicas &&
+		status.Replicas == replicas
+}
+
+// StatefulSetReady reports whether all replicas of the StatefulSet are updated and ready.
+func StatefulSetReady(sts *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	status := sts.Status
+	return status.ObservedGeneration >= sts.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.ReadyReplicas == replicas
+}
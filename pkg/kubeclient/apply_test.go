@@ -0,0 +1,197 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+const testManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: updates.housekeeper.io
+---
+# comment only
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app
+  namespace: app-system
+`
+
+func newTestObject(apiVersion, kind, namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func TestApply(t *testing.T) {
+	t.Run("DecodeManifest Success", func(t *testing.T) {
+		objects, err := DecodeManifest([]byte(testManifest))
+		if err != nil {
+			t.Fatalf("DecodeManifest failed: %v", err)
+		}
+		if len(objects) != 4 {
+			t.Fatalf("expected 4 objects, got %d", len(objects))
+		}
+	})
+
+	t.Run("DecodeManifest Fail", func(t *testing.T) {
+		if _, err := DecodeManifest([]byte("metadata:\n  name: app\n")); err == nil {
+			t.Error("expected failure for an object without kind")
+		}
+		if _, err := DecodeManifest([]byte("kind: [")); err == nil {
+			t.Error("expected failure for invalid YAML")
+		}
+	})
+
+	t.Run("Order Objects Success", func(t *testing.T) {
+		objects, err := DecodeManifest([]byte(testManifest))
+		if err != nil {
+			t.Fatalf("DecodeManifest failed: %v", err)
+		}
+		var kinds []string
+		for _, obj := range orderObjects(objects) {
+			kinds = append(kinds, obj.GetKind())
+		}
+		if strings.Join(kinds, ",") != "Namespace,CustomResourceDefinition,Deployment,ConfigMap" {
+			t.Errorf("unexpected order: %v", kinds)
+		}
+		if objects[0].GetKind() != "Deployment" {
+			t.Error("orderObjects modified the objects")
+		}
+	})
+
+	t.Run("CRD Established Success", func(t *testing.T) {
+		crd := newTestObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "updates.housekeeper.io", nil)
+		if crdEstablished(crd) {
+			t.Error("CRD without conditions is established")
+		}
+		conditions := []interface{}{
+			map[string]interface{}{"type": "NamesAccepted", "status": "True"},
+			map[string]interface{}{"type": "Established", "status": "True"},
+		}
+		if err := unstructured.SetNestedSlice(crd.Object, conditions, "status", "conditions"); err != nil {
+			t.Fatalf("failed to set conditions: %v", err)
+		}
+		if !crdEstablished(crd) {
+			t.Error("CRD is not established")
+		}
+	})
+
+	t.Run("Prune Success", func(t *testing.T) {
+		setLabels := map[string]string{ApplySetLabel: "addon-app"}
+		configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+		deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+		client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{configMaps: "ConfigMapList", deployments: "DeploymentList"},
+			newTestObject("v1", "ConfigMap", "app-system", "app", setLabels),
+			newTestObject("v1", "ConfigMap", "app-system", "stale", setLabels),
+			newTestObject("v1", "ConfigMap", "app-system", "other", map[string]string{ApplySetLabel: "addon-other"}),
+			newTestObject("apps/v1", "Deployment", "default", "stale", setLabels),
+		)
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+		mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		c := &manifestClient{client: client, mapper: mapper, namespace: metav1.NamespaceDefault}
+
+		applied := []*unstructured.Unstructured{newTestObject("v1", "ConfigMap", "app-system", "app", setLabels)}
+		if err := c.prune("addon-app", applied); err != nil {
+			t.Fatalf("prune failed: %v", err)
+		}
+
+		for gvr, expected := range map[schema.GroupVersionResource]string{configMaps: "app,other", deployments: ""} {
+			list, err := client.Resource(gvr).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("failed to list %s: %v", gvr.Resource, err)
+			}
+			var names []string
+			for _, obj := range list.Items {
+				names = append(names, obj.GetName())
+			}
+			if strings.Join(names, ",") != expected {
+				t.Errorf("unexpected %s after prune: %v", gvr.Resource, names)
+			}
+		}
+	})
+
+	t.Run("Namespaces Success", func(t *testing.T) {
+		objects, err := DecodeManifest([]byte(testManifest))
+		if err != nil {
+			t.Fatalf("DecodeManifest failed: %v", err)
+		}
+		objects = append(objects, newTestObject("housekeeper.io/v1alpha1", "Update", "", "update", nil))
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		mapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+		c := &manifestClient{mapper: mapper, namespace: "nkd"}
+
+		if namespaces := strings.Join(c.namespaces(objects), ","); namespaces != "app-system,nkd" {
+			t.Errorf("unexpected namespaces: %s", namespaces)
+		}
+	})
+
+	t.Run("AddPullSecret Success", func(t *testing.T) {
+		account := newTestObject("v1", "ServiceAccount", "app-system", "app", nil)
+		unstructured.SetNestedSlice(account.Object, []interface{}{map[string]interface{}{"name": "other"}}, "imagePullSecrets")
+		objects := []*unstructured.Unstructured{account, newTestObject("v1", "ConfigMap", "app-system", "app", nil)}
+		for i := 0; i < 2; i++ {
+			if err := addPullSecret(objects, "nkd-registry-credentials"); err != nil {
+				t.Fatalf("addPullSecret failed: %v", err)
+			}
+		}
+
+		refs, _, _ := unstructured.NestedSlice(account.Object, "imagePullSecrets")
+		if len(refs) != 2 || refs[1].(map[string]interface{})["name"] != "nkd-registry-credentials" {
+			t.Errorf("unexpected image pull secrets: %v", refs)
+		}
+		if _, found, _ := unstructured.NestedSlice(objects[1].Object, "imagePullSecrets"); found {
+			t.Error("image pull secret added to a ConfigMap")
+		}
+	})
+
+	t.Run("ApplyManifest Fail", func(t *testing.T) {
+		if _, err := ApplyManifest("", []byte(testManifest), ApplyOptions{Prune: true}); err == nil {
+			t.Error("expected failure for prune without an apply set")
+		}
+	})
+}
@@ -16,44 +16,24 @@ limitations under the License.
 package kubeclient
 
 import (
-	"context"
-	"os/exec"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/secret"
 
 	"github.com/sirupsen/logrus"
-	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	"sigs.k8s.io/yaml"
 )
 
-const (
-	// Constants for CRD API groups, versions, and resources
-	CRDAPIGroup   = "apiextensions.k8s.io"
-	CRDAPIVersion = "v1"
-	CRDResource   = "customresourcedefinitions"
-
-	// custom resource
-	HousekeeperAPIGroup   = "housekeeper.io"
-	HousekeeperAPIVersion = "v1alpha1"
-	HousekeeperResource   = "updates"
-
-	// NAMESPACE
-	NSResource   = "namespaces"
-	NSAPIVersion = "v1"
-
-	// RBAC
-	RBACAPIGroup                = "rbac.authorization.k8s.io"
-	RBACAPIVersion              = "v1"
-	ClusterRolesResource        = "clusterroles"
-	ClusterRoleBindingsResource = "clusterrolebindings"
-)
+// loadConfig loads a kubeconfig file, which is encrypted when nkd persists it with secret key material.
+func loadConfig(kubeconfig string) (*rest.Config, error) {
+	content, err := secret.ReadFile(kubeconfig, configmanager.GetSecretSealer())
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.RESTConfigFromKubeConfig(content)
+}
 
 // CreateClient creates a Kubernetes clientset.
 // Parameters:
@@ -64,7 +44,7 @@ const (
 //   - error: Error
 
 func CreateClient(kubeconfig string) (*kubernetes.Clientset, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	config, err := loadConfig(kubeconfig)
 	if err != nil {
 		logrus.Errorf("Error loading kubeconfig: %v", err)
 		return nil, err
@@ -82,7 +62,7 @@ func CreateClient(kubeconfig string) (*kubernetes.Clientset, error) {
 // CreateDynamicClient creates a dynamic client.
 func CreateDynamicClient(kubeconfig string) (dynamic.Interface, error) {
 	// Get the kubeconfig configuration
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	config, err := loadConfig(kubeconfig)
 	if err != nil {
 		config, err = rest.InClusterConfig()
 		if err != nil {
@@ -100,202 +80,3 @@ func CreateDynamicClient(kubeconfig string) (dynamic.Interface, error) {
 
 	return dynamicClient, nil
 }
-
-// parseYAMLToUnstructured parses YAML into Unstructured object
-func parseYAMLToUnstructured(yamlContent string) (*unstructured.Unstructured, error) {
-	unstructuredObj := &unstructured.Unstructured{}
-	if err := yaml.Unmarshal([]byte(yamlContent), unstructuredObj); err != nil {
-		logrus.Errorf("Error parsing YAML as Unstructured: %v", err)
-		return nil, err
-	}
-	return unstructuredObj, nil
-}
-
-// deployResource deploys a resource using dynamic client
-func deployResource(yamlContent, kubeconfig string, apiGroup, apiVersion, resource string) error {
-	client, err := CreateDynamicClient(kubeconfig)
-	if err != nil {
-		return err
-	}
-
-	unstructuredObj, err := parseYAMLToUnstructured(yamlContent)
-	if err != nil {
-		return err
-	}
-
-	_, err = client.Resource(schema.GroupVersionResource{
-		Group:    apiGroup,
-		Version:  apiVersion,
-		Resource: resource,
-	}).Create(context.TODO(), unstructuredObj, metav1.CreateOptions{})
-	if err != nil {
-		logrus.Errorf("Error creating resource %s: %v", resource, err)
-		return err
-	}
-
-	return nil
-}
-
-// DeployCRD deploys a CustomResourceDefinition.
-func DeployCRD(yamlContent string, kubeconfig string) error {
-	return deployResource(yamlContent, kubeconfig, CRDAPIGroup, CRDAPIVersion, CRDResource)
-}
-
-// DeployNamespace deploys a Namespace.
-func DeployNamespace(yamlContent string, kubeconfig string) error {
-	return deployResource(yamlContent, kubeconfig, "", NSAPIVersion, NSResource)
-}
-
-// DeployClusterRole deploys a ClusterRole.
-func DeployClusterRole(yamlContent string, kubeconfig string) error {
-	return deployResource(yamlContent, kubeconfig, RBACAPIGroup, RBACAPIVersion, ClusterRolesResource)
-}
-
-// DeployClusterRoleBinding deploys a ClusterRoleBinding.
-func DeployClusterRoleBinding(yamlContent string, kubeconfig string) error {
-	return deployResource(yamlContent, kubeconfig, RBACAPIGroup, RBACAPIVersion, ClusterRoleBindingsResource)
-}
-
-// DeployDeployment deploys a Deployment.
-func DeployDeployment(yamlContent string, kubeconfig string, namespace string) error {
-	clientset, err := CreateClient(kubeconfig)
-	if err != nil {
-		return err
-	}
-
-	unstructuredObj, err := parseYAMLToUnstructured(yamlContent)
-	if err != nil {
-		return err
-	}
-
-	deployment := &appsv1.Deployment{}
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.Object, deployment)
-	if err != nil {
-		logrus.Errorf("error converting Unstructured to deployment: %v", err)
-		return err
-	}
-
-	// Create the Deployment using the Kubernetes clientset
-	_, err = clientset.AppsV1().Deployments(namespace).Create(context.TODO(), deployment, metav1.CreateOptions{})
-	if err != nil {
-		logrus.Errorf("error creating Deployment: %v", err)
-		return err
-	}
-
-	return nil
-}
-
-// DeployDaemonSet deploys a DaemonSet.
-func DeployDaemonSet(yamlContent string, kubeconfig string, namespace string) error {
-	clientset, err := CreateClient(kubeconfig)
-	if err != nil {
-		return err
-	}
-
-	unstructuredObj, err := parseYAMLToUnstructured(yamlContent)
-	if err != nil {
-		return err
-	}
-
-	daemonSet := &appsv1.DaemonSet{}
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.Object, daemonSet)
-	if err != nil {
-		logrus.Errorf("error converting Unstructured to daemonset: %v", err)
-		return err
-	}
-
-	// Create the DaemonSet using the Kubernetes clientset
-	_, err = clientset.AppsV1().DaemonSets(namespace).Create(context.TODO(), daemonSet, metav1.CreateOptions{})
-	if err != nil {
-		logrus.Errorf("error creating DaemonSet: %v", err)
-		return err
-	}
-
-	return nil
-}
-
-func ApplyHousekeeperCR(yamlContent string, kubeconfig string) error {
-	// Create a dynamic client for interacting with the Kubernetes API server
-	client, err := CreateDynamicClient(kubeconfig)
-	if err != nil {
-		return err
-	}
-
-	// Parse the YAML content into an Unstructured object
-	unstructuredObj, err := parseYAMLToUnstructured(yamlContent)
-	if err != nil {
-		return err
-	}
-
-	// Try to get the existing custom resource
-	existingObj, err := client.
-		Resource(schema.GroupVersionResource{
-			Group:    HousekeeperAPIGroup,
-			Version:  HousekeeperAPIVersion,
-			Resource: HousekeeperResource,
-		}).
-		Namespace(unstructuredObj.GetNamespace()).
-		Get(context.TODO(), unstructuredObj.GetName(), metav1.GetOptions{})
-
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Custom resource doesn't exist, create it
-			_, err = client.
-				Resource(schema.GroupVersionResource{
-					Group:    HousekeeperAPIGroup,
-					Version:  HousekeeperAPIVersion,
-					Resource: HousekeeperResource,
-				}).
-				Namespace(unstructuredObj.GetNamespace()).
-				Create(context.TODO(), unstructuredObj, metav1.CreateOptions{})
-			if err != nil {
-				logrus.Errorf("Error creating custom resource: %v", err)
-				return err
-			}
-			return nil
-		}
-
-		// Error other than "not found" occurred
-		logrus.Errorf("Error checking custom resource existence: %v", err)
-		return err
-	}
-
-	// Custom resource already exists, update it with new configuration
-	unstructuredObj.SetResourceVersion(existingObj.GetResourceVersion())
-	_, err = client.
-		Resource(schema.GroupVersionResource{
-			Group:    HousekeeperAPIGroup,
-			Version:  HousekeeperAPIVersion,
-			Resource: HousekeeperResource,
-		}).
-		Namespace(unstructuredObj.GetNamespace()).
-		Update(context.TODO(), unstructuredObj, metav1.UpdateOptions{})
-	if err != nil {
-		logrus.Errorf("Error updating custom resource: %v", err)
-		return err
-	}
-
-	return nil
-}
-
-func RunKubectlApplyWithYaml(yamlFilePath string) error {
-	kubectlArgs := []string{"apply", "-f", yamlFilePath}
-	cmd := exec.Command("kubectl", kubectlArgs...)
-	// cmd.Stdout = os.Stdout
-	// cmd.Stderr = os.Stderr
-
-	// run kubectl apply
-	err := cmd.Run()
-	if err != nil {
-		logrus.Errorf("Error executing kubectl apply: %v", err)
-		return err
-	}
-
-	return nil
-}
-
-// isKubectlInstalled checks if kubectl is installed on the system.
-func IsKubectlInstalled() bool {
-	_, err := exec.LookPath("kubectl")
-	return err == nil
-}
@@ -19,15 +19,15 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"log"
+	"nestos-kubernetes-deployer/pkg/configmanager"
+	"nestos-kubernetes-deployer/pkg/secret"
+	"path/filepath"
 	"testing"
 )
 
 var (
 	kubeconfigPath = "./kubeconfig"
-	yamlContent    = ""
-	//namespace      = "test_"
 )
 
 func TestCreateClient(t *testing.T) {
@@ -60,7 +60,50 @@ func TestCreateClient(t *testing.T) {
 		log.Println("TestCreateDynamicClient success")
 	})
 
-	p := gomonkey.ApplyFunc(clientcmd.BuildConfigFromFlags, func(string, string) (*rest.Config, error) {
+	t.Run("loadConfig_sealed", func(t *testing.T) {
+		sealer, err := secret.NewSealer([]byte("test-passphrase"))
+		if err != nil {
+			t.Fatalf("NewSealer failed: %v", err)
+		}
+		configmanager.Sealer = sealer
+		defer func() { configmanager.Sealer = nil }()
+
+		path := filepath.Join(t.TempDir(), "admin.config")
+		content := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: kubernetes
+  cluster:
+    server: https://192.168.1.10:6443
+contexts:
+- name: kubernetes-admin@kubernetes
+  context:
+    cluster: kubernetes
+    user: kubernetes-admin
+current-context: kubernetes-admin@kubernetes
+users:
+- name: kubernetes-admin
+  user:
+    token: abcdef.0123456789abcdef
+`)
+		if err := secret.WriteFile(path, content, sealer); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		config, err := loadConfig(path)
+		if err != nil {
+			t.Fatalf("loadConfig failed: %v", err)
+		}
+		if config.Host != "https://192.168.1.10:6443" {
+			t.Errorf("unexpected host %s", config.Host)
+		}
+
+		configmanager.Sealer = nil
+		if _, err := loadConfig(path); err == nil {
+			t.Error("expected failure for an encrypted kubeconfig without key material")
+		}
+	})
+
+	p := gomonkey.ApplyFunc(loadConfig, func(string) (*rest.Config, error) {
 		return &rest.Config{}, nil
 	})
 
@@ -129,49 +172,4 @@ func TestCreateClient(t *testing.T) {
 
 		log.Println("TestCreateDynamicClient success")
 	})
-
-	t.Run("DeployCRD", func(t *testing.T) {
-		DeployCRD(yamlContent, kubeconfigPath)
-	})
-	t.Run("DeployNamespace", func(t *testing.T) {
-		DeployNamespace(yamlContent, kubeconfigPath)
-	})
-
-	t.Run("DeployClusterRoleBinding", func(t *testing.T) {
-		DeployClusterRoleBinding(yamlContent, kubeconfigPath)
-	})
-
-	//t.Run("DeployDeployment", func(t *testing.T) {
-	//	//patches := gomonkey.ApplyMethod(reflect.TypeOf(&appsv1.Deployment{}), "Create", func(_ *appsv1.Deployment, ctx context.Context, deployment *appsv1.Deployment, opts metav1.CreateOptions) (*appsv1.Deployment, error) {
-	//	//	return &appsv1.Deployment{
-	//	//		ObjectMeta: metav1.ObjectMeta{
-	//	//			Name: deployment.Name,
-	//	//		},
-	//	//	}, nil
-	//	//})
-	//	//defer patches.Reset()
-	//	d := appsv1.Deployment{}
-	//	t.Log(d)
-	//	DeployDeployment(yamlContent, kubeconfigPath, namespace)
-	//})
-	//
-	//t.Run("DeployDaemonSet", func(t *testing.T) {
-	//	DeployDaemonSet(yamlContent, kubeconfigPath, namespace)
-	//})
-	t.Run("ApplyHousekeeperCR", func(t *testing.T) {
-		ApplyHousekeeperCR(yamlContent, kubeconfigPath)
-	})
-	t.Run("RunKubectlApplyWithYaml", func(t *testing.T) {
-		RunKubectlApplyWithYaml(yamlContent)
-	})
-
-}
-
-func TestIsKubectlInstalled(t *testing.T) {
-	b := IsKubectlInstalled()
-	if !b {
-		t.Log("no install")
-		return
-	}
-	t.Log("IsKubectlInstalled success")
 }
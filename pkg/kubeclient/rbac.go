@@ -0,0 +1,107 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// SanitizeName returns a valid DNS-1123 subdomain object name derived from name.
+func SanitizeName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = name[:validation.DNS1123SubdomainMaxLength]
+	}
+	return strings.Trim(name, "-.")
+}
+
+// bindingName returns a valid object name for the binding of user to role.
+func bindingName(user, roleName string) string {
+	return SanitizeName("nkd-" + user + "-" + roleName)
+}
+
+// ValidateRoleBinding checks the arguments of CreateUserRoleBinding without contacting the cluster.
+func ValidateRoleBinding(roleKind, roleName, namespace string) error {
+	if roleKind != "Role" && roleKind != "ClusterRole" {
+		return fmt.Errorf("unsupported role kind: %s", roleKind)
+	}
+	if roleName == "" {
+		return fmt.Errorf("role name is empty")
+	}
+	if roleKind == "Role" && namespace == "" {
+		return fmt.Errorf("namespace is required to bind a Role")
+	}
+	if namespace != "" {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// CreateUserRoleBinding binds the user to the named Role or ClusterRole.
+// A RoleBinding is created in namespace, or a ClusterRoleBinding if namespace is empty.
+// It returns the kind and name of the created binding.
+func CreateUserRoleBinding(kubeconfig, user, roleKind, roleName, namespace string) (string, error) {
+	if err := ValidateRoleBinding(roleKind, roleName, namespace); err != nil {
+		return "", err
+	}
+
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	name := bindingName(user, roleName)
+	subjects := []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: user}}
+	roleRef := rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: roleKind, Name: roleName}
+	labels := map[string]string{"app.kubernetes.io/managed-by": "nkd"}
+
+	if namespace != "" {
+		binding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Subjects:   subjects,
+			RoleRef:    roleRef,
+		}
+		if _, err := clientset.RbacV1().RoleBindings(namespace).Create(context.TODO(), binding, metav1.CreateOptions{}); err != nil {
+			logrus.Errorf("Error creating RoleBinding: %v", err)
+			return "", err
+		}
+		return "RoleBinding/" + namespace + "/" + name, nil
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Subjects:   subjects,
+		RoleRef:    roleRef,
+	}
+	if _, err := clientset.RbacV1().ClusterRoleBindings().Create(context.TODO(), binding, metav1.CreateOptions{}); err != nil {
+		logrus.Errorf("Error creating ClusterRoleBinding: %v", err)
+		return "", err
+	}
+	return "ClusterRoleBinding/" + name, nil
+}
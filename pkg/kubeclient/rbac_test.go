@@ -0,0 +1,60 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubeclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRBAC(t *testing.T) {
+	t.Run("SanitizeName Success", func(t *testing.T) {
+		for in, want := range map[string]string{
+			"nkd-Alice@Example.com-1700000000": "nkd-alice-example.com-1700000000",
+			"nkd-dev team/ops-view":            "nkd-dev-team-ops-view",
+			"-Bob_":                            "bob",
+		} {
+			if got := SanitizeName(in); got != want {
+				t.Errorf("SanitizeName(%q) = %q, want %q", in, got, want)
+			}
+		}
+		if got := SanitizeName(strings.Repeat("a", 300)); len(got) != 253 {
+			t.Errorf("name is not truncated: %d", len(got))
+		}
+	})
+
+	t.Run("ValidateRoleBinding Success", func(t *testing.T) {
+		if err := ValidateRoleBinding("ClusterRole", "view", ""); err != nil {
+			t.Errorf("ValidateRoleBinding failed: %v", err)
+		}
+		if err := ValidateRoleBinding("Role", "edit", "dev"); err != nil {
+			t.Errorf("ValidateRoleBinding failed: %v", err)
+		}
+	})
+
+	t.Run("ValidateRoleBinding Fail", func(t *testing.T) {
+		for _, args := range [][3]string{
+			{"Group", "view", ""},
+			{"ClusterRole", "", ""},
+			{"Role", "edit", ""},
+			{"Role", "edit", "Dev_Team"},
+		} {
+			if err := ValidateRoleBinding(args[0], args[1], args[2]); err == nil {
+				t.Errorf("expected error for %v", args)
+			}
+		}
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	nodev1 "k8s.io/api/node/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CreateRuntimeClass creates or updates the RuntimeClass that selects the runtime handler of the same name.
+// Pods using it are only scheduled to nodes matching nodeSelector if it is not empty.
+func CreateRuntimeClass(kubeconfig, name string, nodeSelector map[string]string) error {
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	runtimeClass := &nodev1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"app.kubernetes.io/managed-by": "nkd"},
+		},
+		Handler: name,
+	}
+	if len(nodeSelector) > 0 {
+		runtimeClass.Scheduling = &nodev1.Scheduling{NodeSelector: nodeSelector}
+	}
+
+	runtimeClasses := clientset.NodeV1().RuntimeClasses()
+	existing, err := runtimeClasses.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err := runtimeClasses.Create(context.TODO(), runtimeClass, metav1.CreateOptions{}); err != nil {
+			logrus.Errorf("Error creating RuntimeClass %s: %v", name, err)
+			return err
+		}
+		return nil
+	} else if err != nil {
+		logrus.Errorf("Error getting RuntimeClass %s: %v", name, err)
+		return err
+	}
+
+	// The handler of a RuntimeClass is immutable
+	if existing.Handler != name {
+		logrus.Errorf("RuntimeClass %s already exists with handler %s", name, existing.Handler)
+		return apierrors.NewAlreadyExists(nodev1.Resource("runtimeclasses"), name)
+	}
+	if existing.Labels == nil {
+		existing.Labels = make(map[string]string)
+	}
+	existing.Labels["app.kubernetes.io/managed-by"] = "nkd"
+	existing.Scheduling = runtimeClass.Scheduling
+	if _, err := runtimeClasses.Update(context.TODO(), existing, metav1.UpdateOptions{}); err != nil {
+		logrus.Errorf("Error updating RuntimeClass %s: %v", name, err)
+		return err
+	}
+	return nil
+}
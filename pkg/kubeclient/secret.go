@@ -0,0 +1,94 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// CreateImagePullSecret creates or updates the kubernetes.io/dockerconfigjson secret in each namespace,
+// and adds it to the image pull secrets of the default service account there.
+func CreateImagePullSecret(kubeconfig, name string, dockerConfigJSON []byte, namespaces []string) error {
+	clientset, err := CreateClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "nkd"},
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerConfigJSON},
+		}
+		secrets := clientset.CoreV1().Secrets(namespace)
+		if _, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{}); apierrors.IsAlreadyExists(err) {
+			_, err = secrets.Update(context.TODO(), secret, metav1.UpdateOptions{})
+			if err != nil {
+				logrus.Errorf("Error updating Secret %s/%s: %v", namespace, name, err)
+				return err
+			}
+		} else if err != nil {
+			logrus.Errorf("Error creating Secret %s/%s: %v", namespace, name, err)
+			return err
+		}
+
+		// The default service account is created by kube-controller-manager shortly after the namespace
+		accounts := clientset.CoreV1().ServiceAccounts(namespace)
+		var account *corev1.ServiceAccount
+		err := wait.PollImmediate(2*time.Second, 2*time.Minute, func() (bool, error) {
+			var getErr error
+			account, getErr = accounts.Get(context.TODO(), "default", metav1.GetOptions{})
+			if apierrors.IsNotFound(getErr) {
+				return false, nil
+			}
+			return getErr == nil, getErr
+		})
+		if err != nil {
+			logrus.Errorf("Error getting the default ServiceAccount of %s: %v", namespace, err)
+			return err
+		}
+		if hasPullSecret(account, name) {
+			continue
+		}
+		account.ImagePullSecrets = append(account.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+		if _, err := accounts.Update(context.TODO(), account, metav1.UpdateOptions{}); err != nil {
+			logrus.Errorf("Error updating the default ServiceAccount of %s: %v", namespace, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func hasPullSecret(account *corev1.ServiceAccount, name string) bool {
+	for _, ref := range account.ImagePullSecrets {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
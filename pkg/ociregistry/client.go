@@ -0,0 +1,338 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ociregistry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+
+	dockerRegistryHost = "registry-1.docker.io"
+)
+
+var manifestMediaTypes = []string{mediaTypeOCIIndex, mediaTypeOCIManifest, mediaTypeDockerManifestList, mediaTypeDockerManifest}
+
+// manifest 镜像清单或镜像索引中需要下载的内容
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    *descriptor  `json:"config,omitempty"`
+	Layers    []descriptor `json:"layers,omitempty"`
+	Manifests []struct {
+		descriptor
+		Platform *struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform,omitempty"`
+	} `json:"manifests,omitempty"`
+}
+
+// Puller 以匿名方式从镜像仓库拉取镜像，写入 OCI image layout 目录
+type Puller struct {
+	Client *http.Client
+	tokens map[string]string // 以仓库地址和路径为索引缓存访问令牌
+}
+
+func NewPuller() *Puller {
+	return &Puller{Client: http.DefaultClient, tokens: map[string]string{}}
+}
+
+// Pull 拉取镜像写入 dir。多架构镜像保留完整的镜像索引，但仅下载 arch（如 amd64）架构的内容
+func (p *Puller) Pull(ctx context.Context, name string, dir string, arch string) (Image, error) {
+	ref, err := ParseReference(name)
+	if err != nil {
+		return Image{}, err
+	}
+	if err := initLayout(dir); err != nil {
+		return Image{}, err
+	}
+
+	content, desc, err := p.fetchManifest(ctx, ref, ref.Tag)
+	if err != nil {
+		return Image{}, errors.Wrapf(err, "failed to pull %s", name)
+	}
+	if err := writeBlob(dir, desc.Digest, content); err != nil {
+		return Image{}, err
+	}
+
+	m := manifest{}
+	if err := json.Unmarshal(content, &m); err != nil {
+		return Image{}, errors.Wrapf(err, "failed to parse the manifest of %s", name)
+	}
+	if len(m.Manifests) > 0 {
+		found := false
+		for _, child := range m.Manifests {
+			if child.Platform == nil || child.Platform.OS != "linux" || child.Platform.Architecture != arch {
+				continue
+			}
+			found = true
+			if err := p.pullManifest(ctx, ref, child.Digest, dir); err != nil {
+				return Image{}, errors.Wrapf(err, "failed to pull %s", name)
+			}
+		}
+		if !found {
+			return Image{}, fmt.Errorf("%s has no linux/%s image", name, arch)
+		}
+	} else if err := p.pullContent(ctx, ref, m, dir); err != nil {
+		return Image{}, errors.Wrapf(err, "failed to pull %s", name)
+	}
+
+	image := Image{Reference: ref, Digest: desc.Digest, MediaType: desc.MediaType}
+	if err := addToIndex(dir, image, desc.Size); err != nil {
+		return Image{}, err
+	}
+	return image, nil
+}
+
+func (p *Puller) pullManifest(ctx context.Context, ref Reference, digest string, dir string) error {
+	content, _, err := p.fetchManifest(ctx, ref, digest)
+	if err != nil {
+		return err
+	}
+	if err := writeBlob(dir, digest, content); err != nil {
+		return err
+	}
+	m := manifest{}
+	if err := json.Unmarshal(content, &m); err != nil {
+		return errors.Wrapf(err, "failed to parse manifest %s", digest)
+	}
+	return p.pullContent(ctx, ref, m, dir)
+}
+
+func (p *Puller) pullContent(ctx context.Context, ref Reference, m manifest, dir string) error {
+	blobs := m.Layers
+	if m.Config != nil {
+		blobs = append([]descriptor{*m.Config}, blobs...)
+	}
+	for _, blob := range blobs {
+		if !IsDigest(blob.Digest) {
+			return fmt.Errorf("invalid digest %q", blob.Digest)
+		}
+		if _, err := os.Stat(blobPath(dir, blob.Digest)); err == nil {
+			continue
+		}
+		logrus.Debugf("Downloading %s of %s", blob.Digest, ref)
+		if err := p.fetchBlob(ctx, ref, blob.Digest, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Puller) fetchManifest(ctx context.Context, ref Reference, reference string) ([]byte, descriptor, error) {
+	resp, err := p.get(ctx, ref, "manifests/"+reference, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		return nil, descriptor{}, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, descriptor{}, err
+	}
+	sum := sha256.Sum256(content)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	if IsDigest(reference) && reference != digest {
+		return nil, descriptor{}, fmt.Errorf("manifest %s does not match its digest", reference)
+	}
+	mediaType := strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0])
+	return content, descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(content))}, nil
+}
+
+func (p *Puller) fetchBlob(ctx context.Context, ref Reference, digest string, dir string) error {
+	resp, err := p.get(ctx, ref, "blobs/"+digest, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	tmp, err := os.CreateTemp(filepath.Join(dir, blobsDir), ".download-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), resp.Body); err != nil {
+		return errors.Wrapf(err, "failed to download %s", digest)
+	}
+	if "sha256:"+hex.EncodeToString(hash.Sum(nil)) != digest {
+		return fmt.Errorf("blob %s does not match its digest", digest)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), blobPath(dir, digest))
+}
+
+// get 请求镜像仓库接口，收到 401 时按照 WWW-Authenticate 获取匿名令牌后重试
+func (p *Puller) get(ctx context.Context, ref Reference, path string, accept string) (*http.Response, error) {
+	host := ref.Registry
+	if host == defaultRegistry {
+		host = dockerRegistryHost
+	}
+	endpoint := "https://" + host + APIPrefix + ref.Repository + "/" + path
+	key := host + "/" + ref.Repository
+
+	for attempt := 0; attempt < 2; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token, ok := p.tokens[key]; ok {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := p.Client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusOK {
+			return resp, nil
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized || attempt > 0 {
+			return nil, fmt.Errorf("GET %s: %s", endpoint, resp.Status)
+		}
+
+		token, err := p.fetchToken(ctx, resp.Header.Get("WWW-Authenticate"), ref.Repository)
+		if err != nil {
+			return nil, err
+		}
+		p.tokens[key] = token
+	}
+	return nil, fmt.Errorf("GET %s: unauthorized", endpoint)
+}
+
+func (p *Puller) fetchToken(ctx context.Context, challenge string, repository string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	params := map[string]string{}
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	if params["realm"] == "" {
+		return "", fmt.Errorf("no realm in authentication challenge %q", challenge)
+	}
+
+	query := url.Values{}
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	query.Set("scope", "repository:"+repository+":pull")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, params["realm"]+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get token from %s: %s", params["realm"], resp.Status)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrap(err, "failed to parse token")
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+func blobPath(dir string, digest string) string {
+	parts := strings.SplitN(digest, ":", 2)
+	return filepath.Join(dir, blobsDir, parts[0], parts[1])
+}
+
+func initLayout(dir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, blobsDir, "sha256"), 0755); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(dir, layoutFile)); err == nil {
+		return nil
+	}
+	if err := os.WriteFile(filepath.Join(dir, layoutFile), []byte(`{"imageLayoutVersion":"`+layoutMajor+`"}`), 0644); err != nil {
+		return err
+	}
+	content, _ := json.Marshal(imageIndex{SchemaVersion: 2, MediaType: mediaTypeOCIIndex, Manifests: []descriptor{}})
+	return os.WriteFile(filepath.Join(dir, indexFile), content, 0644)
+}
+
+func writeBlob(dir string, digest string, content []byte) error {
+	return os.WriteFile(blobPath(dir, digest), content, 0644)
+}
+
+// addToIndex 将镜像以完整名称写入 index.json，同名的镜像被替换
+func addToIndex(dir string, image Image, size int64) error {
+	content, err := os.ReadFile(filepath.Join(dir, indexFile))
+	if err != nil {
+		return err
+	}
+	index := imageIndex{}
+	if err := json.Unmarshal(content, &index); err != nil {
+		return errors.Wrapf(err, "failed to parse %s", indexFile)
+	}
+
+	name := image.String()
+	manifests := index.Manifests[:0]
+	for _, desc := range index.Manifests {
+		if desc.Annotations[refNameAnnotation] != name {
+			manifests = append(manifests, desc)
+		}
+	}
+	index.Manifests = append(manifests, descriptor{
+		MediaType:   image.MediaType,
+		Digest:      image.Digest,
+		Size:        size,
+		Annotations: map[string]string{refNameAnnotation: name},
+	})
+
+	content, err = json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, indexFile), content, 0644)
+}
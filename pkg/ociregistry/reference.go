@@ -0,0 +1,86 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ociregistry
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	defaultRegistry  = "docker.io"
+	defaultTag       = "latest"
+	officialRepoName = "library"
+)
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRegexp     = regexp.MustCompile(`^(sha256:[a-f0-9]{64}|sha512:[a-f0-9]{128})$`)
+)
+
+// Reference 镜像名称，例如 registry.k8s.io/pause:3.9 对应
+// Registry=registry.k8s.io、Repository=pause、Tag=3.9
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+}
+
+func (r Reference) String() string {
+	return r.Registry + "/" + r.Repository + ":" + r.Tag
+}
+
+// ParseReference 按照 docker 的规则解析镜像名称：未指定仓库地址时为 docker.io，
+// docker.io 的单级名称补全 library/ 前缀，未指定标签时为 latest，摘要部分被忽略
+func ParseReference(name string) (Reference, error) {
+	ref := Reference{Registry: defaultRegistry, Tag: defaultTag}
+
+	remainder := name
+	if i := strings.Index(remainder, "@"); i >= 0 {
+		remainder = remainder[:i]
+	}
+	if i := strings.LastIndex(remainder, ":"); i > strings.LastIndex(remainder, "/") {
+		ref.Tag = remainder[i+1:]
+		remainder = remainder[:i]
+	}
+	if i := strings.Index(remainder, "/"); i >= 0 {
+		host := remainder[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" || strings.ToLower(host) != host {
+			ref.Registry = host
+			remainder = remainder[i+1:]
+		}
+	}
+	if ref.Registry == defaultRegistry && !strings.Contains(remainder, "/") {
+		remainder = officialRepoName + "/" + remainder
+	}
+	ref.Repository = remainder
+
+	if !repositoryRegexp.MatchString(ref.Repository) {
+		return Reference{}, fmt.Errorf("invalid image name %q", name)
+	}
+	if !tagRegexp.MatchString(ref.Tag) {
+		return Reference{}, fmt.Errorf("invalid tag in image name %q", name)
+	}
+	return ref, nil
+}
+
+// IsDigest 判断是否为合法的内容摘要，例如 sha256:<64位十六进制>
+func IsDigest(s string) bool {
+	return digestRegexp.MatchString(s)
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ociregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// APIPrefix 为 OCI distribution 接口的路径前缀
+	APIPrefix = "/v2/"
+
+	layoutFile  = "oci-layout"
+	indexFile   = "index.json"
+	blobsDir    = "blobs"
+	layoutMajor = "1.0.0"
+
+	// 镜像名称注解：skopeo 等工具写入 ref.name，containerd 导出镜像时写入完整名称
+	refNameAnnotation        = "org.opencontainers.image.ref.name"
+	containerdNameAnnotation = "io.containerd.image.name"
+
+	mediaTypeOCIIndex    = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest = "application/vnd.oci.image.manifest.v1+json"
+)
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type imageIndex struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Manifests     []descriptor `json:"manifests"`
+}
+
+type imageLayout struct {
+	ImageLayoutVersion string `json:"imageLayoutVersion"`
+}
+
+// Image 镜像布局目录中带有名称的镜像
+type Image struct {
+	Reference
+	Digest    string
+	MediaType string
+}
+
+// Registry 基于 OCI image layout 目录的只读镜像仓库，实现 OCI distribution 的拉取接口
+type Registry struct {
+	dir    string
+	images map[string]map[string]Image // 以仓库路径、标签为索引，仓库路径不含仓库地址
+}
+
+// Open 读取 OCI image layout 目录。镜像以不含仓库地址的路径提供，
+// 例如 registry.k8s.io/pause:3.9 通过 /v2/pause/manifests/3.9 获取
+func Open(dir string) (*Registry, error) {
+	content, err := os.ReadFile(filepath.Join(dir, layoutFile))
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s is not an OCI image layout", dir)
+	}
+	layout := imageLayout{}
+	if err := json.Unmarshal(content, &layout); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", layoutFile)
+	}
+	if !strings.HasPrefix(layout.ImageLayoutVersion, "1.") {
+		return nil, fmt.Errorf("unsupported image layout version %q, expected %s", layout.ImageLayoutVersion, layoutMajor)
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, indexFile))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the index of %s", dir)
+	}
+	index := imageIndex{}
+	if err := json.Unmarshal(content, &index); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", indexFile)
+	}
+
+	r := &Registry{dir: dir, images: map[string]map[string]Image{}}
+	for _, desc := range index.Manifests {
+		name := desc.Annotations[containerdNameAnnotation]
+		if name == "" {
+			name = desc.Annotations[refNameAnnotation]
+		}
+		// 仅包含标签的 ref.name 无法确定镜像名称，只能通过摘要获取
+		if !strings.ContainsAny(name, "/:") {
+			logrus.Warnf("Skipping %s in %s: the image has no name", desc.Digest, dir)
+			continue
+		}
+		ref, err := ParseReference(name)
+		if err != nil {
+			logrus.Warnf("Skipping %s in %s: %v", desc.Digest, dir, err)
+			continue
+		}
+		if !IsDigest(desc.Digest) {
+			logrus.Warnf("Skipping %s in %s: invalid digest %q", name, dir, desc.Digest)
+			continue
+		}
+		if _, err := os.Stat(blobPath(dir, desc.Digest)); err != nil {
+			logrus.Warnf("Skipping %s in %s: %v", name, dir, err)
+			continue
+		}
+
+		tags, ok := r.images[ref.Repository]
+		if !ok {
+			tags = map[string]Image{}
+			r.images[ref.Repository] = tags
+		}
+		if image, ok := tags[ref.Tag]; ok && image.Registry != ref.Registry {
+			logrus.Warnf("Both %s and %s are in %s, only %s is served", image, ref, dir, ref)
+		}
+		tags[ref.Tag] = Image{Reference: ref, Digest: desc.Digest, MediaType: desc.MediaType}
+	}
+
+	return r, nil
+}
+
+// Images 返回仓库中的所有镜像，按名称排序
+func (r *Registry) Images() []Image {
+	var images []Image
+	for _, tags := range r.images {
+		for _, image := range tags {
+			images = append(images, image)
+		}
+	}
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].String() < images[j].String()
+	})
+	return images
+}
+
+// Registries 返回镜像所属的仓库地址，节点将本仓库配置为这些地址的 mirror
+func (r *Registry) Registries() []string {
+	seen := map[string]bool{}
+	var registries []string
+	for _, image := range r.Images() {
+		if !seen[image.Registry] {
+			seen[image.Registry] = true
+			registries = append(registries, image.Registry)
+		}
+	}
+	sort.Strings(registries)
+	return registries
+}
+
+// ServeHTTP 处理 /v2/ 下的 manifests、blobs 与 tags/list 请求，仅支持 GET 与 HEAD
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "the registry is read-only")
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, APIPrefix)
+	if path == "" {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, "{}")
+		return
+	}
+
+	if name := strings.TrimSuffix(path, "/tags/list"); name != path {
+		r.serveTags(w, name)
+		return
+	}
+	for _, kind := range []string{"/manifests/", "/blobs/"} {
+		i := strings.LastIndex(path, kind)
+		if i <= 0 {
+			continue
+		}
+		name, reference := path[:i], path[i+len(kind):]
+		tags, ok := r.images[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NAME_UNKNOWN", fmt.Sprintf("repository %s is not known to the registry", name))
+			return
+		}
+		if kind == "/blobs/" {
+			r.serveBlob(w, req, reference, "application/octet-stream", "BLOB_UNKNOWN")
+		} else {
+			r.serveManifest(w, req, tags, reference)
+		}
+		return
+	}
+	writeError(w, http.StatusNotFound, "UNSUPPORTED", "unsupported request "+req.URL.Path)
+}
+
+func (r *Registry) serveTags(w http.ResponseWriter, name string) {
+	tags, ok := r.images[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NAME_UNKNOWN", fmt.Sprintf("repository %s is not known to the registry", name))
+		return
+	}
+	list := struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{Name: name}
+	for tag := range tags {
+		list.Tags = append(list.Tags, tag)
+	}
+	sort.Strings(list.Tags)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		logrus.Debugf("failed to write the tag list of %s: %v", name, err)
+	}
+}
+
+func (r *Registry) serveManifest(w http.ResponseWriter, req *http.Request, tags map[string]Image, reference string) {
+	digest, mediaType := reference, ""
+	if image, ok := tags[reference]; ok {
+		digest, mediaType = image.Digest, image.MediaType
+	} else if !IsDigest(reference) {
+		writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", fmt.Sprintf("manifest %s is not known to the registry", reference))
+		return
+	}
+
+	if mediaType == "" {
+		mediaType = r.manifestMediaType(digest)
+	}
+	r.serveBlob(w, req, digest, mediaType, "MANIFEST_UNKNOWN")
+}
+
+// manifestMediaType 从清单内容中读取 mediaType，未声明时根据内容判断是镜像索引还是镜像清单
+func (r *Registry) manifestMediaType(digest string) string {
+	content, err := os.ReadFile(blobPath(r.dir, digest))
+	if err != nil {
+		return mediaTypeOCIManifest
+	}
+	manifest := struct {
+		MediaType string            `json:"mediaType"`
+		Manifests []json.RawMessage `json:"manifests"`
+	}{}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return mediaTypeOCIManifest
+	}
+	if manifest.MediaType != "" {
+		return manifest.MediaType
+	}
+	if manifest.Manifests != nil {
+		return mediaTypeOCIIndex
+	}
+	return mediaTypeOCIManifest
+}
+
+func (r *Registry) serveBlob(w http.ResponseWriter, req *http.Request, digest, mediaType, code string) {
+	if !IsDigest(digest) {
+		writeError(w, http.StatusBadRequest, "DIGEST_INVALID", fmt.Sprintf("invalid digest %q", digest))
+		return
+	}
+	f, err := os.Open(blobPath(r.dir, digest))
+	if err != nil {
+		writeError(w, http.StatusNotFound, code, fmt.Sprintf("%s is not known to the registry", digest))
+		return
+	}
+	defer f.Close()
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Docker-Content-Digest", digest)
+	w.Header().Set("Etag", `"`+digest+`"`)
+	http.ServeContent(w, req, "", time.Time{}, f)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	type registryError struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	body := struct {
+		Errors []registryError `json:"errors"`
+	}{Errors: []registryError{{Code: code, Message: message}}}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logrus.Debugf("failed to write the registry error: %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ociregistry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestBlob 将内容写入镜像布局目录的 blobs 中，返回其摘要
+func writeTestBlob(t *testing.T, dir string, content []byte) string {
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+	if err := os.MkdirAll(filepath.Join(dir, blobsDir, "sha256"), 0755); err != nil {
+		t.Fatalf("failed to create blobs directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, blobsDir, "sha256", digest), content, 0644); err != nil {
+		t.Fatalf("failed to write blob: %v", err)
+	}
+	return "sha256:" + digest
+}
+
+// newTestLayout 生成包含 registry.k8s.io/pause:3.9 与 nginx:1.25 的镜像布局目录
+func newTestLayout(t *testing.T) (string, string, string) {
+	dir := t.TempDir()
+	layer := writeTestBlob(t, dir, []byte("layer"))
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     mediaTypeOCIManifest,
+		"layers":        []descriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: layer, Size: 5}},
+	})
+	manifestDigest := writeTestBlob(t, dir, manifest)
+
+	index := imageIndex{SchemaVersion: 2, Manifests: []descriptor{
+		{MediaType: mediaTypeOCIManifest, Digest: manifestDigest, Size: int64(len(manifest)),
+			Annotations: map[string]string{refNameAnnotation: "registry.k8s.io/pause:3.9"}},
+		{MediaType: mediaTypeOCIManifest, Digest: manifestDigest, Size: int64(len(manifest)),
+			Annotations: map[string]string{containerdNameAnnotation: "docker.io/library/nginx:1.25", refNameAnnotation: "1.25"}},
+		{MediaType: mediaTypeOCIManifest, Digest: manifestDigest, Size: int64(len(manifest)),
+			Annotations: map[string]string{refNameAnnotation: "latest"}},
+	}}
+	content, _ := json.Marshal(index)
+	if err := os.WriteFile(filepath.Join(dir, indexFile), content, 0644); err != nil {
+		t.Fatalf("failed to write index: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, layoutFile), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644); err != nil {
+		t.Fatalf("failed to write layout: %v", err)
+	}
+	return dir, manifestDigest, layer
+}
+
+func TestRegistry(t *testing.T) {
+	dir, manifestDigest, layer := newTestLayout(t)
+
+	t.Run("ParseReference Success", func(t *testing.T) {
+		cases := map[string]string{
+			"nginx":                                 "docker.io/library/nginx:latest",
+			"calico/node:v3.26.1":                   "docker.io/calico/node:v3.26.1",
+			"registry.k8s.io/pause:3.9":             "registry.k8s.io/pause:3.9",
+			"localhost:5000/nkd/housekeeper@sha256": "localhost:5000/nkd/housekeeper:latest",
+		}
+		for name, expected := range cases {
+			ref, err := ParseReference(name)
+			if err != nil || ref.String() != expected {
+				t.Errorf("ParseReference(%q) = %s, %v, expected %s", name, ref, err, expected)
+			}
+		}
+	})
+
+	t.Run("ParseReference Fail", func(t *testing.T) {
+		for _, name := range []string{"", "docker.io/Nginx", "nginx:bad:tag", "quay.io/"} {
+			if _, err := ParseReference(name); err == nil {
+				t.Errorf("ParseReference(%q) should fail", name)
+			}
+		}
+	})
+
+	t.Run("Open Fail", func(t *testing.T) {
+		if _, err := Open(t.TempDir()); err == nil {
+			t.Error("expected failure for a directory without oci-layout")
+		}
+	})
+
+	registry, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	t.Run("Open Success", func(t *testing.T) {
+		if images := registry.Images(); len(images) != 2 || images[0].String() != "docker.io/library/nginx:1.25" {
+			t.Errorf("unexpected images: %v", images)
+		}
+		if registries := registry.Registries(); len(registries) != 2 || registries[0] != "docker.io" || registries[1] != "registry.k8s.io" {
+			t.Errorf("unexpected registries: %v", registries)
+		}
+	})
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		registry.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	t.Run("ServeHTTP Success", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/v2/"); rec.Code != http.StatusOK {
+			t.Errorf("base endpoint: got %d", rec.Code)
+		}
+		rec := serve(http.MethodGet, "/v2/pause/manifests/3.9")
+		if rec.Code != http.StatusOK || rec.Header().Get("Docker-Content-Digest") != manifestDigest ||
+			rec.Header().Get("Content-Type") != mediaTypeOCIManifest {
+			t.Errorf("manifest by tag: got %d %v", rec.Code, rec.Header())
+		}
+		if rec := serve(http.MethodHead, "/v2/library/nginx/manifests/"+manifestDigest); rec.Code != http.StatusOK {
+			t.Errorf("manifest by digest: got %d", rec.Code)
+		}
+		if rec := serve(http.MethodGet, "/v2/pause/blobs/"+layer); rec.Code != http.StatusOK || rec.Body.String() != "layer" {
+			t.Errorf("blob: got %d %q", rec.Code, rec.Body.String())
+		}
+		if rec := serve(http.MethodGet, "/v2/library/nginx/tags/list"); rec.Code != http.StatusOK || rec.Body.String() != "{\"name\":\"library/nginx\",\"tags\":[\"1.25\"]}\n" {
+			t.Errorf("tags: got %d %q", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("ServeHTTP Fail", func(t *testing.T) {
+		cases := map[string]int{
+			"/v2/pause/manifests/latest":                        http.StatusNotFound,
+			"/v2/coredns/manifests/v1.10.1":                     http.StatusNotFound,
+			"/v2/pause/blobs/sha256:../../oci-layout":           http.StatusBadRequest,
+			"/v2/pause/blobs/sha256:" + strings.Repeat("0", 64): http.StatusNotFound,
+		}
+		for path, status := range cases {
+			if rec := serve(http.MethodGet, path); rec.Code != status {
+				t.Errorf("%s: got %d, expected %d", path, rec.Code, status)
+			}
+		}
+		if rec := serve(http.MethodPut, "/v2/pause/manifests/3.9"); rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("push should be rejected, got %d", rec.Code)
+		}
+	})
+}
+
+func TestPull(t *testing.T) {
+	dir, manifestDigest, layer := newTestLayout(t)
+	registry, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	// 镜像仓库要求先获取令牌
+	var ts *httptest.Server
+	ts = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("scope") != "repository:pause:pull" {
+				http.Error(w, "unexpected scope", http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"test-token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+ts.URL+`/token",service="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		registry.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "https://")
+
+	puller := NewPuller()
+	puller.Client = ts.Client()
+	target := t.TempDir()
+
+	t.Run("Pull Success", func(t *testing.T) {
+		image, err := puller.Pull(context.Background(), host+"/pause:3.9", target, "amd64")
+		if err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+		if image.Digest != manifestDigest {
+			t.Errorf("unexpected digest %s", image.Digest)
+		}
+		if _, err := os.Stat(filepath.Join(target, blobsDir, "sha256", strings.TrimPrefix(layer, "sha256:"))); err != nil {
+			t.Errorf("layer is not downloaded: %v", err)
+		}
+		pulled, err := Open(target)
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		if images := pulled.Images(); len(images) != 1 || images[0].String() != host+"/pause:3.9" {
+			t.Errorf("unexpected images: %v", images)
+		}
+	})
+
+	t.Run("Pull Fail", func(t *testing.T) {
+		if _, err := puller.Pull(context.Background(), host+"/pause:latest", target, "amd64"); err == nil {
+			t.Error("expected failure for an unknown tag")
+		}
+	})
+}
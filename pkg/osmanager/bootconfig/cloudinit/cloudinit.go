@@ -17,10 +17,17 @@ limitations under the License.
 package cloudinit
 
 import (
+	"encoding/base64"
+	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -82,25 +89,131 @@ func (c *Cloudinit) generateNodeConfig(nodeType, service string, yamlPath string
 	if err := tmpl.GenerateBootConfig(c.BootstrapBaseurl, nodeType); err != nil {
 		return err
 	}
+	if err := mergeFragments(tmpl.config, c.ClusterAsset.Fragments.ForRole(nodeType)); err != nil {
+		return err
+	}
+	// 角色配置中包含 token 和 certificateKey，按敏感文件保存，由 terraform 通过 secret 读取后作为 user-data 使用
 	savePath := bootconfig.GetSavePath(c.ClusterAsset.ClusterID)
-	if err := bootconfig.SaveYAML(tmpl.config, savePath, filename, "#cloud-config\n"); err != nil {
+	if err := bootconfig.SaveSensitiveYAML(tmpl.config, savePath, filename, "#cloud-config\n"); err != nil {
 		return err
 	}
 
+	var nodes []asset.NodeAsset
 	switch nodeType {
 	case constants.Controlplane:
 		c.ClusterAsset.BootConfig.Controlplane = asset.BootFile{
 			Path: filepath.Join(savePath, filename),
 		}
+		nodes = c.ClusterAsset.Master[:1]
 	case constants.Master:
 		c.ClusterAsset.BootConfig.Master = asset.BootFile{
 			Path: filepath.Join(savePath, filename),
 		}
+		nodes = c.ClusterAsset.Master[1:]
 	case constants.Worker:
 		c.ClusterAsset.BootConfig.Worker = asset.BootFile{
 			Path: filepath.Join(savePath, filename),
 		}
+		nodes = c.ClusterAsset.Worker
+	}
+
+	for i := range nodes {
+		// 第一个 master 节点通过 certs.json 获取证书
+		var certs []bootconfig.File
+		if nodeType != constants.Controlplane {
+			certs = bootconfig.CertFiles(&nodes[i])
+		}
+		if err := c.generateNodeUserData(tmpl.config, &nodes[i], certs); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// GenerateNodeBootConfig 基于已保存的 worker.cfg 为节点生成专属 user-data，用于扩容新增的节点
+func (c *Cloudinit) GenerateNodeBootConfig(node *asset.NodeAsset) error {
+	roleFilename := cloudinitWorker
+	content, err := secret.ReadFile(filepath.Join(bootconfig.GetSavePath(c.ClusterAsset.ClusterID), roleFilename), configmanager.GetSecretSealer())
+	if err != nil {
+		logrus.Errorf("failed to read cloudinit config %s: %v", roleFilename, err)
+		return err
+	}
+	roleConfig := &CloudinitConfig{}
+	if err := yaml.Unmarshal(content, roleConfig); err != nil {
+		logrus.Errorf("failed to parse cloudinit config %s: %v", roleFilename, err)
+		return err
+	}
+	return c.generateNodeUserData(roleConfig, node, bootconfig.CertFiles(node))
+}
+
+/*
+generateNodeUserData 为设置了网络配置或需要写入证书（certs）的节点生成专属配置：
+  - libvirt：网络配置生成为 cloud-init network-config（nodes/<主机名>-network-config.yaml），由 cloud-init 盘提供给节点
+  - openstack：实例的网络配置由 OpenStack 元数据服务提供，因此网络配置写入节点专属的 user-data，
+    即 NetworkManager 连接配置，并在加入集群前激活
+  - 外部CA模式下预先签发的证书写入节点专属的 user-data（nodes/<主机名>.cfg）
+*/
+func (c *Cloudinit) generateNodeUserData(roleConfig *CloudinitConfig, node *asset.NodeAsset, certs []bootconfig.File) error {
+	network := node.EffectiveNetwork()
+	savePath := bootconfig.GetSavePath(c.ClusterAsset.ClusterID)
+
+	if network != nil && strings.ToLower(c.ClusterAsset.Platform) != "openstack" {
+		content, err := bootconfig.NetworkConfigV2(network)
+		if err != nil {
+			logrus.Errorf("failed to generate network config of node %s: %v", node.Hostname, err)
+			return err
+		}
+		filename := bootconfig.NodeConfigName(node.Hostname, constants.NetworkConfigSuffix)
+		if err := bootconfig.SaveFile(content, savePath, filename); err != nil {
+			return err
+		}
+		node.NetworkConfig = asset.BootFile{
+			Content: content,
+			Path:    filepath.Join(savePath, filename),
+		}
+		// 网络配置已由 network-config 提供，user-data 中只需写入证书
+		network = nil
+	}
+	if network == nil && len(certs) == 0 {
+		return nil
+	}
+
+	nodeConfig := *roleConfig
+	nodeConfig.WriteFiles = append([]WriteFile{}, roleConfig.WriteFiles...)
+	for _, f := range certs {
+		nodeConfig.WriteFiles = append(nodeConfig.WriteFiles, WriteFile{
+			EnCoding:    "b64",
+			Content:     base64.StdEncoding.EncodeToString(f.Contents.Source),
+			Path:        f.Path,
+			Permissions: f.Mode,
+		})
+	}
+	if network != nil {
+		runCmds := []interface{}{"nmcli connection reload"}
+		for _, f := range bootconfig.NetworkKeyfiles(network) {
+			nodeConfig.WriteFiles = append(nodeConfig.WriteFiles, WriteFile{
+				EnCoding:    "b64",
+				Content:     base64.StdEncoding.EncodeToString(f.Contents.Source),
+				Path:        f.Path,
+				Permissions: f.Mode,
+			})
+		}
+		for _, iface := range network.Interfaces {
+			if network.MemberOf(iface.Name) == nil {
+				runCmds = append(runCmds, "nmcli connection up "+iface.Name)
+			}
+		}
+		nodeConfig.RunCmds = append(runCmds, roleConfig.RunCmds...)
+	}
+
+	// 节点专属的 user-data 同样包含加入集群使用的 token，按敏感文件保存
+	filename := bootconfig.NodeConfigName(node.Hostname, constants.CloudinitSuffix)
+	if err := bootconfig.SaveSensitiveYAML(nodeConfig, savePath, filename, "#cloud-config\n"); err != nil {
+		return err
+	}
+	node.BootConfig = asset.BootFile{
+		Path: filepath.Join(savePath, filename),
+	}
+	return nil
+}
@@ -16,11 +16,14 @@ limitations under the License.
 package cloudinit
 
 import (
+	"encoding/base64"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
 	"nestos-kubernetes-deployer/pkg/constants"
+	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -76,6 +79,80 @@ func TestCloudinit(t *testing.T) {
 	})
 	clusterAsset.SSHKey = "./assets.go"
 
+	t.Run("GenerateBootConfig Network Success", func(t *testing.T) {
+		clusterAsset.Runtime = constants.Crio
+		clusterAsset.Worker[0].Network = &asset.NodeNetwork{
+			Interfaces: []asset.NetworkInterface{{Name: "eth0", Type: asset.InterfaceEthernet, Addresses: []string{"192.168.1.12/24"}}},
+		}
+		defer os.RemoveAll(clusterAsset.ClusterID)
+
+		clusterAsset.Platform = "libvirt"
+		if err := ci.GenerateBootConfig(); err != nil {
+			t.Fatalf("GenerateBootConfig failed: %v", err)
+		}
+		worker := &clusterAsset.Worker[0]
+		content, err := os.ReadFile(worker.NetworkConfig.Path)
+		if err != nil || !strings.Contains(string(content), "- 192.168.1.12/24") {
+			t.Errorf("unexpected network config of the worker %s: %s, %v", worker.NetworkConfig.Path, content, err)
+		}
+		if worker.BootConfig.Path != "" || clusterAsset.Master[0].NetworkConfig.Path != "" {
+			t.Errorf("only the network config of the worker should be generated")
+		}
+
+		worker.NetworkConfig = asset.BootFile{}
+		clusterAsset.Platform = "openstack"
+		if err := ci.GenerateBootConfig(); err != nil {
+			t.Fatalf("GenerateBootConfig failed: %v", err)
+		}
+		content, err = os.ReadFile(worker.BootConfig.Path)
+		if err != nil || !strings.HasPrefix(string(content), "#cloud-config\n") ||
+			!strings.Contains(string(content), "path: /etc/NetworkManager/system-connections/eth0.nmconnection") ||
+			!strings.Contains(string(content), "- nmcli connection up eth0") {
+			t.Errorf("unexpected user-data of the worker %s: %s, %v", worker.BootConfig.Path, content, err)
+		}
+		if info, err := os.Stat(worker.BootConfig.Path); err != nil || info.Mode().Perm() != 0600 {
+			t.Errorf("user-data of the worker should be saved as a sensitive file: %v", err)
+		}
+		worker.Network = nil
+		worker.BootConfig = asset.BootFile{}
+	})
+
+	t.Run("GenerateBootConfig ExternalCA Success", func(t *testing.T) {
+		clusterAsset.Runtime = constants.Crio
+		clusterAsset.Platform = "libvirt"
+		clusterAsset.CertAsset.ExternalCA = &asset.ExternalCA{Signer: asset.ExternalCASignerCommand, Command: "sign-csr"}
+		clusterAsset.Master[0].Certs = []utils.StorageContent{{Path: utils.AdminConfig, Mode: 0600, Content: []byte("controlplane-admin")}}
+		clusterAsset.Worker[0].Certs = []utils.StorageContent{{Path: utils.KubeletConfig, Mode: 0600, Content: []byte("worker-kubelet")}}
+		defer func() {
+			clusterAsset.CertAsset.ExternalCA = nil
+			clusterAsset.Master[0].Certs, clusterAsset.Worker[0].Certs = nil, nil
+			clusterAsset.Worker[0].BootConfig = asset.BootFile{}
+			os.RemoveAll(clusterAsset.ClusterID)
+		}()
+		if err := ci.GenerateBootConfig(); err != nil {
+			t.Fatalf("GenerateBootConfig failed: %v", err)
+		}
+
+		if clusterAsset.Master[0].BootConfig.Path != "" || clusterAsset.Master[1].BootConfig.Path != "" {
+			t.Errorf("only the nodes with presigned certs should get a dedicated user-data")
+		}
+		worker := &clusterAsset.Worker[0]
+		content, err := os.ReadFile(worker.BootConfig.Path)
+		if err != nil || !strings.Contains(string(content), "path: /etc/kubernetes/kubelet.conf") ||
+			!strings.Contains(string(content), "--ignore-preflight-errors=FileAvailable--etc-kubernetes-kubelet.conf") {
+			t.Errorf("unexpected user-data of the worker %s: %s, %v", worker.BootConfig.Path, content, err)
+		}
+
+		node := &asset.NodeAsset{Hostname: "worker02", Certs: []utils.StorageContent{{Path: utils.KubeletConfig, Mode: 0600, Content: []byte("worker02-kubelet")}}}
+		if err := ci.GenerateNodeBootConfig(node); err != nil {
+			t.Fatalf("GenerateNodeBootConfig failed: %v", err)
+		}
+		content, err = os.ReadFile(node.BootConfig.Path)
+		if err != nil || !strings.Contains(string(content), base64.StdEncoding.EncodeToString([]byte("worker02-kubelet"))) {
+			t.Errorf("unexpected user-data of the extended node %s: %s, %v", node.BootConfig.Path, content, err)
+		}
+	})
+
 	t.Run("GenerateBootConfig", func(t *testing.T) {
 		clusterAsset.Runtime = constants.Crio
 		configmanager.GlobalConfig.PersistDir = "./"
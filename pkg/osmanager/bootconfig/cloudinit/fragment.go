@@ -0,0 +1,200 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	cloudConfigHeader = "#cloud-config"
+	// generatedSource 表示由 nkd 生成的配置项
+	generatedSource = "the generated config"
+)
+
+// cloudinitFragment 用户提供的 cloud-init user-data 片段
+type cloudinitFragment struct {
+	SSHPasswordAuth   *bool           `yaml:"ssh_pwauth"`
+	SSHAuthorizedKeys []string        `yaml:"ssh_authorized_keys"`
+	Chpasswd          *ChpasswdConfig `yaml:"chpasswd"`
+	WriteFiles        []fragmentFile  `yaml:"write_files"`
+	RunCmds           []interface{}   `yaml:"runcmd"`
+
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+type fragmentFile struct {
+	EnCoding    string      `yaml:"encoding"`
+	Content     string      `yaml:"content"`
+	Path        string      `yaml:"path"`
+	Permissions interface{} `yaml:"permissions"` // 八进制字符串（如 '0644'）或整数
+	Owner       string      `yaml:"owner"`
+	Append      bool        `yaml:"append"`
+	Defer       bool        `yaml:"defer"`
+}
+
+// loadFragment 读取以 #cloud-config 开头的 cloud-init user-data 片段
+func loadFragment(path string) (*cloudinitFragment, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read boot config fragment")
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(content), []byte(cloudConfigHeader)) {
+		return nil, fmt.Errorf("cloud-init fragment %s must start with %s", path, cloudConfigHeader)
+	}
+
+	fragment := &cloudinitFragment{}
+	if err := yaml.UnmarshalStrict(content, fragment); err != nil {
+		return nil, fmt.Errorf("failed to parse cloud-init fragment %s: %v", path, err)
+	}
+	for _, f := range fragment.WriteFiles {
+		if f.Path == "" {
+			return nil, fmt.Errorf("cloud-init fragment %s: path of write_files is required", path)
+		}
+	}
+	return fragment, nil
+}
+
+// toWriteFile 将片段中的文件转换为 write_files 配置项
+func (f *fragmentFile) toWriteFile() (WriteFile, error) {
+	wf := WriteFile{
+		EnCoding: f.EnCoding,
+		Content:  f.Content,
+		Path:     f.Path,
+		Owner:    f.Owner,
+		Append:   f.Append,
+		Defer:    f.Defer,
+	}
+	switch perm := f.Permissions.(type) {
+	case nil:
+	case int:
+		wf.Permissions = os.FileMode(perm)
+	case string:
+		mode, err := strconv.ParseUint(perm, 8, 32)
+		if err != nil {
+			return wf, fmt.Errorf("invalid permissions %q of %s", perm, f.Path)
+		}
+		wf.Permissions = os.FileMode(mode)
+	default:
+		return wf, fmt.Errorf("invalid permissions %v of %s", perm, f.Path)
+	}
+	return wf, nil
+}
+
+/*
+mergeFragments 按顺序将片段合并到生成的配置中：
+  - write_files 中路径相同的文件覆盖之前的文件，append 为 true 的文件追加到文件末尾
+  - runcmd 在生成的命令之前按片段顺序执行，即在节点加入集群之前执行
+  - ssh_authorized_keys 追加到之前的公钥中
+  - ssh_pwauth、chpasswd 及其他配置项覆盖之前的设置，其中列表类型的配置项追加到之前的列表中
+
+每个被覆盖的配置项都会输出警告
+*/
+func mergeFragments(config *CloudinitConfig, paths []string) error {
+	sources := make(map[string]string)
+	for _, f := range config.WriteFiles {
+		sources["path "+f.Path] = generatedSource
+	}
+	sources["ssh_pwauth"] = generatedSource
+	sources["chpasswd"] = generatedSource
+
+	override := func(path, key string) {
+		if source, ok := sources[key]; ok {
+			logrus.Warnf("boot config fragment %s overrides %s of %s", path, key, source)
+		}
+		sources[key] = path
+	}
+
+	var runCmds []interface{}
+	for _, path := range paths {
+		fragment, err := loadFragment(path)
+		if err != nil {
+			logrus.Errorf("failed to load boot config fragment: %v", err)
+			return err
+		}
+
+		if fragment.SSHPasswordAuth != nil {
+			override(path, "ssh_pwauth")
+			config.SSHPasswordAuth = *fragment.SSHPasswordAuth
+		}
+		if fragment.Chpasswd != nil {
+			override(path, "chpasswd")
+			config.Chpasswd = *fragment.Chpasswd
+		}
+		for _, key := range fragment.SSHAuthorizedKeys {
+			if !contains(config.SSHAuthorizedKeys, key) {
+				config.SSHAuthorizedKeys = append(config.SSHAuthorizedKeys, key)
+			}
+		}
+
+		for _, f := range fragment.WriteFiles {
+			wf, err := f.toWriteFile()
+			if err != nil {
+				logrus.Errorf("failed to load boot config fragment %s: %v", path, err)
+				return err
+			}
+			if !wf.Append {
+				override(path, "path "+wf.Path)
+				config.WriteFiles = removeWriteFile(config.WriteFiles, wf.Path)
+			}
+			config.WriteFiles = append(config.WriteFiles, wf)
+		}
+		runCmds = append(runCmds, fragment.RunCmds...)
+
+		for key, value := range fragment.Extra {
+			if config.Extra == nil {
+				config.Extra = make(map[string]interface{})
+			}
+			list, isList := value.([]interface{})
+			previous, ok := config.Extra[key].([]interface{})
+			if isList && ok {
+				config.Extra[key] = append(previous, list...)
+				continue
+			}
+			override(path, key)
+			config.Extra[key] = value
+		}
+	}
+	config.RunCmds = append(runCmds, config.RunCmds...)
+	return nil
+}
+
+func removeWriteFile(files []WriteFile, path string) []WriteFile {
+	var kept []WriteFile
+	for _, f := range files {
+		if f.Path != path {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
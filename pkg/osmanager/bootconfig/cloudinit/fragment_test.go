@@ -0,0 +1,110 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func writeFragment(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMergeFragments(t *testing.T) {
+	dir := t.TempDir()
+	first := writeFragment(t, dir, "all.cfg", `#cloud-config
+ssh_pwauth: false
+ssh_authorized_keys:
+- ssh-ed25519 AAAA user
+write_files:
+- path: /etc/hosts
+  content: 127.0.0.1 localhost
+  permissions: '0600'
+- path: /etc/motd
+  content: welcome
+  append: true
+runcmd:
+- echo first
+packages:
+- vim
+timezone: UTC
+`)
+	second := writeFragment(t, dir, "worker.cfg", `#cloud-config
+packages:
+- git
+timezone: Asia/Shanghai
+runcmd:
+- [sh, -c, echo second]
+`)
+
+	t.Run("MergeFragments Success", func(t *testing.T) {
+		config := &CloudinitConfig{
+			SSHPasswordAuth:   true,
+			SSHAuthorizedKeys: []string{"ssh-rsa AAAA nkd"},
+			WriteFiles:        []WriteFile{{Path: "/etc/hosts", Content: "generated", Permissions: 0644}},
+			RunCmds:           []interface{}{"systemctl start join-worker.service"},
+		}
+		if err := mergeFragments(config, []string{first, second}); err != nil {
+			t.Fatalf("mergeFragments failed: %v", err)
+		}
+
+		if config.SSHPasswordAuth || len(config.SSHAuthorizedKeys) != 2 {
+			t.Errorf("unexpected ssh settings: %v, %v", config.SSHPasswordAuth, config.SSHAuthorizedKeys)
+		}
+		if len(config.WriteFiles) != 2 || config.WriteFiles[0].Content != "127.0.0.1 localhost" ||
+			config.WriteFiles[0].Permissions != 0600 || !config.WriteFiles[1].Append {
+			t.Errorf("unexpected write_files: %v", config.WriteFiles)
+		}
+		if len(config.RunCmds) != 3 || config.RunCmds[0] != "echo first" || config.RunCmds[2] != "systemctl start join-worker.service" {
+			t.Errorf("fragment commands should run before the generated ones: %v", config.RunCmds)
+		}
+		if len(config.Extra["packages"].([]interface{})) != 2 || config.Extra["timezone"] != "Asia/Shanghai" {
+			t.Errorf("unexpected extra settings: %v", config.Extra)
+		}
+
+		content, err := yaml.Marshal(config)
+		if err != nil || !strings.Contains(string(content), "timezone: Asia/Shanghai") {
+			t.Errorf("extra settings are not inlined: %s, %v", content, err)
+		}
+	})
+
+	t.Run("MergeFragments Fail", func(t *testing.T) {
+		for _, content := range []string{
+			"packages:\n- vim\n",
+			"#cloud-config\nwrite_files:\n- content: x\n",
+			"#cloud-config\nwrite_files:\n- path: /etc/x\n  permissions: '0999'\n",
+			"#cloud-config\nssh_pwauth: [true]\n",
+		} {
+			path := writeFragment(t, dir, "bad.cfg", content)
+			if err := mergeFragments(&CloudinitConfig{}, []string{path}); err == nil {
+				t.Errorf("expected error for fragment %q", content)
+			}
+		}
+		if err := mergeFragments(&CloudinitConfig{}, []string{filepath.Join(dir, "missing.cfg")}); err == nil {
+			t.Errorf("expected error for a missing fragment")
+		}
+	})
+}
@@ -23,7 +23,6 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/runtime"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
-	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -61,7 +60,7 @@ func (t *template) GenerateBootConfig(url string, nodeType string) error {
 	}
 	if nodeType == constants.Controlplane {
 		tmplData.IsControlPlane = true
-		tmplData.CertsUrl = utils.ConstructURL(url, constants.CertsFiles)
+		tmplData.CertsUrl = bootconfig.BootstrapFileURL(t.clusterAsset, url, constants.CertsFiles)
 	}
 
 	//set container engine config
@@ -70,18 +69,21 @@ func (t *template) GenerateBootConfig(url string, nodeType string) error {
 		return err
 	}
 	tmplData.CriSocket = engine.GetRuntimeCriSocket()
-	if runtime.IsIsulad(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.IsuladConfig)
-	} else if runtime.IsDocker(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.DockerConfig)
-	} else if runtime.IsCrio(engine) {
+	if runtime.IsCrio(engine) {
 		t.enabledFiles = append(t.enabledFiles, constants.KubeletServiceConf)
 	}
 
+	t.enabledFiles = append(t.enabledFiles, bootconfig.RpmRepoFiles(tmplData)...)
+
 	if err := bootconfig.AppendStorageFiles(&bootConfigFiles, "/", constants.BootConfigFilesPath, tmplData, t.enabledFiles); err != nil {
 		logrus.Errorf("failed to add files to an cloudinit config: %v", err)
 		return err
 	}
+	runtimeFiles, err := bootconfig.RuntimeFiles(t.clusterAsset, tmplData, nodeType)
+	if err != nil {
+		return err
+	}
+	bootConfigFiles = append(bootConfigFiles, runtimeFiles...)
 
 	if err := bootconfig.AppendSystemdUnits(&bootConfigSystemd, constants.BootConfigSystemdPath, tmplData, t.enabledServices); err != nil {
 		logrus.Errorf("failed to add systemd units to an cloudinit config: %v", err)
@@ -23,6 +23,8 @@ type CloudinitConfig struct {
 	Chpasswd          ChpasswdConfig `yaml:"chpasswd,omitempty"`
 	WriteFiles        []WriteFile    `yaml:"write_files,omitempty"`
 	RunCmds           []interface{}  `yaml:"runcmd,omitempty"`
+
+	Extra map[string]interface{} `yaml:",inline"` // 由引导配置片段合并的其他配置项
 }
 
 type ChpasswdConfig struct {
@@ -35,4 +37,7 @@ type WriteFile struct {
 	Content     string      `yaml:"content,omitempty"`
 	Path        string      `yaml:"path,omitempty"`
 	Permissions os.FileMode `yaml:"permissions,omitempty"`
+	Owner       string      `yaml:"owner,omitempty"`
+	Append      bool        `yaml:"append,omitempty"`
+	Defer       bool        `yaml:"defer,omitempty"`
 }
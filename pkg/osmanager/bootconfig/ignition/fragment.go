@@ -0,0 +1,142 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	butane "github.com/coreos/butane/config"
+	butanecommon "github.com/coreos/butane/config/common"
+	ignconfig "github.com/coreos/ignition/v2/config/v3_3"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
+	"github.com/coreos/vcontext/report"
+)
+
+// generatedSource 表示由 nkd 生成的配置项
+const generatedSource = "the generated config"
+
+// loadFragment 读取 Ignition JSON 或 Butane YAML 格式的片段，Butane 中 local 引用的文件相对于片段所在目录
+func loadFragment(path string) (*igntypes.Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read boot config fragment")
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		options := butanecommon.TranslateBytesOptions{}
+		options.FilesDir = filepath.Dir(path)
+		ign, r, err := butane.TranslateBytes(content, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate butane fragment %s: %v%s", path, err, reportDetails(r))
+		}
+		if len(r.Entries) > 0 {
+			logrus.Warnf("butane fragment %s: %s", path, r.String())
+		}
+		content = ign
+	}
+
+	config, r, err := ignconfig.ParseCompatibleVersion(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ignition fragment %s (spec 3.3.0 or earlier is supported): %v%s", path, err, reportDetails(r))
+	}
+	if config.Ignition.Config.Replace.Source != nil {
+		return nil, fmt.Errorf("ignition fragment %s can not replace the generated config", path)
+	}
+	return &config, nil
+}
+
+// reportDetails 返回校验报告中的条目，附加在错误信息之后
+func reportDetails(r report.Report) string {
+	if len(r.Entries) == 0 {
+		return ""
+	}
+	return "\n" + r.String()
+}
+
+/*
+mergeFragments 按顺序将片段合并到生成的配置中，合并规则与 Ignition 合并配置（ignition.config.merge）相同：
+后合并的片段中路径相同的文件、目录、链接，名称相同的 systemd 单元、用户组，设备相同的磁盘和文件系统等覆盖之前的配置项，
+用户的 SSH 公钥等列表追加到之前的配置项中。每个被覆盖的配置项都会输出警告
+*/
+func mergeFragments(config *igntypes.Config, paths []string) error {
+	sources := make(map[string]string)
+	for _, key := range entryKeys(config) {
+		sources[key] = generatedSource
+	}
+
+	for _, path := range paths {
+		fragment, err := loadFragment(path)
+		if err != nil {
+			logrus.Errorf("failed to load boot config fragment: %v", err)
+			return err
+		}
+		for _, key := range entryKeys(fragment) {
+			if source, ok := sources[key]; ok {
+				logrus.Warnf("boot config fragment %s overrides %s of %s", path, key, source)
+			}
+			sources[key] = path
+		}
+		*config = ignconfig.Merge(*config, *fragment)
+	}
+	return nil
+}
+
+// entryKeys 返回配置中可被覆盖的配置项，文件、目录和链接共用路径
+func entryKeys(config *igntypes.Config) []string {
+	var keys []string
+	for _, f := range config.Storage.Files {
+		keys = append(keys, "path "+f.Path)
+	}
+	for _, d := range config.Storage.Directories {
+		keys = append(keys, "path "+d.Path)
+	}
+	for _, l := range config.Storage.Links {
+		keys = append(keys, "path "+l.Path)
+	}
+	for _, d := range config.Storage.Disks {
+		keys = append(keys, "disk "+d.Device)
+	}
+	for _, fs := range config.Storage.Filesystems {
+		if fs.Device != "" {
+			keys = append(keys, "filesystem "+fs.Device)
+		}
+	}
+	for _, r := range config.Storage.Raid {
+		keys = append(keys, "raid "+r.Name)
+	}
+	for _, l := range config.Storage.Luks {
+		keys = append(keys, "luks "+l.Name)
+	}
+	for _, u := range config.Systemd.Units {
+		if u.Contents != nil {
+			keys = append(keys, "unit "+u.Name)
+		}
+		for _, d := range u.Dropins {
+			keys = append(keys, "dropin "+u.Name+"/"+d.Name)
+		}
+	}
+	for _, g := range config.Passwd.Groups {
+		keys = append(keys, "group "+g.Name)
+	}
+	return keys
+}
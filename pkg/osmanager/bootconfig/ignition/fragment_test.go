@@ -0,0 +1,100 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ignutil "github.com/coreos/ignition/v2/config/util"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
+)
+
+func writeFragment(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMergeFragments(t *testing.T) {
+	dir := t.TempDir()
+	writeFragment(t, dir, "motd", "welcome\n")
+	jsonFragment := writeFragment(t, dir, "hosts.ign", `{
+  "ignition": {"version": "3.1.0"},
+  "storage": {"files": [{"path": "/etc/hosts", "contents": {"source": "data:,127.0.0.1%20localhost"}}]},
+  "systemd": {"units": [{"name": "extra.service", "enabled": true, "contents": "[Service]\nExecStart=/bin/true\n"}]}
+}`)
+	butaneFragment := writeFragment(t, dir, "motd.bu", `variant: fcos
+version: 1.3.0
+passwd:
+  users:
+  - name: root
+    ssh_authorized_keys:
+    - ssh-ed25519 AAAA user
+storage:
+  files:
+  - path: /etc/motd
+    contents:
+      local: motd
+`)
+
+	t.Run("MergeFragments Success", func(t *testing.T) {
+		config := &igntypes.Config{Ignition: igntypes.Ignition{Version: "3.2.0"}}
+		config.Storage.Files = []igntypes.File{fileWithContents("/etc/hosts", 0644, []byte("generated"))}
+		config.Passwd.Users = []igntypes.PasswdUser{{Name: "root", SSHAuthorizedKeys: []igntypes.SSHAuthorizedKey{"ssh-rsa AAAA nkd"}}}
+		config.Systemd.Units = []igntypes.Unit{{Name: "kubelet.service", Enabled: ignutil.BoolToPtr(true)}}
+
+		if err := mergeFragments(config, []string{jsonFragment, butaneFragment}); err != nil {
+			t.Fatalf("mergeFragments failed: %v", err)
+		}
+
+		files := map[string]string{}
+		for _, f := range config.Storage.Files {
+			files[f.Path] = *f.Contents.Source
+		}
+		if files["/etc/hosts"] != "data:,127.0.0.1%20localhost" || len(files) != 2 {
+			t.Errorf("unexpected files: %v", files)
+		}
+		if len(config.Systemd.Units) != 2 || config.Systemd.Units[1].Name != "extra.service" {
+			t.Errorf("unexpected units: %v", config.Systemd.Units)
+		}
+		if len(config.Passwd.Users) != 1 || len(config.Passwd.Users[0].SSHAuthorizedKeys) != 2 {
+			t.Errorf("ssh keys of the fragment are not appended: %v", config.Passwd.Users)
+		}
+	})
+
+	t.Run("MergeFragments Fail", func(t *testing.T) {
+		for _, content := range []string{
+			`{"ignition": {"version": "3.2.0", "config": {"replace": {"source": "http://example.com/x.ign"}}}}`,
+			`{"ignition": {"version": "3.4.0"}}`,
+			"variant: fcos\nversion: 1.5.0\n",
+			"not: butane\n",
+		} {
+			path := writeFragment(t, dir, "bad.ign", content)
+			config := &igntypes.Config{Ignition: igntypes.Ignition{Version: "3.2.0"}}
+			if err := mergeFragments(config, []string{path}); err == nil {
+				t.Errorf("expected error for fragment %q", content)
+			}
+		}
+		if err := mergeFragments(&igntypes.Config{}, []string{filepath.Join(dir, "missing.ign")}); err == nil {
+			t.Errorf("expected error for a missing fragment")
+		}
+	})
+}
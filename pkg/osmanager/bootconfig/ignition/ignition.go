@@ -16,13 +16,16 @@ limitations under the License.
 package ignition
 
 import (
+	"encoding/json"
+	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"path/filepath"
 
 	ignutil "github.com/coreos/ignition/v2/config/util"
-	igntypes "github.com/coreos/ignition/v2/config/v3_2/types"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
 	"github.com/sirupsen/logrus"
 	"github.com/vincent-petithory/dataurl"
 )
@@ -80,7 +83,7 @@ func (ig *Ignition) generateNodeIgnition(nodeType, service string, yamlPath stri
 		tmpl.enabledFiles = append(tmpl.enabledFiles, yamlPath)
 	}
 
-	if err := tmpl.GenerateBootConfig(); err != nil {
+	if err := tmpl.GenerateBootConfig(nodeType); err != nil {
 		return err
 	}
 
@@ -92,42 +95,111 @@ func (ig *Ignition) generateNodeIgnition(nodeType, service string, yamlPath stri
 		}
 	}
 
-	savePath := bootconfig.GetSavePath(ig.ClusterAsset.ClusterID)
-	if err := bootconfig.SaveJSON(tmpl.config, savePath, ignFilename); err != nil {
+	if err := mergeFragments(tmpl.config, ig.ClusterAsset.Fragments.ForRole(nodeType)); err != nil {
 		return err
 	}
-	mergeIgnFile := generateMergeIgnition(ig.BootstrapBaseurl, ignFilename)
-	if err := bootconfig.SaveJSON(mergeIgnFile, savePath, mergeIgnFilename); err != nil {
+
+	savePath := bootconfig.GetSavePath(ig.ClusterAsset.ClusterID)
+	ignData, err := ig.saveConfig(tmpl.config, ignFilename, true)
+	if err != nil {
+		logrus.Errorf("failed to generate ignition config for %s node: %v", nodeType, err)
 		return err
 	}
 
-	ignData, err := bootconfig.Marshal(tmpl.config)
+	mergeIgnFile, err := generateMergeIgnition(ig.ClusterAsset, ig.BootstrapBaseurl, ignFilename, ignData)
 	if err != nil {
-		logrus.WithError(err).Errorf("failed to Marshal ignition config for %s node", nodeType)
+		return err
+	}
+	if _, err := ig.saveConfig(mergeIgnFile, mergeIgnFilename, false); err != nil {
+		logrus.Errorf("failed to generate merge ignition config for %s node: %v", nodeType, err)
 		return err
 	}
 
+	var nodes []asset.NodeAsset
 	switch nodeType {
 	case constants.Controlplane:
 		ig.ClusterAsset.BootConfig.Controlplane = asset.BootFile{
 			Content: ignData,
 			Path:    filepath.Join(savePath, mergeIgnFilename),
 		}
+		nodes = ig.ClusterAsset.Master[:1]
 	case constants.Master:
 		ig.ClusterAsset.BootConfig.Master = asset.BootFile{
 			Content: ignData,
 			Path:    filepath.Join(savePath, mergeIgnFilename),
 		}
+		nodes = ig.ClusterAsset.Master[1:]
 	case constants.Worker:
 		ig.ClusterAsset.BootConfig.Worker = asset.BootFile{
 			Content: ignData,
 			Path:    filepath.Join(savePath, mergeIgnFilename),
 		}
+		nodes = ig.ClusterAsset.Worker
+	}
+
+	for i := range nodes {
+		if err := ig.generateNodeConfig(tmpl.config, &nodes[i]); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// GenerateNodeBootConfig 基于已保存的角色配置（如 worker.ign）为节点生成专属配置，用于扩容新增的节点
+func (ig *Ignition) GenerateNodeBootConfig(roleIgnFilename string, node *asset.NodeAsset) error {
+	content, err := secret.ReadFile(filepath.Join(bootconfig.GetSavePath(ig.ClusterAsset.ClusterID), roleIgnFilename), configmanager.GetSecretSealer())
+	if err != nil {
+		logrus.Errorf("failed to read ignition config %s: %v", roleIgnFilename, err)
+		return err
+	}
+	roleConfig := &igntypes.Config{}
+	if err := json.Unmarshal(content, roleConfig); err != nil {
+		logrus.Errorf("failed to parse ignition config %s: %v", roleIgnFilename, err)
+		return err
+	}
+	return ig.generateNodeConfig(roleConfig, node)
+}
+
+/*
+generateNodeConfig 在角色配置的基础上写入节点专属文件（含节点的 NetworkManager 连接配置和外部CA模式下预先签发的证书），
+生成 nodes/<主机名>.ign 及其 merge ignition。第一个 master 节点的证书已写入 controlplane 角色配置，同路径的文件只保留一份
+*/
+func (ig *Ignition) generateNodeConfig(roleConfig *igntypes.Config, node *asset.NodeAsset) error {
+	nodeConfig := *roleConfig
+	nodeConfig.Storage.Files = append([]igntypes.File{}, roleConfig.Storage.Files...)
+	files := append(bootconfig.NodeFiles(node), bootconfig.NetworkKeyfiles(node.EffectiveNetwork())...)
+	files = append(files, bootconfig.CertFiles(node)...)
+	for _, f := range files {
+		ignFile := fileWithContents(f.Path, int(f.Mode.Perm()), f.Contents.Source)
+		nodeConfig.Storage.Files = appendFiles(nodeConfig.Storage.Files, ignFile)
+	}
+
+	savePath := bootconfig.GetSavePath(ig.ClusterAsset.ClusterID)
+	ignFilename := bootconfig.NodeConfigName(node.Hostname, constants.IgnSuffix)
+	ignData, err := ig.saveConfig(&nodeConfig, ignFilename, true)
+	if err != nil {
+		logrus.Errorf("failed to generate ignition config for node %s: %v", node.Hostname, err)
+		return err
+	}
+
+	mergeIgnFile, err := generateMergeIgnition(ig.ClusterAsset, ig.BootstrapBaseurl, ignFilename, ignData)
+	if err != nil {
+		return err
+	}
+	mergeIgnFilename := bootconfig.NodeConfigName(node.Hostname, constants.MergeIgnSuffix)
+	if _, err := ig.saveConfig(mergeIgnFile, mergeIgnFilename, false); err != nil {
+		logrus.Errorf("failed to generate merge ignition config for node %s: %v", node.Hostname, err)
+		return err
+	}
+
+	node.BootConfig = asset.BootFile{
+		Content: ignData,
+		Path:    filepath.Join(savePath, mergeIgnFilename),
+	}
+	return nil
+}
+
 func fileWithContents(path string, mode int, contents []byte) igntypes.File {
 	return igntypes.File{
 		Node: igntypes.Node{
@@ -153,3 +225,28 @@ func appendFiles(files []igntypes.File, file igntypes.File) []igntypes.File {
 	files = append(files, file)
 	return files
 }
+
+/*
+saveConfig 将配置转换为集群配置的 Ignition 规范版本并校验，保存后返回转换后的内容。
+角色配置和节点配置中包含集群 CA 私钥、admin.conf 等敏感数据，sensitive 为 true 时按敏感文件保存（设置了密钥材料时加密），
+由 HTTP 服务提供给节点的是内存中的内容；merge ignition 仅包含配置的地址和校验值，由 terraform 读取，不加密
+*/
+func (ig *Ignition) saveConfig(config *igntypes.Config, filename string, sensitive bool) ([]byte, error) {
+	version, err := specVersion(ig.ClusterAsset.OSImage.IgnitionVersion)
+	if err != nil {
+		return nil, err
+	}
+	data, err := marshalConfig(config, version)
+	if err != nil {
+		return nil, err
+	}
+	if sensitive {
+		err = bootconfig.SaveSensitiveFile(data, bootconfig.GetSavePath(ig.ClusterAsset.ClusterID), filename)
+	} else {
+		err = bootconfig.SaveFile(data, bootconfig.GetSavePath(ig.ClusterAsset.ClusterID), filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
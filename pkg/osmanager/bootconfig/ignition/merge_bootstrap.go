@@ -16,25 +16,49 @@ limitations under the License.
 package ignition
 
 import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
-	"nestos-kubernetes-deployer/pkg/utils"
+	"os"
 
 	ignutil "github.com/coreos/ignition/v2/config/util"
-	igntypes "github.com/coreos/ignition/v2/config/v3_2/types"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
+	"github.com/vincent-petithory/dataurl"
 )
 
-func generateMergeIgnition(bootstrapIgnitionHost string, role string) *igntypes.Config {
+/*
+generateMergeIgnition 生成节点启动时使用的引导配置，完整配置通过HTTPS从引导服务获取：
+URL中携带该配置文件的访问令牌，引导服务的CA证书及其摘要内嵌在引导配置中，
+并校验获取到的完整配置的 sha512 摘要。
+*/
+func generateMergeIgnition(clusterAsset *asset.ClusterAsset, bootstrapIgnitionHost string, role string, content []byte) (*igntypes.Config, error) {
 	setHostnameUnit := bootconfig.CreateSetHostnameUnit()
 
+	caCert, err := os.ReadFile(clusterAsset.CertAsset.BootstrapCACertPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bootstrap CA certificate: %v", err)
+	}
+
 	ign := igntypes.Config{
 		Ignition: igntypes.Ignition{
 			Version: igntypes.MaxVersion.String(),
 			Config: igntypes.IgnitionConfig{
 				Merge: []igntypes.Resource{{
-					Source: ignutil.StrToPtr(utils.ConstructURL(bootstrapIgnitionHost, role)),
+					Source:       ignutil.StrToPtr(bootconfig.BootstrapFileURL(clusterAsset, bootstrapIgnitionHost, role)),
+					Verification: sha512Verification(content),
 				}},
 			},
+			Security: igntypes.Security{
+				TLS: igntypes.TLS{
+					CertificateAuthorities: []igntypes.Resource{{
+						Source:       ignutil.StrToPtr(dataurl.EncodeBytes(caCert)),
+						Verification: sha512Verification(caCert),
+					}},
+				},
+			},
 		},
 		Systemd: igntypes.Systemd{
 			Units: []igntypes.Unit{
@@ -46,5 +70,12 @@ func generateMergeIgnition(bootstrapIgnitionHost string, role string) *igntypes.
 			},
 		},
 	}
-	return &ign
+	return &ign, nil
+}
+
+func sha512Verification(content []byte) igntypes.Verification {
+	sum := sha512.Sum512(content)
+	return igntypes.Verification{
+		Hash: ignutil.StrToPtr("sha512-" + hex.EncodeToString(sum[:])),
+	}
 }
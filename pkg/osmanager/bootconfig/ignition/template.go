@@ -26,7 +26,7 @@ import (
 	"github.com/vincent-petithory/dataurl"
 
 	ignutil "github.com/coreos/ignition/v2/config/util"
-	igntypes "github.com/coreos/ignition/v2/config/v3_2/types"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
 )
 
 type template struct {
@@ -45,7 +45,7 @@ func newTemplate(clusterAsset *asset.ClusterAsset, config *igntypes.Config, enab
 	}
 }
 
-func (t *template) GenerateBootConfig() error {
+func (t *template) GenerateBootConfig(nodeType string) error {
 	var (
 		files         []bootconfig.File
 		systemdConfig bootconfig.Systemd
@@ -67,16 +67,16 @@ func (t *template) GenerateBootConfig() error {
 		return err
 	}
 	tmplData.CriSocket = engine.GetRuntimeCriSocket()
-	if runtime.IsIsulad(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.IsuladConfig)
-	} else if runtime.IsDocker(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.DockerConfig)
-	}
 
 	if err := bootconfig.AppendStorageFiles(&files, "/", constants.BootConfigFilesPath, tmplData, t.enabledFiles); err != nil {
 		logrus.Errorf("failed to add files to an Ignition config: %v", err)
 		return err
 	}
+	runtimeFiles, err := bootconfig.RuntimeFiles(t.clusterAsset, tmplData, nodeType)
+	if err != nil {
+		return err
+	}
+	files = append(files, runtimeFiles...)
 	if err := bootconfig.AppendSystemdUnits(&systemdConfig, constants.BootConfigSystemdPath, tmplData, t.enabledServices); err != nil {
 		logrus.Errorf("failed to add systemd units to an Ignition config: %v", err)
 		return err
@@ -102,7 +102,7 @@ func (t *template) GenerateBootConfig() error {
 				{
 					Node: igntypes.Node{Path: "/etc/local/time"},
 					LinkEmbedded1: igntypes.LinkEmbedded1{
-						Target: "/usr/share/zoneinfo/Asia/Shanghai",
+						Target: ignutil.StrToPtr("/usr/share/zoneinfo/Asia/Shanghai"),
 					},
 				},
 			},
@@ -129,19 +129,20 @@ func (t *template) GenerateBootConfig() error {
 
 	if len(t.clusterAsset.HookConf.ShellFiles) > 0 {
 		for _, sf := range t.clusterAsset.HookConf.ShellFiles {
+			mode := sf.Mode
 			ignHookFile := igntypes.File{
 				Node: igntypes.Node{
-					Path:      constants.HookFilesPath,
+					Path:      constants.HookFilesPath + sf.Name,
 					Overwrite: ignutil.BoolToPtr(true),
 				},
 				FileEmbedded1: igntypes.FileEmbedded1{
-					Mode: &sf.Mode,
+					Mode: &mode,
 					Contents: igntypes.Resource{
 						Source: ignutil.StrToPtr(dataurl.EncodeBytes(sf.Content)),
 					},
 				},
 			}
-			t.config.Storage.Files = append(t.config.Storage.Files, ignHookFile)
+			t.config.Storage.Files = appendFiles(t.config.Storage.Files, ignHookFile)
 		}
 	}
 
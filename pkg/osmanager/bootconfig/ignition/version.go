@@ -0,0 +1,108 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	ign32 "github.com/coreos/ignition/v2/config/v3_2"
+	ign32types "github.com/coreos/ignition/v2/config/v3_2/types"
+	ignconfig "github.com/coreos/ignition/v2/config/v3_3"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
+	"github.com/coreos/vcontext/report"
+)
+
+// supportedVersions 生成的配置支持的 Ignition 规范版本，最后一个为 NestOS 支持的最新版本，也是默认版本
+var supportedVersions = []string{
+	ign32types.MaxVersion.String(),
+	igntypes.MaxVersion.String(),
+}
+
+// specVersion 返回集群配置的 Ignition 规范版本，未设置时使用默认版本
+func specVersion(version string) (string, error) {
+	if version == "" {
+		return supportedVersions[len(supportedVersions)-1], nil
+	}
+	for _, v := range supportedVersions {
+		if v == version {
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported ignition version %q, supported versions: %s", version, strings.Join(supportedVersions, ", "))
+}
+
+/*
+marshalConfig 将配置转换为指定规范版本的 JSON，并使用 Ignition 的校验器校验：
+校验失败时返回错误，校验警告仅输出日志。转换为较低版本时，配置中使用了该版本不支持的配置项（如 3.3.0 的 kernelArguments）同样返回错误
+*/
+func marshalConfig(config *igntypes.Config, version string) ([]byte, error) {
+	cfg := *config
+	cfg.Ignition.Version = version
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case igntypes.MaxVersion.String():
+		if _, r, err := ignconfig.Parse(raw); err != nil {
+			return nil, fmt.Errorf("invalid ignition config: %v%s", err, reportDetails(r))
+		} else if len(r.Entries) > 0 {
+			logrus.Warnf("ignition config: %s", r.String())
+		}
+		return raw, nil
+	case ign32types.MaxVersion.String():
+		// 3.3.0 中的 kernelArguments 即使为空也会被序列化
+		if len(cfg.KernelArguments.ShouldExist) == 0 && len(cfg.KernelArguments.ShouldNotExist) == 0 {
+			fields := make(map[string]json.RawMessage)
+			if err := json.Unmarshal(raw, &fields); err != nil {
+				return nil, err
+			}
+			delete(fields, "kernelArguments")
+			if raw, err = json.Marshal(fields); err != nil {
+				return nil, err
+			}
+		}
+		downgraded, r, err := ign32.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignition config: %v%s", err, reportDetails(r))
+		}
+		if unsupported := unusedKeys(r); len(unsupported) > 0 {
+			return nil, fmt.Errorf("ignition config uses %s not supported by spec %s", strings.Join(unsupported, ", "), version)
+		}
+		if len(r.Entries) > 0 {
+			logrus.Warnf("ignition config: %s", r.String())
+		}
+		return json.Marshal(downgraded)
+	}
+	return nil, fmt.Errorf("unsupported ignition version %q", version)
+}
+
+// unusedKeys 返回校验报告中未使用的配置项，即目标版本不支持的配置项
+func unusedKeys(r report.Report) []string {
+	var keys []string
+	for _, entry := range r.Entries {
+		if strings.HasPrefix(entry.Message, "Unused key") {
+			keys = append(keys, entry.Context.String())
+		}
+	}
+	return keys
+}
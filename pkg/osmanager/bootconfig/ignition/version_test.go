@@ -0,0 +1,86 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"strings"
+	"testing"
+
+	ignutil "github.com/coreos/ignition/v2/config/util"
+	ign32 "github.com/coreos/ignition/v2/config/v3_2"
+	igntypes "github.com/coreos/ignition/v2/config/v3_3/types"
+)
+
+func TestMarshalConfig(t *testing.T) {
+	newConfig := func() *igntypes.Config {
+		config := &igntypes.Config{}
+		config.Storage.Files = []igntypes.File{fileWithContents("/etc/hosts", 0644, []byte("127.0.0.1 localhost"))}
+		config.Systemd.Units = []igntypes.Unit{{Name: "kubelet.service", Enabled: ignutil.BoolToPtr(true)}}
+		return config
+	}
+
+	t.Run("SpecVersion Success", func(t *testing.T) {
+		if version, err := specVersion(""); err != nil || version != "3.3.0" {
+			t.Errorf("unexpected default version %s: %v", version, err)
+		}
+		if version, err := specVersion("3.2.0"); err != nil || version != "3.2.0" {
+			t.Errorf("unexpected version %s: %v", version, err)
+		}
+	})
+
+	t.Run("SpecVersion Fail", func(t *testing.T) {
+		for _, version := range []string{"3.1.0", "3.4.0-experimental", "latest"} {
+			if _, err := specVersion(version); err == nil {
+				t.Errorf("expected error for version %s", version)
+			}
+		}
+	})
+
+	t.Run("MarshalConfig Success", func(t *testing.T) {
+		data, err := marshalConfig(newConfig(), "3.3.0")
+		if err != nil || !strings.Contains(string(data), `"version":"3.3.0"`) {
+			t.Errorf("unexpected 3.3.0 config %s: %v", data, err)
+		}
+
+		data, err = marshalConfig(newConfig(), "3.2.0")
+		if err != nil {
+			t.Fatalf("marshalConfig failed: %v", err)
+		}
+		if strings.Contains(string(data), "kernelArguments") {
+			t.Errorf("3.2.0 config contains kernelArguments: %s", data)
+		}
+		if _, r, err := ign32.Parse(data); err != nil || len(r.Entries) > 0 {
+			t.Errorf("invalid 3.2.0 config %s: %v %s", data, err, r.String())
+		}
+	})
+
+	t.Run("MarshalConfig Fail", func(t *testing.T) {
+		config := newConfig()
+		config.KernelArguments.ShouldExist = []igntypes.KernelArgument{"nosmt"}
+		if _, err := marshalConfig(config, "3.2.0"); err == nil || !strings.Contains(err.Error(), "kernelArguments") {
+			t.Errorf("expected error for kernelArguments in a 3.2.0 config, got %v", err)
+		}
+
+		for _, version := range []string{"3.2.0", "3.3.0"} {
+			config = newConfig()
+			config.Storage.Files = append(config.Storage.Files, fileWithContents("etc/relative", 0644, nil))
+			if _, err := marshalConfig(config, version); err == nil {
+				t.Errorf("expected error for a relative path in a %s config", version)
+			}
+		}
+	})
+}
@@ -4,9 +4,9 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
-	"os"
 	"path/filepath"
-	"strings"
+
+	"github.com/sirupsen/logrus"
 )
 
 type Kickstart struct {
@@ -35,61 +35,74 @@ var (
 )
 
 func (c *Kickstart) GenerateBootConfig() error {
-	if err := c.generateNodeConfig(constants.Controlplane, constants.InitClusterService, constants.InitClusterYaml, c.ClusterAsset.Master[0].Hostname+constants.KickstartSuffix); err != nil {
+	if !c.ClusterAsset.Fragments.Empty() {
+		logrus.Warnf("boot config fragments are not supported by kickstart and will be ignored")
+	}
+	if err := c.generateNodeConfig(constants.Controlplane, constants.InitClusterService, constants.InitClusterYaml, &c.ClusterAsset.Master[0]); err != nil {
 		return err
 	}
 
-	n := len(c.ClusterAsset.Master)
-	if n > 1 {
-		for i := 1; i < n; i++ {
-			if err := c.generateNodeConfig(constants.Master, constants.JoinMasterService, "", c.ClusterAsset.Master[i].Hostname+constants.KickstartSuffix); err != nil {
-				return err
-			}
+	for i := 1; i < len(c.ClusterAsset.Master); i++ {
+		if err := c.generateNodeConfig(constants.Master, constants.JoinMasterService, "", &c.ClusterAsset.Master[i]); err != nil {
+			return err
 		}
 	}
 
-	if err := c.generateNodeConfig(constants.Worker, constants.JoinWorkerService, "", constants.Worker+constants.KickstartSuffix); err != nil {
+	for i := range c.ClusterAsset.Worker {
+		if err := c.generateNodeConfig(constants.Worker, constants.JoinWorkerService, "", &c.ClusterAsset.Worker[i]); err != nil {
+			return err
+		}
+	}
+
+	// 不区分节点的 worker.cfg，用于扩容时数量未知的节点
+	if err := c.generateNodeConfig(constants.Worker, constants.JoinWorkerService, "", nil); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (c *Kickstart) generateNodeConfig(nodeType, service string, yamlPath string, filename string) error {
-	tmpl := newTemplate(c.ClusterAsset, append(enabledServices, service), enabledFiles)
+// GenerateNodeBootConfig 为节点生成 worker 角色的专属 kickstart 文件，用于扩容新增的节点
+func (c *Kickstart) GenerateNodeBootConfig(node *asset.NodeAsset) error {
+	return c.generateNodeConfig(constants.Worker, constants.JoinWorkerService, "", node)
+}
+
+/*
+generateNodeConfig 生成 kickstart 文件：
+node 非空时生成节点专属的 nodes/<主机名>.cfg，其中包含节点的主机名、节点IP和节点标签；
+node 为空时生成同一角色所有节点共用的 <角色>.cfg
+*/
+func (c *Kickstart) generateNodeConfig(nodeType, service string, yamlPath string, node *asset.NodeAsset) error {
+	tmpl := newTemplate(c.ClusterAsset, c.ClusterAsset.NodeKickstart(node), append(enabledServices, service), enabledFiles)
 	if yamlPath != "" {
 		tmpl.enabledFiles = append(tmpl.enabledFiles, yamlPath)
 	}
-	if err := tmpl.GenerateBootConfig(c.BootstrapBaseurl, nodeType, strings.TrimSuffix(filename, constants.KickstartSuffix)); err != nil {
+	if err := tmpl.GenerateBootConfig(c.BootstrapBaseurl, nodeType, node); err != nil {
 		return err
 	}
+
+	filename := nodeType + constants.KickstartSuffix
+	if node != nil {
+		filename = bootconfig.NodeConfigName(node.Hostname, constants.KickstartSuffix)
+	}
+	// kickstart 文件中包含 token 和 certificateKey，按敏感文件保存，由 HTTP 服务提供给节点的是内存中的内容
 	savePath := bootconfig.GetSavePath(c.ClusterAsset.ClusterID)
-	if err := bootconfig.SaveFile(tmpl.config, savePath, filename); err != nil {
+	if err := bootconfig.SaveSensitiveFile(tmpl.config, savePath, filename); err != nil {
 		return err
 	}
 
-	ksPath := filepath.Join(savePath, filename)
-	ksContent, err := os.ReadFile(ksPath)
-	if err != nil {
-		return err
+	bootFile := asset.BootFile{
+		Content: tmpl.config,
+		Path:    filepath.Join(savePath, filename),
 	}
-
-	switch nodeType {
-	case constants.Controlplane:
-		c.ClusterAsset.BootConfig.Controlplane = asset.BootFile{
-			Content: ksContent,
-			Path:    ksPath,
-		}
-	case constants.Master:
-		c.ClusterAsset.BootConfig.KickstartMaster = append(c.ClusterAsset.BootConfig.KickstartMaster, asset.BootFile{
-			Content: ksContent,
-			Path:    ksPath,
-		})
-	case constants.Worker:
-		c.ClusterAsset.BootConfig.Worker = asset.BootFile{
-			Content: ksContent,
-			Path:    ksPath,
-		}
+	if node != nil {
+		node.BootConfig = bootFile
+	}
+	switch {
+	case nodeType == constants.Controlplane:
+		c.ClusterAsset.BootConfig.Controlplane = bootFile
+	case nodeType == constants.Worker && node == nil:
+		c.ClusterAsset.BootConfig.Worker = bootFile
 	}
 
 	return nil
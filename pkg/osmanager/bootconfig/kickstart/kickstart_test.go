@@ -20,7 +20,9 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
 	"nestos-kubernetes-deployer/pkg/constants"
+	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -34,12 +36,15 @@ func TestKickstart(t *testing.T) {
 		Platform:  "libvirt",
 		Master: []asset.NodeAsset{
 			{Hostname: "k8s-master01", IP: "127.0.0.1"},
-			{Hostname: "k8s-master02", IP: "127.0.0.1"},
+			{Hostname: "k8s-master02", IP: "127.0.0.1", Network: &asset.NodeNetwork{
+				Interfaces: []asset.NetworkInterface{{Name: "eth0", Type: asset.InterfaceEthernet, Addresses: []string{"127.0.0.1/8"}}},
+			}},
 		},
 		Worker: []asset.NodeAsset{
-			{Hostname: "k8s-worker01", IP: "127.0.0.1"},
+			{Hostname: "k8s-worker01", IP: "127.0.0.1", MAC: "52:54:00:aa:bb:01", Kickstart: &asset.KickstartAsset{Disk: "nvme0n1"}},
 		},
-		SSHKey: "./assets.go",
+		Kickstart: asset.KickstartAsset{Timezone: "Europe/Berlin", NTPServers: []string{"ntp1.example.com", "ntp2.example.com"}},
+		SSHKey:    "./assets.go",
 		HookConf: asset.HookConf{
 			ShellFiles: []asset.ShellFile{
 				{Name: "sss"},
@@ -67,11 +72,75 @@ func TestKickstart(t *testing.T) {
 		}
 		t.Log("success")
 
+		content := string(clusterAsset.Worker[0].BootConfig.Content)
+		if !strings.Contains(content, "network  --bootproto=dhcp --device=52:54:00:aa:bb:01 --ipv6=auto --hostname=k8s-worker01 --activate") ||
+			!strings.Contains(content, "--node-ip=127.0.0.1") {
+			t.Errorf("kickstart file of k8s-worker01 lacks the node settings:\n%s", content)
+		}
+		if strings.Contains(content, "uuidgen") {
+			t.Errorf("kickstart file of k8s-worker01 should not generate a random hostname")
+		}
+		if !strings.Contains(content, "ignoredisk --only-use=nvme0n1") ||
+			!strings.Contains(content, "timezone Europe/Berlin --utc --ntpservers=ntp1.example.com,ntp2.example.com") ||
+			strings.Contains(content, "services --disabled=\"chronyd\"") {
+			t.Errorf("kickstart file of k8s-worker01 lacks the install settings:\n%s", content)
+		}
+		master := string(clusterAsset.Master[1].BootConfig.Content)
+		if !strings.Contains(master, "network  --device=eth0 --bootproto=static --ip=127.0.0.1 --netmask=255.0.0.0 --ipv6=auto --onboot=yes --activate\n"+
+			"network  --hostname=k8s-master02\n# Root password") {
+			t.Errorf("kickstart file of k8s-master02 lacks the network settings:\n%s", master)
+		}
+		shared := string(clusterAsset.BootConfig.Worker.Content)
+		if strings.Contains(shared, "--hostname") || !strings.Contains(shared, "network  --bootproto=dhcp --device=link --ipv6=auto --activate") {
+			t.Errorf("shared worker kickstart file should configure the first connected interface by dhcp:\n%s", shared)
+		}
+
 		if err := os.RemoveAll(clusterAsset.ClusterID); err != nil {
 			t.Logf("Failed to remove cluster folder: %v", err)
 		}
 	})
 
+	t.Run("GenerateBootConfig ExternalCA Success", func(t *testing.T) {
+		clusterAsset.Runtime = constants.Crio
+		clusterAsset.CertAsset.ExternalCA = &asset.ExternalCA{Signer: asset.ExternalCASignerCommand, Command: "sign-csr"}
+		clusterAsset.Master[0].Certs = []utils.StorageContent{{Path: utils.AdminConfig, Mode: 0600, Content: []byte("controlplane-admin")}}
+		clusterAsset.Master[1].Certs = []utils.StorageContent{{Path: utils.ApiserverCrt, Mode: 0600, Content: []byte("master02-apiserver")}}
+		clusterAsset.Worker[0].Certs = []utils.StorageContent{{Path: utils.KubeletConfig, Mode: 0600, Content: []byte("worker01-kubelet")}}
+		defer func() {
+			clusterAsset.CertAsset.ExternalCA = nil
+			clusterAsset.Master[0].Certs, clusterAsset.Master[1].Certs, clusterAsset.Worker[0].Certs = nil, nil, nil
+			os.RemoveAll(clusterAsset.ClusterID)
+		}()
+		if err := ci.GenerateBootConfig(); err != nil {
+			t.Fatalf("GenerateBootConfig failed: %v", err)
+		}
+
+		controlplane := string(clusterAsset.Master[0].BootConfig.Content)
+		if strings.Contains(controlplane, "controlplane-admin") {
+			t.Errorf("certs of the first master should be fetched from certs.json:\n%s", controlplane)
+		}
+		master := string(clusterAsset.Master[1].BootConfig.Content)
+		if !strings.Contains(master, "cat <<'EOF'> /etc/kubernetes/pki/apiserver.crt\nmaster02-apiserver\nEOF") ||
+			!strings.Contains(master, "--control-plane --ignore-preflight-errors=FileAvailable--etc-kubernetes-kubelet.conf") ||
+			strings.Contains(master, "--certificate-key") {
+			t.Errorf("kickstart file of k8s-master02 should join with the presigned certs:\n%s", master)
+		}
+		worker := string(clusterAsset.Worker[0].BootConfig.Content)
+		if !strings.Contains(worker, "cat <<'EOF'> /etc/kubernetes/kubelet.conf\nworker01-kubelet\nEOF") ||
+			!strings.Contains(worker, "chmod 600 /etc/kubernetes/kubelet.conf") ||
+			!strings.Contains(worker, "--ignore-preflight-errors=FileAvailable--etc-kubernetes-kubelet.conf") {
+			t.Errorf("kickstart file of k8s-worker01 should join with the presigned kubelet.conf:\n%s", worker)
+		}
+
+		node := &asset.NodeAsset{Hostname: "k8s-worker02", Certs: []utils.StorageContent{{Path: utils.KubeletConfig, Mode: 0600, Content: []byte("worker02-kubelet")}}}
+		if err := ci.GenerateNodeBootConfig(node); err != nil {
+			t.Fatalf("GenerateNodeBootConfig failed: %v", err)
+		}
+		if content := string(node.BootConfig.Content); !strings.Contains(content, "worker02-kubelet") || !strings.Contains(content, "--hostname=k8s-worker02") {
+			t.Errorf("kickstart file of the extended node lacks its settings:\n%s", content)
+		}
+	})
+
 	t.Run("GenerateBootConfig_fail", func(t *testing.T) {
 		clusterAsset.Runtime = "podman"
 		err := ci.GenerateBootConfig()
@@ -80,3 +149,66 @@ func TestKickstart(t *testing.T) {
 		}
 	})
 }
+
+func TestNetworkArgs(t *testing.T) {
+	tests := []struct {
+		node *asset.NodeAsset
+		want string
+	}{
+		{
+			node: &asset.NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11"},
+			want: "--bootproto=dhcp --device=link --ipv6=auto --hostname=k8s-worker01 --activate",
+		},
+		{
+			node: &asset.NodeAsset{Hostname: "k8s-worker01", IP: "192.168.1.11", MAC: "52:54:00:aa:bb:01", Interface: "eno1",
+				Netmask: "255.255.255.0", Gateway: "192.168.1.1", DNS: []string{"192.168.1.2", "192.168.1.3"}},
+			want: "--bootproto=static --device=eno1 --ip=192.168.1.11 --netmask=255.255.255.0 --ipv6=auto --gateway=192.168.1.1 " +
+				"--nameserver=192.168.1.2,192.168.1.3 --hostname=k8s-worker01 --activate",
+		},
+		{
+			node: &asset.NodeAsset{Hostname: "k8s-worker01", IP: "fd00::11", MAC: "52:54:00:aa:bb:01", Netmask: "64", Gateway: "fd00::1"},
+			want: "--bootproto=static --device=52:54:00:aa:bb:01 --noipv4 --ipv6=fd00::11/64 --ipv6gateway=fd00::1 --hostname=k8s-worker01 --activate",
+		},
+	}
+	for _, test := range tests {
+		if got := networkArgs(test.node); got != test.want {
+			t.Errorf("networkArgs(%+v) = %q, want %q", test.node, got, test.want)
+		}
+	}
+}
+
+func TestNetworkLines(t *testing.T) {
+	node := &asset.NodeAsset{
+		Hostname: "k8s-worker01",
+		IP:       "192.168.1.11",
+		Network: &asset.NodeNetwork{
+			Interfaces: []asset.NetworkInterface{
+				{Name: "eno1", MAC: "52:54:00:aa:bb:01"},
+				{Name: "bond0", Type: asset.InterfaceBond, Members: []string{"eno1", "eno2"}},
+				{Name: "bond0.100", Type: asset.InterfaceVLAN, VLANID: 100, Parent: "bond0",
+					Addresses: []string{"192.168.1.11/24", "fd00::11/64"}, Gateway: "192.168.1.1", MTU: 1500},
+				{Name: "br0", Type: asset.InterfaceBridge, Members: []string{"eno3"}},
+			},
+			DNS: []string{"192.168.1.2"},
+		},
+	}
+	if err := node.ValidateNetwork(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"--device=bond0 --bondslaves=eno1,eno2 --bondopts=mode=active-backup,miimon=100 --noipv4 --noipv6 --onboot=yes --activate",
+		"--device=bond0 --vlanid=100 --interfacename=bond0.100 --bootproto=static --ip=192.168.1.11 --netmask=255.255.255.0 " +
+			"--ipv6=fd00::11/64 --gateway=192.168.1.1 --nameserver=192.168.1.2 --mtu=1500 --onboot=yes --activate",
+		"--device=br0 --bridgeslaves=eno3 --bootproto=dhcp --ipv6=auto --onboot=yes --activate",
+		"--hostname=k8s-worker01",
+	}
+	got := networkLines(node)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("networkLines() = %q, want %q", got, want)
+	}
+
+	if got := networkLines(nil); len(got) != 1 || got[0] != networkArgs(nil) {
+		t.Errorf("networkLines(nil) = %q", got)
+	}
+}
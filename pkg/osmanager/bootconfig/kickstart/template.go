@@ -8,17 +8,21 @@ import (
 	"nestos-kubernetes-deployer/pkg/constants"
 	"nestos-kubernetes-deployer/pkg/osmanager/bootconfig"
 	"nestos-kubernetes-deployer/pkg/utils"
+	"net"
+	"path"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
 type KsTempData struct {
-	Hostname string
-	Password string
-	Files    []File
-	Systemds []string
-	IsDocker bool
-	IsIsulad bool
+	Hostname   string
+	Network    []string // network 命令的参数，每项生成一条 network 命令
+	Password   string
+	Install    asset.KickstartAsset
+	NTPServers string
+	Files      []File
+	Systemds   []string
 }
 
 type File struct {
@@ -28,25 +32,33 @@ type File struct {
 
 type template struct {
 	clusterAsset    *asset.ClusterAsset
+	install         asset.KickstartAsset // 节点的安装参数
 	config          []byte
 	enabledServices []string
 	enabledFiles    []string
 }
 
-func newTemplate(clusterAsset *asset.ClusterAsset, enabledServices, enabledFiles []string) *template {
+func newTemplate(clusterAsset *asset.ClusterAsset, install asset.KickstartAsset, enabledServices, enabledFiles []string) *template {
 	return &template{
 		clusterAsset:    clusterAsset,
+		install:         install,
 		enabledServices: enabledServices,
 		enabledFiles:    enabledFiles,
 	}
 }
 
-func (t *template) GenerateBootConfig(url string, nodeType string, hostname string) error {
+func (t *template) GenerateBootConfig(url string, nodeType string, node *asset.NodeAsset) error {
 	files := []bootconfig.File{}
 	systemds := bootconfig.Systemd{}
 	ksData := KsTempData{
 		Password: t.clusterAsset.Password,
-		Hostname: hostname,
+		Network:  networkLines(node),
+		Install:  t.install,
+		// 指定NTP服务器时保留chronyd服务
+		NTPServers: strings.Join(t.install.NTPServers, ","),
+	}
+	if node != nil {
+		ksData.Hostname = node.Hostname
 	}
 
 	tmplData, err := bootconfig.GetTmplData(t.clusterAsset)
@@ -56,7 +68,7 @@ func (t *template) GenerateBootConfig(url string, nodeType string, hostname stri
 
 	if nodeType == constants.Controlplane {
 		tmplData.IsControlPlane = true
-		tmplData.CertsUrl = utils.ConstructURL(url, constants.CertsFiles)
+		tmplData.CertsUrl = bootconfig.BootstrapFileURL(t.clusterAsset, url, constants.CertsFiles)
 	}
 
 	engine, err := runtime.GetRuntime(t.clusterAsset.Runtime)
@@ -65,29 +77,38 @@ func (t *template) GenerateBootConfig(url string, nodeType string, hostname stri
 	}
 
 	tmplData.CriSocket = engine.GetRuntimeCriSocket()
-	if runtime.IsIsulad(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.IsuladConfig)
-		ksData.IsIsulad = true
-	} else if runtime.IsDocker(engine) {
-		t.enabledFiles = append(t.enabledFiles, constants.DockerConfig)
-		ksData.IsDocker = true
-	} else if runtime.IsCrio(engine) {
+	if runtime.IsCrio(engine) {
 		t.enabledFiles = append(t.enabledFiles, constants.KubeletServiceConf)
 	}
 
+	t.enabledFiles = append(t.enabledFiles, bootconfig.RpmRepoFiles(tmplData)...)
+
 	if err := bootconfig.AppendStorageFiles(&files, "/", constants.BootConfigFilesPath, tmplData, t.enabledFiles); err != nil {
 		logrus.Errorf("failed to add files to a kickstart config: %v", err)
 		return err
 	}
+	runtimeFiles, err := bootconfig.RuntimeFiles(t.clusterAsset, tmplData, nodeType)
+	if err != nil {
+		return err
+	}
+	files = append(files, runtimeFiles...)
 
 	if err := bootconfig.AppendSystemdUnits(&systemds, constants.BootConfigSystemdPath, tmplData, t.enabledServices); err != nil {
 		logrus.Errorf("failed to add systemd units to a kickstart config: %v", err)
 		return err
 	}
 
+	if node != nil {
+		files = append(files, bootconfig.NodeFiles(node)...)
+		// 第一个 master 节点通过 certs.json 获取证书
+		if nodeType != constants.Controlplane {
+			files = append(files, bootconfig.CertFiles(node)...)
+		}
+	}
+
 	for _, f := range files {
 		ksData.Files = append(ksData.Files, File{
-			Content:   fmt.Sprintf("cat <<'EOF'> %s\n%s\nEOF", f.Path, string(f.Contents.Source)),
+			Content:   fmt.Sprintf("mkdir -p %s\ncat <<'EOF'> %s\n%s\nEOF", path.Dir(f.Path), f.Path, string(f.Contents.Source)),
 			ChangeMod: fmt.Sprintf("chmod %o %s", f.Mode, f.Path),
 		})
 	}
@@ -126,3 +147,133 @@ func (t *template) GenerateBootConfig(url string, nodeType string, hostname stri
 	t.config = data
 	return nil
 }
+
+/*
+networkArgs 生成节点引导网卡的 network 命令参数：
+网卡依次按名称、MAC地址选择，均未指定时使用第一个已连接的网卡；
+设置了子网掩码的节点使用静态IP地址，其余节点通过DHCP获取地址
+*/
+func networkArgs(node *asset.NodeAsset) string {
+	if node == nil {
+		return "--bootproto=dhcp --device=link --ipv6=auto --activate"
+	}
+
+	device := "link"
+	if node.Interface != "" {
+		device = node.Interface
+	} else if node.MAC != "" {
+		device = node.MAC
+	}
+
+	args := []string{"--bootproto=dhcp", "--device=" + device, "--ipv6=auto"}
+	if node.StaticNetwork() {
+		args = []string{"--bootproto=static", "--device=" + device}
+		if ip := net.ParseIP(node.IP); ip.To4() != nil {
+			args = append(args, "--ip="+node.IP, "--netmask="+node.Netmask, "--ipv6=auto")
+		} else {
+			args = append(args, "--noipv4", "--ipv6="+node.IP+"/"+node.Netmask)
+		}
+		if node.Gateway != "" {
+			if net.ParseIP(node.Gateway).To4() != nil {
+				args = append(args, "--gateway="+node.Gateway)
+			} else {
+				args = append(args, "--ipv6gateway="+node.Gateway)
+			}
+		}
+		if len(node.DNS) > 0 {
+			args = append(args, "--nameserver="+strings.Join(node.DNS, ","))
+		}
+	}
+	args = append(args, "--hostname="+node.Hostname, "--activate")
+
+	return strings.Join(args, " ")
+}
+
+/*
+networkLines 生成节点 network 配置对应的多条 network 命令参数：
+bond 和 bridge 的成员网卡由其所属接口的命令配置，每个地址族仅使用第一个静态地址，主机名由最后一条命令设置；
+未设置 network 的节点使用 networkArgs 生成的一条命令
+*/
+func networkLines(node *asset.NodeAsset) []string {
+	if node == nil || node.Network == nil {
+		return []string{networkArgs(node)}
+	}
+
+	network := node.Network
+	primary := network.PrimaryInterface()
+	var lines []string
+	for _, iface := range network.Interfaces {
+		if network.MemberOf(iface.Name) != nil {
+			continue
+		}
+
+		var args []string
+		switch iface.Type {
+		case asset.InterfaceEthernet:
+			args = append(args, "--device="+ksDevice(network, iface.Name))
+		case asset.InterfaceBond:
+			args = append(args, "--device="+iface.Name, "--bondslaves="+strings.Join(iface.Members, ","),
+				"--bondopts=mode="+iface.BondMode+",miimon=100")
+		case asset.InterfaceVLAN:
+			args = append(args, "--device="+ksDevice(network, iface.Parent), fmt.Sprintf("--vlanid=%d", iface.VLANID),
+				"--interfacename="+iface.Name)
+		case asset.InterfaceBridge:
+			args = append(args, "--device="+iface.Name, "--bridgeslaves="+strings.Join(iface.Members, ","))
+		}
+
+		ipv4, ipv6 := firstAddress(iface.Addresses, true), firstAddress(iface.Addresses, false)
+		ipv6Arg := "--ipv6=auto"
+		if ipv6 != nil {
+			ones, _ := ipv6.Mask.Size()
+			ipv6Arg = fmt.Sprintf("--ipv6=%s/%d", ipv6.IP, ones)
+		}
+		switch {
+		case network.Unaddressed(&iface):
+			args = append(args, "--noipv4", "--noipv6")
+		case ipv4 != nil:
+			args = append(args, "--bootproto=static", "--ip="+ipv4.IP.String(), "--netmask="+net.IP(ipv4.Mask).String(), ipv6Arg)
+		case ipv6 != nil:
+			args = append(args, "--bootproto=static", "--noipv4", ipv6Arg)
+		default:
+			args = append(args, "--bootproto=dhcp", ipv6Arg)
+		}
+		if iface.Gateway != "" {
+			if net.ParseIP(iface.Gateway).To4() != nil {
+				args = append(args, "--gateway="+iface.Gateway)
+			} else {
+				args = append(args, "--ipv6gateway="+iface.Gateway)
+			}
+		}
+		if iface.Name == primary && len(network.DNS) > 0 {
+			args = append(args, "--nameserver="+strings.Join(network.DNS, ","))
+		}
+		if iface.MTU > 0 {
+			args = append(args, fmt.Sprintf("--mtu=%d", iface.MTU))
+		}
+		args = append(args, "--onboot=yes", "--activate")
+		lines = append(lines, strings.Join(args, " "))
+	}
+	lines = append(lines, "--hostname="+node.Hostname)
+
+	return lines
+}
+
+// ksDevice 返回 network 命令中的网卡：声明了 MAC 地址的网卡按 MAC 地址选择，其余按名称选择
+func ksDevice(network *asset.NodeNetwork, name string) string {
+	if iface := network.Interface(name); iface != nil && iface.MAC != "" {
+		return iface.MAC
+	}
+	return name
+}
+
+// firstAddress 返回指定地址族的第一个静态地址
+func firstAddress(addresses []string, ipv4 bool) *net.IPNet {
+	for _, address := range addresses {
+		ip, ipNet, err := net.ParseCIDR(address)
+		if err == nil && (ip.To4() != nil) == ipv4 {
+			ipNet.IP = ip
+			return ipNet
+		}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootconfig
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"net"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+/*
+NetworkKeyfiles 将节点的网络配置渲染为 NetworkManager keyfile（/etc/NetworkManager/system-connections/<接口名>.nmconnection）：
+  - 未设置地址的接口使用 DHCP 获取 IPv4 地址，未设置地址的 vlan 父接口不配置地址
+  - bond 和 bridge 的成员网卡生成从属连接，未在 interfaces 中声明的成员按名称匹配网卡
+  - DNS 服务器和搜索域设置在设置了网关的接口上，均未设置网关时设置在第一个接口上
+*/
+func NetworkKeyfiles(network *asset.NodeNetwork) []File {
+	if network == nil {
+		return nil
+	}
+
+	var files []File
+	interfaces := append([]asset.NetworkInterface{}, network.Interfaces...)
+	for _, member := range network.UndeclaredMembers() {
+		interfaces = append(interfaces, asset.NetworkInterface{Name: member, Type: asset.InterfaceEthernet})
+	}
+	for _, iface := range interfaces {
+		path := constants.NMConnectionsPath + iface.Name + ".nmconnection"
+		files = append(files, fileWithContents(path, constants.NMConnectionMode, keyfile(network, iface)))
+	}
+	return files
+}
+
+func keyfile(network *asset.NodeNetwork, iface asset.NetworkInterface) []byte {
+	var b strings.Builder
+	section := func(name string, lines ...string) {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[%s]\n", name)
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
+	connection := []string{"id=" + iface.Name, "type=" + iface.Type}
+	if iface.Type != asset.InterfaceEthernet || iface.MAC == "" {
+		connection = append(connection, "interface-name="+iface.Name)
+	}
+	connection = append(connection, "autoconnect=true")
+	if iface.Type == asset.InterfaceBond || iface.Type == asset.InterfaceBridge {
+		connection = append(connection, "autoconnect-slaves=1")
+	}
+	owner := network.MemberOf(iface.Name)
+	if owner != nil {
+		connection = append(connection, "master="+owner.Name, "slave-type="+owner.Type)
+	}
+	section("connection", connection...)
+
+	var ethernet []string
+	if iface.MAC != "" {
+		ethernet = append(ethernet, "mac-address="+iface.MAC)
+	}
+	if iface.MTU > 0 {
+		ethernet = append(ethernet, fmt.Sprintf("mtu=%d", iface.MTU))
+	}
+	if iface.Type == asset.InterfaceEthernet || len(ethernet) > 0 {
+		section("ethernet", ethernet...)
+	}
+
+	switch iface.Type {
+	case asset.InterfaceBond:
+		section("bond", "miimon=100", "mode="+iface.BondMode)
+	case asset.InterfaceVLAN:
+		section("vlan", fmt.Sprintf("id=%d", iface.VLANID), "parent="+iface.Parent)
+	case asset.InterfaceBridge:
+		section("bridge", "stp=false")
+	}
+
+	// 成员网卡的地址由其所属的 bond 或 bridge 配置
+	if owner != nil {
+		return []byte(b.String())
+	}
+	if network.Unaddressed(&iface) {
+		section("ipv4", "method=disabled")
+		section("ipv6", "method=disabled")
+		return []byte(b.String())
+	}
+	primary := network.PrimaryInterface() == iface.Name
+	// 仅设置了 IPv6 静态地址时不使用 IPv4，否则 IPv6 使用自动配置
+	ipv4Disabled := !iface.HasStaticAddress(true) && iface.HasStaticAddress(false)
+	for _, ipv4 := range []bool{true, false} {
+		name, method := "ipv6", "auto"
+		if ipv4 {
+			name = "ipv4"
+			if ipv4Disabled {
+				method = "disabled"
+			}
+		}
+		if iface.HasStaticAddress(ipv4) {
+			method = "manual"
+		}
+		lines := []string{"method=" + method}
+		i := 0
+		for _, address := range iface.Addresses {
+			if sameFamily(address, ipv4) {
+				i++
+				lines = append(lines, fmt.Sprintf("address%d=%s", i, address))
+			}
+		}
+		if iface.Gateway != "" && sameFamily(iface.Gateway, ipv4) {
+			lines = append(lines, "gateway="+iface.Gateway)
+		}
+		if primary {
+			var servers []string
+			for _, server := range network.DNS {
+				if sameFamily(server, ipv4) {
+					servers = append(servers, server+";")
+				}
+			}
+			if len(servers) > 0 {
+				lines = append(lines, "dns="+strings.Join(servers, ""))
+			}
+			if len(network.Search) > 0 && ipv4 != ipv4Disabled {
+				lines = append(lines, "dns-search="+strings.Join(network.Search, ";")+";")
+			}
+		}
+		i = 0
+		for _, route := range iface.Routes {
+			if sameFamily(route.To, ipv4) {
+				i++
+				line := fmt.Sprintf("route%d=%s,%s", i, route.To, route.Via)
+				if route.Metric > 0 {
+					line = fmt.Sprintf("%s,%d", line, route.Metric)
+				}
+				lines = append(lines, line)
+			}
+		}
+		section(name, lines...)
+	}
+	return []byte(b.String())
+}
+
+// sameFamily 判断地址（IP 或 CIDR）是否属于指定的地址族
+func sameFamily(address string, ipv4 bool) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(address)
+	}
+	return ip != nil && (ip.To4() != nil) == ipv4
+}
+
+// cloud-init network-config version 2 的结构
+type networkConfigV2 struct {
+	Version   int                   `yaml:"version"`
+	Ethernets map[string]ethernetV2 `yaml:"ethernets,omitempty"`
+	Bonds     map[string]bondV2     `yaml:"bonds,omitempty"`
+	VLANs     map[string]vlanV2     `yaml:"vlans,omitempty"`
+	Bridges   map[string]bridgeV2   `yaml:"bridges,omitempty"`
+}
+
+type deviceV2 struct {
+	DHCP4       bool           `yaml:"dhcp4"`
+	Addresses   []string       `yaml:"addresses,omitempty"`
+	Gateway4    string         `yaml:"gateway4,omitempty"`
+	Gateway6    string         `yaml:"gateway6,omitempty"`
+	Nameservers *nameserversV2 `yaml:"nameservers,omitempty"`
+	Routes      []routeV2      `yaml:"routes,omitempty"`
+	MTU         int            `yaml:"mtu,omitempty"`
+}
+
+type nameserversV2 struct {
+	Addresses []string `yaml:"addresses,omitempty"`
+	Search    []string `yaml:"search,omitempty"`
+}
+
+type routeV2 struct {
+	To     string `yaml:"to"`
+	Via    string `yaml:"via"`
+	Metric int    `yaml:"metric,omitempty"`
+}
+
+type ethernetV2 struct {
+	Match    map[string]string `yaml:"match,omitempty"`
+	deviceV2 `yaml:",inline"`
+}
+
+type bondV2 struct {
+	Interfaces []string `yaml:"interfaces"`
+	Parameters struct {
+		Mode               string `yaml:"mode"`
+		MIIMonitorInterval int    `yaml:"mii-monitor-interval"`
+	} `yaml:"parameters"`
+	deviceV2 `yaml:",inline"`
+}
+
+type vlanV2 struct {
+	ID       int    `yaml:"id"`
+	Link     string `yaml:"link"`
+	deviceV2 `yaml:",inline"`
+}
+
+type bridgeV2 struct {
+	Interfaces []string `yaml:"interfaces"`
+	Parameters struct {
+		STP bool `yaml:"stp"`
+	} `yaml:"parameters"`
+	deviceV2 `yaml:",inline"`
+}
+
+// NetworkConfigV2 将节点的网络配置渲染为 cloud-init network-config（version 2），设置规则与 NetworkKeyfiles 相同
+func NetworkConfigV2(network *asset.NodeNetwork) ([]byte, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	config := networkConfigV2{Version: 2}
+	primary := network.PrimaryInterface()
+	for _, iface := range network.Interfaces {
+		device := deviceV2{DHCP4: len(iface.Addresses) == 0, Addresses: iface.Addresses, MTU: iface.MTU}
+		if network.MemberOf(iface.Name) != nil || network.Unaddressed(&iface) {
+			device.DHCP4 = false
+		}
+		if iface.Gateway != "" {
+			if sameFamily(iface.Gateway, true) {
+				device.Gateway4 = iface.Gateway
+			} else {
+				device.Gateway6 = iface.Gateway
+			}
+		}
+		if iface.Name == primary && (len(network.DNS) > 0 || len(network.Search) > 0) {
+			device.Nameservers = &nameserversV2{Addresses: network.DNS, Search: network.Search}
+		}
+		for _, route := range iface.Routes {
+			device.Routes = append(device.Routes, routeV2{To: route.To, Via: route.Via, Metric: route.Metric})
+		}
+
+		switch iface.Type {
+		case asset.InterfaceEthernet:
+			ethernet := ethernetV2{deviceV2: device}
+			if iface.MAC != "" {
+				ethernet.Match = map[string]string{"macaddress": iface.MAC}
+			}
+			if config.Ethernets == nil {
+				config.Ethernets = make(map[string]ethernetV2)
+			}
+			config.Ethernets[iface.Name] = ethernet
+		case asset.InterfaceBond:
+			bond := bondV2{Interfaces: iface.Members, deviceV2: device}
+			bond.Parameters.Mode = iface.BondMode
+			bond.Parameters.MIIMonitorInterval = 100
+			if config.Bonds == nil {
+				config.Bonds = make(map[string]bondV2)
+			}
+			config.Bonds[iface.Name] = bond
+		case asset.InterfaceVLAN:
+			if config.VLANs == nil {
+				config.VLANs = make(map[string]vlanV2)
+			}
+			config.VLANs[iface.Name] = vlanV2{ID: iface.VLANID, Link: iface.Parent, deviceV2: device}
+		case asset.InterfaceBridge:
+			if config.Bridges == nil {
+				config.Bridges = make(map[string]bridgeV2)
+			}
+			config.Bridges[iface.Name] = bridgeV2{Interfaces: iface.Members, deviceV2: device}
+		}
+	}
+
+	// bond、bridge 和 vlan 引用的网卡须在 ethernets 中声明
+	undeclared := network.UndeclaredMembers()
+	for _, iface := range network.Interfaces {
+		if iface.Type == asset.InterfaceVLAN && network.Interface(iface.Parent) == nil {
+			undeclared = append(undeclared, iface.Parent)
+		}
+	}
+	for _, name := range undeclared {
+		if config.Ethernets == nil {
+			config.Ethernets = make(map[string]ethernetV2)
+		}
+		if _, ok := config.Ethernets[name]; !ok {
+			config.Ethernets[name] = ethernetV2{}
+		}
+	}
+
+	return yaml.Marshal(config)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootconfig
+
+import (
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"strings"
+	"testing"
+)
+
+func testNetwork(t *testing.T) *asset.NodeNetwork {
+	network := &asset.NodeNetwork{
+		Interfaces: []asset.NetworkInterface{
+			{Name: "eno1", MAC: "52:54:00:aa:bb:01"},
+			{Name: "bond0", Type: asset.InterfaceBond, Members: []string{"eno1", "eno2"}, MTU: 9000},
+			{Name: "bond0.100", Type: asset.InterfaceVLAN, VLANID: 100, Parent: "bond0",
+				Addresses: []string{"192.168.1.11/24", "fd00::11/64"}, Gateway: "192.168.1.1",
+				Routes: []asset.NetworkRoute{{To: "10.0.0.0/8", Via: "192.168.1.254", Metric: 100}}},
+		},
+		DNS:    []string{"192.168.1.2", "fd00::2"},
+		Search: []string{"example.com"},
+	}
+	if err := network.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return network
+}
+
+func TestNetworkKeyfiles(t *testing.T) {
+	t.Run("NetworkKeyfiles Success", func(t *testing.T) {
+		files := NetworkKeyfiles(testNetwork(t))
+		if len(files) != 4 {
+			t.Fatalf("expected 4 keyfiles, got %d", len(files))
+		}
+		contents := make(map[string]string)
+		for _, f := range files {
+			if f.Mode != constants.NMConnectionMode {
+				t.Errorf("unexpected mode of %s: %o", f.Path, f.Mode)
+			}
+			contents[strings.TrimSuffix(strings.TrimPrefix(f.Path, constants.NMConnectionsPath), ".nmconnection")] = string(f.Contents.Source)
+		}
+
+		for name, lines := range map[string][]string{
+			"eno1":      {"mac-address=52:54:00:aa:bb:01", "master=bond0", "slave-type=bond"},
+			"eno2":      {"interface-name=eno2", "master=bond0"},
+			"bond0":     {"interface-name=bond0", "autoconnect-slaves=1", "mtu=9000", "mode=active-backup", "[ipv4]\nmethod=disabled"},
+			"bond0.100": {"[vlan]\nid=100\nparent=bond0", "method=manual\naddress1=192.168.1.11/24\ngateway=192.168.1.1\ndns=192.168.1.2;\ndns-search=example.com;\nroute1=10.0.0.0/8,192.168.1.254,100", "[ipv6]\nmethod=manual\naddress1=fd00::11/64\ndns=fd00::2;\n"},
+		} {
+			for _, line := range lines {
+				if !strings.Contains(contents[name], line) {
+					t.Errorf("keyfile of %s does not contain %q:\n%s", name, line, contents[name])
+				}
+			}
+		}
+		if strings.Contains(contents["eno1"], "interface-name") || strings.Contains(contents["eno1"], "[ipv4]") {
+			t.Errorf("unexpected settings in keyfile of bond member:\n%s", contents["eno1"])
+		}
+		if strings.Contains(contents["bond0"], "dns=") {
+			t.Errorf("dns should only be set on the primary interface:\n%s", contents["bond0"])
+		}
+	})
+
+	t.Run("NetworkKeyfiles IPv6 Success", func(t *testing.T) {
+		network := &asset.NodeNetwork{Interfaces: []asset.NetworkInterface{{Name: "eth0", Addresses: []string{"fd00::11/64"}}}}
+		files := NetworkKeyfiles(network)
+		if len(files) != 1 || !strings.Contains(string(files[0].Contents.Source), "[ipv4]\nmethod=disabled") {
+			t.Errorf("ipv4 should be disabled on an ipv6-only interface: %v", files)
+		}
+		if NetworkKeyfiles(nil) != nil {
+			t.Errorf("expected no keyfiles without network")
+		}
+	})
+}
+
+func TestNetworkConfigV2(t *testing.T) {
+	t.Run("NetworkConfigV2 Success", func(t *testing.T) {
+		content, err := NetworkConfigV2(testNetwork(t))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := `version: 2
+ethernets:
+  eno1:
+    match:
+      macaddress: 52:54:00:aa:bb:01
+    dhcp4: false
+  eno2:
+    dhcp4: false
+bonds:
+  bond0:
+    interfaces:
+    - eno1
+    - eno2
+    parameters:
+      mode: active-backup
+      mii-monitor-interval: 100
+    dhcp4: false
+    mtu: 9000
+vlans:
+  bond0.100:
+    id: 100
+    link: bond0
+    dhcp4: false
+    addresses:
+    - 192.168.1.11/24
+    - fd00::11/64
+    gateway4: 192.168.1.1
+    nameservers:
+      addresses:
+      - 192.168.1.2
+      - fd00::2
+      search:
+      - example.com
+    routes:
+    - to: 10.0.0.0/8
+      via: 192.168.1.254
+      metric: 100
+`
+		if string(content) != expected {
+			t.Errorf("unexpected network config:\n%s", content)
+		}
+	})
+}
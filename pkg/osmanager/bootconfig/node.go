@@ -0,0 +1,78 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootconfig
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/constants"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// NodeConfigName 返回节点专属引导配置在引导服务中的文件名，例如 nodes/k8s-master01.ign
+func NodeConfigName(hostname string, suffix string) string {
+	return path.Join(constants.NodeBootConfigDir, hostname+suffix)
+}
+
+/*
+NodeFiles 返回写入节点专属引导配置的文件：
+  - /etc/hostname：节点主机名
+  - /etc/sysconfig/kubelet：kubelet 注册节点时使用的节点IP（--node-ip）和节点标签（--node-labels）
+*/
+func NodeFiles(node *asset.NodeAsset) []File {
+	files := []File{
+		fileWithContents(constants.HostnameFile, constants.BootConfigFileMode, []byte(node.Hostname+"\n")),
+	}
+
+	var args []string
+	if node.IP != "" {
+		args = append(args, "--node-ip="+node.IP)
+	}
+	if len(node.Labels) > 0 {
+		args = append(args, "--node-labels="+nodeLabels(node.Labels))
+	}
+	if len(args) > 0 {
+		content := fmt.Sprintf("KUBELET_EXTRA_ARGS=\"%s\"\n", strings.Join(args, " "))
+		files = append(files, fileWithContents(constants.KubeletSysconfig, constants.BootConfigFileMode, []byte(content)))
+	}
+
+	return files
+}
+
+/*
+CertFiles 返回节点的证书和 kubeconfig 文件。外部CA模式下集群中没有CA私钥，
+除第一个 master 节点外的其余节点使用nkd预先签发的证书加入集群，这些证书写入节点专属引导配置
+*/
+func CertFiles(node *asset.NodeAsset) []File {
+	var files []File
+	for _, c := range node.Certs {
+		files = append(files, fileWithContents(c.Path, os.FileMode(c.Mode), c.Content))
+	}
+	return files
+}
+
+func nodeLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootconfig
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/configmanager/runtime"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+/*
+RuntimeConfig 返回节点容器运行时的设置：
+  - pause 镜像、cgroup 驱动
+  - 内置镜像仓库和用户配置的 mirror，内置镜像仓库及通过 HTTP 访问的 mirror 设置为 plain HTTP 镜像仓库
+  - 用户配置的镜像仓库的访问方式、CA 证书及认证信息
+  - 注册到该角色（controlplane、master、worker）节点的额外运行时
+*/
+func RuntimeConfig(c *asset.ClusterAsset, tmplData *TmplData, role string) (*api.RuntimeConfig, error) {
+	config := &api.RuntimeConfig{
+		CgroupDriver: c.Kubernetes.CgroupDriver,
+	}
+	if c.Kubernetes.PauseImage != "" {
+		config.PauseImage = tmplData.ImageRegistry + "/" + c.Kubernetes.PauseImage
+	}
+	plainHTTP := func(registry string) {
+		for _, r := range config.PlainHTTPRegistries {
+			if r == registry {
+				return
+			}
+		}
+		config.PlainHTTPRegistries = append(config.PlainHTTPRegistries, registry)
+	}
+	if tmplData.RegistryEndpoint != "" {
+		plainHTTP(tmplData.RegistryEndpoint)
+	}
+	for _, mirror := range tmplData.Mirrors {
+		m := api.RegistryMirror{Registry: mirror.Registry}
+		for _, endpoint := range mirror.Endpoints {
+			m.Endpoints = append(m.Endpoints, endpoint.URL())
+			if endpoint.Insecure {
+				plainHTTP(strings.SplitN(endpoint.Host, "/", 2)[0])
+			}
+		}
+		config.Mirrors = append(config.Mirrors, m)
+	}
+
+	for _, registry := range c.Kubernetes.Registries {
+		if registry.PlainHTTP {
+			plainHTTP(registry.Name)
+		}
+		if registry.Insecure {
+			config.InsecureRegistries = append(config.InsecureRegistries, registry.Name)
+		}
+		if registry.CAFile != "" {
+			ca, err := os.ReadFile(registry.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA file of registry %s: %v", registry.Name, err)
+			}
+			if config.CAs == nil {
+				config.CAs = make(map[string][]byte)
+			}
+			config.CAs[registry.Name] = ca
+		}
+		if registry.HasAuth() {
+			if config.Auths == nil {
+				config.Auths = make(map[string]api.RegistryAuth)
+			}
+			config.Auths[registry.Name] = api.RegistryAuth{Username: registry.Username, Password: registry.Password}
+		}
+	}
+
+	for _, handler := range c.RuntimeHandlers {
+		if handler.OnRole(role) {
+			config.Handlers = append(config.Handlers, api.RuntimeHandler{Name: handler.Name, Type: handler.Type, RuntimePath: handler.RuntimePath})
+		}
+	}
+	return config, nil
+}
+
+/*
+RuntimeFiles 返回写入该角色节点的容器运行时配置文件：
+  - 由集群使用的容器运行时生成的完整配置文件或配置片段，以及 crictl 的配置文件
+  - containers/image 使用的 containers-registries.conf 配置片段和 CA 证书，NestOS 通过 rpm-ostree 获取发布镜像时同样使用
+*/
+func RuntimeFiles(c *asset.ClusterAsset, tmplData *TmplData, role string) ([]File, error) {
+	engine, err := runtime.GetRuntime(c.Runtime)
+	if err != nil {
+		return nil, err
+	}
+	config, err := RuntimeConfig(c, tmplData, role)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.ValidateConfig(config); err != nil {
+		logrus.Errorf("invalid runtime config: %v", err)
+		return nil, err
+	}
+
+	runtimeFiles, err := engine.GetRuntimeConfigFiles(config)
+	if err != nil {
+		logrus.Errorf("failed to generate runtime config files: %v", err)
+		return nil, err
+	}
+	containersFiles, err := runtime.ContainersFiles(config)
+	if err != nil {
+		logrus.Errorf("failed to generate registries config: %v", err)
+		return nil, err
+	}
+	runtimeFiles = append(runtimeFiles, containersFiles...)
+
+	files := make([]File, 0, len(runtimeFiles))
+	for _, f := range runtimeFiles {
+		files = append(files, fileWithContents(f.Path, f.Mode, f.Contents))
+	}
+	return files, nil
+}
@@ -19,11 +19,17 @@ import (
 	"errors"
 	"fmt"
 	"nestos-kubernetes-deployer/data"
+	"nestos-kubernetes-deployer/pkg/api"
+	"nestos-kubernetes-deployer/pkg/cert"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/runtime"
 	"nestos-kubernetes-deployer/pkg/constants"
+	"nestos-kubernetes-deployer/pkg/httpserver"
+	"nestos-kubernetes-deployer/pkg/ociregistry"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/utils"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -31,6 +37,7 @@ import (
 
 	"github.com/clarketm/json"
 	ignutil "github.com/coreos/ignition/v2/config/util"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
@@ -48,10 +55,13 @@ type TmplData struct {
 	CaCertHash        string
 	ReleaseImageURl   string
 	CertificateKey    string
+	ExternalCA        bool   // 外部CA模式：节点使用预先签发的证书和 kubelet.conf 加入集群，不从集群下载证书
 	Hsip              string //HostName + IP
 	KubeadmApiVersion string
+	PublicKeysECDSA   bool // kubeadm 自行生成的证书使用 ECDSA-P256 密钥
 	HookFilesPath     string
 	CertsUrl          string
+	BootstrapCACert   string // 引导服务的CA证书，节点通过HTTPS获取受保护文件时使用
 	IsControlPlane    bool
 	IsDocker          bool
 	IsIsulad          bool
@@ -61,7 +71,29 @@ type TmplData struct {
 	IsGeneralOS       bool
 	PackageList       []string
 	RpmPackageCurl    string
-	RegistryMirrors   string
+	RpmGPGKey         string           // 软件包仓库的签名公钥
+	CgroupDriver      string           // kubelet 和容器运行时使用的 cgroup 驱动
+	RegistryEndpoint  string           // 内置镜像仓库的地址
+	Mirrors           []RegistryMirror // 节点容器运行时的镜像仓库mirror配置
+}
+
+// RegistryMirror 镜像仓库及其mirror地址
+type RegistryMirror struct {
+	Registry  string
+	Endpoints []MirrorEndpoint
+}
+
+// MirrorEndpoint mirror地址，Insecure 表示通过HTTP访问
+type MirrorEndpoint struct {
+	Host     string
+	Insecure bool
+}
+
+func (e MirrorEndpoint) URL() string {
+	if e.Insecure {
+		return "http://" + e.Host
+	}
+	return "https://" + e.Host
 }
 
 func GetTmplData(c *asset.ClusterAsset) (*TmplData, error) {
@@ -84,21 +116,62 @@ func GetTmplData(c *asset.ClusterAsset) (*TmplData, error) {
 		rpmPackageCurl = utils.ConstructURL(configmanager.GetBootstrapIgnHostPort(), constants.RpmPackageList)
 	}
 
+	var bootstrapCACert string
+	if c.CertAsset.BootstrapCACertPath != "" {
+		caCert, err := os.ReadFile(c.CertAsset.BootstrapCACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bootstrap CA certificate: %v", err)
+		}
+		bootstrapCACert = string(caCert)
+	}
+
+	var rpmGPGKey string
+	if rpmPackageCurl != "" && c.Kubernetes.RpmGPGKeyPath != "" {
+		key, err := os.ReadFile(c.Kubernetes.RpmGPGKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rpm GPG key: %v", err)
+		}
+		rpmGPGKey = string(key)
+	}
+
+	cgroupDriver := c.Kubernetes.CgroupDriver
+	if cgroupDriver == "" {
+		cgroupDriver = runtime.CgroupDriverSystemd
+	}
+
 	deflash := strings.TrimPrefix(strings.TrimPrefix(c.Kubernetes.RegistryMirror, "http://"), "https://")
 
+	var registryEndpoint string
+	var registries []string
+	if c.Kubernetes.ImageLayoutPath != "" {
+		registry, err := ociregistry.Open(c.Kubernetes.ImageLayoutPath)
+		if err != nil {
+			return nil, err
+		}
+		registryEndpoint = configmanager.GetBootstrapIgnHostPort()
+		registries = registry.Registries()
+	}
+
+	mirrors, err := userMirrors(registryMirrors(registryEndpoint, registries, deflash), c.Kubernetes.Registries)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TmplData{
 		APIServerURL:      c.Kubernetes.ApiServerEndpoint,
-		ImageRegistry:     c.Kubernetes.ImageRegistry,
+		ImageRegistry:     imageRepository(c.Kubernetes.ImageRegistry, engine, mirrors),
 		Runtime:           c.Runtime,
 		PauseImage:        c.Kubernetes.PauseImage,
 		KubeVersion:       c.Kubernetes.KubernetesVersion,
 		KubeadmApiVersion: c.Kubernetes.KubernetesAPIVersion,
+		PublicKeysECDSA:   cert.KubeadmPublicKeysECDSA(c),
 		ServiceSubnet:     c.Network.ServiceSubnet,
 		PodSubnet:         c.Network.PodSubnet,
 		Token:             c.Kubernetes.Token,
 		CaCertHash:        c.Kubernetes.CaCertHash,
 		ReleaseImageURl:   c.Kubernetes.ReleaseImageURL,
 		CertificateKey:    c.Kubernetes.CertificateKey,
+		ExternalCA:        c.CertAsset.ExternalCA != nil,
 		Hsip:              hsip,
 		HookFilesPath:     constants.HookFilesPath,
 		IsDocker:          runtime.IsDocker(engine),
@@ -109,10 +182,99 @@ func GetTmplData(c *asset.ClusterAsset) (*TmplData, error) {
 		IsGeneralOS:       c.IsGeneralOS,
 		PackageList:       c.PackageList,
 		RpmPackageCurl:    rpmPackageCurl,
-		RegistryMirrors:   deflash,
+		RpmGPGKey:         rpmGPGKey,
+		CgroupDriver:      cgroupDriver,
+		RegistryEndpoint:  registryEndpoint,
+		Mirrors:           mirrors,
+		BootstrapCACert:   bootstrapCACert,
 	}, nil
 }
 
+// registryMirrors 将内置镜像仓库配置为其中镜像所属仓库的mirror，并保留用户为 docker.io 配置的mirror
+func registryMirrors(endpoint string, registries []string, dockerMirror string) []RegistryMirror {
+	var mirrors []RegistryMirror
+	hasDocker := false
+	for _, registry := range registries {
+		mirror := RegistryMirror{Registry: registry, Endpoints: []MirrorEndpoint{{Host: endpoint, Insecure: true}}}
+		if registry == "docker.io" && dockerMirror != "" {
+			hasDocker = true
+			mirror.Endpoints = append(mirror.Endpoints, MirrorEndpoint{Host: dockerMirror})
+		}
+		mirrors = append(mirrors, mirror)
+	}
+	if dockerMirror != "" && !hasDocker {
+		mirrors = append(mirrors, RegistryMirror{Registry: "docker.io", Endpoints: []MirrorEndpoint{{Host: dockerMirror}}})
+	}
+	return mirrors
+}
+
+// userMirrors 将用户为镜像仓库配置的mirror追加到已有的mirror之后
+func userMirrors(mirrors []RegistryMirror, registries []asset.RegistryAsset) ([]RegistryMirror, error) {
+	for _, registry := range registries {
+		if len(registry.Mirrors) == 0 {
+			continue
+		}
+		i := 0
+		for i < len(mirrors) && mirrors[i].Registry != registry.Name {
+			i++
+		}
+		if i == len(mirrors) {
+			mirrors = append(mirrors, RegistryMirror{Registry: registry.Name})
+		}
+		for _, mirror := range registry.Mirrors {
+			u, err := url.Parse(mirror)
+			if err != nil || u.Host == "" {
+				return nil, fmt.Errorf("invalid mirror %q of registry %s", mirror, registry.Name)
+			}
+			endpoint := MirrorEndpoint{Host: u.Host + strings.TrimSuffix(u.Path, "/"), Insecure: u.Scheme == "http"}
+			mirrors[i].Endpoints = append(mirrors[i].Endpoints, endpoint)
+		}
+	}
+	return mirrors, nil
+}
+
+/*
+imageRepository 返回 kubeadm 使用的镜像仓库（imageRepository）：
+运行时不支持为 imageRegistry 所属的镜像仓库配置 mirror 时（docker 和 iSulad 仅支持 docker.io），直接使用该镜像仓库的第一个 mirror
+*/
+func imageRepository(repository string, engine api.Runtime, mirrors []RegistryMirror) string {
+	registry, path := "docker.io", repository
+	if parts := strings.SplitN(repository, "/", 2); strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost" {
+		registry, path = parts[0], ""
+		if len(parts) == 2 {
+			path = parts[1]
+		}
+	}
+	if runtime.SupportsMirror(engine, registry) {
+		return repository
+	}
+	for _, mirror := range mirrors {
+		if mirror.Registry == registry && len(mirror.Endpoints) > 0 {
+			mirrored := strings.TrimSuffix(mirror.Endpoints[0].Host+"/"+path, "/")
+			logrus.Infof("runtime does not support mirrors of %s, using mirror %s as image repository", registry, mirrored)
+			return mirrored
+		}
+	}
+	return repository
+}
+
+// RpmRepoFiles 返回使用软件包仓库时需要写入节点的文件：仓库配置以及签名公钥
+func RpmRepoFiles(tmplData *TmplData) []string {
+	if tmplData.RpmPackageCurl == "" {
+		return nil
+	}
+	files := []string{constants.RpmRepoFile}
+	if tmplData.RpmGPGKey != "" {
+		files = append(files, constants.RpmGPGKeyFile)
+	}
+	return files
+}
+
+// BootstrapFileURL 返回受保护引导文件的HTTPS访问地址，地址中携带该文件的访问令牌
+func BootstrapFileURL(c *asset.ClusterAsset, hostPort string, fileName string) string {
+	return utils.ConstructSecureURL(hostPort, fileName, httpserver.AccessToken(c.CertAsset.BootstrapTokenSecret, fileName))
+}
+
 /*
 AppendStorageFiles: 向提供的切片中追加存储文件的信息。
 参数：
@@ -241,6 +403,31 @@ func SaveFile(data []byte, filePath, fileName string) error {
 	return saveDataToFile(string(data), filePath, fileName)
 }
 
+// SaveSensitiveFile 以敏感文件的权限保存包含私钥等敏感数据的文件，设置了密钥材料时加密保存
+func SaveSensitiveFile(data []byte, filePath, fileName string) error {
+	fullPath := filepath.Join(filePath, fileName)
+	if err := os.MkdirAll(filepath.Dir(fullPath), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := secret.WriteFile(fullPath, data, configmanager.GetSecretSealer()); err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+	return nil
+}
+
+// SaveSensitiveYAML 将数据转换为 YAML 格式后按敏感文件保存，用于包含 token、certificateKey 等敏感数据的引导配置
+func SaveSensitiveYAML(data interface{}, filePath, fileName, header string) error {
+	if data == nil {
+		return errors.New("data is nil")
+	}
+
+	dataBytes, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data: %w", err)
+	}
+	return SaveSensitiveFile(append([]byte(header), dataBytes...), filePath, fileName)
+}
+
 func saveFile(data interface{}, filePath, fileName, header string, marshalFunc func(interface{}) ([]byte, error)) error {
 	if data == nil {
 		return errors.New("data is nil")
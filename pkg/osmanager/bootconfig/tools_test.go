@@ -19,8 +19,11 @@ import (
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"nestos-kubernetes-deployer/pkg/configmanager/runtime"
 	"nestos-kubernetes-deployer/pkg/constants"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -65,6 +68,189 @@ func TestTool(t *testing.T) {
 		t.Log("success")
 	})
 
+	t.Run("NodeFiles Success", func(t *testing.T) {
+		node := &asset.NodeAsset{
+			Hostname: "k8s-worker01",
+			IP:       "192.168.1.10",
+			Labels:   map[string]string{"zone": "b", "node.kubernetes.io/pool": "gpu"},
+		}
+		files := NodeFiles(node)
+		if len(files) != 2 {
+			t.Fatalf("expected hostname and kubelet files, got %d files", len(files))
+		}
+		expected := "KUBELET_EXTRA_ARGS=\"--node-ip=192.168.1.10 --node-labels=node.kubernetes.io/pool=gpu,zone=b\"\n"
+		if files[1].Path != constants.KubeletSysconfig || string(files[1].Contents.Source) != expected {
+			t.Errorf("unexpected kubelet file %s: %s", files[1].Path, files[1].Contents.Source)
+		}
+		if name := NodeConfigName("k8s-worker01", constants.IgnSuffix); name != "nodes/k8s-worker01.ign" {
+			t.Errorf("unexpected node config name: %s", name)
+		}
+	})
+
+	t.Run("RpmRepoFiles Success", func(t *testing.T) {
+		data := &TmplData{RpmPackageCurl: "http://192.168.1.1:9080/packagelist/"}
+		var files []File
+		if err := AppendStorageFiles(&files, "/", constants.BootConfigFilesPath, data, RpmRepoFiles(data)); err != nil {
+			t.Fatalf("AppendStorageFiles failed: %v", err)
+		}
+		if len(files) != 1 || files[0].Path != "/etc/yum.repos.d/nkd-packages.repo" {
+			t.Fatalf("unexpected repo files: %v", files)
+		}
+		if !strings.Contains(string(files[0].Contents.Source), "baseurl=http://192.168.1.1:9080/packagelist/") ||
+			!strings.Contains(string(files[0].Contents.Source), "gpgcheck=0") ||
+			!strings.Contains(string(files[0].Contents.Source), "skip_if_unavailable=1") {
+			t.Errorf("unexpected repo file: %s", files[0].Contents.Source)
+		}
+
+		data.RpmGPGKey = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+		if files := RpmRepoFiles(data); len(files) != 2 || files[1] != constants.RpmGPGKeyFile {
+			t.Errorf("expected gpg key file, got %v", files)
+		}
+		if files := RpmRepoFiles(&TmplData{}); len(files) != 0 {
+			t.Errorf("expected no repo files without packages, got %v", files)
+		}
+	})
+
+	t.Run("RuntimeFiles Success", func(t *testing.T) {
+		data := &TmplData{
+			RegistryEndpoint: "192.168.1.1:9080",
+			Mirrors:          registryMirrors("192.168.1.1:9080", []string{"docker.io", "registry.k8s.io"}, "mirror.example.com"),
+		}
+		if len(data.Mirrors) != 2 || len(data.Mirrors[0].Endpoints) != 2 || data.Mirrors[0].Endpoints[0].URL() != "http://192.168.1.1:9080" ||
+			data.Mirrors[0].Endpoints[1].URL() != "https://mirror.example.com" {
+			t.Fatalf("unexpected mirrors: %v", data.Mirrors)
+		}
+		cluster := &asset.ClusterAsset{Runtime: constants.Containerd}
+		cluster.Kubernetes.ImageRegistry = "registry.k8s.io"
+		cluster.Kubernetes.PauseImage = "pause:3.9"
+
+		data.ImageRegistry = cluster.Kubernetes.ImageRegistry
+		config, err := RuntimeConfig(cluster, data, constants.Worker)
+		if err != nil {
+			t.Fatalf("RuntimeConfig failed: %v", err)
+		}
+		if config.PauseImage != "registry.k8s.io/pause:3.9" || len(config.PlainHTTPRegistries) != 1 || len(config.Mirrors) != 2 {
+			t.Errorf("unexpected runtime config: %+v", config)
+		}
+		files, err := RuntimeFiles(cluster, data, constants.Worker)
+		if err != nil {
+			t.Fatalf("RuntimeFiles failed: %v", err)
+		}
+		paths := make(map[string]string)
+		for _, f := range files {
+			paths[f.Path] = string(f.Contents.Source)
+		}
+		if !strings.Contains(paths[constants.ContainerdConfig], `sandbox_image = "registry.k8s.io/pause:3.9"`) {
+			t.Errorf("unexpected containerd config: %s", paths[constants.ContainerdConfig])
+		}
+		if !strings.Contains(paths[constants.RegistriesConf], `prefix = "registry.k8s.io"`) {
+			t.Errorf("unexpected registries config: %s", paths[constants.RegistriesConf])
+		}
+		if _, ok := paths[constants.CrictlConfig]; !ok {
+			t.Errorf("expected crictl config, got %v", files)
+		}
+
+		cluster.Kubernetes.CgroupDriver = "cgroup"
+		if _, err := RuntimeFiles(cluster, data, constants.Worker); err == nil {
+			t.Errorf("expected error of an invalid cgroup driver")
+		}
+	})
+
+	t.Run("Registries Success", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.crt")
+		if err := os.WriteFile(caFile, []byte("-----BEGIN CERTIFICATE-----\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cluster := &asset.ClusterAsset{Runtime: constants.Docker}
+		cluster.Kubernetes.ImageRegistry = "registry.k8s.io"
+		cluster.Kubernetes.PauseImage = "pause:3.9"
+		cluster.Kubernetes.Registries = []asset.RegistryAsset{
+			{Name: "registry.k8s.io", Mirrors: []string{"http://cache.example.com:5000/k8s"}},
+			{Name: "registry.example.com", CAFile: caFile, Username: "user", Password: "pass"},
+			{Name: "registry.local:5000", PlainHTTP: true, Insecure: true},
+		}
+
+		mirrors, err := userMirrors(nil, cluster.Kubernetes.Registries)
+		if err != nil || len(mirrors) != 1 || mirrors[0].Endpoints[0].Host != "cache.example.com:5000/k8s" || !mirrors[0].Endpoints[0].Insecure {
+			t.Fatalf("unexpected mirrors %v: %v", mirrors, err)
+		}
+		// docker 不支持 registry.k8s.io 的 mirror，kubeadm 直接使用 mirror
+		docker, _ := runtime.GetRuntime(constants.Docker)
+		containerd, _ := runtime.GetRuntime(constants.Containerd)
+		if repo := imageRepository("registry.k8s.io", docker, mirrors); repo != "cache.example.com:5000/k8s" {
+			t.Errorf("unexpected image repository of docker: %s", repo)
+		}
+		if repo := imageRepository("registry.k8s.io", containerd, mirrors); repo != "registry.k8s.io" {
+			t.Errorf("unexpected image repository of containerd: %s", repo)
+		}
+		if repo := imageRepository("registry.k8s.io/sig", docker, mirrors); repo != "cache.example.com:5000/k8s/sig" {
+			t.Errorf("unexpected image repository with path: %s", repo)
+		}
+
+		data := &TmplData{ImageRegistry: imageRepository("registry.k8s.io", docker, mirrors), Mirrors: mirrors}
+		config, err := RuntimeConfig(cluster, data, constants.Worker)
+		if err != nil {
+			t.Fatalf("RuntimeConfig failed: %v", err)
+		}
+		if config.PauseImage != "cache.example.com:5000/k8s/pause:3.9" {
+			t.Errorf("unexpected pause image: %s", config.PauseImage)
+		}
+		if len(config.PlainHTTPRegistries) != 2 || config.PlainHTTPRegistries[0] != "cache.example.com:5000" ||
+			len(config.InsecureRegistries) != 1 || config.InsecureRegistries[0] != "registry.local:5000" {
+			t.Errorf("unexpected insecure registries: %v, %v", config.PlainHTTPRegistries, config.InsecureRegistries)
+		}
+		if config.Auths["registry.example.com"].Username != "user" || len(config.CAs["registry.example.com"]) == 0 {
+			t.Errorf("unexpected auths and CAs: %v, %v", config.Auths, config.CAs)
+		}
+
+		files, err := RuntimeFiles(cluster, data, constants.Worker)
+		if err != nil {
+			t.Fatalf("RuntimeFiles failed: %v", err)
+		}
+		paths := make(map[string]bool)
+		for _, f := range files {
+			paths[f.Path] = true
+		}
+		for _, path := range []string{constants.DockerConfig, constants.KubeletAuthFile, "/etc/docker/certs.d/registry.example.com/ca.crt",
+			"/etc/containers/certs.d/registry.example.com/ca.crt", constants.RegistriesConf} {
+			if !paths[path] {
+				t.Errorf("expected file %s, got %v", path, paths)
+			}
+		}
+	})
+
+	t.Run("RuntimeHandlers Success", func(t *testing.T) {
+		cluster := &asset.ClusterAsset{Runtime: constants.Containerd}
+		cluster.RuntimeHandlers = []asset.RuntimeHandlerAsset{
+			{Name: "kata", Type: constants.RuntimeHandlerKata},
+			{Name: "gvisor", Type: constants.RuntimeHandlerGVisor, Roles: []string{constants.Worker}},
+		}
+		for role, expected := range map[string]int{constants.Controlplane: 1, constants.Master: 1, constants.Worker: 2} {
+			config, err := RuntimeConfig(cluster, &TmplData{}, role)
+			if err != nil {
+				t.Fatalf("RuntimeConfig failed: %v", err)
+			}
+			if len(config.Handlers) != expected || config.Handlers[0].Name != "kata" {
+				t.Errorf("unexpected runtime handlers of %s: %v", role, config.Handlers)
+			}
+		}
+
+		files, err := RuntimeFiles(cluster, &TmplData{}, constants.Worker)
+		if err != nil {
+			t.Fatalf("RuntimeFiles failed: %v", err)
+		}
+		for _, f := range files {
+			if f.Path == constants.ContainerdConfig && !strings.Contains(string(f.Contents.Source), `runtime_type = "io.containerd.runsc.v1"`) {
+				t.Errorf("expected gvisor in containerd config: %s", f.Contents.Source)
+			}
+		}
+
+		cluster.Runtime = constants.Docker
+		if _, err := RuntimeFiles(cluster, &TmplData{}, constants.Worker); err == nil {
+			t.Errorf("expected error of runtime handlers on docker")
+		}
+	})
+
 	t.Run("AppendStorageFiles Success", func(t *testing.T) {
 		var files []File
 		err := AppendStorageFiles(&files, "/", constants.BootConfigFilesPath, tmplData, []string{constants.InitClusterService})
@@ -87,7 +87,8 @@ func TestGeneralos(t *testing.T) {
 	}
 
 	configmanager.GlobalConfig = &globalconfig.GlobalConfig{
-		PersistDir: "/",
+		PersistDir:            "/",
+		AllowPlaintextSecrets: true,
 		BootstrapUrl: globalconfig.BootstrapUrl{
 			BootstrapIgnHost: "127.0.0.1",
 			BootstrapIgnPort: "1234",
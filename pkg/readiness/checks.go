@@ -0,0 +1,501 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"nestos-kubernetes-deployer/pkg/addon"
+	"nestos-kubernetes-deployer/pkg/cert"
+	"nestos-kubernetes-deployer/pkg/cni"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"nestos-kubernetes-deployer/pkg/kubeclient"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 集群的检查项
+const (
+	CheckAPIServer     = "api-server"
+	CheckNodes         = "nodes"
+	CheckNetworkPlugin = "network-plugin"
+	CheckControlPlane  = "control-plane"
+	CheckEtcd          = "etcd"
+	CheckCoreDNS       = "coredns"
+	CheckSystemPods    = "system-pods"
+	CheckAddons        = "addons"
+)
+
+// 健康报告的输出格式
+const (
+	ReportText = "text"
+	ReportJSON = "json"
+)
+
+// checkNames 集群检查项的执行顺序
+var checkNames = []string{
+	CheckAPIServer,
+	CheckNodes,
+	CheckNetworkPlugin,
+	CheckControlPlane,
+	CheckEtcd,
+	CheckCoreDNS,
+	CheckSystemPods,
+	CheckAddons,
+}
+
+// defaultTimeouts 检查项的默认超时时间
+var defaultTimeouts = map[string]time.Duration{
+	CheckAPIServer:     60 * time.Minute,
+	CheckNodes:         20 * time.Minute,
+	CheckNetworkPlugin: 10 * time.Minute,
+	CheckControlPlane:  10 * time.Minute,
+	CheckEtcd:          10 * time.Minute,
+	CheckCoreDNS:       10 * time.Minute,
+	CheckSystemPods:    20 * time.Minute,
+	CheckAddons:        10 * time.Minute,
+}
+
+const (
+	etcdClientPort = "2379"
+	// etcdClientCertValidity 检查 etcd 成员列表使用的客户端证书的有效期
+	etcdClientCertValidity = time.Hour
+	etcdRequestTimeout     = 10 * time.Second
+)
+
+// controlPlaneComponents kubeadm 在每个 master 节点上以静态 Pod 运行的控制平面组件
+var controlPlaneComponents = []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler"}
+
+// Names 返回所有检查项
+func Names() []string {
+	return append([]string{}, checkNames...)
+}
+
+// Validate 校验集群配置中的就绪检查设置
+func Validate(conf *asset.ClusterAsset) error {
+	for name, timeout := range conf.Readiness.Timeouts {
+		if _, ok := defaultTimeouts[name]; !ok {
+			return fmt.Errorf("unknown readiness check %q, supported checks: %s", name, strings.Join(checkNames, ", "))
+		}
+		if d, err := time.ParseDuration(timeout); err != nil || d <= 0 {
+			return fmt.Errorf("invalid timeout %q of readiness check %s", timeout, name)
+		}
+	}
+	for _, name := range conf.Readiness.Skip {
+		if _, ok := defaultTimeouts[name]; !ok {
+			return fmt.Errorf("unknown readiness check %q, supported checks: %s", name, strings.Join(checkNames, ", "))
+		}
+		if name == CheckAPIServer {
+			return fmt.Errorf("readiness check %s can not be skipped", CheckAPIServer)
+		}
+	}
+	switch conf.Readiness.ReportFormat {
+	case "", ReportText, ReportJSON:
+	default:
+		return fmt.Errorf("unsupported report format %q, supported formats: %s, %s", conf.Readiness.ReportFormat, ReportText, ReportJSON)
+	}
+	return nil
+}
+
+// ClusterChecks 返回集群的检查项，第一个检查项为 api-server，其未就绪时跳过其余检查项
+func ClusterChecks(conf *asset.ClusterAsset, client kubernetes.Interface) ([]Check, error) {
+	if err := Validate(conf); err != nil {
+		return nil, err
+	}
+
+	runs := map[string]func(ctx context.Context) error{
+		CheckAPIServer:     apiServerCheck(client),
+		CheckNodes:         nodesCheck(client, conf),
+		CheckNetworkPlugin: networkPluginCheck(client),
+		CheckControlPlane:  controlPlaneCheck(client, conf),
+		CheckEtcd:          etcdCheck(conf),
+		CheckCoreDNS:       coreDNSCheck(client),
+		CheckSystemPods:    systemPodsCheck(client),
+		CheckAddons:        addonsCheck(client, conf),
+	}
+	var checks []Check
+	for _, name := range checkNames {
+		check := Check{
+			Name:     name,
+			Timeout:  defaultTimeouts[name],
+			Blocking: name == CheckAPIServer,
+			Run:      runs[name],
+		}
+		if timeout, ok := conf.Readiness.Timeouts[name]; ok {
+			check.Timeout, _ = time.ParseDuration(timeout)
+		}
+		for _, skip := range conf.Readiness.Skip {
+			if skip == name {
+				check.Skip = true
+			}
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func apiServerCheck(client kubernetes.Interface) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if _, err := client.Discovery().ServerVersion(); err != nil {
+			return err
+		}
+		if body, err := client.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+			return fmt.Errorf("%v: %s", err, body)
+		}
+		return nil
+	}
+}
+
+// expectedNodes 返回集群配置中所有节点的名称，kubelet 使用小写的主机名作为节点名称
+func expectedNodes(conf *asset.ClusterAsset) []string {
+	var names []string
+	for _, nodes := range [][]asset.NodeAsset{conf.Master, conf.Worker} {
+		for _, node := range nodes {
+			names = append(names, strings.ToLower(node.Hostname))
+		}
+	}
+	return names
+}
+
+func nodesCheck(client kubernetes.Interface, conf *asset.ClusterAsset) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		return nodesReady(nodes.Items, expectedNodes(conf))
+	}
+}
+
+// nodesReady 检查所有节点均已加入集群并处于 Ready 状态
+func nodesReady(nodes []corev1.Node, expected []string) error {
+	registered := make(map[string]*corev1.Node, len(nodes))
+	for i := range nodes {
+		registered[nodes[i].Name] = &nodes[i]
+	}
+
+	var missing, notReady []string
+	for _, name := range expected {
+		node, ok := registered[name]
+		if !ok {
+			missing = append(missing, name)
+		} else if !nodeReady(node) {
+			notReady = append(notReady, name)
+		}
+	}
+	var reasons []string
+	if len(missing) > 0 {
+		reasons = append(reasons, "nodes not registered: "+strings.Join(missing, ", "))
+	}
+	if len(notReady) > 0 {
+		reasons = append(reasons, "nodes not ready: "+strings.Join(notReady, ", "))
+	}
+	if len(reasons) > 0 {
+		return fmt.Errorf("%s", strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func controlPlaneCheck(client kubernetes.Interface, conf *asset.ClusterAsset) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var notReady []string
+		for _, master := range conf.Master {
+			for _, component := range controlPlaneComponents {
+				name := component + "-" + strings.ToLower(master.Hostname)
+				pod, err := client.CoreV1().Pods(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+				if err != nil || !podReady(pod) {
+					notReady = append(notReady, name)
+				}
+			}
+		}
+		if len(notReady) > 0 {
+			return fmt.Errorf("control plane components not ready: %s", strings.Join(notReady, ", "))
+		}
+		return nil
+	}
+}
+
+/*
+etcdCheck 通过 master 节点上 etcd 客户端端口的 v3 JSON 网关查询 etcd 的成员列表。
+客户端证书由 etcd CA 签发，过期前重新签发，避免每次检查都经过签发方
+*/
+func etcdCheck(conf *asset.ClusterAsset) func(ctx context.Context) error {
+	var httpClient *http.Client
+	var renewAt time.Time
+	return func(ctx context.Context) error {
+		if httpClient == nil || time.Now().After(renewAt) {
+			issuer, err := cert.NewEtcdCAIssuer(conf)
+			if err != nil {
+				return err
+			}
+			tlsConfig, err := cert.EtcdClientTLSConfig(issuer, etcdClientCertValidity)
+			if err != nil {
+				return err
+			}
+			httpClient = &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}, Timeout: etcdRequestTimeout}
+			renewAt = time.Now().Add(etcdClientCertValidity / 2)
+		}
+
+		var endpoints []string
+		for _, master := range conf.Master {
+			endpoints = append(endpoints, "https://"+net.JoinHostPort(master.IP, etcdClientPort))
+		}
+		members, err := listEtcdMembers(ctx, httpClient, endpoints)
+		if err != nil {
+			return err
+		}
+		return etcdMembersReady(members, len(conf.Master))
+	}
+}
+
+// etcdMember etcd v3 JSON 网关返回的集群成员，尚未启动的成员没有名称
+type etcdMember struct {
+	Name      string `json:"name"`
+	IsLearner bool   `json:"isLearner"`
+}
+
+// listEtcdMembers 依次向各 etcd 客户端地址查询成员列表，返回第一个成功的结果
+func listEtcdMembers(ctx context.Context, httpClient *http.Client, endpoints []string) ([]etcdMember, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no etcd endpoint")
+	}
+	var lastErr error
+	for _, endpoint := range endpoints {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/v3/cluster/member/list", strings.NewReader("{}"))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			lastErr = fmt.Errorf("etcd %s returned %s: %s", endpoint, resp.Status, strings.TrimSpace(string(body)))
+			continue
+		}
+		var result struct {
+			Members []etcdMember `json:"members"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("invalid member list of etcd %s: %v", endpoint, err)
+		}
+		return result.Members, nil
+	}
+	return nil, fmt.Errorf("failed to list etcd members: %v", lastErr)
+}
+
+// etcdMembersReady 检查 etcd 成员的数量与 master 节点的数量一致，且均已启动并参与投票，kubeadm 在每个 master 节点上运行一个 etcd 成员
+func etcdMembersReady(members []etcdMember, masters int) error {
+	var notStarted, learners []string
+	for i, member := range members {
+		switch {
+		case member.Name == "":
+			notStarted = append(notStarted, fmt.Sprintf("#%d", i+1))
+		case member.IsLearner:
+			learners = append(learners, member.Name)
+		}
+	}
+	if len(members) != masters {
+		return fmt.Errorf("etcd has %d members, %d masters are expected", len(members), masters)
+	}
+	if len(notStarted) > 0 || len(learners) > 0 {
+		return fmt.Errorf("etcd members not started: [%s], learners: [%s]", strings.Join(notStarted, ", "), strings.Join(learners, ", "))
+	}
+	return nil
+}
+
+func networkPluginCheck(client kubernetes.Interface) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		total, notReady, err := workloads(ctx, client, cni.ApplySet)
+		if err != nil {
+			return err
+		}
+		if total == 0 {
+			return fmt.Errorf("no workload of the network plugin is found")
+		}
+		if len(notReady) > 0 {
+			return fmt.Errorf("network plugin workloads not ready: %s", strings.Join(notReady, ", "))
+		}
+		return nil
+	}
+}
+
+// workloads 返回属于 apply set 的 Deployment、DaemonSet 和 StatefulSet 的数量，以及其中未就绪的工作负载
+func workloads(ctx context.Context, client kubernetes.Interface, applySet string) (int, []string, error) {
+	options := metav1.ListOptions{LabelSelector: kubeclient.ApplySetLabel + "=" + applySet}
+	apps := client.AppsV1()
+	var notReady []string
+
+	deployments, err := apps.Deployments(metav1.NamespaceAll).List(ctx, options)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := range deployments.Items {
+		if d := &deployments.Items[i]; !kubeclient.DeploymentReady(d) {
+			notReady = append(notReady, "Deployment "+d.Namespace+"/"+d.Name)
+		}
+	}
+	daemonSets, err := apps.DaemonSets(metav1.NamespaceAll).List(ctx, options)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := range daemonSets.Items {
+		if ds := &daemonSets.Items[i]; !kubeclient.DaemonSetReady(ds) {
+			notReady = append(notReady, "DaemonSet "+ds.Namespace+"/"+ds.Name)
+		}
+	}
+	statefulSets, err := apps.StatefulSets(metav1.NamespaceAll).List(ctx, options)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := range statefulSets.Items {
+		if sts := &statefulSets.Items[i]; !kubeclient.StatefulSetReady(sts) {
+			notReady = append(notReady, "StatefulSet "+sts.Namespace+"/"+sts.Name)
+		}
+	}
+	return len(deployments.Items) + len(daemonSets.Items) + len(statefulSets.Items), notReady, nil
+}
+
+// coreDNSCheck 检查 CoreDNS 就绪：Deployment 可用、kube-dns 服务有就绪的端点，且每个 CoreDNS Pod 的 ready 插件（8181 端口）通过 API Server 代理返回就绪，即已与 API Server 同步，可以解析集群内的域名
+func coreDNSCheck(client kubernetes.Interface) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		deploy, err := client.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, "coredns", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !kubeclient.DeploymentReady(deploy) {
+			return fmt.Errorf("%d of %d coredns replicas are available", deploy.Status.AvailableReplicas, deploy.Status.Replicas)
+		}
+
+		endpoints, err := client.CoreV1().Endpoints(metav1.NamespaceSystem).Get(ctx, "kube-dns", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		addresses := 0
+		for _, subset := range endpoints.Subsets {
+			addresses += len(subset.Addresses)
+		}
+		if addresses == 0 {
+			return fmt.Errorf("service kube-dns has no ready endpoints")
+		}
+
+		pods, err := client.CoreV1().Pods(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{LabelSelector: "k8s-app=kube-dns"})
+		if err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			if _, err := client.CoreV1().Pods(metav1.NamespaceSystem).ProxyGet("http", pod.Name, "8181", "ready", nil).DoRaw(ctx); err != nil {
+				return fmt.Errorf("coredns pod %s is not ready to serve: %v", pod.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+func systemPodsCheck(client kubernetes.Interface) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		pods, err := client.CoreV1().Pods(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		if notReady := notReadyPods(pods.Items); len(notReady) > 0 {
+			return fmt.Errorf("pods not ready in %s: %s", metav1.NamespaceSystem, strings.Join(notReady, ", "))
+		}
+		return nil
+	}
+}
+
+// notReadyPods 返回未就绪的 Pod，已完成的 Pod 不需要就绪
+func notReadyPods(pods []corev1.Pod) []string {
+	var names []string
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+		if !podReady(pod) {
+			names = append(names, pod.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func addonsCheck(client kubernetes.Interface, conf *asset.ClusterAsset) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var reasons []string
+		for i := range conf.Addons {
+			a := &conf.Addons[i]
+			if !a.IsEnabled() {
+				continue
+			}
+			if a.Status.Phase != asset.AddonInstalled {
+				reasons = append(reasons, fmt.Sprintf("addon %s is not installed: %s", a.Name, a.Status.Message))
+				continue
+			}
+			_, notReady, err := workloads(ctx, client, addon.ApplySet(a.Name))
+			if err != nil {
+				return err
+			}
+			if len(notReady) > 0 {
+				reasons = append(reasons, fmt.Sprintf("addon %s workloads not ready: %s", a.Name, strings.Join(notReady, ", ")))
+			}
+		}
+		if len(reasons) > 0 {
+			return fmt.Errorf("%s", strings.Join(reasons, "; "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 检查结果的状态
+const (
+	StatusReady    = "Ready"
+	StatusNotReady = "NotReady"
+	StatusSkipped  = "Skipped"
+)
+
+const defaultInterval = 5 * time.Second
+
+// Check 就绪检查项。Run 在超时前按 Interval 重复执行，返回 nil 表示已就绪，否则返回未就绪的原因
+type Check struct {
+	Name     string
+	Timeout  time.Duration
+	Interval time.Duration // 默认为 5 秒
+	Blocking bool          // 未就绪时跳过之后的检查项
+	Skip     bool          // 跳过该检查项
+	Run      func(ctx context.Context) error
+}
+
+// Result 检查项的结果
+type Result struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report 集群的健康报告
+type Report struct {
+	ClusterID string   `json:"clusterID"`
+	Healthy   bool     `json:"healthy"`
+	CheckedAt string   `json:"checkedAt"`
+	Checks    []Result `json:"checks"`
+
+	blocked string // 未就绪的阻塞检查项
+}
+
+// NewReport 创建集群的健康报告
+func NewReport(clusterID string) *Report {
+	return &Report{ClusterID: clusterID, Healthy: true}
+}
+
+/*
+Run 按顺序执行检查项并将结果记录到报告中，所有检查项就绪时返回 true。
+阻塞的检查项未就绪时，之后的检查项（包括之后再次调用 Run 时的检查项）被跳过
+*/
+func (r *Report) Run(ctx context.Context, checks []Check) bool {
+	ready := r.blocked == ""
+	for _, check := range checks {
+		var result Result
+		switch {
+		case r.blocked != "":
+			result = Result{Name: check.Name, Status: StatusSkipped, Message: fmt.Sprintf("%s is not ready", r.blocked)}
+		case check.Skip:
+			result = Result{Name: check.Name, Status: StatusSkipped, Message: "skipped by configuration"}
+		default:
+			result = run(ctx, check)
+		}
+		if result.Duration == "" {
+			result.Duration = "0s"
+		}
+		if result.Status == StatusNotReady {
+			ready = false
+			if check.Blocking {
+				r.blocked = check.Name
+			}
+		}
+		r.Checks = append(r.Checks, result)
+	}
+	if !ready {
+		r.Healthy = false
+	}
+	r.CheckedAt = time.Now().Format(time.RFC3339)
+	return ready
+}
+
+// run 重复执行检查项直到就绪或超时
+func run(ctx context.Context, check Check) Result {
+	interval := check.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	logrus.Infof("Waiting up to %v for %s ready...", check.Timeout, check.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+	start := time.Now()
+	result := Result{Name: check.Name, Status: StatusReady}
+	var lastErr error
+	for {
+		err := check.Run(ctx)
+		if err == nil {
+			break
+		}
+		// 超时中断的请求返回的错误不能说明未就绪的原因
+		if lastErr == nil || ctx.Err() == nil {
+			lastErr = err
+		}
+		logrus.Debugf("Still waiting for %s: %v", check.Name, err)
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Status, result.Message = StatusNotReady, lastErr.Error()
+		case <-timer.C:
+		}
+		if result.Status == StatusNotReady {
+			break
+		}
+	}
+	result.Duration = time.Since(start).Round(time.Second).String()
+	if result.Status == StatusReady {
+		logrus.Infof("%s is ready", check.Name)
+	} else {
+		logrus.Errorf("%s is not ready within %v: %s", check.Name, check.Timeout, result.Message)
+	}
+	return result
+}
+
+// Failed 返回未就绪的检查项
+func (r *Report) Failed() []string {
+	var names []string
+	for _, result := range r.Checks {
+		if result.Status == StatusNotReady {
+			names = append(names, result.Name)
+		}
+	}
+	return names
+}
+
+// WriteText 以表格形式输出健康报告
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	health := "healthy"
+	if !r.Healthy {
+		health = "unhealthy"
+	}
+	fmt.Fprintf(tw, "Cluster %s is %s\n", r.ClusterID, health)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tDURATION\tMESSAGE")
+	for _, result := range r.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Name, result.Status, result.Duration, result.Message)
+	}
+	return tw.Flush()
+}
+
+// JSON 返回 JSON 格式的健康报告
+func (r *Report) JSON() ([]byte, error) {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
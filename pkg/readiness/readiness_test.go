@@ -0,0 +1,283 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"nestos-kubernetes-deployer/pkg/configmanager/asset"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func readyPod(name string, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func readyNode(name string, ready bool) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}},
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	ready := func(ctx context.Context) error { return nil }
+	notReady := func(ctx context.Context) error { return errors.New("2 of 3 replicas are ready") }
+
+	t.Run("Run Success", func(t *testing.T) {
+		calls := 0
+		report := NewReport("cluster")
+		ok := report.Run(context.Background(), []Check{{
+			Name:     "flaky",
+			Timeout:  time.Second,
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				if calls++; calls < 3 {
+					return errors.New("not yet")
+				}
+				return nil
+			},
+		}})
+		if !ok || !report.Healthy || report.Checks[0].Status != StatusReady || calls != 3 {
+			t.Errorf("unexpected report: %+v", report)
+		}
+	})
+
+	t.Run("Run Fail", func(t *testing.T) {
+		report := NewReport("cluster")
+		checks := []Check{
+			{Name: "first", Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond, Run: notReady},
+			{Name: "second", Timeout: time.Second, Run: ready},
+			{Name: "third", Skip: true, Run: notReady},
+		}
+		if report.Run(context.Background(), checks) || report.Healthy {
+			t.Fatalf("report should be unhealthy: %+v", report)
+		}
+		results := report.Checks
+		if results[0].Status != StatusNotReady || results[0].Message != "2 of 3 replicas are ready" {
+			t.Errorf("unexpected result: %+v", results[0])
+		}
+		if results[1].Status != StatusReady || results[2].Status != StatusSkipped {
+			t.Errorf("unexpected results: %+v", results)
+		}
+		if failed := report.Failed(); len(failed) != 1 || failed[0] != "first" {
+			t.Errorf("unexpected failed checks: %v", failed)
+		}
+	})
+
+	t.Run("Run Blocking Fail", func(t *testing.T) {
+		report := NewReport("cluster")
+		report.Run(context.Background(), []Check{{Name: CheckAPIServer, Blocking: true, Timeout: 20 * time.Millisecond, Interval: 10 * time.Millisecond, Run: notReady}})
+		if report.Run(context.Background(), []Check{{Name: CheckNodes, Timeout: time.Second, Run: ready}}) {
+			t.Fatal("checks after a failed blocking check should not be ready")
+		}
+		if result := report.Checks[1]; result.Status != StatusSkipped || result.Message != "api-server is not ready" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("Report Output Success", func(t *testing.T) {
+		report := NewReport("cluster")
+		report.Run(context.Background(), []Check{{Name: CheckNodes, Timeout: time.Second, Run: ready}, {Name: CheckCoreDNS, Skip: true}})
+
+		var buf bytes.Buffer
+		if err := report.WriteText(&buf); err != nil {
+			t.Fatalf("WriteText failed: %v", err)
+		}
+		text := buf.String()
+		for _, s := range []string{"Cluster cluster is healthy", "CHECK", "nodes", "Ready", "coredns", "skipped by configuration"} {
+			if !strings.Contains(text, s) {
+				t.Errorf("text report does not contain %q:\n%s", s, text)
+			}
+		}
+
+		content, err := report.JSON()
+		if err != nil {
+			t.Fatalf("JSON failed: %v", err)
+		}
+		var decoded Report
+		if err := json.Unmarshal(content, &decoded); err != nil {
+			t.Fatalf("invalid JSON report: %v", err)
+		}
+		if decoded.ClusterID != "cluster" || !decoded.Healthy || len(decoded.Checks) != 2 || decoded.Checks[1].Status != StatusSkipped {
+			t.Errorf("unexpected JSON report: %s", content)
+		}
+	})
+
+	t.Run("Validate Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{Readiness: asset.ReadinessAsset{
+			Timeouts:     map[string]string{CheckAPIServer: "30m", CheckNodes: "1h"},
+			Skip:         []string{CheckCoreDNS},
+			ReportFormat: ReportJSON,
+		}}
+		if err := Validate(conf); err != nil {
+			t.Fatalf("Validate failed: %v", err)
+		}
+
+		checks, err := ClusterChecks(conf, nil)
+		if err != nil {
+			t.Fatalf("ClusterChecks failed: %v", err)
+		}
+		if len(checks) != len(Names()) || checks[0].Name != CheckAPIServer || !checks[0].Blocking {
+			t.Fatalf("unexpected checks: %+v", checks)
+		}
+		for _, check := range checks {
+			switch check.Name {
+			case CheckAPIServer:
+				if check.Timeout != 30*time.Minute {
+					t.Errorf("unexpected timeout of %s: %v", check.Name, check.Timeout)
+				}
+			case CheckNodes:
+				if check.Timeout != time.Hour {
+					t.Errorf("unexpected timeout of %s: %v", check.Name, check.Timeout)
+				}
+			case CheckCoreDNS:
+				if !check.Skip {
+					t.Errorf("%s should be skipped", check.Name)
+				}
+			default:
+				if check.Timeout != defaultTimeouts[check.Name] || check.Skip || check.Blocking {
+					t.Errorf("unexpected check: %+v", check)
+				}
+			}
+		}
+	})
+
+	t.Run("Validate Fail", func(t *testing.T) {
+		for _, readiness := range []asset.ReadinessAsset{
+			{Timeouts: map[string]string{"dns": "10m"}},
+			{Timeouts: map[string]string{CheckNodes: "ten minutes"}},
+			{Timeouts: map[string]string{CheckNodes: "-1m"}},
+			{Skip: []string{"dns"}},
+			{Skip: []string{CheckAPIServer}},
+			{ReportFormat: "yaml"},
+		} {
+			if err := Validate(&asset.ClusterAsset{Readiness: readiness}); err == nil {
+				t.Errorf("Validate should fail: %+v", readiness)
+			}
+		}
+	})
+
+	t.Run("Nodes Success", func(t *testing.T) {
+		conf := &asset.ClusterAsset{
+			Master: []asset.NodeAsset{{Hostname: "K8s-Master01"}},
+			Worker: []asset.NodeAsset{{Hostname: "k8s-worker01"}},
+		}
+		nodes := []corev1.Node{readyNode("k8s-master01", true), readyNode("k8s-worker01", true), readyNode("other", false)}
+		if err := nodesReady(nodes, expectedNodes(conf)); err != nil {
+			t.Errorf("nodesReady failed: %v", err)
+		}
+	})
+
+	t.Run("Nodes Fail", func(t *testing.T) {
+		nodes := []corev1.Node{readyNode("k8s-master01", true), readyNode("k8s-worker01", false)}
+		err := nodesReady(nodes, []string{"k8s-master01", "k8s-worker01", "k8s-worker02"})
+		if err == nil || !strings.Contains(err.Error(), "not registered: k8s-worker02") || !strings.Contains(err.Error(), "not ready: k8s-worker01") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Etcd Success", func(t *testing.T) {
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/v3/cluster/member/list" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(`{"header":{"cluster_id":"1"},"members":[{"ID":"1","name":"k8s-master01","clientURLs":["https://192.0.2.11:2379"]},` +
+				`{"ID":"2","name":"k8s-master02"},{"ID":"3","name":"k8s-master03"}]}`))
+		}))
+		defer ts.Close()
+
+		// 第一个地址不可达时使用下一个地址
+		members, err := listEtcdMembers(context.Background(), ts.Client(), []string{"https://127.0.0.1:1", ts.URL})
+		if err != nil {
+			t.Fatalf("listEtcdMembers failed: %v", err)
+		}
+		if err := etcdMembersReady(members, 3); err != nil {
+			t.Errorf("etcdMembersReady failed: %v", err)
+		}
+	})
+
+	t.Run("Etcd Fail", func(t *testing.T) {
+		members := []etcdMember{{Name: "k8s-master01"}, {Name: ""}, {Name: "k8s-master03", IsLearner: true}}
+		if err := etcdMembersReady(members, 3); err == nil || !strings.Contains(err.Error(), "k8s-master03") {
+			t.Errorf("etcdMembersReady should fail with a member not started and a learner, got %v", err)
+		}
+		if err := etcdMembersReady([]etcdMember{{Name: "k8s-master01"}}, 3); err == nil {
+			t.Error("etcdMembersReady should fail with missing members")
+		}
+
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "etcdserver: unhealthy cluster", http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+		if _, err := listEtcdMembers(context.Background(), ts.Client(), []string{ts.URL}); err == nil || !strings.Contains(err.Error(), "unhealthy") {
+			t.Errorf("listEtcdMembers should fail for an unavailable etcd, got %v", err)
+		}
+		if err := etcdCheck(&asset.ClusterAsset{ClusterID: "cluster"})(context.Background()); err == nil {
+			t.Error("etcdCheck should fail without the etcd CA")
+		}
+	})
+
+	t.Run("SystemPods Success", func(t *testing.T) {
+		job := readyPod("job", false)
+		job.Status.Phase = corev1.PodSucceeded
+		pods := []corev1.Pod{readyPod("kube-proxy-a", true), job, readyPod("coredns-b", false), readyPod("coredns-a", false)}
+		if notReady := notReadyPods(pods); strings.Join(notReady, ",") != "coredns-a,coredns-b" {
+			t.Errorf("unexpected pods not ready: %v", notReady)
+		}
+	})
+
+	t.Run("Addons Fail", func(t *testing.T) {
+		disabled := asset.AddonAsset{Name: "nfs-csi"}
+		disabled.SetEnabled(false)
+		conf := &asset.ClusterAsset{Addons: []asset.AddonAsset{
+			disabled,
+			{Name: "metrics-server", Status: asset.AddonStatus{Phase: asset.AddonFailed, Message: "timed out"}},
+		}}
+		err := addonsCheck(nil, conf)(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "metrics-server is not installed: timed out") || strings.Contains(err.Error(), "nfs-csi") {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := addonsCheck(nil, &asset.ClusterAsset{})(context.Background()); err != nil {
+			t.Errorf("addonsCheck without addons failed: %v", err)
+		}
+	})
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpmrepo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// RepodataDir 为仓库元数据所在目录
+	RepodataDir = "repodata"
+
+	commonNamespace    = "http://linux.duke.edu/metadata/common"
+	rpmNamespace       = "http://linux.duke.edu/metadata/rpm"
+	filelistsNamespace = "http://linux.duke.edu/metadata/filelists"
+	repoNamespace      = "http://linux.duke.edu/metadata/repo"
+	checksumType       = "sha256"
+)
+
+// Repository 为软件包目录生成的 yum 仓库元数据，Files 以仓库内的相对路径为索引
+type Repository struct {
+	Packages []*Package
+	Files    map[string][]byte
+}
+
+// Build 读取目录（含子目录）中的RPM软件包，生成 primary、filelists 及 repomd.xml 元数据。
+// 元数据仅保存在内存中，不写入软件包目录。
+func Build(dir string) (*Repository, error) {
+	var (
+		primary   = primaryMetadata{XMLNS: commonNamespace, XMLNSRpm: rpmNamespace}
+		filelists = filelistsMetadata{XMLNS: filelistsNamespace}
+		repo      = &Repository{Files: map[string][]byte{}}
+	)
+
+	var paths []string
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == RepodataDir {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && strings.HasSuffix(info.Name(), ".rpm") && !strings.HasSuffix(info.Name(), ".src.rpm") {
+			paths = append(paths, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read package directory %s", dir)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return nil, err
+		}
+		pkg, checksum, info, err := readPackageFile(p)
+		if err != nil {
+			logrus.Warnf("Skipping %s: %v", p, err)
+			continue
+		}
+		repo.Packages = append(repo.Packages, pkg)
+		primary.Packages = append(primary.Packages, newPrimaryPackage(pkg, checksum, filepath.ToSlash(rel), info))
+		filelists.Packages = append(filelists.Packages, newFilelistsPackage(pkg, checksum))
+	}
+	primary.Count = len(primary.Packages)
+	filelists.Count = len(filelists.Packages)
+
+	now := time.Now().Unix()
+	repomd := repomdMetadata{
+		XMLNS:    repoNamespace,
+		XMLNSRpm: rpmNamespace,
+		Revision: fmt.Sprint(now),
+	}
+	for _, md := range []struct {
+		name string
+		data interface{}
+	}{
+		{"primary", primary},
+		{"filelists", filelists},
+	} {
+		data, err := marshal(md.data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate %s metadata", md.name)
+		}
+		compressed, err := compress(data)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256Hex(compressed)
+		href := path.Join(RepodataDir, sum+"-"+md.name+".xml.gz")
+		repo.Files[href] = compressed
+		repomd.Data = append(repomd.Data, repomdData{
+			Type:         md.name,
+			Checksum:     checksum{Type: checksumType, Value: sum},
+			OpenChecksum: checksum{Type: checksumType, Value: sha256Hex(data)},
+			Location:     location{Href: href},
+			Timestamp:    now,
+			Size:         len(compressed),
+			OpenSize:     len(data),
+		})
+	}
+
+	data, err := marshal(repomd)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate repomd.xml")
+	}
+	repo.Files[path.Join(RepodataDir, "repomd.xml")] = data
+
+	return repo, nil
+}
+
+func readPackageFile(p string) (*Package, string, os.FileInfo, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, "", nil, err
+	}
+	pkg, err := ReadPackage(f)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	// 软件包校验和为整个文件的 sha256 摘要
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, "", nil, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, "", nil, err
+	}
+	return pkg, hex.EncodeToString(h.Sum(nil)), info, nil
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// primaryFile 判断文件是否写入 primary 元数据，与 createrepo 的规则一致
+func primaryFile(p string) bool {
+	return strings.HasPrefix(p, "/etc/") || strings.Contains(p, "bin/") || p == "/usr/lib/sendmail"
+}
+
+func newPrimaryPackage(pkg *Package, sum string, href string, info os.FileInfo) primaryPackage {
+	p := primaryPackage{
+		Type:        "rpm",
+		Name:        pkg.Name,
+		Arch:        pkg.Arch,
+		Version:     newVersion(pkg),
+		Checksum:    checksum{Type: checksumType, PkgID: "YES", Value: sum},
+		Summary:     pkg.Summary,
+		Description: pkg.Description,
+		Packager:    pkg.Packager,
+		URL:         pkg.URL,
+		Time:        packageTime{File: info.ModTime().Unix(), Build: pkg.BuildTime},
+		Size:        packageSize{Package: info.Size(), Installed: pkg.InstalledSize, Archive: pkg.ArchiveSize},
+		Location:    location{Href: href},
+		Format: primaryFormat{
+			License:     pkg.License,
+			Vendor:      pkg.Vendor,
+			Group:       pkg.Group,
+			BuildHost:   pkg.BuildHost,
+			SourceRPM:   pkg.SourceRPM,
+			HeaderRange: headerRange{Start: pkg.HeaderStart, End: pkg.HeaderEnd},
+			Provides:    newEntries(pkg.Provides),
+			Requires:    newEntries(pkg.Requires),
+			Conflicts:   newEntries(pkg.Conflicts),
+			Obsoletes:   newEntries(pkg.Obsoletes),
+		},
+	}
+	for _, f := range pkg.Files {
+		if primaryFile(f.Path) {
+			p.Format.Files = append(p.Format.Files, newFileEntry(f))
+		}
+	}
+	return p
+}
+
+func newFilelistsPackage(pkg *Package, sum string) filelistsPackage {
+	p := filelistsPackage{
+		PkgID:   sum,
+		Name:    pkg.Name,
+		Arch:    pkg.Arch,
+		Version: newVersion(pkg),
+	}
+	for _, f := range pkg.Files {
+		p.Files = append(p.Files, newFileEntry(f))
+	}
+	return p
+}
+
+func newVersion(pkg *Package) version {
+	return version{Epoch: pkg.Epoch, Ver: pkg.Version, Rel: pkg.Release}
+}
+
+func newEntries(deps []Dependency) *entries {
+	if len(deps) == 0 {
+		return nil
+	}
+	e := &entries{}
+	for _, dep := range deps {
+		entry := entry{Name: dep.Name, Flags: dep.Flags}
+		if dep.Flags != "" {
+			entry.Epoch, entry.Ver, entry.Rel = dep.Epoch, dep.Version, dep.Release
+		}
+		if dep.Pre {
+			entry.Pre = "1"
+		}
+		e.Entries = append(e.Entries, entry)
+	}
+	return e
+}
+
+func newFileEntry(f PackageFile) fileEntry {
+	entry := fileEntry{Path: f.Path}
+	if f.Ghost {
+		entry.Type = "ghost"
+	} else if f.Dir {
+		entry.Type = "dir"
+	}
+	return entry
+}
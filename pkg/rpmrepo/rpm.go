@@ -0,0 +1,449 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpmrepo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RPM 文件格式参见 https://rpm-software-management.github.io/rpm/manual/format.html
+const (
+	leadSize       = 96
+	headerIntroLen = 16
+	indexEntryLen  = 16
+	maxHeaderSize  = 256 << 20
+
+	typeInt8        = 2
+	typeInt16       = 3
+	typeInt32       = 4
+	typeInt64       = 5
+	typeString      = 6
+	typeStringArray = 8
+	typeI18NString  = 9
+)
+
+var (
+	leadMagic   = []byte{0xed, 0xab, 0xee, 0xdb}
+	headerMagic = []byte{0x8e, 0xad, 0xe8, 0x01}
+)
+
+// 主头部中使用的标签
+const (
+	tagName            = 1000
+	tagVersion         = 1001
+	tagRelease         = 1002
+	tagEpoch           = 1003
+	tagSummary         = 1004
+	tagDescription     = 1005
+	tagBuildTime       = 1006
+	tagBuildHost       = 1007
+	tagSize            = 1009
+	tagVendor          = 1011
+	tagLicense         = 1014
+	tagPackager        = 1015
+	tagGroup           = 1016
+	tagURL             = 1020
+	tagArch            = 1022
+	tagFileModes       = 1030
+	tagFileFlags       = 1037
+	tagSourceRPM       = 1044
+	tagArchiveSize     = 1046
+	tagProvideName     = 1047
+	tagRequireFlags    = 1048
+	tagRequireName     = 1049
+	tagRequireVersion  = 1050
+	tagConflictFlags   = 1053
+	tagConflictName    = 1054
+	tagConflictVersion = 1055
+	tagObsoleteName    = 1090
+	tagSourcePackage   = 1106
+	tagProvideFlags    = 1112
+	tagProvideVersion  = 1113
+	tagObsoleteFlags   = 1114
+	tagObsoleteVersion = 1115
+	tagDirIndexes      = 1116
+	tagBaseNames       = 1117
+	tagDirNames        = 1118
+	tagLongSize        = 5009
+
+	// 签名头部中的标签
+	sigTagPayloadSize     = 1007
+	sigTagLongArchiveSize = 271
+)
+
+// 依赖关系标志位
+const (
+	senseLess       = 1 << 1
+	senseGreater    = 1 << 2
+	senseEqual      = 1 << 3
+	sensePrereq     = 1 << 6
+	senseScriptPre  = 1 << 9
+	senseScriptPost = 1 << 10
+	senseRpmlib     = 1 << 24
+
+	fileGhost = 1 << 6
+	modeDir   = 0040000
+	modeMask  = 0170000
+)
+
+// Dependency 为软件包的一条依赖关系（provides、requires、conflicts、obsoletes）
+type Dependency struct {
+	Name    string
+	Flags   string // EQ、LT、LE、GT、GE，无版本约束时为空
+	Epoch   string
+	Version string
+	Release string
+	Pre     bool
+}
+
+// PackageFile 为软件包中的文件
+type PackageFile struct {
+	Path  string
+	Dir   bool
+	Ghost bool
+}
+
+// Package 为生成仓库元数据所需的软件包信息
+type Package struct {
+	Name        string
+	Arch        string
+	Epoch       string
+	Version     string
+	Release     string
+	Summary     string
+	Description string
+	Packager    string
+	URL         string
+	License     string
+	Vendor      string
+	Group       string
+	BuildHost   string
+	SourceRPM   string
+	BuildTime   uint64
+	// 头部在文件中的起止位置
+	HeaderStart uint64
+	HeaderEnd   uint64
+	// 安装后大小与 cpio 归档大小
+	InstalledSize uint64
+	ArchiveSize   uint64
+
+	Provides  []Dependency
+	Requires  []Dependency
+	Conflicts []Dependency
+	Obsoletes []Dependency
+	Files     []PackageFile
+}
+
+type indexEntry struct {
+	Tag    int32
+	Type   uint32
+	Offset int32
+	Count  uint32
+}
+
+type header struct {
+	entries map[int32]indexEntry
+	store   []byte
+}
+
+// readHeader 读取一个头部结构，返回头部及其占用的字节数
+func readHeader(r io.Reader) (*header, int, error) {
+	intro := make([]byte, headerIntroLen)
+	if _, err := io.ReadFull(r, intro); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to read rpm header")
+	}
+	if !bytes.Equal(intro[:4], headerMagic) {
+		return nil, 0, errors.New("invalid rpm header magic")
+	}
+	nindex := binary.BigEndian.Uint32(intro[8:12])
+	hsize := binary.BigEndian.Uint32(intro[12:16])
+	if uint64(nindex)*indexEntryLen+uint64(hsize) > maxHeaderSize {
+		return nil, 0, errors.New("rpm header is too large")
+	}
+
+	index := make([]byte, int(nindex)*indexEntryLen)
+	if _, err := io.ReadFull(r, index); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to read rpm header index")
+	}
+	h := &header{
+		entries: make(map[int32]indexEntry, nindex),
+		store:   make([]byte, hsize),
+	}
+	if _, err := io.ReadFull(r, h.store); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to read rpm header store")
+	}
+	for i := 0; i < int(nindex); i++ {
+		e := index[i*indexEntryLen:]
+		entry := indexEntry{
+			Tag:    int32(binary.BigEndian.Uint32(e[0:4])),
+			Type:   binary.BigEndian.Uint32(e[4:8]),
+			Offset: int32(binary.BigEndian.Uint32(e[8:12])),
+			Count:  binary.BigEndian.Uint32(e[12:16]),
+		}
+		if entry.Offset < 0 || int(entry.Offset) > len(h.store) {
+			return nil, 0, fmt.Errorf("invalid offset of rpm header tag %d", entry.Tag)
+		}
+		h.entries[entry.Tag] = entry
+	}
+	return h, headerIntroLen + len(index) + len(h.store), nil
+}
+
+func (h *header) strings(tag int32) []string {
+	entry, ok := h.entries[tag]
+	if !ok {
+		return nil
+	}
+	switch entry.Type {
+	case typeString, typeStringArray, typeI18NString:
+	default:
+		return nil
+	}
+	data := h.store[entry.Offset:]
+	// 每个字符串至少占用结尾的一个字节，数量不超过剩余数据的长度
+	count := len(data)
+	if uint64(entry.Count) < uint64(count) {
+		count = int(entry.Count)
+	}
+	values := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		end := bytes.IndexByte(data, 0)
+		if end < 0 {
+			break
+		}
+		values = append(values, string(data[:end]))
+		data = data[end+1:]
+	}
+	return values
+}
+
+func (h *header) string(tag int32) string {
+	if values := h.strings(tag); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (h *header) ints(tag int32) []uint64 {
+	entry, ok := h.entries[tag]
+	if !ok {
+		return nil
+	}
+	var size int
+	switch entry.Type {
+	case typeInt8:
+		size = 1
+	case typeInt16:
+		size = 2
+	case typeInt32:
+		size = 4
+	case typeInt64:
+		size = 8
+	default:
+		return nil
+	}
+	data := h.store[entry.Offset:]
+	if len(data) < size*int(entry.Count) {
+		return nil
+	}
+	values := make([]uint64, entry.Count)
+	for i := range values {
+		v := data[i*size : (i+1)*size]
+		switch size {
+		case 1:
+			values[i] = uint64(v[0])
+		case 2:
+			values[i] = uint64(binary.BigEndian.Uint16(v))
+		case 4:
+			values[i] = uint64(binary.BigEndian.Uint32(v))
+		case 8:
+			values[i] = binary.BigEndian.Uint64(v)
+		}
+	}
+	return values
+}
+
+func (h *header) int(tag int32) (uint64, bool) {
+	if values := h.ints(tag); len(values) > 0 {
+		return values[0], true
+	}
+	return 0, false
+}
+
+// ReadPackage 读取RPM文件的签名头部与主头部
+func ReadPackage(r io.Reader) (*Package, error) {
+	lead := make([]byte, leadSize)
+	if _, err := io.ReadFull(r, lead); err != nil {
+		return nil, errors.Wrap(err, "failed to read rpm lead")
+	}
+	if !bytes.Equal(lead[:4], leadMagic) {
+		return nil, errors.New("not an rpm package")
+	}
+
+	sig, sigLen, err := readHeader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read signature header")
+	}
+	// 签名头部按8字节对齐
+	if pad := (8 - sigLen%8) % 8; pad > 0 {
+		if _, err := io.CopyN(io.Discard, r, int64(pad)); err != nil {
+			return nil, errors.Wrap(err, "failed to read signature header padding")
+		}
+		sigLen += pad
+	}
+
+	h, hdrLen, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := h.entries[tagSourcePackage]; ok {
+		return nil, errors.New("source packages are not supported")
+	}
+
+	pkg := &Package{
+		Name:        h.string(tagName),
+		Arch:        h.string(tagArch),
+		Version:     h.string(tagVersion),
+		Release:     h.string(tagRelease),
+		Summary:     h.string(tagSummary),
+		Description: h.string(tagDescription),
+		Packager:    h.string(tagPackager),
+		URL:         h.string(tagURL),
+		License:     h.string(tagLicense),
+		Vendor:      h.string(tagVendor),
+		Group:       h.string(tagGroup),
+		BuildHost:   h.string(tagBuildHost),
+		SourceRPM:   h.string(tagSourceRPM),
+		Epoch:       "0",
+		HeaderStart: uint64(leadSize + sigLen),
+	}
+	pkg.HeaderEnd = pkg.HeaderStart + uint64(hdrLen)
+	if pkg.Name == "" || pkg.Version == "" || pkg.Arch == "" {
+		return nil, errors.New("rpm header lacks name, version or arch")
+	}
+	if epoch, ok := h.int(tagEpoch); ok {
+		pkg.Epoch = fmt.Sprint(epoch)
+	}
+	pkg.BuildTime, _ = h.int(tagBuildTime)
+	if size, ok := h.int(tagLongSize); ok {
+		pkg.InstalledSize = size
+	} else {
+		pkg.InstalledSize, _ = h.int(tagSize)
+	}
+	if size, ok := sig.int(sigTagLongArchiveSize); ok {
+		pkg.ArchiveSize = size
+	} else if size, ok := sig.int(sigTagPayloadSize); ok {
+		pkg.ArchiveSize = size
+	} else {
+		pkg.ArchiveSize, _ = h.int(tagArchiveSize)
+	}
+
+	pkg.Provides = dependencies(h, tagProvideName, tagProvideFlags, tagProvideVersion)
+	pkg.Conflicts = dependencies(h, tagConflictName, tagConflictFlags, tagConflictVersion)
+	pkg.Obsoletes = dependencies(h, tagObsoleteName, tagObsoleteFlags, tagObsoleteVersion)
+	for _, dep := range dependencies(h, tagRequireName, tagRequireFlags, tagRequireVersion) {
+		// rpmlib() 依赖由 rpm 自身满足，不写入仓库元数据
+		if !strings.HasPrefix(dep.Name, "rpmlib(") {
+			pkg.Requires = append(pkg.Requires, dep)
+		}
+	}
+	pkg.Files = files(h)
+
+	return pkg, nil
+}
+
+func dependencies(h *header, nameTag, flagsTag, versionTag int32) []Dependency {
+	names := h.strings(nameTag)
+	flags := h.ints(flagsTag)
+	versions := h.strings(versionTag)
+
+	deps := make([]Dependency, 0, len(names))
+	for i, name := range names {
+		var flag uint64
+		if i < len(flags) {
+			flag = flags[i]
+		}
+		dep := Dependency{
+			Name: name,
+			Pre:  flag&(sensePrereq|senseScriptPre|senseScriptPost) != 0,
+		}
+		if i < len(versions) && versions[i] != "" {
+			dep.Flags = senseFlags(flag)
+			dep.Epoch, dep.Version, dep.Release = splitEVR(versions[i])
+		}
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
+func senseFlags(flag uint64) string {
+	switch flag & (senseLess | senseGreater | senseEqual) {
+	case senseEqual:
+		return "EQ"
+	case senseLess:
+		return "LT"
+	case senseLess | senseEqual:
+		return "LE"
+	case senseGreater:
+		return "GT"
+	case senseGreater | senseEqual:
+		return "GE"
+	}
+	return ""
+}
+
+// splitEVR 将 [epoch:]version[-release] 拆分为三部分
+func splitEVR(evr string) (epoch, version, release string) {
+	epoch = "0"
+	if i := strings.Index(evr, ":"); i >= 0 {
+		epoch, evr = evr[:i], evr[i+1:]
+	}
+	version = evr
+	if i := strings.LastIndex(evr, "-"); i >= 0 {
+		version, release = evr[:i], evr[i+1:]
+	}
+	return epoch, version, release
+}
+
+func files(h *header) []PackageFile {
+	baseNames := h.strings(tagBaseNames)
+	dirNames := h.strings(tagDirNames)
+	dirIndexes := h.ints(tagDirIndexes)
+	modes := h.ints(tagFileModes)
+	flags := h.ints(tagFileFlags)
+
+	result := make([]PackageFile, 0, len(baseNames))
+	for i, base := range baseNames {
+		if i >= len(dirIndexes) || int(dirIndexes[i]) >= len(dirNames) {
+			break
+		}
+		f := PackageFile{Path: dirNames[dirIndexes[i]] + base}
+		if i < len(modes) {
+			f.Dir = modes[i]&modeMask == modeDir
+		}
+		if i < len(flags) {
+			f.Ghost = flags[i]&fileGhost != 0
+		}
+		result = append(result, f)
+	}
+	return result
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpmrepo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testTag struct {
+	tag   int32
+	typ   uint32
+	value interface{}
+}
+
+// buildHeader 按RPM头部格式编码标签
+func buildHeader(tags []testTag) []byte {
+	var index, store bytes.Buffer
+	for _, t := range tags {
+		var count int
+		switch v := t.value.(type) {
+		case string:
+			count = 1
+			store.WriteString(v + "\x00")
+		case []string:
+			count = len(v)
+			for _, s := range v {
+				store.WriteString(s + "\x00")
+			}
+		case []uint32:
+			for store.Len()%4 != 0 {
+				store.WriteByte(0)
+			}
+			count = len(v)
+			t.writeIndex(&index, store.Len(), count)
+			binary.Write(&store, binary.BigEndian, v)
+			continue
+		case []uint16:
+			for store.Len()%2 != 0 {
+				store.WriteByte(0)
+			}
+			count = len(v)
+			t.writeIndex(&index, store.Len(), count)
+			binary.Write(&store, binary.BigEndian, v)
+			continue
+		}
+		offset := store.Len() - stringsLen(t.value)
+		t.writeIndex(&index, offset, count)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(headerMagic)
+	buf.Write([]byte{0, 0, 0, 0})
+	binary.Write(&buf, binary.BigEndian, uint32(len(tags)))
+	binary.Write(&buf, binary.BigEndian, uint32(store.Len()))
+	buf.Write(index.Bytes())
+	buf.Write(store.Bytes())
+	return buf.Bytes()
+}
+
+func (t testTag) writeIndex(index *bytes.Buffer, offset, count int) {
+	binary.Write(index, binary.BigEndian, t.tag)
+	binary.Write(index, binary.BigEndian, t.typ)
+	binary.Write(index, binary.BigEndian, int32(offset))
+	binary.Write(index, binary.BigEndian, uint32(count))
+}
+
+func stringsLen(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return len(v) + 1
+	case []string:
+		n := 0
+		for _, s := range v {
+			n += len(s) + 1
+		}
+		return n
+	}
+	return 0
+}
+
+// buildTestRPM 生成只包含头部和伪造负载的RPM文件
+func buildTestRPM(name, version string, requires []string) []byte {
+	lead := make([]byte, leadSize)
+	copy(lead, leadMagic)
+
+	sig := buildHeader([]testTag{{sigTagPayloadSize, typeInt32, []uint32{4096}}})
+	for len(sig)%8 != 0 {
+		sig = append(sig, 0)
+	}
+
+	requireFlags := make([]uint32, len(requires))
+	requireVersions := make([]string, len(requires))
+	requireFlags = append(requireFlags, senseRpmlib|senseLess|senseEqual)
+	requireVersions = append(requireVersions, "3.0.4-1")
+	hdr := buildHeader([]testTag{
+		{tagName, typeString, name},
+		{tagVersion, typeString, version},
+		{tagRelease, typeString, "1.oe2203"},
+		{tagSummary, typeI18NString, name + " summary"},
+		{tagArch, typeString, "x86_64"},
+		{tagLicense, typeString, "ASL 2.0"},
+		{tagSize, typeInt32, []uint32{1024}},
+		{tagBuildTime, typeInt32, []uint32{1700000000}},
+		{tagProvideName, typeStringArray, []string{name}},
+		{tagProvideFlags, typeInt32, []uint32{senseEqual}},
+		{tagProvideVersion, typeStringArray, []string{"1:" + version + "-1.oe2203"}},
+		{tagRequireName, typeStringArray, append(requires, "rpmlib(CompressedFileNames)")},
+		{tagRequireFlags, typeInt32, requireFlags},
+		{tagRequireVersion, typeStringArray, requireVersions},
+		{tagBaseNames, typeStringArray, []string{name, name}},
+		{tagDirNames, typeStringArray, []string{"/usr/bin/", "/usr/share/doc/"}},
+		{tagDirIndexes, typeInt32, []uint32{0, 1}},
+		{tagFileModes, typeInt16, []uint16{0100755, 040755}},
+	})
+
+	var buf bytes.Buffer
+	buf.Write(lead)
+	buf.Write(sig)
+	buf.Write(hdr)
+	buf.WriteString("payload")
+	return buf.Bytes()
+}
+
+func gunzip(t *testing.T, data []byte) string {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to decompress metadata: %v", err)
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to decompress metadata: %v", err)
+	}
+	return string(content)
+}
+
+func TestRpmRepo(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "x86_64"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	kubeadm := buildTestRPM("kubeadm", "1.29.1", []string{"kubelet", "cri-tools"})
+	if err := os.WriteFile(filepath.Join(dir, "x86_64", "kubeadm-1.29.1-1.oe2203.x86_64.rpm"), kubeadm, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "kubelet-1.29.1-1.oe2203.x86_64.rpm"), buildTestRPM("kubelet", "1.29.1", nil), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken.rpm"), []byte("not an rpm"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ReadPackage Success", func(t *testing.T) {
+		pkg, err := ReadPackage(bytes.NewReader(kubeadm))
+		if err != nil {
+			t.Fatalf("ReadPackage failed: %v", err)
+		}
+		if pkg.Name != "kubeadm" || pkg.Version != "1.29.1" || pkg.Release != "1.oe2203" || pkg.Arch != "x86_64" || pkg.Epoch != "0" {
+			t.Errorf("unexpected package: %+v", pkg)
+		}
+		if pkg.ArchiveSize != 4096 || pkg.InstalledSize != 1024 || pkg.HeaderEnd != uint64(len(kubeadm)-len("payload")) {
+			t.Errorf("unexpected sizes: %+v", pkg)
+		}
+		if len(pkg.Requires) != 2 || pkg.Requires[0].Name != "kubelet" {
+			t.Errorf("rpmlib requirements should be dropped: %+v", pkg.Requires)
+		}
+		if p := pkg.Provides[0]; p.Flags != "EQ" || p.Epoch != "1" || p.Version != "1.29.1" || p.Release != "1.oe2203" {
+			t.Errorf("unexpected provide: %+v", p)
+		}
+		if len(pkg.Files) != 2 || pkg.Files[0].Path != "/usr/bin/kubeadm" || !pkg.Files[1].Dir {
+			t.Errorf("unexpected files: %+v", pkg.Files)
+		}
+	})
+
+	t.Run("ReadPackage Fail", func(t *testing.T) {
+		if _, err := ReadPackage(bytes.NewReader([]byte("not an rpm"))); err == nil {
+			t.Errorf("expected error for an invalid package")
+		}
+		if _, err := ReadPackage(bytes.NewReader(kubeadm[:leadSize+20])); err == nil {
+			t.Errorf("expected error for a truncated package")
+		}
+	})
+
+	t.Run("Header Strings Success", func(t *testing.T) {
+		// 数量超出剩余数据长度时按数据中的字符串返回
+		h := &header{
+			entries: map[int32]indexEntry{1000: {Tag: 1000, Type: typeStringArray, Offset: 0, Count: 0xffffffff}},
+			store:   []byte("a\x00b\x00"),
+		}
+		if values := h.strings(1000); len(values) != 2 || values[0] != "a" || values[1] != "b" {
+			t.Errorf("unexpected strings: %q", values)
+		}
+	})
+
+	t.Run("Build Success", func(t *testing.T) {
+		repo, err := Build(dir)
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+		if len(repo.Packages) != 2 {
+			t.Fatalf("expected 2 packages, got %d", len(repo.Packages))
+		}
+		repomd, ok := repo.Files["repodata/repomd.xml"]
+		if !ok {
+			t.Fatalf("repomd.xml is not generated")
+		}
+		var primary, filelists string
+		for name, content := range repo.Files {
+			if !strings.Contains(string(repomd), `href="`+name+`"`) && name != "repodata/repomd.xml" {
+				t.Errorf("%s is not referenced by repomd.xml", name)
+			}
+			switch {
+			case strings.HasSuffix(name, "-primary.xml.gz"):
+				primary = gunzip(t, content)
+			case strings.HasSuffix(name, "-filelists.xml.gz"):
+				filelists = gunzip(t, content)
+			}
+		}
+		for _, expected := range []string{
+			`xmlns:rpm="http://linux.duke.edu/metadata/rpm"`,
+			`packages="2"`,
+			`<location href="x86_64/kubeadm-1.29.1-1.oe2203.x86_64.rpm">`,
+			`<rpm:entry name="kubelet">`,
+			`<file>/usr/bin/kubeadm</file>`,
+		} {
+			if !strings.Contains(primary, expected) {
+				t.Errorf("primary.xml lacks %s:\n%s", expected, primary)
+			}
+		}
+		if strings.Contains(primary, "/usr/share/doc/") {
+			t.Errorf("primary.xml should only list the primary files")
+		}
+		if !strings.Contains(filelists, `<file type="dir">/usr/share/doc/kubeadm</file>`) {
+			t.Errorf("filelists.xml lacks the directory entry:\n%s", filelists)
+		}
+	})
+
+	t.Run("Build Fail", func(t *testing.T) {
+		if _, err := Build(filepath.Join(dir, "missing")); err == nil {
+			t.Errorf("expected error for a missing directory")
+		}
+	})
+}
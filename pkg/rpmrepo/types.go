@@ -0,0 +1,142 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpmrepo
+
+import "encoding/xml"
+
+// 仓库元数据的XML结构，与 createrepo 生成的格式一致
+
+type primaryMetadata struct {
+	XMLName  xml.Name         `xml:"metadata"`
+	XMLNS    string           `xml:"xmlns,attr"`
+	XMLNSRpm string           `xml:"xmlns:rpm,attr"`
+	Count    int              `xml:"packages,attr"`
+	Packages []primaryPackage `xml:"package"`
+}
+
+type primaryPackage struct {
+	Type        string        `xml:"type,attr"`
+	Name        string        `xml:"name"`
+	Arch        string        `xml:"arch"`
+	Version     version       `xml:"version"`
+	Checksum    checksum      `xml:"checksum"`
+	Summary     string        `xml:"summary"`
+	Description string        `xml:"description"`
+	Packager    string        `xml:"packager"`
+	URL         string        `xml:"url"`
+	Time        packageTime   `xml:"time"`
+	Size        packageSize   `xml:"size"`
+	Location    location      `xml:"location"`
+	Format      primaryFormat `xml:"format"`
+}
+
+type primaryFormat struct {
+	License     string      `xml:"rpm:license"`
+	Vendor      string      `xml:"rpm:vendor"`
+	Group       string      `xml:"rpm:group"`
+	BuildHost   string      `xml:"rpm:buildhost"`
+	SourceRPM   string      `xml:"rpm:sourcerpm"`
+	HeaderRange headerRange `xml:"rpm:header-range"`
+	Provides    *entries    `xml:"rpm:provides,omitempty"`
+	Requires    *entries    `xml:"rpm:requires,omitempty"`
+	Conflicts   *entries    `xml:"rpm:conflicts,omitempty"`
+	Obsoletes   *entries    `xml:"rpm:obsoletes,omitempty"`
+	Files       []fileEntry `xml:"file"`
+}
+
+type version struct {
+	Epoch string `xml:"epoch,attr"`
+	Ver   string `xml:"ver,attr"`
+	Rel   string `xml:"rel,attr"`
+}
+
+type checksum struct {
+	Type  string `xml:"type,attr"`
+	PkgID string `xml:"pkgid,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type packageTime struct {
+	File  int64  `xml:"file,attr"`
+	Build uint64 `xml:"build,attr"`
+}
+
+type packageSize struct {
+	Package   int64  `xml:"package,attr"`
+	Installed uint64 `xml:"installed,attr"`
+	Archive   uint64 `xml:"archive,attr"`
+}
+
+type location struct {
+	Href string `xml:"href,attr"`
+}
+
+type headerRange struct {
+	Start uint64 `xml:"start,attr"`
+	End   uint64 `xml:"end,attr"`
+}
+
+type entries struct {
+	Entries []entry `xml:"rpm:entry"`
+}
+
+type entry struct {
+	Name  string `xml:"name,attr"`
+	Flags string `xml:"flags,attr,omitempty"`
+	Epoch string `xml:"epoch,attr,omitempty"`
+	Ver   string `xml:"ver,attr,omitempty"`
+	Rel   string `xml:"rel,attr,omitempty"`
+	Pre   string `xml:"pre,attr,omitempty"`
+}
+
+type fileEntry struct {
+	Type string `xml:"type,attr,omitempty"`
+	Path string `xml:",chardata"`
+}
+
+type filelistsMetadata struct {
+	XMLName  xml.Name           `xml:"filelists"`
+	XMLNS    string             `xml:"xmlns,attr"`
+	Count    int                `xml:"packages,attr"`
+	Packages []filelistsPackage `xml:"package"`
+}
+
+type filelistsPackage struct {
+	PkgID   string      `xml:"pkgid,attr"`
+	Name    string      `xml:"name,attr"`
+	Arch    string      `xml:"arch,attr"`
+	Version version     `xml:"version"`
+	Files   []fileEntry `xml:"file"`
+}
+
+type repomdMetadata struct {
+	XMLName  xml.Name     `xml:"repomd"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	XMLNSRpm string       `xml:"xmlns:rpm,attr"`
+	Revision string       `xml:"revision"`
+	Data     []repomdData `xml:"data"`
+}
+
+type repomdData struct {
+	Type         string   `xml:"type,attr"`
+	Checksum     checksum `xml:"checksum"`
+	OpenChecksum checksum `xml:"open-checksum"`
+	Location     location `xml:"location"`
+	Timestamp    int64    `xml:"timestamp"`
+	Size         int      `xml:"size"`
+	OpenSize     int      `xml:"open-size"`
+}
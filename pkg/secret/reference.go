@@ -0,0 +1,82 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"fmt"
+	"nestos-kubernetes-deployer/pkg/utils"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// 外部引用前缀
+const (
+	EnvRefPrefix  = "env:"
+	FileRefPrefix = "file:"
+	ExecRefPrefix = "exec:"
+)
+
+// IsReference 判断配置值是否为外部引用
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, EnvRefPrefix) ||
+		strings.HasPrefix(value, FileRefPrefix) ||
+		strings.HasPrefix(value, ExecRefPrefix)
+}
+
+/*
+ResolveReference 解析外部引用，结果去掉末尾换行：
+env:NAME      读取环境变量
+file:PATH     读取文件内容
+exec:COMMAND  执行命令并读取标准输出
+*/
+func ResolveReference(ref string) (string, error) {
+	var value string
+	switch {
+	case strings.HasPrefix(ref, EnvRefPrefix):
+		name := strings.TrimPrefix(ref, EnvRefPrefix)
+		env, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s referenced by %s is not set", name, ref)
+		}
+		value = env
+	case strings.HasPrefix(ref, FileRefPrefix):
+		path := strings.TrimPrefix(ref, FileRefPrefix)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read secret reference %s", ref)
+		}
+		value = string(content)
+	case strings.HasPrefix(ref, ExecRefPrefix):
+		command := strings.TrimPrefix(ref, ExecRefPrefix)
+		out, err := utils.RunCommand(command)
+		if err != nil {
+			// 引用中可能包含敏感参数，错误信息中不输出命令内容
+			return "", errors.Wrap(err, "failed to resolve exec secret reference")
+		}
+		value = out
+	default:
+		return "", fmt.Errorf("%s is not a secret reference", ref)
+	}
+
+	value = strings.TrimRight(value, "\r\n")
+	if value == "" {
+		return "", errors.New("secret reference resolved to an empty value")
+	}
+	return value, nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// 提供加密口令的环境变量
+	PassphraseEnv = "NKD_SECRET_PASSPHRASE"
+	// 提供加密口令文件路径的环境变量
+	KeyFileEnv = "NKD_SECRET_KEY_FILE"
+
+	// SealedPrefix 标识经过加密的数据
+	SealedPrefix = "nkd-sealed:v1:"
+
+	// 敏感文件的权限
+	FileMode os.FileMode = 0600
+
+	// scrypt 参数
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	keyLength    = 32
+	saltLength   = 16
+	minKeyLength = 8
+)
+
+// envelope 为加密后数据的存储结构：
+// 每份数据使用随机生成的数据密钥加密，数据密钥再由口令派生的密钥加密。
+type envelope struct {
+	Salt       []byte `json:"salt"`
+	KeyNonce   []byte `json:"keyNonce"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Sealer 负责nkd持久化目录中敏感数据的加解密
+type Sealer struct {
+	passphrase []byte
+	salt       []byte
+	mutex      sync.Mutex
+	keys       map[string][]byte // 以salt为索引缓存派生密钥，避免重复计算scrypt
+}
+
+func NewSealer(passphrase []byte) (*Sealer, error) {
+	passphrase = bytes.TrimSpace(passphrase)
+	if len(passphrase) < minKeyLength {
+		return nil, fmt.Errorf("secret key material must be at least %d bytes", minKeyLength)
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+
+	return &Sealer{
+		passphrase: passphrase,
+		salt:       salt,
+		keys:       make(map[string][]byte),
+	}, nil
+}
+
+// LoadSealer 按以下顺序查找密钥材料：环境变量 NKD_SECRET_PASSPHRASE、keyFile 参数、环境变量 NKD_SECRET_KEY_FILE。
+// 若均未提供，返回 nil 且不报错。
+func LoadSealer(keyFile string) (*Sealer, error) {
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		return NewSealer([]byte(passphrase))
+	}
+
+	if keyFile == "" {
+		keyFile = os.Getenv(KeyFileEnv)
+	}
+	if keyFile == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read secret key file %s", keyFile)
+	}
+	return NewSealer(content)
+}
+
+// IsSealed 判断数据是否已被加密
+func IsSealed(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(SealedPrefix))
+}
+
+// IsSealedString 判断字符串是否已被加密
+func IsSealedString(s string) bool {
+	return strings.HasPrefix(s, SealedPrefix)
+}
+
+// Seal 加密数据，返回带有 SealedPrefix 前缀的文本
+func (s *Sealer) Seal(plaintext []byte) ([]byte, error) {
+	kek, err := s.deriveKey(s.salt)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, keyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "failed to generate data key")
+	}
+
+	keyNonce, wrappedKey, err := encrypt(kek, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce, ciphertext, err := encrypt(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := json.Marshal(envelope{
+		Salt:       s.salt,
+		KeyNonce:   keyNonce,
+		WrappedKey: wrappedKey,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(SealedPrefix + base64.StdEncoding.EncodeToString(env)), nil
+}
+
+// Open 解密由 Seal 生成的数据，未加密的数据原样返回
+func (s *Sealer) Open(data []byte) ([]byte, error) {
+	if !IsSealed(data) {
+		return data, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data[len(SealedPrefix):])))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode sealed data")
+	}
+	env := envelope{}
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sealed data")
+	}
+
+	kek, err := s.deriveKey(env.Salt)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := decrypt(kek, env.KeyNonce, env.WrappedKey)
+	if err != nil {
+		return nil, errors.New("failed to unwrap data key: wrong secret key material?")
+	}
+	plaintext, err := decrypt(dataKey, env.Nonce, env.Ciphertext)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt sealed data")
+	}
+
+	return plaintext, nil
+}
+
+// SealString 加密字符串，空字符串和已加密的字符串原样返回
+func (s *Sealer) SealString(value string) (string, error) {
+	if value == "" || IsSealedString(value) {
+		return value, nil
+	}
+	sealed, err := s.Seal([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(sealed), nil
+}
+
+// OpenString 解密字符串
+func (s *Sealer) OpenString(value string) (string, error) {
+	plaintext, err := s.Open([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (s *Sealer) deriveKey(salt []byte) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if key, ok := s.keys[string(salt)]; ok {
+		return key, nil
+	}
+	key, err := scrypt.Key(s.passphrase, salt, scryptN, scryptR, scryptP, keyLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive key from secret key material")
+	}
+	s.keys[string(salt)] = key
+	return key, nil
+}
+
+func encrypt(key, plaintext []byte) (nonce []byte, ciphertext []byte, err error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce = make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return nonce, gcm.Seal(nil, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, nonce, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ReadFile 读取文件内容，若内容已加密则使用 sealer 解密
+func ReadFile(path string, sealer *Sealer) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSealed(content) {
+		return content, nil
+	}
+	if sealer == nil {
+		return nil, fmt.Errorf("%s is encrypted but no secret key material is provided (set %s or %s)", path, PassphraseEnv, KeyFileEnv)
+	}
+	return sealer.Open(content)
+}
+
+// WriteFile 以0600权限写入敏感数据，sealer 非空时先加密
+func WriteFile(path string, data []byte, sealer *Sealer) error {
+	if sealer != nil {
+		sealed, err := sealer.Seal(data)
+		if err != nil {
+			return err
+		}
+		data = sealed
+	}
+	return os.WriteFile(path, data, FileMode)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2024 KylinSoft  Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSealer(t *testing.T) {
+	sealer, err := NewSealer([]byte("nkd-test-passphrase"))
+	if err != nil {
+		t.Fatalf("NewSealer failed: %v", err)
+	}
+
+	t.Run("SealString Success", func(t *testing.T) {
+		sealed, err := sealer.SealString("secret-token")
+		if err != nil {
+			t.Fatalf("SealString failed: %v", err)
+		}
+		if !IsSealedString(sealed) {
+			t.Errorf("sealed value has no prefix: %s", sealed)
+		}
+
+		opened, err := sealer.OpenString(sealed)
+		if err != nil || opened != "secret-token" {
+			t.Errorf("OpenString failed: %v, %s", err, opened)
+		}
+	})
+
+	t.Run("SealString Empty", func(t *testing.T) {
+		sealed, err := sealer.SealString("")
+		if err != nil || sealed != "" {
+			t.Errorf("empty value should not be sealed: %v, %s", err, sealed)
+		}
+	})
+
+	t.Run("Open Wrong Key Fail", func(t *testing.T) {
+		sealed, err := sealer.Seal([]byte("secret-token"))
+		if err != nil {
+			t.Fatalf("Seal failed: %v", err)
+		}
+		other, _ := NewSealer([]byte("another-passphrase"))
+		if _, err := other.Open(sealed); err == nil {
+			t.Errorf("Open with wrong key material should fail")
+		}
+	})
+
+	t.Run("NewSealer Short Key Fail", func(t *testing.T) {
+		if _, err := NewSealer([]byte("short")); err == nil {
+			t.Errorf("NewSealer with short key material should fail")
+		}
+	})
+
+	t.Run("WriteFile ReadFile Success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.key")
+		if err := WriteFile(path, []byte("private key"), sealer); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+
+		info, err := os.Stat(path)
+		if err != nil || info.Mode().Perm() != FileMode {
+			t.Errorf("unexpected file mode: %v", err)
+		}
+
+		if _, err := ReadFile(path, nil); err == nil {
+			t.Errorf("ReadFile of sealed file without sealer should fail")
+		}
+		content, err := ReadFile(path, sealer)
+		if err != nil || string(content) != "private key" {
+			t.Errorf("ReadFile failed: %v, %s", err, content)
+		}
+	})
+}
+
+func TestLoadSealer(t *testing.T) {
+	t.Run("LoadSealer None", func(t *testing.T) {
+		t.Setenv(PassphraseEnv, "")
+		t.Setenv(KeyFileEnv, "")
+		sealer, err := LoadSealer("")
+		if err != nil || sealer != nil {
+			t.Errorf("LoadSealer without key material should return nil: %v", err)
+		}
+	})
+
+	t.Run("LoadSealer KeyFile Success", func(t *testing.T) {
+		t.Setenv(PassphraseEnv, "")
+		keyFile := filepath.Join(t.TempDir(), "key")
+		if err := os.WriteFile(keyFile, []byte("nkd-test-passphrase\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		sealer, err := LoadSealer(keyFile)
+		if err != nil || sealer == nil {
+			t.Errorf("LoadSealer failed: %v", err)
+		}
+	})
+}
+
+func TestResolveReference(t *testing.T) {
+	t.Run("ResolveReference Env Success", func(t *testing.T) {
+		t.Setenv("NKD_TEST_SECRET", "from-env")
+		value, err := ResolveReference("env:NKD_TEST_SECRET")
+		if err != nil || value != "from-env" {
+			t.Errorf("ResolveReference env failed: %v, %s", err, value)
+		}
+	})
+
+	t.Run("ResolveReference File Success", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "secret")
+		if err := os.WriteFile(path, []byte("from-file\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		value, err := ResolveReference("file:" + path)
+		if err != nil || value != "from-file" {
+			t.Errorf("ResolveReference file failed: %v, %s", err, value)
+		}
+	})
+
+	t.Run("ResolveReference Exec Success", func(t *testing.T) {
+		value, err := ResolveReference("exec:echo from-exec")
+		if err != nil || value != "from-exec" {
+			t.Errorf("ResolveReference exec failed: %v, %s", err, value)
+		}
+	})
+
+	t.Run("ResolveReference Fail", func(t *testing.T) {
+		os.Unsetenv("NKD_TEST_UNSET_SECRET")
+		if _, err := ResolveReference("env:NKD_TEST_UNSET_SECRET"); err == nil {
+			t.Errorf("ResolveReference of unset env should fail")
+		}
+		if IsReference("plain-password") {
+			t.Errorf("plain value should not be a reference")
+		}
+	})
+}
@@ -17,12 +17,15 @@ limitations under the License.
 package terraform
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"nestos-kubernetes-deployer/data"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"nestos-kubernetes-deployer/pkg/utils"
 	"os"
 	"path/filepath"
@@ -34,6 +37,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// userDataEnv 通用操作系统节点的 user-data 通过环境变量提供给 terraform，不写入 terraform 配置文件
+const userDataEnv = "TF_VAR_instance_user_data"
+
+// userData 以 terraform 配置目录为索引，记录 Generate 生成的 user-data 变量值
+var userData = map[string]string{}
+
 type Infra struct {
 	ClusterID   string
 	Platform    interface{}
@@ -70,6 +79,10 @@ type Node struct {
 	IP         []string
 	BootConfig []string
 	WWN        []string
+	// 节点的 cloud-init network-config 路径，未设置时为 null
+	NetworkConfig []string
+	// 节点是否使用静态地址，使用静态地址时不等待 DHCP 租约
+	StaticNetwork []string
 }
 
 func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error) {
@@ -102,6 +115,7 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 		return err
 	}
 
+	var bootConfigs []string
 	if node == "master" {
 		var (
 			master_cpu        []uint
@@ -111,6 +125,8 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 			master_ip         []string
 			master_bootConfig []string
 			master_wwn        []string
+			master_network    []string
+			master_static     []string
 		)
 
 		infra.Master.Count = len(conf.Master)
@@ -126,6 +142,13 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 			}
 			master_wwn = append(master_wwn, wwn)
 			master_ip = append(master_ip, master.IP)
+			master_network = append(master_network, networkConfigPath(&master))
+			master_static = append(master_static, staticNetwork(&master))
+			// 优先使用节点专属引导配置
+			if master.BootConfig.Path != "" {
+				master_bootConfig = append(master_bootConfig, master.BootConfig.Path)
+				continue
+			}
 			if i == 0 {
 				master_bootConfig = append(master_bootConfig, conf.BootConfig.Controlplane.Path)
 				continue
@@ -152,6 +175,7 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 		if err != nil {
 			return err
 		}
+		bootConfigs = master_bootConfig
 		infra.Master.BootConfig, err = convertSliceToStrings(master_bootConfig)
 		if err != nil {
 			return err
@@ -160,6 +184,14 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 		if err != nil {
 			return err
 		}
+		infra.Master.NetworkConfig, err = convertSliceToStrings(master_network)
+		if err != nil {
+			return err
+		}
+		infra.Master.StaticNetwork, err = convertSliceToStrings(master_static)
+		if err != nil {
+			return err
+		}
 	} else if node == "worker" {
 		var (
 			worker_cpu        []uint
@@ -169,6 +201,8 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 			worker_ip         []string
 			worker_bootConfig []string
 			worker_wwn        []string
+			worker_network    []string
+			worker_static     []string
 		)
 
 		infra.Worker.Count = len(conf.Worker)
@@ -181,7 +215,13 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 			}
 			worker_ip = append(worker_ip, worker.IP)
 			worker_hostname = append(worker_hostname, worker.Hostname)
-			worker_bootConfig = append(worker_bootConfig, conf.BootConfig.Worker.Path)
+			worker_network = append(worker_network, networkConfigPath(&worker))
+			worker_static = append(worker_static, staticNetwork(&worker))
+			if worker.BootConfig.Path != "" {
+				worker_bootConfig = append(worker_bootConfig, worker.BootConfig.Path)
+			} else {
+				worker_bootConfig = append(worker_bootConfig, conf.BootConfig.Worker.Path)
+			}
 			wwn, err := utils.GenerateWWN()
 			if err != nil {
 				logrus.Errorf("Failed to generate WWN: %v", err)
@@ -209,6 +249,7 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 		if err != nil {
 			return err
 		}
+		bootConfigs = worker_bootConfig
 		infra.Worker.BootConfig, err = convertSliceToStrings(worker_bootConfig)
 		if err != nil {
 			return err
@@ -217,6 +258,14 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 		if err != nil {
 			return err
 		}
+		infra.Worker.NetworkConfig, err = convertSliceToStrings(worker_network)
+		if err != nil {
+			return err
+		}
+		infra.Worker.StaticNetwork, err = convertSliceToStrings(worker_static)
+		if err != nil {
+			return err
+		}
 	}
 
 	var arch string
@@ -236,6 +285,13 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 	if err := os.MkdirAll(filepath.Join(persistDir, conf.ClusterID, node), 0644); err != nil {
 		return err
 	}
+	if strings.ToLower(conf.OSImage.Type) == "generalos" {
+		value, err := userDataValue(bootConfigs)
+		if err != nil {
+			return err
+		}
+		userData[filepath.Join(persistDir, conf.ClusterID, node)] = value
+	}
 
 	outputFile, err := os.Create(filepath.Join(persistDir, conf.ClusterID, node, fmt.Sprintf("%s.tf", node)))
 	if err != nil {
@@ -268,6 +324,38 @@ func (infra *Infra) Generate(conf *asset.ClusterAsset, node string) (err error)
 	return nil
 }
 
+// userDataValue 读取节点的 cloud-init 配置（按敏感文件保存，可能已加密），返回 base64 编码后的 terraform 列表变量值
+func userDataValue(paths []string) (string, error) {
+	values := make([]string, 0, len(paths))
+	for _, path := range paths {
+		content, err := secret.ReadFile(path, configmanager.GetSecretSealer())
+		if err != nil {
+			logrus.Errorf("Failed to read user-data %s: %v", path, err)
+			return "", err
+		}
+		values = append(values, base64.StdEncoding.EncodeToString(content))
+	}
+	value, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func networkConfigPath(node *asset.NodeAsset) string {
+	if node.NetworkConfig.Path == "" {
+		return "null"
+	}
+	return node.NetworkConfig.Path
+}
+
+func staticNetwork(node *asset.NodeAsset) string {
+	if network := node.EffectiveNetwork(); network != nil && network.Static() {
+		return "true"
+	}
+	return "false"
+}
+
 func convertSliceToStrings(slice interface{}) ([]string, error) {
 	sliceValue := reflect.ValueOf(slice)
 	if sliceValue.Kind() != reflect.Slice {
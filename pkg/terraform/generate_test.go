@@ -16,12 +16,16 @@ limitations under the License.
 package terraform
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"nestos-kubernetes-deployer/pkg/configmanager"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset"
 	"nestos-kubernetes-deployer/pkg/configmanager/asset/infraasset"
 	"nestos-kubernetes-deployer/pkg/configmanager/globalconfig"
+	"nestos-kubernetes-deployer/pkg/secret"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -87,3 +91,52 @@ func TestGenerate(t *testing.T) {
 		fmt.Println("Directory removed successfully")
 	}
 }
+
+func TestNodeNetwork(t *testing.T) {
+	static := &asset.NodeAsset{
+		Hostname: "worker",
+		Network: &asset.NodeNetwork{
+			Interfaces: []asset.NetworkInterface{{Name: "eth0", Addresses: []string{"192.168.1.12/24"}}},
+		},
+		NetworkConfig: asset.BootFile{Path: "/nkd/k8s-001/nodes/worker-network-config.yaml"},
+	}
+	if staticNetwork(static) != "true" || networkConfigPath(static) != static.NetworkConfig.Path {
+		t.Errorf("unexpected network settings of a node with static addresses")
+	}
+
+	dhcp := &asset.NodeAsset{Hostname: "worker", IP: "192.168.1.12"}
+	if staticNetwork(dhcp) != "false" || networkConfigPath(dhcp) != "null" {
+		t.Errorf("unexpected network settings of a node using dhcp")
+	}
+}
+
+func TestUserDataValue(t *testing.T) {
+	sealer, err := secret.NewSealer([]byte("test-passphrase"))
+	if err != nil {
+		t.Fatalf("NewSealer failed: %v", err)
+	}
+	configmanager.Sealer = sealer
+	defer func() { configmanager.Sealer = nil }()
+
+	content := []byte("#cloud-config\nhostname: worker\n")
+	path := filepath.Join(t.TempDir(), "worker.cfg")
+	if err := secret.WriteFile(path, content, sealer); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	value, err := userDataValue([]string{path})
+	if err != nil {
+		t.Fatalf("userDataValue failed: %v", err)
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(value), &values); err != nil || len(values) != 1 {
+		t.Fatalf("unexpected value %s: %v", value, err)
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(values[0]); err != nil || string(decoded) != string(content) {
+		t.Errorf("unexpected user-data %q: %v", decoded, err)
+	}
+
+	if _, err := userDataValue([]string{filepath.Join(t.TempDir(), "master.cfg")}); err == nil {
+		t.Error("expected failure for a missing user-data file")
+	}
+}
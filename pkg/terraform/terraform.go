@@ -107,6 +107,11 @@ func TFApply(tfFileDir string, persistDir string, applyOpts ...tfexec.ApplyOptio
 		return errors.Wrap(err, "failed to create a new tfexec")
 	}
 
+	// tfexec 不允许通过 SetEnv 设置 TF_VAR_ 变量，因此仅在 apply 期间设置到进程环境中
+	if value, ok := userData[tfFileDir]; ok {
+		os.Setenv(userDataEnv, value)
+		defer os.Unsetenv(userDataEnv)
+	}
 	err = tf.Apply(context.Background(), applyOpts...)
 	if err != nil {
 		return errors.Wrap(err, "failed to apply Terraform")
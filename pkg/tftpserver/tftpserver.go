@@ -17,19 +17,25 @@ limitations under the License.
 package tftpserver
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pin/tftp"
 	"github.com/sirupsen/logrus"
 )
 
 type TFTPService struct {
-	IP      string
-	Port    string
-	RootDir string
-	server  *tftp.Server
+	IP       string
+	Port     string
+	RootDir  string
+	Writable bool // 是否允许客户端上传文件，默认只读
+	server   *tftp.Server
+	files    map[string][]byte // 由nkd生成、保存在内存中的文件
+	links    map[string]string // 映射到根目录之外的本地文件
 }
 
 func NewTFTPService(ip string, port string, rootDir string) *TFTPService {
@@ -37,14 +43,34 @@ func NewTFTPService(ip string, port string, rootDir string) *TFTPService {
 		IP:      ip,
 		Port:    port,
 		RootDir: rootDir,
+		files:   make(map[string][]byte),
+		links:   make(map[string]string),
 	}
 }
 
+// AddFile 在内存中提供文件，不修改TFTP根目录，name 为相对于根目录的路径。需在 Start 之前调用
+func (t *TFTPService) AddFile(name string, content []byte) {
+	t.files[cleanName(name)] = content
+}
+
+// AddLink 以 name 提供本地文件 path 的内容。需在 Start 之前调用
+func (t *TFTPService) AddLink(name string, path string) {
+	t.links[cleanName(name)] = path
+}
+
 func (t *TFTPService) Start() error {
 	tftpHandler := TFTPHandler{
 		RootDir: t.RootDir,
+		Files:   t.files,
+		Links:   t.links,
+	}
+	if t.Writable {
+		t.server = tftp.NewServer(tftpHandler.ReadHandler, tftpHandler.WriteHandler)
+		logrus.Warnf("TFTP server accepts write requests under %s", t.RootDir)
+	} else {
+		// 未设置写处理函数时，服务端拒绝所有写请求
+		t.server = tftp.NewServer(tftpHandler.ReadHandler, nil)
 	}
-	t.server = tftp.NewServer(tftpHandler.ReadHandler, tftpHandler.WriteHandler)
 	tftpServerAddr := t.IP + ":" + t.Port
 	logrus.Printf("TFTP server is listening on %s\n", tftpServerAddr)
 	err := t.server.ListenAndServe(tftpServerAddr)
@@ -56,44 +82,132 @@ func (t *TFTPService) Start() error {
 	return nil
 }
 
+// TFTPHandler 处理TFTP请求。请求的文件依次从内存中的文件、链接文件及根目录中查找，
+// 根目录中的文件（包括符号链接的目标）必须位于根目录之内
 type TFTPHandler struct {
 	RootDir string
+	Files   map[string][]byte
+	Links   map[string]string
 }
 
 // ReadHandler handles TFTP read requests
 func (h *TFTPHandler) ReadHandler(filename string, rf io.ReaderFrom) error {
-	filePath := filepath.Join(h.RootDir, filename)
-	file, err := os.Open(filePath)
+	client := remoteIP(rf)
+	name, err := normalizeName(filename)
 	if err != nil {
+		logrus.Warnf("TFTP read of %q from %s rejected: %v", filename, client, err)
 		return err
 	}
-	defer file.Close()
 
-	_, err = rf.ReadFrom(file)
+	var reader io.Reader
+	if content, ok := h.Files[name]; ok {
+		reader = bytes.NewReader(content)
+	} else {
+		filePath, ok := h.Links[name]
+		if !ok {
+			filePath, err = h.resolve(name)
+			if err != nil {
+				logrus.Warnf("TFTP read of %s from %s rejected: %v", name, client, err)
+				return err
+			}
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			logrus.Warnf("TFTP read of %s from %s failed: %v", name, client, err)
+			return err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	n, err := rf.ReadFrom(reader)
 	if err != nil {
+		logrus.Warnf("TFTP read of %s from %s failed after %d bytes: %v", name, client, n, err)
 		return err
 	}
+	logrus.Infof("TFTP read of %s from %s: %d bytes", name, client, n)
 
 	return nil
 }
 
 // WriteHandler handles TFTP write requests
 func (h *TFTPHandler) WriteHandler(filename string, wt io.WriterTo) error {
-	filePath := filepath.Join(h.RootDir, filename)
+	client := remoteIP(wt)
+	name, err := normalizeName(filename)
+	if err != nil {
+		logrus.Warnf("TFTP write of %q from %s rejected: %v", filename, client, err)
+		return err
+	}
+	if _, ok := h.Files[name]; ok {
+		err := fmt.Errorf("%s is served by nkd", name)
+		logrus.Warnf("TFTP write of %s from %s rejected: %v", name, client, err)
+		return err
+	}
+	if _, ok := h.Links[name]; ok {
+		err := fmt.Errorf("%s is served by nkd", name)
+		logrus.Warnf("TFTP write of %s from %s rejected: %v", name, client, err)
+		return err
+	}
+
+	// 目标文件所在目录须位于根目录之内，目标文件不能是符号链接
+	dir, err := h.resolve(filepath.Dir(filepath.FromSlash(name)))
+	if err != nil {
+		logrus.Warnf("TFTP write of %s from %s rejected: %v", name, client, err)
+		return err
+	}
+	filePath := filepath.Join(dir, filepath.Base(filepath.FromSlash(name)))
+	if info, err := os.Lstat(filePath); err == nil && !info.Mode().IsRegular() {
+		err := fmt.Errorf("%s is not a regular file", name)
+		logrus.Warnf("TFTP write of %s from %s rejected: %v", name, client, err)
+		return err
+	}
+
 	file, err := os.Create(filePath)
 	if err != nil {
+		logrus.Warnf("TFTP write of %s from %s failed: %v", name, client, err)
 		return err
 	}
 	defer file.Close()
 
-	_, err = wt.WriteTo(file)
+	n, err := wt.WriteTo(file)
 	if err != nil {
+		logrus.Warnf("TFTP write of %s from %s failed after %d bytes: %v", name, client, n, err)
 		return err
 	}
+	logrus.Infof("TFTP write of %s from %s: %d bytes", name, client, n)
 
 	return nil
 }
 
+// resolve 返回根目录中 name 对应文件解析符号链接后的路径，路径不在根目录之内时返回错误
+func (h *TFTPHandler) resolve(name string) (string, error) {
+	root := h.RootDir
+	if root == "" {
+		root = "."
+	}
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+
+	filePath, err := filepath.EvalSymlinks(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		return "", err
+	}
+	filePath, err = filepath.Abs(filePath)
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(root, filePath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside of the TFTP root directory", name)
+	}
+	return filePath, nil
+}
+
 func (t *TFTPService) Stop() error {
 	if t.server != nil {
 		t.server.Shutdown()
@@ -102,3 +216,39 @@ func (t *TFTPService) Stop() error {
 
 	return nil
 }
+
+// normalizeName 将客户端请求的路径转换为相对于根目录的路径，
+// 部分客户端使用"\"作为分隔符，路径中包含".."时返回错误
+func normalizeName(filename string) (string, error) {
+	name := strings.ReplaceAll(filename, "\\", "/")
+	if strings.ContainsRune(name, 0) {
+		return "", fmt.Errorf("invalid file name %q", filename)
+	}
+	for _, elem := range strings.Split(name, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("file name %q contains \"..\"", filename)
+		}
+	}
+	if name = cleanName(name); name == "" {
+		return "", fmt.Errorf("invalid file name %q", filename)
+	}
+	return name, nil
+}
+
+// cleanName 去掉客户端请求路径开头的"/"
+func cleanName(name string) string {
+	return strings.TrimLeft(filepath.ToSlash(filepath.Clean("/"+name)), "/")
+}
+
+// remoteIP 返回发起请求的客户端地址
+func remoteIP(transfer interface{}) string {
+	switch t := transfer.(type) {
+	case tftp.OutgoingTransfer:
+		addr := t.RemoteAddr()
+		return addr.IP.String()
+	case tftp.IncomingTransfer:
+		addr := t.RemoteAddr()
+		return addr.IP.String()
+	}
+	return "unknown"
+}
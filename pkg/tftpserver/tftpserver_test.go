@@ -17,8 +17,10 @@ limitations under the License.
 package tftpserver
 
 import (
+	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -93,3 +95,100 @@ func TestTFTPServer(t *testing.T) {
 	})
 
 }
+
+func TestTFTPOverlay(t *testing.T) {
+	service := NewTFTPService("127.0.0.1", "69", "testDir")
+	service.AddFile("/pxelinux.cfg/default", []byte("DEFAULT local"))
+	service.AddLink("nkd/vmlinuz", "tftpserver.go")
+	handler := TFTPHandler{RootDir: service.RootDir, Files: service.files, Links: service.links}
+
+	t.Run("ReadHandler File Success", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := handler.ReadHandler("pxelinux.cfg/default", buf); err != nil {
+			t.Fatalf("ReadHandler failed: %v", err)
+		}
+		if buf.String() != "DEFAULT local" {
+			t.Errorf("unexpected content: %s", buf.String())
+		}
+	})
+
+	t.Run("ReadHandler Link Success", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := handler.ReadHandler("/nkd/vmlinuz", buf); err != nil {
+			t.Fatalf("ReadHandler failed: %v", err)
+		}
+		if !bytes.Contains(buf.Bytes(), []byte("package tftpserver")) {
+			t.Error("unexpected content of the linked file")
+		}
+	})
+
+	t.Run("ReadHandler Fail", func(t *testing.T) {
+		if err := handler.ReadHandler("pxelinux.cfg/01-52-54-00-aa-bb-01", &bytes.Buffer{}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestTFTPConfinement(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "pxelinux.0"), []byte("pxelinux"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "secret"), filepath.Join(root, "escape")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escapedir")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("pxelinux.0", filepath.Join(root, "inside")); err != nil {
+		t.Fatal(err)
+	}
+	handler := TFTPHandler{RootDir: root}
+
+	t.Run("ReadHandler Success", func(t *testing.T) {
+		for _, name := range []string{"pxelinux.0", "/pxelinux.0", "\\pxelinux.0", "./inside"} {
+			buf := &bytes.Buffer{}
+			if err := handler.ReadHandler(name, buf); err != nil {
+				t.Errorf("ReadHandler %s failed: %v", name, err)
+			} else if buf.String() != "pxelinux" {
+				t.Errorf("unexpected content of %s: %s", name, buf.String())
+			}
+		}
+	})
+
+	t.Run("ReadHandler Fail", func(t *testing.T) {
+		for _, name := range []string{"../secret", "a/../../secret", "..\\secret", "escape", "escapedir/secret", "", "/", "pxe\x00"} {
+			if err := handler.ReadHandler(name, &bytes.Buffer{}); err == nil {
+				t.Errorf("expected ReadHandler %q to be rejected", name)
+			}
+		}
+	})
+
+	t.Run("WriteHandler Success", func(t *testing.T) {
+		if err := handler.WriteHandler("upload", &RFWT{}); err != nil {
+			t.Fatalf("WriteHandler failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(root, "upload")); err != nil {
+			t.Errorf("expected the uploaded file to be created: %v", err)
+		}
+	})
+
+	t.Run("WriteHandler Fail", func(t *testing.T) {
+		overlay := TFTPHandler{RootDir: root, Files: map[string][]byte{"grub.cfg": nil}}
+		for _, name := range []string{"../upload", "escape", "escapedir/upload", "grub.cfg"} {
+			if err := overlay.WriteHandler(name, &RFWT{}); err == nil {
+				t.Errorf("expected WriteHandler %q to be rejected", name)
+			}
+		}
+		if content, _ := os.ReadFile(filepath.Join(outside, "secret")); string(content) != "secret" {
+			t.Errorf("file outside of the root was modified")
+		}
+		if _, err := os.Stat(filepath.Join(outside, "upload")); err == nil {
+			t.Errorf("file was created outside of the root")
+		}
+	})
+}
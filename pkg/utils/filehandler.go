@@ -17,15 +17,29 @@ package utils
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"nestos-kubernetes-deployer/data"
+	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// manifestFetchTimeout is the timeout of downloading a manifest
+	manifestFetchTimeout = 5 * time.Minute
+	// maxManifestSize is the maximum size of a manifest
+	maxManifestSize = 32 << 20
+)
+
 // FetchAndUnmarshalURL fetches content from a specified URL, unmarshals it into the provided structure,
 func FetchAndUnmarshalUrl(url string, tmplData interface{}) ([]byte, error) {
 	file, err := data.Assets.Open(url)
@@ -75,3 +89,50 @@ func applyTmplData(tmpl *template.Template, data interface{}) string {
 	}
 	return buf.String()
 }
+
+// IsURL reports whether source is an HTTP(S) URL rather than a local path.
+func IsURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// FetchManifest reads a manifest from a local path or downloads it from an HTTP(S) URL.
+// The content is verified against sha256sum if it is not empty.
+func FetchManifest(source string, sha256sum string) ([]byte, error) {
+	var content []byte
+	if IsURL(source) {
+		client := &http.Client{Timeout: manifestFetchTimeout}
+		resp, err := client.Get(source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to download manifest")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to download manifest %s: %s", source, resp.Status)
+		}
+		content, err = io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to download manifest")
+		}
+		if len(content) > maxManifestSize {
+			return nil, fmt.Errorf("manifest %s exceeds %d bytes", source, maxManifestSize)
+		}
+	} else {
+		var err error
+		content, err = os.ReadFile(source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read manifest")
+		}
+	}
+
+	if sha256sum == "" {
+		if IsURL(source) {
+			logrus.Warnf("The checksum of manifest %s is not verified", source)
+		}
+		return content, nil
+	}
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, sha256sum) {
+		return nil, fmt.Errorf("checksum mismatch of manifest %s: expected %s, got %s", source, sha256sum, actual)
+	}
+	return content, nil
+}
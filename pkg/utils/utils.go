@@ -102,6 +102,19 @@ func ConstructURL(bootstrapIgnitionHost string, role string) string {
 	return u.String()
 }
 
+// ConstructSecureURL constructs the https URL of a protected bootstrap file, the access token is carried in the query
+func ConstructSecureURL(bootstrapIgnitionHost string, role string, token string) string {
+	u := url.URL{
+		Scheme: "https",
+		Host:   bootstrapIgnitionHost,
+		Path:   role,
+	}
+	if token != "" {
+		u.RawQuery = url.Values{"token": []string{token}}.Encode()
+	}
+	return u.String()
+}
+
 // GenerateWWN generates a random 16-byte WWN (World Wide Name)
 func GenerateWWN() (string, error) {
 	wwnBytes := make([]byte, 8)